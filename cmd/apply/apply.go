@@ -0,0 +1,237 @@
+// Package apply reconciles the resources of an instance with a declarative document,
+// applying it repeatedly results in the same state.
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	"github.com/zitadel/zitadel/cmd/key"
+	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/cache/connector"
+	"github.com/zitadel/zitadel/internal/command"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
+	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
+)
+
+const (
+	// ApplyUserID is set as editor of all events created by the apply command
+	ApplyUserID = "APPLY"
+
+	flagFile     = "file"
+	flagInstance = "instance"
+	flagDryRun   = "dry-run"
+	flagPrune    = "prune"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "applies a declarative configuration to an instance",
+		Long: `applies a declarative configuration of orgs, projects, roles, apps, identity providers, policies and targets to an instance
+the document (YAML or JSON) describes the desired state, the required changes are printed as plan and executed afterwards
+resources are identified by their names, resources which are not described are only deleted if --prune is set
+orgs themselves are never deleted`,
+		Example: `apply --instance 840498034930840 -f zitadel.yaml
+apply --instance 840498034930840 -f orgs.yaml -f targets.yaml --dry-run
+cat zitadel.yaml | apply --instance 840498034930840 -f - --prune`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, _ := cmd.Flags().GetStringArray(flagFile)
+			instanceID, _ := cmd.Flags().GetString(flagInstance)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+			prune, _ := cmd.Flags().GetBool(flagPrune)
+
+			doc, err := readDocuments(cmd.InOrStdin(), files...)
+			if err != nil {
+				return err
+			}
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			return apply(cmd.Context(), cmd.OutOrStdout(), config, masterKey, instanceID, doc, dryRun, prune)
+		},
+	}
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().StringArrayP(flagFile, "f", nil, "path to the document, - reads from stdin, the documents of multiple files are merged")
+	cmd.Flags().String(flagInstance, "", "id of the instance the document is applied to")
+	cmd.Flags().Bool(flagDryRun, false, "only prints the plan without executing it")
+	cmd.Flags().Bool(flagPrune, false, "deletes the resources of the described orgs and the targets which are not part of the document")
+	logging.OnError(cmd.MarkFlagRequired(flagFile)).Fatal("unable to mark flag required")
+	logging.OnError(cmd.MarkFlagRequired(flagInstance)).Fatal("unable to mark flag required")
+	return cmd
+}
+
+func apply(ctx context.Context, out io.Writer, config *Config, masterKey, instanceID string, doc *Document, dryRun, prune bool) error {
+	queries, commands, err := start(ctx, config, masterKey)
+	if err != nil {
+		return err
+	}
+	ctx, err = instanceContext(ctx, queries, instanceID)
+	if err != nil {
+		return err
+	}
+	// the current state is read from the projections, so they must be up to date
+	if err = projection.ProjectInstance(ctx); err != nil {
+		return err
+	}
+
+	current, err := loadState(ctx, queries, doc)
+	if err != nil {
+		return err
+	}
+	p := newPlan(doc, current, prune)
+	if len(p) == 0 {
+		fmt.Fprintln(out, "no changes, the instance matches the document")
+		return nil
+	}
+	p.print(out)
+	if dryRun {
+		return nil
+	}
+	fmt.Fprintln(out)
+	return newExecutor(commands, queries, out, current).execute(ctx, p)
+}
+
+// instanceContext sets the instance, the editor of the events and the primary domain of the instance,
+// which is required for the domains of created orgs
+func instanceContext(ctx context.Context, queries *query.Queries, instanceID string) (context.Context, error) {
+	instance, err := queries.InstanceByID(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = internal_authz.SetCtxData(internal_authz.WithInstance(ctx, instance), internal_authz.CtxData{UserID: ApplyUserID})
+
+	primaryQuery, err := query.NewInstanceDomainPrimarySearchQuery(true)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := queries.SearchInstanceDomains(ctx, &query.InstanceDomainSearchQueries{Queries: []query.SearchQuery{primaryQuery}})
+	if err != nil {
+		return nil, err
+	}
+	if len(domains.Domains) == 0 {
+		return nil, fmt.Errorf("instance %s has no primary domain", instanceID)
+	}
+	return http_util.WithDomainContext(ctx, &http_util.DomainCtx{InstanceHost: domains.Domains[0].Domain}), nil
+}
+
+// start creates the queries and commands without starting the projections in the background.
+// The permissions are not checked as the command has direct access to the database anyway.
+func start(ctx context.Context, config *Config, masterKey string) (*query.Queries, *command.Commands, error) {
+	queryDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectionDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeProjectionSpooler)
+	if err != nil {
+		return nil, nil, err
+	}
+	esPusherDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeEventPusher)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyStorage, err := crypto_db.NewKeyStorage(queryDBClient, masterKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	esPusher := new_es.NewEventstore(esPusherDBClient, new_es.WithPersonalData(keys.PersonalData))
+	config.Eventstore.Pusher = esPusher
+	config.Eventstore.Searcher = esPusher
+	config.Eventstore.PersonalData = esPusher
+	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
+	es := eventstore.NewEventstore(config.Eventstore)
+	esV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(queryDBClient, &es_v4_pg.Config{
+		MaxRetries: config.Eventstore.MaxRetries,
+	}))
+
+	cacheConnectors, err := connector.StartConnectors(config.Caches, queryDBClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	sessionTokenVerifier := internal_authz.SessionTokenVerifier(keys.OIDC)
+	permissionCheck := func(ctx context.Context, permission, orgID, resourceID string) error {
+		return nil
+	}
+
+	queries, err := query.StartQueries(
+		ctx,
+		es,
+		esV4.Querier,
+		queryDBClient,
+		projectionDBClient,
+		cacheConnectors,
+		config.Projections,
+		config.SystemDefaults,
+		keys.IDPConfig,
+		keys.OTP,
+		keys.OIDC,
+		keys.SAML,
+		config.InternalAuthZ.RolePermissionMappings,
+		sessionTokenVerifier,
+		func(q *query.Queries) domain.PermissionCheck {
+			return permissionCheck
+		},
+		0,
+		config.SystemAPIUsers,
+		false,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commands, err := command.StartCommands(ctx,
+		es,
+		cacheConnectors,
+		config.SystemDefaults,
+		config.InternalAuthZ.RolePermissionMappings,
+		nil,
+		nil,
+		config.ExternalDomain,
+		config.ExternalSecure,
+		config.ExternalPort,
+		keys.IDPConfig,
+		keys.OTP,
+		keys.SMTP,
+		keys.SMS,
+		keys.User,
+		keys.DomainVerification,
+		keys.OIDC,
+		keys.SAML,
+		&http.Client{},
+		permissionCheck,
+		sessionTokenVerifier,
+		0,
+		0,
+		0,
+		config.DefaultInstance.SecretGenerators,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return queries, commands, nil
+}
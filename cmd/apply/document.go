@@ -0,0 +1,320 @@
+package apply
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// Document describes the desired state of the resources of an instance.
+// Resources are identified by their names, orgs and targets by name within the instance,
+// projects and identity providers by name within the org,
+// roles by key and apps by name within the project.
+type Document struct {
+	Targets []*Target `json:"targets,omitempty"`
+	Orgs    []*Org    `json:"orgs,omitempty"`
+}
+
+type Target struct {
+	Name string `json:"name"`
+	// Type is one of webhook, call or async
+	Type             string   `json:"type"`
+	Endpoint         string   `json:"endpoint"`
+	Timeout          Duration `json:"timeout"`
+	InterruptOnError bool     `json:"interruptOnError,omitempty"`
+}
+
+type Org struct {
+	Name string `json:"name"`
+	// LoginPolicy is set as custom policy of the org, the default policy of the instance is used if empty
+	LoginPolicy *LoginPolicy `json:"loginPolicy,omitempty"`
+	// PasswordComplexityPolicy is set as custom policy of the org, the default policy of the instance is used if empty
+	PasswordComplexityPolicy *PasswordComplexityPolicy `json:"passwordComplexityPolicy,omitempty"`
+	IDPs                     []*IDP                    `json:"idps,omitempty"`
+	Projects                 []*Project                `json:"projects,omitempty"`
+}
+
+// LoginPolicy contains the managed settings of the login policy,
+// the remaining settings (e.g. lifetimes and factors) are taken over from the current policy
+type LoginPolicy struct {
+	AllowUsernamePassword  bool   `json:"allowUsernamePassword,omitempty"`
+	AllowRegister          bool   `json:"allowRegister,omitempty"`
+	AllowExternalIDP       bool   `json:"allowExternalIdp,omitempty"`
+	ForceMFA               bool   `json:"forceMfa,omitempty"`
+	ForceMFALocalOnly      bool   `json:"forceMfaLocalOnly,omitempty"`
+	AllowPasswordless      bool   `json:"allowPasswordless,omitempty"`
+	HidePasswordReset      bool   `json:"hidePasswordReset,omitempty"`
+	IgnoreUnknownUsernames bool   `json:"ignoreUnknownUsernames,omitempty"`
+	AllowDomainDiscovery   bool   `json:"allowDomainDiscovery,omitempty"`
+	DisableLoginWithEmail  bool   `json:"disableLoginWithEmail,omitempty"`
+	DisableLoginWithPhone  bool   `json:"disableLoginWithPhone,omitempty"`
+	AllowMagicLink         bool   `json:"allowMagicLink,omitempty"`
+	DefaultRedirectURI     string `json:"defaultRedirectUri,omitempty"`
+}
+
+type PasswordComplexityPolicy struct {
+	MinLength    uint64 `json:"minLength"`
+	HasLowercase bool   `json:"hasLowercase,omitempty"`
+	HasUppercase bool   `json:"hasUppercase,omitempty"`
+	HasNumber    bool   `json:"hasNumber,omitempty"`
+	HasSymbol    bool   `json:"hasSymbol,omitempty"`
+}
+
+type IDP struct {
+	Name              string   `json:"name"`
+	OIDC              *OIDCIDP `json:"oidc,omitempty"`
+	IsCreationAllowed bool     `json:"isCreationAllowed,omitempty"`
+	IsLinkingAllowed  bool     `json:"isLinkingAllowed,omitempty"`
+	IsAutoCreation    bool     `json:"isAutoCreation,omitempty"`
+	IsAutoUpdate      bool     `json:"isAutoUpdate,omitempty"`
+}
+
+type OIDCIDP struct {
+	Issuer           string   `json:"issuer"`
+	ClientID         string   `json:"clientId"`
+	ClientSecret     string   `json:"clientSecret"`
+	Scopes           []string `json:"scopes,omitempty"`
+	IsIDTokenMapping bool     `json:"isIdTokenMapping,omitempty"`
+}
+
+type Project struct {
+	Name                 string  `json:"name"`
+	ProjectRoleAssertion bool    `json:"projectRoleAssertion,omitempty"`
+	ProjectRoleCheck     bool    `json:"projectRoleCheck,omitempty"`
+	HasProjectCheck      bool    `json:"hasProjectCheck,omitempty"`
+	Roles                []*Role `json:"roles,omitempty"`
+	Apps                 []*App  `json:"apps,omitempty"`
+}
+
+type Role struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+// App is either an OIDC or an API application
+type App struct {
+	Name string   `json:"name"`
+	OIDC *OIDCApp `json:"oidc,omitempty"`
+	API  *APIApp  `json:"api,omitempty"`
+}
+
+type OIDCApp struct {
+	RedirectURIs           []string `json:"redirectUris,omitempty"`
+	PostLogoutRedirectURIs []string `json:"postLogoutRedirectUris,omitempty"`
+	// ResponseTypes are code, id_token or id_token_token
+	ResponseTypes []string `json:"responseTypes,omitempty"`
+	// GrantTypes are authorization_code, implicit, refresh_token, device_code or token_exchange
+	GrantTypes []string `json:"grantTypes,omitempty"`
+	// AppType is one of web, user_agent or native
+	AppType string `json:"appType"`
+	// AuthMethod is one of basic, post, none or private_key_jwt
+	AuthMethod        string   `json:"authMethod"`
+	DevMode           bool     `json:"devMode,omitempty"`
+	AdditionalOrigins []string `json:"additionalOrigins,omitempty"`
+}
+
+type APIApp struct {
+	// AuthMethod is one of basic or private_key_jwt
+	AuthMethod string `json:"authMethod"`
+}
+
+// Duration is a [time.Duration] unmarshalled from its string representation (e.g. 10s)
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+var (
+	targetTypes = map[string]domain.TargetType{
+		"webhook": domain.TargetTypeWebhook,
+		"call":    domain.TargetTypeCall,
+		"async":   domain.TargetTypeAsync,
+	}
+	oidcResponseTypes = map[string]domain.OIDCResponseType{
+		"code":           domain.OIDCResponseTypeCode,
+		"id_token":       domain.OIDCResponseTypeIDToken,
+		"id_token_token": domain.OIDCResponseTypeIDTokenToken,
+	}
+	oidcGrantTypes = map[string]domain.OIDCGrantType{
+		"authorization_code": domain.OIDCGrantTypeAuthorizationCode,
+		"implicit":           domain.OIDCGrantTypeImplicit,
+		"refresh_token":      domain.OIDCGrantTypeRefreshToken,
+		"device_code":        domain.OIDCGrantTypeDeviceCode,
+		"token_exchange":     domain.OIDCGrantTypeTokenExchange,
+	}
+	oidcAppTypes = map[string]domain.OIDCApplicationType{
+		"web":        domain.OIDCApplicationTypeWeb,
+		"user_agent": domain.OIDCApplicationTypeUserAgent,
+		"native":     domain.OIDCApplicationTypeNative,
+	}
+	oidcAuthMethods = map[string]domain.OIDCAuthMethodType{
+		"basic":           domain.OIDCAuthMethodTypeBasic,
+		"post":            domain.OIDCAuthMethodTypePost,
+		"none":            domain.OIDCAuthMethodTypeNone,
+		"private_key_jwt": domain.OIDCAuthMethodTypePrivateKeyJWT,
+	}
+	apiAuthMethods = map[string]domain.APIAuthMethodType{
+		"basic":           domain.APIAuthMethodTypeBasic,
+		"private_key_jwt": domain.APIAuthMethodTypePrivateKeyJWT,
+	}
+)
+
+// readDocuments reads and merges the documents of the paths, "-" reads from stdin
+func readDocuments(in io.Reader, paths ...string) (*Document, error) {
+	merged := new(Document)
+	for _, path := range paths {
+		var (
+			data []byte
+			err  error
+		)
+		if path == "-" {
+			data, err = io.ReadAll(in)
+		} else {
+			data, err = os.ReadFile(path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %w", path, err)
+		}
+		doc := new(Document)
+		// json is a subset of yaml, so both formats are supported
+		if err = yaml.UnmarshalStrict(data, doc); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+		merged.Targets = append(merged.Targets, doc.Targets...)
+		merged.Orgs = append(merged.Orgs, doc.Orgs...)
+	}
+	return merged, merged.validate()
+}
+
+// validate checks the document for missing names, duplicates and unknown enum values,
+// all problems are returned at once
+func (d *Document) validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	targets := make(map[string]bool, len(d.Targets))
+	for _, target := range d.Targets {
+		if target.Name == "" {
+			invalid("target without name")
+		} else if targets[target.Name] {
+			invalid("target %s is defined multiple times", target.Name)
+		}
+		targets[target.Name] = true
+		if _, ok := targetTypes[target.Type]; !ok {
+			invalid("target %s: unknown type %q", target.Name, target.Type)
+		}
+	}
+
+	orgs := make(map[string]bool, len(d.Orgs))
+	for _, org := range d.Orgs {
+		if org.Name == "" {
+			invalid("org without name")
+		} else if orgs[org.Name] {
+			invalid("org %s is defined multiple times", org.Name)
+		}
+		orgs[org.Name] = true
+
+		idps := make(map[string]bool, len(org.IDPs))
+		for _, idp := range org.IDPs {
+			if idp.Name == "" {
+				invalid("org %s: identity provider without name", org.Name)
+			} else if idps[idp.Name] {
+				invalid("org %s: identity provider %s is defined multiple times", org.Name, idp.Name)
+			}
+			idps[idp.Name] = true
+			if idp.OIDC == nil {
+				invalid("org %s: identity provider %s has no configuration", org.Name, idp.Name)
+			}
+		}
+
+		projects := make(map[string]bool, len(org.Projects))
+		for _, project := range org.Projects {
+			if project.Name == "" {
+				invalid("org %s: project without name", org.Name)
+			} else if projects[project.Name] {
+				invalid("org %s: project %s is defined multiple times", org.Name, project.Name)
+			}
+			projects[project.Name] = true
+			project.validate(org.Name, invalid)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Project) validate(orgName string, invalid func(format string, args ...any)) {
+	roles := make(map[string]bool, len(p.Roles))
+	for _, role := range p.Roles {
+		if role.Key == "" {
+			invalid("org %s: project %s: role without key", orgName, p.Name)
+		} else if roles[role.Key] {
+			invalid("org %s: project %s: role %s is defined multiple times", orgName, p.Name, role.Key)
+		}
+		roles[role.Key] = true
+	}
+
+	apps := make(map[string]bool, len(p.Apps))
+	for _, app := range p.Apps {
+		if app.Name == "" {
+			invalid("org %s: project %s: app without name", orgName, p.Name)
+		} else if apps[app.Name] {
+			invalid("org %s: project %s: app %s is defined multiple times", orgName, p.Name, app.Name)
+		}
+		apps[app.Name] = true
+
+		switch {
+		case app.OIDC != nil && app.API != nil:
+			invalid("org %s: project %s: app %s has an oidc and an api configuration", orgName, p.Name, app.Name)
+		case app.OIDC != nil:
+			for _, responseType := range app.OIDC.ResponseTypes {
+				if _, ok := oidcResponseTypes[responseType]; !ok {
+					invalid("org %s: project %s: app %s: unknown response type %q", orgName, p.Name, app.Name, responseType)
+				}
+			}
+			for _, grantType := range app.OIDC.GrantTypes {
+				if _, ok := oidcGrantTypes[grantType]; !ok {
+					invalid("org %s: project %s: app %s: unknown grant type %q", orgName, p.Name, app.Name, grantType)
+				}
+			}
+			if _, ok := oidcAppTypes[app.OIDC.AppType]; !ok {
+				invalid("org %s: project %s: app %s: unknown app type %q", orgName, p.Name, app.Name, app.OIDC.AppType)
+			}
+			if _, ok := oidcAuthMethods[app.OIDC.AuthMethod]; !ok {
+				invalid("org %s: project %s: app %s: unknown auth method %q", orgName, p.Name, app.Name, app.OIDC.AuthMethod)
+			}
+		case app.API != nil:
+			if _, ok := apiAuthMethods[app.API.AuthMethod]; !ok {
+				invalid("org %s: project %s: app %s: unknown auth method %q", orgName, p.Name, app.Name, app.API.AuthMethod)
+			}
+		default:
+			invalid("org %s: project %s: app %s has no configuration", orgName, p.Name, app.Name)
+		}
+	}
+}
+
+func mapValues[K comparable, V any](keys []K, values map[K]V) []V {
+	mapped := make([]V, len(keys))
+	for i, key := range keys {
+		mapped[i] = values[key]
+	}
+	return mapped
+}
@@ -0,0 +1,105 @@
+package apply
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readDocuments(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    *Document
+		wantErr []string
+	}{
+		{
+			name: "yaml",
+			input: `
+targets:
+  - name: webhook
+    type: webhook
+    endpoint: https://example.com/hook
+    timeout: 10s
+orgs:
+  - name: acme
+    projects:
+      - name: portal
+        roles:
+          - key: admin
+        apps:
+          - name: backend
+            api:
+              authMethod: basic
+`,
+			want: &Document{
+				Targets: []*Target{{Name: "webhook", Type: "webhook", Endpoint: "https://example.com/hook", Timeout: Duration(10 * time.Second)}},
+				Orgs: []*Org{{
+					Name: "acme",
+					Projects: []*Project{{
+						Name:  "portal",
+						Roles: []*Role{{Key: "admin"}},
+						Apps:  []*App{{Name: "backend", API: &APIApp{AuthMethod: "basic"}}},
+					}},
+				}},
+			},
+		},
+		{
+			name:  "json",
+			input: `{"orgs": [{"name": "acme"}]}`,
+			want:  &Document{Orgs: []*Org{{Name: "acme"}}},
+		},
+		{
+			name:    "unknown field",
+			input:   `{"orgs": [{"name": "acme", "unknown": true}]}`,
+			wantErr: []string{"unknown"},
+		},
+		{
+			name: "invalid",
+			input: `
+targets:
+  - name: webhook
+    type: unknown
+    timeout: 1s
+orgs:
+  - name: acme
+    projects:
+      - name: portal
+        roles:
+          - key: admin
+          - key: admin
+        apps:
+          - name: web
+            oidc:
+              appType: web
+              authMethod: secret
+          - name: empty
+  - name: acme
+`,
+			wantErr: []string{
+				`target webhook: unknown type "unknown"`,
+				"org acme is defined multiple times",
+				"org acme: project portal: role admin is defined multiple times",
+				`org acme: project portal: app web: unknown auth method "secret"`,
+				"org acme: project portal: app empty has no configuration",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readDocuments(strings.NewReader(tt.input), "-")
+			if len(tt.wantErr) > 0 {
+				require.Error(t, err)
+				for _, wantErr := range tt.wantErr {
+					assert.ErrorContains(t, err, wantErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// executor applies the changes of a plan in order
+type executor struct {
+	commands *command.Commands
+	queries  *query.Queries
+	out      io.Writer
+	// ids contains the ids of the orgs and projects by path,
+	// resources created during the execution are added so subsequent changes can reference them
+	ids map[string]string
+}
+
+func newExecutor(commands *command.Commands, queries *query.Queries, out io.Writer, current *state) *executor {
+	e := &executor{
+		commands: commands,
+		queries:  queries,
+		out:      out,
+		ids:      make(map[string]string),
+	}
+	for orgName, org := range current.orgs {
+		e.ids[orgName] = org.id
+		for projectName, project := range org.projects {
+			e.ids[joinPath(orgName, projectName)] = project.project.ID
+		}
+	}
+	return e
+}
+
+// execute stops at the first failing change, the changes applied until then are kept.
+// As the plan is idempotent, the execution can be repeated after fixing the cause.
+func (e *executor) execute(ctx context.Context, p plan) error {
+	for _, c := range p {
+		if err := c.apply(ctx, e); err != nil {
+			return fmt.Errorf("unable to %s %s %s: %w", c.action, c.resource, c.path, err)
+		}
+		fmt.Fprintf(e.out, "%s %s %s\n", c.action, c.resource, c.path)
+	}
+	return nil
+}
+
+func createTarget(target *Target) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.AddTarget(ctx, &command.AddTarget{
+			Name:             target.Name,
+			TargetType:       targetTypes[target.Type],
+			Endpoint:         target.Endpoint,
+			Timeout:          time.Duration(target.Timeout),
+			InterruptOnError: target.InterruptOnError,
+		}, authz.GetInstance(ctx).InstanceID())
+		return err
+	}
+}
+
+func updateTarget(target *Target, id string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		targetType := targetTypes[target.Type]
+		timeout := time.Duration(target.Timeout)
+		_, err := e.commands.ChangeTarget(ctx, &command.ChangeTarget{
+			ObjectRoot:       models.ObjectRoot{AggregateID: id},
+			TargetType:       &targetType,
+			Endpoint:         &target.Endpoint,
+			Timeout:          &timeout,
+			InterruptOnError: &target.InterruptOnError,
+		}, authz.GetInstance(ctx).InstanceID())
+		return err
+	}
+}
+
+func deleteTarget(id string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.DeleteTarget(ctx, id, authz.GetInstance(ctx).InstanceID())
+		return err
+	}
+}
+
+func createOrg(org *Org) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		created, err := e.commands.SetUpOrg(ctx, &command.OrgSetup{Name: org.Name}, false)
+		if err != nil {
+			return err
+		}
+		e.ids[org.Name] = created.ObjectDetails.ResourceOwner
+		return nil
+	}
+}
+
+// setLoginPolicy takes over the settings not managed by the document from the effective policy of the org
+func setLoginPolicy(orgPath string, policy *LoginPolicy, exists bool) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		orgID := e.ids[orgPath]
+		current, err := e.queries.LoginPolicyByID(ctx, true, orgID, false)
+		if err != nil {
+			return err
+		}
+		if exists {
+			_, err = e.commands.ChangeLoginPolicy(ctx, orgID, &command.ChangeLoginPolicy{
+				AllowUsernamePassword:      policy.AllowUsernamePassword,
+				AllowRegister:              policy.AllowRegister,
+				AllowExternalIDP:           policy.AllowExternalIDP,
+				ForceMFA:                   policy.ForceMFA,
+				ForceMFALocalOnly:          policy.ForceMFALocalOnly,
+				PasswordlessType:           passwordlessType(policy.AllowPasswordless),
+				HidePasswordReset:          policy.HidePasswordReset,
+				IgnoreUnknownUsernames:     policy.IgnoreUnknownUsernames,
+				AllowDomainDiscovery:       policy.AllowDomainDiscovery,
+				DefaultRedirectURI:         policy.DefaultRedirectURI,
+				PasswordCheckLifetime:      time.Duration(current.PasswordCheckLifetime),
+				ExternalLoginCheckLifetime: time.Duration(current.ExternalLoginCheckLifetime),
+				MFAInitSkipLifetime:        time.Duration(current.MFAInitSkipLifetime),
+				SecondFactorCheckLifetime:  time.Duration(current.SecondFactorCheckLifetime),
+				MultiFactorCheckLifetime:   time.Duration(current.MultiFactorCheckLifetime),
+				DisableLoginWithEmail:      policy.DisableLoginWithEmail,
+				DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+				AllowMagicLink:             policy.AllowMagicLink,
+			})
+			return err
+		}
+		_, err = e.commands.AddLoginPolicy(ctx, orgID, &command.AddLoginPolicy{
+			AllowUsernamePassword:      policy.AllowUsernamePassword,
+			AllowRegister:              policy.AllowRegister,
+			AllowExternalIDP:           policy.AllowExternalIDP,
+			ForceMFA:                   policy.ForceMFA,
+			ForceMFALocalOnly:          policy.ForceMFALocalOnly,
+			SecondFactors:              current.SecondFactors,
+			MultiFactors:               current.MultiFactors,
+			PasswordlessType:           passwordlessType(policy.AllowPasswordless),
+			HidePasswordReset:          policy.HidePasswordReset,
+			IgnoreUnknownUsernames:     policy.IgnoreUnknownUsernames,
+			AllowDomainDiscovery:       policy.AllowDomainDiscovery,
+			DefaultRedirectURI:         policy.DefaultRedirectURI,
+			PasswordCheckLifetime:      time.Duration(current.PasswordCheckLifetime),
+			ExternalLoginCheckLifetime: time.Duration(current.ExternalLoginCheckLifetime),
+			MFAInitSkipLifetime:        time.Duration(current.MFAInitSkipLifetime),
+			SecondFactorCheckLifetime:  time.Duration(current.SecondFactorCheckLifetime),
+			MultiFactorCheckLifetime:   time.Duration(current.MultiFactorCheckLifetime),
+			DisableLoginWithEmail:      policy.DisableLoginWithEmail,
+			DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+			AllowMagicLink:             policy.AllowMagicLink,
+		})
+		return err
+	}
+}
+
+func deleteLoginPolicy(orgPath string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.RemoveLoginPolicy(ctx, e.ids[orgPath])
+		return err
+	}
+}
+
+func setPasswordComplexityPolicy(orgPath string, policy *PasswordComplexityPolicy, exists bool) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) (err error) {
+		complexity := &domain.PasswordComplexityPolicy{
+			MinLength:    policy.MinLength,
+			HasLowercase: policy.HasLowercase,
+			HasUppercase: policy.HasUppercase,
+			HasNumber:    policy.HasNumber,
+			HasSymbol:    policy.HasSymbol,
+		}
+		if exists {
+			_, err = e.commands.ChangePasswordComplexityPolicy(ctx, e.ids[orgPath], complexity)
+			return err
+		}
+		_, err = e.commands.AddPasswordComplexityPolicy(ctx, e.ids[orgPath], complexity)
+		return err
+	}
+}
+
+func deletePasswordComplexityPolicy(orgPath string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.RemovePasswordComplexityPolicy(ctx, e.ids[orgPath])
+		return err
+	}
+}
+
+func createIDP(orgPath string, provider *IDP) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, _, err := e.commands.AddOrgGenericOIDCProvider(ctx, e.ids[orgPath], idpToCommand(provider))
+		return err
+	}
+}
+
+func updateIDP(orgPath string, provider *IDP, id string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.UpdateOrgGenericOIDCProvider(ctx, e.ids[orgPath], id, idpToCommand(provider))
+		return err
+	}
+}
+
+func deleteIDP(orgPath, id string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.DeleteOrgProvider(ctx, e.ids[orgPath], id)
+		return err
+	}
+}
+
+func projectToDomain(projectID string, project *Project) *domain.Project {
+	return &domain.Project{
+		ObjectRoot:           models.ObjectRoot{AggregateID: projectID},
+		Name:                 project.Name,
+		ProjectRoleAssertion: project.ProjectRoleAssertion,
+		ProjectRoleCheck:     project.ProjectRoleCheck,
+		HasProjectCheck:      project.HasProjectCheck,
+	}
+}
+
+func createProject(orgPath string, project *Project) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectID, err := id.SonyFlakeGenerator().Next()
+		if err != nil {
+			return err
+		}
+		if _, err = e.commands.AddProjectWithID(ctx, projectToDomain(projectID, project), e.ids[orgPath], projectID); err != nil {
+			return err
+		}
+		e.ids[joinPath(orgPath, project.Name)] = projectID
+		return nil
+	}
+}
+
+func updateProject(orgPath string, project *Project) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectID := e.ids[joinPath(orgPath, project.Name)]
+		_, err := e.commands.ChangeProject(ctx, projectToDomain(projectID, project), e.ids[orgPath])
+		return err
+	}
+}
+
+// deleteProject removes the user grants of the project as well
+func deleteProject(orgPath, projectID string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+		if err != nil {
+			return err
+		}
+		grants, err := e.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectQuery}}, true)
+		if err != nil {
+			return err
+		}
+		_, err = e.commands.RemoveProject(ctx, projectID, e.ids[orgPath], userGrantIDs(grants)...)
+		return err
+	}
+}
+
+func roleToDomain(projectID string, role *Role) *domain.ProjectRole {
+	return &domain.ProjectRole{
+		ObjectRoot:  models.ObjectRoot{AggregateID: projectID},
+		Key:         role.Key,
+		DisplayName: role.DisplayName,
+		Group:       role.Group,
+	}
+}
+
+func createRole(orgPath, projectPath string, role *Role) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.AddProjectRole(ctx, roleToDomain(e.ids[projectPath], role), e.ids[orgPath])
+		return err
+	}
+}
+
+func updateRole(orgPath, projectPath string, role *Role) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.ChangeProjectRole(ctx, roleToDomain(e.ids[projectPath], role), e.ids[orgPath])
+		return err
+	}
+}
+
+// deleteRole removes the role from the user and project grants as well
+func deleteRole(orgPath, projectPath, key string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectID := e.ids[projectPath]
+		projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+		if err != nil {
+			return err
+		}
+		roleQuery, err := query.NewUserGrantRoleQuery(key)
+		if err != nil {
+			return err
+		}
+		userGrants, err := e.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectQuery, roleQuery}}, true)
+		if err != nil {
+			return err
+		}
+		projectGrants, err := e.queries.SearchProjectGrantsByProjectIDAndRoleKey(ctx, projectID, key)
+		if err != nil {
+			return err
+		}
+		projectGrantIDs := make([]string, len(projectGrants.ProjectGrants))
+		for i, grant := range projectGrants.ProjectGrants {
+			projectGrantIDs[i] = grant.GrantID
+		}
+		_, err = e.commands.RemoveProjectRole(ctx, projectID, key, e.ids[orgPath], projectGrantIDs, userGrantIDs(userGrants)...)
+		return err
+	}
+}
+
+func userGrantIDs(grants *query.UserGrants) []string {
+	ids := make([]string, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		ids[i] = grant.ID
+	}
+	return ids
+}
+
+// createApp prints the generated client id and secret as they cannot be queried afterwards
+func createApp(orgPath, projectPath string, app *App) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectID := e.ids[projectPath]
+		if app.API != nil {
+			created, err := e.commands.AddAPIApplication(ctx, &domain.APIApp{
+				ObjectRoot:     models.ObjectRoot{AggregateID: projectID},
+				AppName:        app.Name,
+				AuthMethodType: apiAuthMethods[app.API.AuthMethod],
+			}, e.ids[orgPath])
+			if err != nil {
+				return err
+			}
+			e.printClient(joinPath(projectPath, app.Name), created.ClientID, created.ClientSecretString)
+			return nil
+		}
+		created, err := e.commands.AddOIDCApplication(ctx, oidcAppToDomain(projectID, app, nil), e.ids[orgPath])
+		if err != nil {
+			return err
+		}
+		e.printClient(joinPath(projectPath, app.Name), created.ClientID, created.ClientSecretString)
+		return nil
+	}
+}
+
+func (e *executor) printClient(path, clientID, clientSecret string) {
+	fmt.Fprintf(e.out, "client id of app %s: %s\n", path, clientID)
+	if clientSecret != "" {
+		fmt.Fprintf(e.out, "client secret of app %s: %s\n", path, clientSecret)
+	}
+}
+
+func updateApp(orgPath, projectPath string, app *App, current *query.App) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		projectID := e.ids[projectPath]
+		if app.API != nil {
+			_, err := e.commands.ChangeAPIApplication(ctx, &domain.APIApp{
+				ObjectRoot:     models.ObjectRoot{AggregateID: projectID},
+				AppID:          current.ID,
+				AppName:        app.Name,
+				AuthMethodType: apiAuthMethods[app.API.AuthMethod],
+			}, e.ids[orgPath])
+			return err
+		}
+		oidcApp := oidcAppToDomain(projectID, app, current.OIDCConfig)
+		oidcApp.AppID = current.ID
+		_, err := e.commands.ChangeOIDCApplication(ctx, oidcApp, e.ids[orgPath])
+		return err
+	}
+}
+
+func deleteApp(orgPath, projectPath, appID string) func(ctx context.Context, e *executor) error {
+	return func(ctx context.Context, e *executor) error {
+		_, err := e.commands.RemoveApplication(ctx, e.ids[projectPath], appID, e.ids[orgPath])
+		return err
+	}
+}
@@ -0,0 +1,359 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+)
+
+type action string
+
+const (
+	actionCreate action = "create"
+	actionUpdate action = "update"
+	actionDelete action = "delete"
+)
+
+var actionSymbols = map[action]string{
+	actionCreate: "+",
+	actionUpdate: "~",
+	actionDelete: "-",
+}
+
+// change is a single step of the plan
+type change struct {
+	action   action
+	resource string
+	// path identifies the resource by the names of its parents and its own name (e.g. org/project/app)
+	path  string
+	apply func(ctx context.Context, e *executor) error
+}
+
+type plan []*change
+
+// newPlan computes the changes required to reach the state of the document.
+// Resources which are not part of the document are only deleted if prune is set,
+// orgs are never deleted.
+func newPlan(doc *Document, current *state, prune bool) plan {
+	var p plan
+	p = append(p, diffTargets(doc.Targets, current.targets, prune)...)
+	for _, org := range doc.Orgs {
+		p = append(p, diffOrg(org, current.orgs[org.Name], prune)...)
+	}
+	return p
+}
+
+func (p plan) print(w io.Writer) {
+	counts := make(map[action]int, len(actionSymbols))
+	for _, c := range p {
+		fmt.Fprintf(w, "%s %s %s %s\n", actionSymbols[c.action], c.action, c.resource, c.path)
+		counts[c.action]++
+	}
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to delete\n", counts[actionCreate], counts[actionUpdate], counts[actionDelete])
+}
+
+func joinPath(parent, name string) string {
+	return parent + "/" + name
+}
+
+func diffTargets(desired []*Target, current map[string]*query.Target, prune bool) (changes []*change) {
+	declared := make(map[string]bool, len(desired))
+	for _, target := range desired {
+		declared[target.Name] = true
+		existing, ok := current[target.Name]
+		if !ok {
+			changes = append(changes, &change{action: actionCreate, resource: "target", path: target.Name, apply: createTarget(target)})
+			continue
+		}
+		if targetChanged(target, existing) {
+			changes = append(changes, &change{action: actionUpdate, resource: "target", path: target.Name, apply: updateTarget(target, existing.ID)})
+		}
+	}
+	if !prune {
+		return changes
+	}
+	for _, name := range sortedKeys(current) {
+		if !declared[name] {
+			changes = append(changes, &change{action: actionDelete, resource: "target", path: name, apply: deleteTarget(current[name].ID)})
+		}
+	}
+	return changes
+}
+
+func targetChanged(desired *Target, current *query.Target) bool {
+	return targetTypes[desired.Type] != current.TargetType ||
+		desired.Endpoint != current.Endpoint ||
+		time.Duration(desired.Timeout) != current.Timeout ||
+		desired.InterruptOnError != current.InterruptOnError
+}
+
+func diffOrg(desired *Org, current *orgState, prune bool) (changes []*change) {
+	path := desired.Name
+	if current == nil {
+		changes = append(changes, &change{action: actionCreate, resource: "org", path: path, apply: createOrg(desired)})
+		current = new(orgState)
+	}
+	changes = append(changes, diffLoginPolicy(path, desired.LoginPolicy, current.loginPolicy, prune)...)
+	changes = append(changes, diffPasswordComplexityPolicy(path, desired.PasswordComplexityPolicy, current.passwordComplexityPolicy, prune)...)
+	changes = append(changes, diffIDPs(path, desired.IDPs, current.idps, prune)...)
+
+	declared := make(map[string]bool, len(desired.Projects))
+	for _, project := range desired.Projects {
+		declared[project.Name] = true
+		changes = append(changes, diffProject(path, project, current.projects[project.Name], prune)...)
+	}
+	if !prune {
+		return changes
+	}
+	for _, name := range sortedKeys(current.projects) {
+		if !declared[name] {
+			changes = append(changes, &change{action: actionDelete, resource: "project", path: joinPath(path, name), apply: deleteProject(path, current.projects[name].project.ID)})
+		}
+	}
+	return changes
+}
+
+// diffLoginPolicy compares the custom policy of the document with the current policy,
+// a custom policy is created even if the values equal the default policy of the instance.
+func diffLoginPolicy(orgPath string, desired *LoginPolicy, current *query.LoginPolicy, prune bool) []*change {
+	isCustom := current != nil && !current.IsDefault
+	switch {
+	case desired != nil && !isCustom:
+		return []*change{{action: actionCreate, resource: "login policy", path: orgPath, apply: setLoginPolicy(orgPath, desired, false)}}
+	case desired != nil && loginPolicyChanged(desired, current):
+		return []*change{{action: actionUpdate, resource: "login policy", path: orgPath, apply: setLoginPolicy(orgPath, desired, true)}}
+	case desired == nil && isCustom && prune:
+		return []*change{{action: actionDelete, resource: "login policy", path: orgPath, apply: deleteLoginPolicy(orgPath)}}
+	}
+	return nil
+}
+
+func loginPolicyChanged(desired *LoginPolicy, current *query.LoginPolicy) bool {
+	return desired.AllowUsernamePassword != current.AllowUsernamePassword ||
+		desired.AllowRegister != current.AllowRegister ||
+		desired.AllowExternalIDP != current.AllowExternalIDPs ||
+		desired.ForceMFA != current.ForceMFA ||
+		desired.ForceMFALocalOnly != current.ForceMFALocalOnly ||
+		passwordlessType(desired.AllowPasswordless) != current.PasswordlessType ||
+		desired.HidePasswordReset != current.HidePasswordReset ||
+		desired.IgnoreUnknownUsernames != current.IgnoreUnknownUsernames ||
+		desired.AllowDomainDiscovery != current.AllowDomainDiscovery ||
+		desired.DisableLoginWithEmail != current.DisableLoginWithEmail ||
+		desired.DisableLoginWithPhone != current.DisableLoginWithPhone ||
+		desired.AllowMagicLink != current.AllowMagicLink ||
+		desired.DefaultRedirectURI != current.DefaultRedirectURI
+}
+
+func passwordlessType(allowed bool) domain.PasswordlessType {
+	if allowed {
+		return domain.PasswordlessTypeAllowed
+	}
+	return domain.PasswordlessTypeNotAllowed
+}
+
+func diffPasswordComplexityPolicy(orgPath string, desired *PasswordComplexityPolicy, current *query.PasswordComplexityPolicy, prune bool) []*change {
+	isCustom := current != nil && !current.IsDefault
+	switch {
+	case desired != nil && !isCustom:
+		return []*change{{action: actionCreate, resource: "password complexity policy", path: orgPath, apply: setPasswordComplexityPolicy(orgPath, desired, false)}}
+	case desired != nil && passwordComplexityPolicyChanged(desired, current):
+		return []*change{{action: actionUpdate, resource: "password complexity policy", path: orgPath, apply: setPasswordComplexityPolicy(orgPath, desired, true)}}
+	case desired == nil && isCustom && prune:
+		return []*change{{action: actionDelete, resource: "password complexity policy", path: orgPath, apply: deletePasswordComplexityPolicy(orgPath)}}
+	}
+	return nil
+}
+
+func passwordComplexityPolicyChanged(desired *PasswordComplexityPolicy, current *query.PasswordComplexityPolicy) bool {
+	return desired.MinLength != current.MinLength ||
+		desired.HasLowercase != current.HasLowercase ||
+		desired.HasUppercase != current.HasUppercase ||
+		desired.HasNumber != current.HasNumber ||
+		desired.HasSymbol != current.HasSymbol
+}
+
+func diffIDPs(orgPath string, desired []*IDP, current map[string]*idpState, prune bool) (changes []*change) {
+	declared := make(map[string]bool, len(desired))
+	for _, provider := range desired {
+		declared[provider.Name] = true
+		path := joinPath(orgPath, provider.Name)
+		existing, ok := current[provider.Name]
+		switch {
+		case !ok:
+			changes = append(changes, &change{action: actionCreate, resource: "identity provider", path: path, apply: createIDP(orgPath, provider)})
+		case existing.template.Type != domain.IDPTypeOIDC:
+			// the type of an identity provider cannot be changed
+			changes = append(changes,
+				&change{action: actionDelete, resource: "identity provider", path: path, apply: deleteIDP(orgPath, existing.template.ID)},
+				&change{action: actionCreate, resource: "identity provider", path: path, apply: createIDP(orgPath, provider)},
+			)
+		case idpChanged(provider, existing):
+			changes = append(changes, &change{action: actionUpdate, resource: "identity provider", path: path, apply: updateIDP(orgPath, provider, existing.template.ID)})
+		}
+	}
+	if !prune {
+		return changes
+	}
+	for _, name := range sortedKeys(current) {
+		if !declared[name] {
+			changes = append(changes, &change{action: actionDelete, resource: "identity provider", path: joinPath(orgPath, name), apply: deleteIDP(orgPath, current[name].template.ID)})
+		}
+	}
+	return changes
+}
+
+func idpChanged(desired *IDP, current *idpState) bool {
+	template := current.template
+	return desired.OIDC.Issuer != template.OIDCIDPTemplate.Issuer ||
+		desired.OIDC.ClientID != template.OIDCIDPTemplate.ClientID ||
+		desired.OIDC.ClientSecret != current.clientSecret ||
+		!slices.Equal(desired.OIDC.Scopes, []string(template.OIDCIDPTemplate.Scopes)) ||
+		desired.OIDC.IsIDTokenMapping != template.OIDCIDPTemplate.IsIDTokenMapping ||
+		desired.IsCreationAllowed != template.IsCreationAllowed ||
+		desired.IsLinkingAllowed != template.IsLinkingAllowed ||
+		desired.IsAutoCreation != template.IsAutoCreation ||
+		desired.IsAutoUpdate != template.IsAutoUpdate
+}
+
+func idpToCommand(provider *IDP) command.GenericOIDCProvider {
+	return command.GenericOIDCProvider{
+		Name:             provider.Name,
+		Issuer:           provider.OIDC.Issuer,
+		ClientID:         provider.OIDC.ClientID,
+		ClientSecret:     provider.OIDC.ClientSecret,
+		Scopes:           provider.OIDC.Scopes,
+		IsIDTokenMapping: provider.OIDC.IsIDTokenMapping,
+		IDPOptions: idp.Options{
+			IsCreationAllowed: provider.IsCreationAllowed,
+			IsLinkingAllowed:  provider.IsLinkingAllowed,
+			IsAutoCreation:    provider.IsAutoCreation,
+			IsAutoUpdate:      provider.IsAutoUpdate,
+		},
+	}
+}
+
+func diffProject(orgPath string, desired *Project, current *projectState, prune bool) (changes []*change) {
+	path := joinPath(orgPath, desired.Name)
+	if current == nil {
+		changes = append(changes, &change{action: actionCreate, resource: "project", path: path, apply: createProject(orgPath, desired)})
+		current = new(projectState)
+	} else if projectChanged(desired, current.project) {
+		changes = append(changes, &change{action: actionUpdate, resource: "project", path: path, apply: updateProject(orgPath, desired)})
+	}
+	changes = append(changes, diffRoles(orgPath, path, desired.Roles, current.roles, prune)...)
+	changes = append(changes, diffApps(orgPath, path, desired.Apps, current.apps, prune)...)
+	return changes
+}
+
+func projectChanged(desired *Project, current *query.Project) bool {
+	return desired.ProjectRoleAssertion != current.ProjectRoleAssertion ||
+		desired.ProjectRoleCheck != current.ProjectRoleCheck ||
+		desired.HasProjectCheck != current.HasProjectCheck
+}
+
+func diffRoles(orgPath, projectPath string, desired []*Role, current map[string]*query.ProjectRole, prune bool) (changes []*change) {
+	declared := make(map[string]bool, len(desired))
+	for _, role := range desired {
+		declared[role.Key] = true
+		path := joinPath(projectPath, role.Key)
+		existing, ok := current[role.Key]
+		if !ok {
+			changes = append(changes, &change{action: actionCreate, resource: "role", path: path, apply: createRole(orgPath, projectPath, role)})
+			continue
+		}
+		if role.DisplayName != existing.DisplayName || role.Group != existing.Group {
+			changes = append(changes, &change{action: actionUpdate, resource: "role", path: path, apply: updateRole(orgPath, projectPath, role)})
+		}
+	}
+	if !prune {
+		return changes
+	}
+	for _, key := range sortedKeys(current) {
+		if !declared[key] {
+			changes = append(changes, &change{action: actionDelete, resource: "role", path: joinPath(projectPath, key), apply: deleteRole(orgPath, projectPath, key)})
+		}
+	}
+	return changes
+}
+
+func diffApps(orgPath, projectPath string, desired []*App, current map[string]*query.App, prune bool) (changes []*change) {
+	declared := make(map[string]bool, len(desired))
+	for _, app := range desired {
+		declared[app.Name] = true
+		path := joinPath(projectPath, app.Name)
+		existing, ok := current[app.Name]
+		switch {
+		case !ok:
+			changes = append(changes, &change{action: actionCreate, resource: "app", path: path, apply: createApp(orgPath, projectPath, app)})
+		case (app.OIDC != nil) != (existing.OIDCConfig != nil) || (app.API != nil) != (existing.APIConfig != nil):
+			// the type of an app cannot be changed
+			changes = append(changes,
+				&change{action: actionDelete, resource: "app", path: path, apply: deleteApp(orgPath, projectPath, existing.ID)},
+				&change{action: actionCreate, resource: "app", path: path, apply: createApp(orgPath, projectPath, app)},
+			)
+		case appChanged(app, existing):
+			changes = append(changes, &change{action: actionUpdate, resource: "app", path: path, apply: updateApp(orgPath, projectPath, app, existing)})
+		}
+	}
+	if !prune {
+		return changes
+	}
+	for _, name := range sortedKeys(current) {
+		if !declared[name] {
+			changes = append(changes, &change{action: actionDelete, resource: "app", path: joinPath(projectPath, name), apply: deleteApp(orgPath, projectPath, current[name].ID)})
+		}
+	}
+	return changes
+}
+
+func appChanged(desired *App, current *query.App) bool {
+	if desired.API != nil {
+		return apiAuthMethods[desired.API.AuthMethod] != current.APIConfig.AuthMethodType
+	}
+	config := current.OIDCConfig
+	return !slices.Equal(desired.OIDC.RedirectURIs, []string(config.RedirectURIs)) ||
+		!slices.Equal(desired.OIDC.PostLogoutRedirectURIs, []string(config.PostLogoutRedirectURIs)) ||
+		!slices.Equal(mapValues(desired.OIDC.ResponseTypes, oidcResponseTypes), []domain.OIDCResponseType(config.ResponseTypes)) ||
+		!slices.Equal(mapValues(desired.OIDC.GrantTypes, oidcGrantTypes), []domain.OIDCGrantType(config.GrantTypes)) ||
+		oidcAppTypes[desired.OIDC.AppType] != config.AppType ||
+		oidcAuthMethods[desired.OIDC.AuthMethod] != config.AuthMethodType ||
+		desired.OIDC.DevMode != config.IsDevMode ||
+		!slices.Equal(desired.OIDC.AdditionalOrigins, []string(config.AdditionalOrigins))
+}
+
+// oidcAppToDomain maps the app of the document,
+// the settings not managed by the document are taken over from the current config if present.
+func oidcAppToDomain(projectID string, app *App, current *query.OIDCApp) *domain.OIDCApp {
+	oidcApp := &domain.OIDCApp{
+		ObjectRoot:             models.ObjectRoot{AggregateID: projectID},
+		AppName:                app.Name,
+		RedirectUris:           app.OIDC.RedirectURIs,
+		PostLogoutRedirectUris: app.OIDC.PostLogoutRedirectURIs,
+		ResponseTypes:          mapValues(app.OIDC.ResponseTypes, oidcResponseTypes),
+		GrantTypes:             mapValues(app.OIDC.GrantTypes, oidcGrantTypes),
+		ApplicationType:        oidcAppTypes[app.OIDC.AppType],
+		AuthMethodType:         oidcAuthMethods[app.OIDC.AuthMethod],
+		OIDCVersion:            domain.OIDCVersionV1,
+		DevMode:                app.OIDC.DevMode,
+		AdditionalOrigins:      app.OIDC.AdditionalOrigins,
+	}
+	if current != nil {
+		oidcApp.OIDCVersion = current.Version
+		oidcApp.AccessTokenType = current.AccessTokenType
+		oidcApp.AccessTokenRoleAssertion = current.AssertAccessTokenRole
+		oidcApp.IDTokenRoleAssertion = current.AssertIDTokenRole
+		oidcApp.IDTokenUserinfoAssertion = current.AssertIDTokenUserinfo
+		oidcApp.ClockSkew = current.ClockSkew
+		oidcApp.SkipNativeAppSuccessPage = current.SkipNativeAppSuccessPage
+		oidcApp.BackChannelLogoutURI = current.BackChannelLogoutURI
+	}
+	return oidcApp
+}
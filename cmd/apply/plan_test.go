@@ -0,0 +1,214 @@
+package apply
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func testDocument() *Document {
+	return &Document{
+		Targets: []*Target{
+			{Name: "webhook", Type: "webhook", Endpoint: "https://example.com/hook", Timeout: Duration(10 * time.Second)},
+		},
+		Orgs: []*Org{
+			{
+				Name:                     "acme",
+				LoginPolicy:              &LoginPolicy{AllowUsernamePassword: true, AllowExternalIDP: true},
+				PasswordComplexityPolicy: &PasswordComplexityPolicy{MinLength: 12, HasNumber: true},
+				IDPs: []*IDP{
+					{Name: "corporate", OIDC: &OIDCIDP{Issuer: "https://idp.example.com", ClientID: "client", ClientSecret: "secret", Scopes: []string{"openid"}}},
+				},
+				Projects: []*Project{
+					{
+						Name:                 "portal",
+						ProjectRoleAssertion: true,
+						Roles:                []*Role{{Key: "admin", DisplayName: "Administrator"}},
+						Apps: []*App{
+							{Name: "web", OIDC: &OIDCApp{
+								RedirectURIs:  []string{"https://portal.example.com/callback"},
+								ResponseTypes: []string{"code"},
+								GrantTypes:    []string{"authorization_code"},
+								AppType:       "web",
+								AuthMethod:    "basic",
+							}},
+							{Name: "backend", API: &APIApp{AuthMethod: "private_key_jwt"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testState returns the state after applying the document of testDocument
+func testState() *state {
+	return &state{
+		targets: map[string]*query.Target{
+			"webhook": {
+				ObjectDetails: domain.ObjectDetails{ID: "target1"},
+				Name:          "webhook",
+				TargetType:    domain.TargetTypeWebhook,
+				Endpoint:      "https://example.com/hook",
+				Timeout:       10 * time.Second,
+			},
+		},
+		orgs: map[string]*orgState{
+			"acme": {
+				id:                       "org1",
+				loginPolicy:              &query.LoginPolicy{AllowUsernamePassword: true, AllowExternalIDPs: true},
+				passwordComplexityPolicy: &query.PasswordComplexityPolicy{MinLength: 12, HasNumber: true},
+				idps: map[string]*idpState{
+					"corporate": {
+						template: &query.IDPTemplate{
+							ID:   "idp1",
+							Name: "corporate",
+							Type: domain.IDPTypeOIDC,
+							OIDCIDPTemplate: &query.OIDCIDPTemplate{
+								Issuer:   "https://idp.example.com",
+								ClientID: "client",
+								Scopes:   database.TextArray[string]{"openid"},
+							},
+						},
+						clientSecret: "secret",
+					},
+				},
+				projects: map[string]*projectState{
+					"portal": {
+						project: &query.Project{ID: "project1", Name: "portal", ProjectRoleAssertion: true},
+						roles: map[string]*query.ProjectRole{
+							"admin": {Key: "admin", DisplayName: "Administrator"},
+						},
+						apps: map[string]*query.App{
+							"web": {ID: "app1", Name: "web", OIDCConfig: &query.OIDCApp{
+								RedirectURIs:   database.TextArray[string]{"https://portal.example.com/callback"},
+								ResponseTypes:  database.NumberArray[domain.OIDCResponseType]{domain.OIDCResponseTypeCode},
+								GrantTypes:     database.NumberArray[domain.OIDCGrantType]{domain.OIDCGrantTypeAuthorizationCode},
+								AppType:        domain.OIDCApplicationTypeWeb,
+								AuthMethodType: domain.OIDCAuthMethodTypeBasic,
+							}},
+							"backend": {ID: "app2", Name: "backend", APIConfig: &query.APIApp{AuthMethodType: domain.APIAuthMethodTypePrivateKeyJWT}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func planSteps(p plan) []string {
+	steps := make([]string, len(p))
+	for i, c := range p {
+		steps[i] = string(c.action) + " " + c.resource + " " + c.path
+	}
+	return steps
+}
+
+func Test_newPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     func() *Document
+		current func() *state
+		prune   bool
+		want    []string
+	}{
+		{
+			name:    "empty instance",
+			doc:     testDocument,
+			current: func() *state { return &state{} },
+			want: []string{
+				"create target webhook",
+				"create org acme",
+				"create login policy acme",
+				"create password complexity policy acme",
+				"create identity provider acme/corporate",
+				"create project acme/portal",
+				"create role acme/portal/admin",
+				"create app acme/portal/web",
+				"create app acme/portal/backend",
+			},
+		},
+		{
+			name: "default policies",
+			doc:  testDocument,
+			current: func() *state {
+				s := testState()
+				s.orgs["acme"].loginPolicy.IsDefault = true
+				s.orgs["acme"].passwordComplexityPolicy.IsDefault = true
+				return s
+			},
+			want: []string{
+				"create login policy acme",
+				"create password complexity policy acme",
+			},
+		},
+		{
+			name:    "up to date",
+			doc:     testDocument,
+			current: testState,
+			prune:   true,
+			want:    []string{},
+		},
+		{
+			name: "changed",
+			doc: func() *Document {
+				doc := testDocument()
+				doc.Targets[0].InterruptOnError = true
+				doc.Orgs[0].LoginPolicy.ForceMFA = true
+				doc.Orgs[0].IDPs[0].OIDC.ClientSecret = "rotated"
+				doc.Orgs[0].Projects[0].HasProjectCheck = true
+				doc.Orgs[0].Projects[0].Roles[0].Group = "staff"
+				doc.Orgs[0].Projects[0].Apps[0].OIDC.GrantTypes = []string{"authorization_code", "refresh_token"}
+				doc.Orgs[0].Projects[0].Apps[1] = &App{Name: "backend", OIDC: &OIDCApp{AppType: "web", AuthMethod: "basic"}}
+				return doc
+			},
+			current: testState,
+			want: []string{
+				"update target webhook",
+				"update login policy acme",
+				"update identity provider acme/corporate",
+				"update project acme/portal",
+				"update role acme/portal/admin",
+				"update app acme/portal/web",
+				"delete app acme/portal/backend",
+				"create app acme/portal/backend",
+			},
+		},
+		{
+			name: "removed without prune",
+			doc: func() *Document {
+				return &Document{Orgs: []*Org{{Name: "acme", Projects: []*Project{{Name: "portal", ProjectRoleAssertion: true}}}}}
+			},
+			current: testState,
+			want:    []string{},
+		},
+		{
+			name: "removed with prune",
+			doc: func() *Document {
+				return &Document{Orgs: []*Org{{Name: "acme", Projects: []*Project{{Name: "portal", ProjectRoleAssertion: true}}}}}
+			},
+			current: testState,
+			prune:   true,
+			want: []string{
+				"delete target webhook",
+				"delete login policy acme",
+				"delete password complexity policy acme",
+				"delete identity provider acme/corporate",
+				"delete role acme/portal/admin",
+				"delete app acme/portal/backend",
+				"delete app acme/portal/web",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newPlan(tt.doc(), tt.current(), tt.prune)
+			assert.Equal(t, tt.want, planSteps(got))
+		})
+	}
+}
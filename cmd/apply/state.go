@@ -0,0 +1,198 @@
+package apply
+
+import (
+	"context"
+	"sort"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// state is the current state of the resources referenced by the document
+type state struct {
+	targets map[string]*query.Target
+	orgs    map[string]*orgState
+}
+
+type orgState struct {
+	id string
+	// loginPolicy is the effective policy, the default policy of the instance if the org has no custom policy
+	loginPolicy *query.LoginPolicy
+	// passwordComplexityPolicy is the effective policy, the default policy of the instance if the org has no custom policy
+	passwordComplexityPolicy *query.PasswordComplexityPolicy
+	idps                     map[string]*idpState
+	projects                 map[string]*projectState
+}
+
+type idpState struct {
+	template     *query.IDPTemplate
+	clientSecret string
+}
+
+type projectState struct {
+	project *query.Project
+	roles   map[string]*query.ProjectRole
+	apps    map[string]*query.App
+}
+
+// loadState queries the targets of the instance and the orgs of the document including their resources.
+// Orgs which are not part of the document are not loaded as they are never changed.
+func loadState(ctx context.Context, queries *query.Queries, doc *Document) (_ *state, err error) {
+	current := &state{
+		orgs: make(map[string]*orgState, len(doc.Orgs)),
+	}
+	if current.targets, err = loadTargets(ctx, queries); err != nil {
+		return nil, err
+	}
+	for _, org := range doc.Orgs {
+		orgState, err := loadOrg(ctx, queries, org.Name)
+		if err != nil {
+			return nil, err
+		}
+		if orgState != nil {
+			current.orgs[org.Name] = orgState
+		}
+	}
+	return current, nil
+}
+
+func loadTargets(ctx context.Context, queries *query.Queries) (map[string]*query.Target, error) {
+	targets, err := queries.SearchTargets(ctx, &query.TargetSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*query.Target, len(targets.Targets))
+	for _, target := range targets.Targets {
+		byName[target.Name] = target
+	}
+	return byName, nil
+}
+
+// loadOrg returns nil if the org does not exist
+func loadOrg(ctx context.Context, queries *query.Queries, name string) (_ *orgState, err error) {
+	nameQuery, err := query.NewOrgNameSearchQuery(query.TextEquals, name)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := queries.SearchOrgs(ctx, &query.OrgSearchQueries{Queries: []query.SearchQuery{nameQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var org *query.Org
+	for _, o := range orgs.Orgs {
+		if o.State != domain.OrgStateRemoved {
+			org = o
+		}
+	}
+	if org == nil {
+		return nil, nil
+	}
+
+	current := &orgState{id: org.ID}
+	if current.loginPolicy, err = queries.LoginPolicyByID(ctx, false, org.ID, false); err != nil {
+		return nil, err
+	}
+	if current.passwordComplexityPolicy, err = queries.PasswordComplexityPolicyByOrg(ctx, false, org.ID, false); err != nil {
+		return nil, err
+	}
+	if current.idps, err = loadIDPs(ctx, queries, org.ID); err != nil {
+		return nil, err
+	}
+	if current.projects, err = loadProjects(ctx, queries, org.ID); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func loadIDPs(ctx context.Context, queries *query.Queries, orgID string) (map[string]*idpState, error) {
+	ownerQuery, err := query.NewIDPTemplateOwnerTypeSearchQuery(domain.IdentityProviderTypeOrg)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewIDPTemplateResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := queries.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{Queries: []query.SearchQuery{ownerQuery, resourceOwnerQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	idps := make(map[string]*idpState, len(templates.Templates))
+	for _, template := range templates.Templates {
+		secret, err := queries.IDPTemplateSecret(template)
+		if err != nil {
+			return nil, err
+		}
+		idps[template.Name] = &idpState{template: template, clientSecret: secret}
+	}
+	return idps, nil
+}
+
+func loadProjects(ctx context.Context, queries *query.Queries, orgID string) (map[string]*projectState, error) {
+	resourceOwnerQuery, err := query.NewProjectResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := queries.SearchProjects(ctx, &query.ProjectSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*projectState, len(projects.Projects))
+	for _, project := range projects.Projects {
+		// the project of ZITADEL itself is never managed
+		if project.ID == authz.GetInstance(ctx).ProjectID() {
+			continue
+		}
+		current := &projectState{project: project}
+		if current.roles, err = loadRoles(ctx, queries, project.ID); err != nil {
+			return nil, err
+		}
+		if current.apps, err = loadApps(ctx, queries, project.ID); err != nil {
+			return nil, err
+		}
+		byName[project.Name] = current
+	}
+	return byName, nil
+}
+
+func loadRoles(ctx context.Context, queries *query.Queries, projectID string) (map[string]*query.ProjectRole, error) {
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := queries.SearchProjectRoles(ctx, false, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectQuery}})
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*query.ProjectRole, len(roles.ProjectRoles))
+	for _, role := range roles.ProjectRoles {
+		byKey[role.Key] = role
+	}
+	return byKey, nil
+}
+
+func loadApps(ctx context.Context, queries *query.Queries, projectID string) (map[string]*query.App, error) {
+	projectQuery, err := query.NewAppProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := queries.SearchApps(ctx, &query.AppSearchQueries{Queries: []query.SearchQuery{projectQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*query.App, len(apps.Apps))
+	for _, app := range apps.Apps {
+		byName[app.Name] = app
+	}
+	return byName, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
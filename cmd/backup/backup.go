@@ -0,0 +1,214 @@
+// Package backup creates backups of single instances and restores them,
+// optionally up to a point in time or into another instance.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/key"
+	admin_handler "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/handler"
+	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	auth_handler "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+const (
+	// BackupUserID is set as editor of the events created by the restore
+	BackupUserID = "BACKUP"
+
+	flagInstance = "instance"
+	flagOutput   = "output"
+	flagFile     = "file"
+	flagPosition = "position"
+	flagReplace  = "replace"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "creates and restores backups of single instances",
+		Long: `creates and restores backups of single instances
+a backup contains the events, unique constraints, personal data keys and assets of the instance
+the encryption keys are not part of the backup, a backup can only be restored with the same keys`,
+	}
+	cmd.AddCommand(createCmd(), restoreCmd())
+	return cmd
+}
+
+func createCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "creates a backup of an instance",
+		Example: `backup create --instance 840498034930840 -o instance.backup
+backup create --instance 840498034930840 | aws s3 cp - s3://backups/instance.backup`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceID, _ := cmd.Flags().GetString(flagInstance)
+			output, _ := cmd.Flags().GetString(flagOutput)
+
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			w, closeOutput, err := openOutput(cmd.OutOrStdout(), output)
+			if err != nil {
+				return err
+			}
+			defer closeOutput()
+			return create(cmd.Context(), cmd.ErrOrStderr(), w, config, masterKey, instanceID)
+		},
+	}
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().String(flagInstance, "", "id of the instance to backup")
+	cmd.Flags().StringP(flagOutput, "o", "-", "path of the backup file, - writes to stdout")
+	logging.OnError(cmd.MarkFlagRequired(flagInstance)).Fatal("unable to mark flag required")
+	return cmd
+}
+
+func restoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restores a backup of an instance",
+		Long: `restores a backup of an instance and rebuilds the projections of the instance
+the data of an existing instance are only replaced if --replace is set
+if --instance differs from the instance of the backup, the backup is restored as a new instance with a generated domain
+the events after --position are not restored, the unique constraints of the backup are complemented by the ones of the restored events`,
+		Example: `backup restore -f instance.backup
+backup restore -f instance.backup --replace --position 1718870213.521391
+backup restore -f instance.backup --instance 278397503453298`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, _ := cmd.Flags().GetString(flagFile)
+			instanceID, _ := cmd.Flags().GetString(flagInstance)
+			position, _ := cmd.Flags().GetFloat64(flagPosition)
+			replace, _ := cmd.Flags().GetBool(flagReplace)
+
+			config := MustNewConfig(viper.GetViper())
+			masterKey, err := key.MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			r, closeInput, err := openInput(cmd.InOrStdin(), file)
+			if err != nil {
+				return err
+			}
+			defer closeInput()
+			return restore(cmd.Context(), cmd.OutOrStdout(), r, config, masterKey, &command.InstanceRestore{
+				InstanceID: instanceID,
+				Position:   position,
+				Replace:    replace,
+			})
+		},
+	}
+	key.AddMasterKeyFlag(cmd)
+	cmd.Flags().StringP(flagFile, "f", "", "path of the backup file, - reads from stdin")
+	cmd.Flags().String(flagInstance, "", "id of the restored instance, defaults to the instance of the backup")
+	cmd.Flags().Float64(flagPosition, 0, "restores the events up to and including the position, all events are restored if not set")
+	cmd.Flags().Bool(flagReplace, false, "replaces the data of an existing instance")
+	logging.OnError(cmd.MarkFlagRequired(flagFile)).Fatal("unable to mark flag required")
+	return cmd
+}
+
+func create(ctx context.Context, out, w io.Writer, config *Config, masterKey, instanceID string) error {
+	commands, err := start(ctx, config, masterKey)
+	if err != nil {
+		return err
+	}
+	backup, err := commands.BackupInstance(backupContext(ctx, config), instanceID, w)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "backup of instance %s created: %d events, %d unique constraints, %d personal data keys, %d assets, position %f\n",
+		backup.InstanceID, backup.Events, backup.UniqueConstraints, backup.PersonalDataKeys, backup.Assets, backup.Position)
+	return nil
+}
+
+func restore(ctx context.Context, out io.Writer, r io.Reader, config *Config, masterKey string, instanceRestore *command.InstanceRestore) error {
+	commands, err := start(ctx, config, masterKey)
+	if err != nil {
+		return err
+	}
+	restored, err := commands.RestoreInstance(backupContext(ctx, config), r, instanceRestore)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "backup of %s restored into instance %s: %d events, position %f\n",
+		restored.CreatedAt.Format(time.RFC3339), restored.InstanceID, restored.Events, restored.Position)
+
+	if err = resetInstanceProjections(ctx, restored.InstanceID); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "projections reset, rebuilding projections of the instance")
+	if err = projectInstance(internal_authz.WithInstanceID(ctx, restored.InstanceID)); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "projections rebuilt")
+	return nil
+}
+
+// backupContext sets the editor of the events and the domain used to generate the domain of new instances
+func backupContext(ctx context.Context, config *Config) context.Context {
+	ctx = internal_authz.SetCtxData(ctx, internal_authz.CtxData{UserID: BackupUserID})
+	return http_util.WithDomainContext(ctx, &http_util.DomainCtx{InstanceHost: config.ExternalDomain})
+}
+
+// resetInstanceProjections removes the data of the instance from the projections,
+// the notification handlers skip the restored events so no notifications are sent again
+func resetInstanceProjections(ctx context.Context, instanceID string) error {
+	if err := projection.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	if err := admin_handler.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	if err := auth_handler.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	return notification.SkipInstance(ctx, instanceID)
+}
+
+func projectInstance(ctx context.Context) error {
+	if err := projection.ProjectInstance(ctx); err != nil {
+		return err
+	}
+	if err := admin_handler.ProjectInstance(ctx); err != nil {
+		return err
+	}
+	return auth_handler.ProjectInstance(ctx)
+}
+
+func openOutput(stdout io.Writer, path string) (io.Writer, func(), error) {
+	if path == "-" {
+		return stdout, func() {}, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() {
+		logging.OnError(f.Close()).Error("unable to close backup file")
+	}, nil
+}
+
+func openInput(stdin io.Reader, path string) (io.Reader, func(), error) {
+	if path == "-" {
+		return stdin, func() {}, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() {
+		logging.OnError(f.Close()).Debug("unable to close backup file")
+	}, nil
+}
@@ -0,0 +1,187 @@
+package backup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	admin_handler "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/handler"
+	admin_view "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/view"
+	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	auth_handler "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
+	auth_view "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/view"
+	"github.com/zitadel/zitadel/internal/cache/connector"
+	"github.com/zitadel/zitadel/internal/command"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
+	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
+	"github.com/zitadel/zitadel/internal/webauthn"
+)
+
+// start creates the commands and registers the projections and handlers without starting them in the background,
+// so the projections of a restored instance can be reset and triggered.
+// The permissions are not checked as the command has direct access to the database anyway.
+func start(ctx context.Context, config *Config, masterKey string) (*command.Commands, error) {
+	queryDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeQuery)
+	if err != nil {
+		return nil, err
+	}
+	projectionDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeProjectionSpooler)
+	if err != nil {
+		return nil, err
+	}
+	esPusherDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeEventPusher)
+	if err != nil {
+		return nil, err
+	}
+
+	keyStorage, err := crypto_db.NewKeyStorage(queryDBClient, masterKey)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	staticStorage, err := config.AssetStorage.NewStorage(queryDBClient.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	esPusher := new_es.NewEventstore(esPusherDBClient, new_es.WithPersonalData(keys.PersonalData))
+	config.Eventstore.Pusher = esPusher
+	config.Eventstore.Searcher = esPusher
+	config.Eventstore.PersonalData = esPusher
+	config.Eventstore.Backup = esPusher
+	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
+	es := eventstore.NewEventstore(config.Eventstore)
+	esV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(queryDBClient, &es_v4_pg.Config{
+		MaxRetries: config.Eventstore.MaxRetries,
+	}))
+
+	cacheConnectors, err := connector.StartConnectors(config.Caches, queryDBClient)
+	if err != nil {
+		return nil, err
+	}
+	sessionTokenVerifier := internal_authz.SessionTokenVerifier(keys.OIDC)
+	permissionCheck := func(ctx context.Context, permission, orgID, resourceID string) error {
+		return nil
+	}
+
+	queries, err := query.StartQueries(
+		ctx,
+		es,
+		esV4.Querier,
+		queryDBClient,
+		projectionDBClient,
+		cacheConnectors,
+		config.Projections,
+		config.SystemDefaults,
+		keys.IDPConfig,
+		keys.OTP,
+		keys.OIDC,
+		keys.SAML,
+		config.InternalAuthZ.RolePermissionMappings,
+		sessionTokenVerifier,
+		func(q *query.Queries) domain.PermissionCheck {
+			return permissionCheck
+		},
+		0,
+		config.SystemAPIUsers,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commands, err := command.StartCommands(ctx,
+		es,
+		cacheConnectors,
+		config.SystemDefaults,
+		config.InternalAuthZ.RolePermissionMappings,
+		staticStorage,
+		&webauthn.Config{
+			DisplayName:    config.WebAuthNName,
+			ExternalSecure: config.ExternalSecure,
+		},
+		config.ExternalDomain,
+		config.ExternalSecure,
+		config.ExternalPort,
+		keys.IDPConfig,
+		keys.OTP,
+		keys.SMTP,
+		keys.SMS,
+		keys.User,
+		keys.DomainVerification,
+		keys.OIDC,
+		keys.SAML,
+		&http.Client{},
+		permissionCheck,
+		sessionTokenVerifier,
+		config.OIDC.DefaultAccessTokenLifetime,
+		config.OIDC.DefaultRefreshTokenExpiration,
+		config.OIDC.DefaultRefreshTokenIdleExpiration,
+		config.DefaultInstance.SecretGenerators,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = projection.Create(ctx, projectionDBClient, es, config.Projections, keys.OIDC, keys.SAML, config.SystemAPIUsers); err != nil {
+		return nil, err
+	}
+
+	i18n.MustLoadSupportedLanguagesFromDir()
+
+	notification.Register(
+		ctx,
+		config.Projections.Customizations["notifications"],
+		config.Projections.Customizations["notificationsquotas"],
+		config.Projections.Customizations["backchannel"],
+		config.Projections.Customizations["telemetry"],
+		*config.Telemetry,
+		config.ExternalDomain,
+		config.ExternalPort,
+		config.ExternalSecure,
+		commands,
+		queries,
+		es,
+		staticStorage,
+		config.Login.DefaultOTPEmailURLV2,
+		config.SystemDefaults.Notifications.FileSystemPath,
+		keys.User,
+		keys.SMTP,
+		keys.SMS,
+		keys.OIDC,
+		config.OIDC.DefaultBackChannelLogoutLifetime,
+		config.SystemDefaults.Notifications.Push,
+	)
+
+	config.Auth.Spooler.Client = projectionDBClient
+	config.Auth.Spooler.Eventstore = es
+	authView, err := auth_view.StartView(config.Auth.Spooler.Client, keys.OIDC, queries, config.Auth.Spooler.Eventstore)
+	if err != nil {
+		return nil, err
+	}
+	auth_handler.Register(ctx, config.Auth.Spooler, authView, queries)
+
+	config.Admin.Spooler.Client = projectionDBClient
+	config.Admin.Spooler.Eventstore = es
+	adminView, err := admin_view.StartView(config.Admin.Spooler.Client)
+	if err != nil {
+		return nil, err
+	}
+	admin_handler.Register(ctx, config.Admin.Spooler, adminView, staticStorage)
+
+	return commands, nil
+}
@@ -22,7 +22,10 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-var shouldIgnorePrevious bool
+var (
+	shouldIgnorePrevious  bool
+	shouldIncludeArchived bool
+)
 
 func eventstoreCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,15 +33,23 @@ func eventstoreCmd() *cobra.Command {
 		Short: "mirrors the eventstore of an instance from one database to another",
 		Long: `mirrors the eventstore of an instance from one database to another
 ZITADEL needs to be initialized and set up with the --for-mirror flag
-Migrate only copies events2 and unique constraints`,
+Migrate only copies events2 and unique constraints
+Archived events are only copied if --include-archived is set, they are inserted into events2 of the destination
+If --follow is set the events are copied continuously, the unique constraints are replaced after the cutover`,
 		Run: func(cmd *cobra.Command, args []string) {
 			config := mustNewMigrationConfig(viper.GetViper())
+			if shouldFollow {
+				followEvents(cmd.Context(), config)
+				shouldReplace = true
+			}
 			copyEventstore(cmd.Context(), config)
 		},
 	}
 
 	cmd.Flags().BoolVar(&shouldReplace, "replace", false, "allow delete unique constraints of defined instances before copy")
 	cmd.Flags().BoolVar(&shouldIgnorePrevious, "ignore-previous", false, "ignores previous migrations of the events table")
+	cmd.Flags().BoolVar(&shouldIncludeArchived, "include-archived", false, "also copies the archived events of the source")
+	followFlags(cmd)
 
 	return cmd
 }
@@ -52,8 +63,34 @@ func copyEventstore(ctx context.Context, config *Migration) {
 	logging.OnError(err).Fatal("unable to connect to destination database")
 	defer destClient.Close()
 
-	copyEvents(ctx, sourceClient, destClient, config.EventBulkSize)
+	_, _, err = copyEvents(ctx, sourceClient, destClient, config.EventBulkSize)
+	logging.OnError(err).Fatal("unable to copy events")
 	copyUniqueConstraints(ctx, sourceClient, destClient)
+
+	if shouldFollow {
+		err = verifyCutover(ctx, sourceClient, destClient)
+		logging.OnError(err).Fatal("cutover incomplete")
+	}
+}
+
+// verifyCutover compares the amount of events of the source and the destination after the cutover.
+// A difference means that events were pushed to the source after the remaining events were copied.
+func verifyCutover(ctx context.Context, source, dest *db.DB) error {
+	sourceCount := countEntries(ctx, source, eventsSourceTable())
+	destCount := countEntries(ctx, dest, "eventstore.events2")
+	if sourceCount != destCount {
+		return zerrors.ThrowInternalf(nil, "MIGRA-Eeb4k", "source contains %d events, destination %d events", sourceCount, destCount)
+	}
+	logging.WithFields("count", destCount).Info("cutover verified")
+	return nil
+}
+
+// eventsSourceTable returns the table or view the events are copied from
+func eventsSourceTable() string {
+	if shouldIncludeArchived {
+		return "eventstore.events2_with_archive"
+	}
+	return "eventstore.events2"
 }
 
 func positionQuery(db *db.DB) string {
@@ -68,7 +105,8 @@ func positionQuery(db *db.DB) string {
 	}
 }
 
-func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) {
+// copyEvents copies the events since the last successful migration and returns the position the events were copied up to.
+func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) (_ float64, eventCount int64, _ error) {
 	start := time.Now()
 	reader, writer := io.Pipe()
 
@@ -94,6 +132,9 @@ func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) {
 	maxPosition, err := writeMigrationStart(ctx, sourceES, migrationID, dest.DatabaseName())
 	logging.OnError(err).Fatal("unable to write migration started event")
 
+	maxPosition, err = awaitOpenTransactions(ctx, source, maxPosition)
+	logging.OnError(err).Fatal("unable to query open transactions")
+
 	logging.WithFields("from", previousMigration.Position, "to", maxPosition).Info("start event migration")
 
 	nextPos := make(chan bool, 1)
@@ -109,7 +150,9 @@ func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) {
 				var stmt database.Statement
 				stmt.WriteString("COPY (SELECT instance_id, aggregate_type, aggregate_id, event_type, sequence, revision, created_at, regexp_replace(payload::TEXT, '\\\\u0000', '', 'g')::JSON payload, creator, owner, ")
 				stmt.WriteArg(position)
-				stmt.WriteString(" position, row_number() OVER (PARTITION BY instance_id ORDER BY position, in_tx_order) AS in_tx_order FROM eventstore.events2 ")
+				stmt.WriteString(" position, row_number() OVER (PARTITION BY instance_id ORDER BY position, in_tx_order) AS in_tx_order FROM ")
+				stmt.WriteString(eventsSourceTable())
+				stmt.WriteString(" ")
 				stmt.WriteString(instanceClause())
 				stmt.WriteString(" AND ")
 				database.NewNumberAtMost(maxPosition).Write(&stmt, "position")
@@ -161,7 +204,6 @@ func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) {
 		}
 	}()
 
-	var eventCount int64
 	errs <- destConn.Raw(func(driverConn interface{}) error {
 		conn := driverConn.(*stdlib.Conn).Conn()
 
@@ -175,12 +217,16 @@ func copyEvents(ctx context.Context, source, dest *db.DB, bulkSize uint32) {
 	})
 
 	close(errs)
-	writeCopyEventsDone(ctx, destinationES, migrationID, source.DatabaseName(), maxPosition, errs)
+	err = writeCopyEventsDone(ctx, destinationES, migrationID, source.DatabaseName(), maxPosition, errs)
+	if err != nil {
+		return 0, 0, err
+	}
 
 	logging.WithFields("took", time.Since(start), "count", eventCount).Info("events migrated")
+	return maxPosition, eventCount, nil
 }
 
-func writeCopyEventsDone(ctx context.Context, es *eventstore.EventStore, id, source string, position float64, errs <-chan error) {
+func writeCopyEventsDone(ctx context.Context, es *eventstore.EventStore, id, source string, position float64, errs <-chan error) error {
 	joinedErrs := make([]error, 0, len(errs))
 	for err := range errs {
 		joinedErrs = append(joinedErrs, err)
@@ -189,13 +235,14 @@ func writeCopyEventsDone(ctx context.Context, es *eventstore.EventStore, id, sou
 
 	if err != nil {
 		logging.WithError(err).Error("unable to mirror events")
-		err := writeMigrationFailed(ctx, es, id, source, err)
-		logging.OnError(err).Fatal("unable to write failed event")
-		return
+		writeErr := writeMigrationFailed(ctx, es, id, source, err)
+		logging.OnError(writeErr).Fatal("unable to write failed event")
+		return err
 	}
 
 	err = writeMigrationSucceeded(ctx, es, id, source, position)
-	logging.OnError(err).Fatal("unable to write failed event")
+	logging.OnError(err).Fatal("unable to write succeeded event")
+	return nil
 }
 
 func copyUniqueConstraints(ctx context.Context, source, dest *db.DB) {
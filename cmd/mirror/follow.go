@@ -0,0 +1,109 @@
+package mirror
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zitadel/logging"
+
+	db "github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+)
+
+var (
+	shouldFollow   bool
+	followInterval time.Duration
+)
+
+func followFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&shouldFollow, "follow", false, `continuously copies new events from the source to the destination until the process receives SIGINT or SIGTERM.
+Afterwards the remaining events are copied, make sure ZITADEL does not serve traffic from the source anymore at this point.
+The data which are not provided by the events are replaced in the destination after the cutover.`)
+	cmd.Flags().DurationVar(&followInterval, "follow-interval", 10*time.Second, "interval between copying new events if --follow is set")
+}
+
+// followEvents copies the new events of the source to the destination in the configured interval
+// until the process receives SIGINT or SIGTERM.
+// The events pushed afterwards must be copied by the caller as part of the cutover.
+func followEvents(ctx context.Context, config *Migration) {
+	sourceClient, err := db.Connect(config.Source, false, dialect.DBPurposeEventPusher)
+	logging.OnError(err).Fatal("unable to connect to source database")
+	defer sourceClient.Close()
+
+	destClient, err := db.Connect(config.Destination, false, dialect.DBPurposeEventPusher)
+	logging.OnError(err).Fatal("unable to connect to destination database")
+	defer destClient.Close()
+
+	// running copies are finished on cutover, therefore only the wait between them is canceled
+	cutover, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logging.WithFields("interval", followInterval).Info("follow events of source, send SIGINT or SIGTERM to start the cutover")
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+
+	for {
+		position, count, err := copyEvents(ctx, sourceClient, destClient, config.EventBulkSize)
+		// the previous migrations must be respected from now on, otherwise events are copied multiple times
+		shouldIgnorePrevious = false
+		if err == nil {
+			logging.WithFields("count", count, "lag", time.Since(positionTime(sourceClient.Type(), position)).Round(time.Millisecond)).Info("events followed")
+		}
+
+		select {
+		case <-cutover.Done():
+			logging.Info("cutover started, copy remaining data")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// awaitOpenTransactions returns the highest position which is safe to copy.
+// Events of transactions which are still open on the source could be committed with a lower position than maxPosition later on
+// and would be skipped by the next migration.
+func awaitOpenTransactions(ctx context.Context, source *db.DB, maxPosition float64) (float64, error) {
+	var query string
+	switch source.Type() {
+	case "postgres":
+		query = "SELECT EXTRACT(EPOCH FROM min(xact_start)) FROM pg_stat_activity WHERE datname = current_database() AND application_name = '" + dialect.EventstorePusherAppName + "' AND state <> 'idle' AND pid <> pg_backend_pid()"
+	case "cockroach":
+		query = "SELECT (EXTRACT(EPOCH FROM MIN(start)) * 1000000000)::DECIMAL FROM crdb_internal.cluster_transactions WHERE application_name = '" + dialect.EventstorePusherAppName + "'"
+	default:
+		logging.WithFields("db_type", source.Type()).Fatal("database type not recognized")
+	}
+
+	var oldestTransaction sql.NullFloat64
+	err := source.QueryRowContext(
+		ctx,
+		func(row *sql.Row) error {
+			return row.Scan(&oldestTransaction)
+		},
+		query,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !oldestTransaction.Valid || oldestTransaction.Float64 > maxPosition {
+		return maxPosition, nil
+	}
+	// the positions of the open transactions are at least their start
+	return math.Nextafter(oldestTransaction.Float64, 0), nil
+}
+
+// positionTime converts the position of an event to the time it was created at
+func positionTime(dbType string, position float64) time.Time {
+	if dbType == "cockroach" {
+		// the integer part of the hybrid logical clock are the nanoseconds since epoch
+		return time.Unix(0, int64(position))
+	}
+	seconds, fraction := math.Modf(position)
+	return time.Unix(int64(seconds), int64(fraction*float64(time.Second)))
+}
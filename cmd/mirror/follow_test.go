@@ -0,0 +1,147 @@
+package mirror
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	db "github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/cockroach"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	db_mock "github.com/zitadel/zitadel/internal/database/mock"
+	pg "github.com/zitadel/zitadel/internal/database/postgres"
+)
+
+func Test_awaitOpenTransactions(t *testing.T) {
+	const (
+		postgresQuery  = "SELECT EXTRACT(EPOCH FROM min(xact_start)) FROM pg_stat_activity WHERE datname = current_database() AND application_name = 'zitadel_es_pusher' AND state <> 'idle' AND pid <> pg_backend_pid()"
+		cockroachQuery = "SELECT (EXTRACT(EPOCH FROM MIN(start)) * 1000000000)::DECIMAL FROM crdb_internal.cluster_transactions WHERE application_name = 'zitadel_es_pusher'"
+	)
+	type args struct {
+		dialect     dialect.Database
+		maxPosition float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mock    *db_mock.SQLMock
+		want    float64
+		wantErr error
+	}{
+		{
+			name: "postgres, no open transactions",
+			args: args{
+				dialect:     new(pg.Config),
+				maxPosition: 1718870213.5,
+			},
+			mock: db_mock.NewSQLMock(t,
+				db_mock.ExpectQuery(postgresQuery,
+					db_mock.WithQueryResult([]string{"min"}, [][]driver.Value{{nil}}),
+				),
+			),
+			want: 1718870213.5,
+		},
+		{
+			name: "postgres, open transaction after max position",
+			args: args{
+				dialect:     new(pg.Config),
+				maxPosition: 1718870213.5,
+			},
+			mock: db_mock.NewSQLMock(t,
+				db_mock.ExpectQuery(postgresQuery,
+					db_mock.WithQueryResult([]string{"min"}, [][]driver.Value{{1718870214.5}}),
+				),
+			),
+			want: 1718870213.5,
+		},
+		{
+			name: "postgres, open transaction before max position",
+			args: args{
+				dialect:     new(pg.Config),
+				maxPosition: 1718870213.5,
+			},
+			mock: db_mock.NewSQLMock(t,
+				db_mock.ExpectQuery(postgresQuery,
+					db_mock.WithQueryResult([]string{"min"}, [][]driver.Value{{1718870212.5}}),
+				),
+			),
+			want: math.Nextafter(1718870212.5, 0),
+		},
+		{
+			name: "cockroach, open transaction before max position",
+			args: args{
+				dialect:     new(cockroach.Config),
+				maxPosition: 1718870213500000000,
+			},
+			mock: db_mock.NewSQLMock(t,
+				db_mock.ExpectQuery(cockroachQuery,
+					db_mock.WithQueryResult([]string{"min"}, [][]driver.Value{{1718870212500000000}}),
+				),
+			),
+			want: math.Nextafter(1718870212500000000, 0),
+		},
+		{
+			name: "query fails",
+			args: args{
+				dialect:     new(pg.Config),
+				maxPosition: 1718870213.5,
+			},
+			mock: db_mock.NewSQLMock(t,
+				db_mock.ExpectQuery(postgresQuery,
+					db_mock.WithQueryErr(errors.New("query failed")),
+				),
+			),
+			wantErr: errors.New("query failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := awaitOpenTransactions(context.Background(), &db.DB{DB: tt.mock.DB, Database: tt.args.dialect}, tt.args.maxPosition)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			tt.mock.Assert(t)
+		})
+	}
+}
+
+func Test_positionTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		dbType   string
+		position float64
+		want     time.Time
+	}{
+		{
+			name:     "postgres",
+			dbType:   "postgres",
+			position: 1718870213.5,
+			want:     time.Unix(1718870213, int64(500*time.Millisecond)),
+		},
+		{
+			name:     "cockroach",
+			dbType:   "cockroach",
+			position: 1718870213500000000,
+			want:     time.Unix(1718870213, int64(500*time.Millisecond)),
+		},
+		{
+			name:     "zero",
+			dbType:   "postgres",
+			position: 0,
+			want:     time.Unix(0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(positionTime(tt.dbType, tt.position)), "want %v, got %v", tt.want, positionTime(tt.dbType, tt.position))
+		})
+	}
+}
@@ -33,7 +33,9 @@ Order of execution:
 2. mirror auth tables
 3. mirror event store tables
 4. recompute projections
-5. verify`,
+5. verify
+
+If --follow is set the events are copied continuously before the steps above are executed as cutover.`,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			err := viper.MergeConfig(bytes.NewBuffer(defaultConfig))
 			logging.OnError(err).Fatal("unable to read default config")
@@ -51,6 +53,12 @@ Order of execution:
 			masterKey, err := key.MasterKey(cmd)
 			logging.OnError(err).Fatal("unable to read master key")
 
+			if shouldFollow {
+				followEvents(cmd.Context(), config)
+				// the static data could have changed while following
+				shouldReplace = true
+			}
+
 			copySystem(cmd.Context(), config)
 			copyAuth(cmd.Context(), config)
 			copyEventstore(cmd.Context(), config)
@@ -67,6 +75,7 @@ Order of execution:
 * auth.auth_requests
 * eventstore.unique_constraints
 The flag should be provided if you want to execute the mirror command multiple times so that the static data are also mirrored to prevent inconsistent states.`)
+	followFlags(cmd)
 	migrateProjectionsFlags(cmd)
 
 	cmd.AddCommand(
@@ -91,10 +100,12 @@ func instanceClause() string {
 	if isSystem {
 		return "WHERE instance_id <> ''"
 	}
-	for i := range instanceIDs {
-		instanceIDs[i] = "'" + instanceIDs[i] + "'"
+	// the ids are quoted on a copy because the clause is built multiple times
+	quoted := make([]string, len(instanceIDs))
+	for i, id := range instanceIDs {
+		quoted[i] = "'" + id + "'"
 	}
 
 	// COPY does not allow parameters so we need to set them directly
-	return "WHERE instance_id IN (" + strings.Join(instanceIDs, ", ") + ")"
+	return "WHERE instance_id IN (" + strings.Join(quoted, ", ") + ")"
 }
@@ -212,6 +212,7 @@ func projections(
 		commands,
 		queries,
 		es,
+		staticStorage,
 		config.Login.DefaultOTPEmailURLV2,
 		config.SystemDefaults.Notifications.FileSystemPath,
 		keys.User,
@@ -219,6 +220,7 @@ func projections(
 		keys.SMS,
 		keys.OIDC,
 		config.OIDC.DefaultBackChannelLogoutLifetime,
+		config.SystemDefaults.Notifications.Push,
 	)
 
 	config.Auth.Spooler.Client = client
@@ -21,7 +21,7 @@ func systemCmd() *cobra.Command {
 		Short: "mirrors the system tables of ZITADEL from one database to another",
 		Long: `mirrors the system tables of ZITADEL from one database to another
 ZITADEL needs to be initialized
-Only keys and assets are mirrored`,
+Only keys, personal data keys and assets are mirrored`,
 		Run: func(cmd *cobra.Command, args []string) {
 			config := mustNewMigrationConfig(viper.GetViper())
 			copySystem(cmd.Context(), config)
@@ -44,6 +44,7 @@ func copySystem(ctx context.Context, config *Migration) {
 
 	copyAssets(ctx, sourceClient, destClient)
 	copyEncryptionKeys(ctx, sourceClient, destClient)
+	copyPersonalDataKeys(ctx, sourceClient, destClient)
 }
 
 func copyAssets(ctx context.Context, source, dest *database.DB) {
@@ -137,3 +138,48 @@ func copyEncryptionKeys(ctx context.Context, source, dest *database.DB) {
 	logging.OnError(<-errs).Fatal("unable to copy encryption keys from source")
 	logging.WithFields("took", time.Since(start), "count", eventCount).Info("encryption keys migrated")
 }
+
+func copyPersonalDataKeys(ctx context.Context, source, dest *database.DB) {
+	start := time.Now()
+
+	sourceConn, err := source.Conn(ctx)
+	logging.OnError(err).Fatal("unable to acquire source connection")
+	defer sourceConn.Close()
+
+	r, w := io.Pipe()
+	errs := make(chan error, 1)
+
+	go func() {
+		err = sourceConn.Raw(func(driverConn interface{}) error {
+			conn := driverConn.(*stdlib.Conn).Conn()
+			_, err := conn.PgConn().CopyTo(ctx, w, "COPY (SELECT instance_id, subject_id, key_id, key, creation_date FROM eventstore.personal_data_keys "+instanceClause()+") TO stdout")
+			w.Close()
+			return err
+		})
+		errs <- err
+	}()
+
+	destConn, err := dest.Conn(ctx)
+	logging.OnError(err).Fatal("unable to acquire dest connection")
+	defer destConn.Close()
+
+	var keyCount int64
+	err = destConn.Raw(func(driverConn interface{}) error {
+		conn := driverConn.(*stdlib.Conn).Conn()
+
+		if shouldReplace {
+			_, err := conn.Exec(ctx, "DELETE FROM eventstore.personal_data_keys "+instanceClause())
+			if err != nil {
+				return err
+			}
+		}
+
+		tag, err := conn.PgConn().CopyFrom(ctx, r, "COPY eventstore.personal_data_keys (instance_id, subject_id, key_id, key, creation_date) FROM stdin")
+		keyCount = tag.RowsAffected()
+
+		return err
+	})
+	logging.OnError(err).Fatal("unable to copy personal data keys to destination")
+	logging.OnError(<-errs).Fatal("unable to copy personal data keys from source")
+	logging.WithFields("took", time.Since(start), "count", keyCount).Info("personal data keys migrated")
+}
@@ -0,0 +1,159 @@
+package projections
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	"github.com/zitadel/zitadel/cmd/key"
+	crypto_db "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
+	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+const (
+	flagProjection = "projection"
+	flagInstances  = "instance"
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projections",
+		Short: "inspect and control the projections",
+		Long: `inspect and control the projections of a running ZITADEL
+the commands connect to the database directly, the changes apply to all nodes`,
+	}
+	key.AddMasterKeyFlag(cmd)
+	cmd.AddCommand(
+		listCmd(),
+		rebuildCmd(),
+		pauseCmd(),
+		resumeCmd(),
+	)
+	return cmd
+}
+
+func listCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists the projections with their current position and lag per instance",
+		Example: `list
+list --projection projections.users14 --instance 840498034930840`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectionName, _ := cmd.Flags().GetString(flagProjection)
+			instanceIDs, _ := cmd.Flags().GetStringSlice(flagInstances)
+
+			mustCreateProjections(cmd)
+			states, err := projection.States(cmd.Context(), projectionName, instanceIDs...)
+			if err != nil {
+				return err
+			}
+			return printStates(cmd.OutOrStdout(), states)
+		},
+	}
+	cmd.Flags().String(flagProjection, "", "name of the projection, all projections are listed if empty")
+	cmd.Flags().StringSlice(flagInstances, nil, "ids of the instances, all instances are listed if empty")
+	return cmd
+}
+
+func rebuildCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rebuild [projection]",
+		Short: "rebuilds the projection into shadow tables and swaps them as soon as they are up to date",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mustCreateProjections(cmd)
+			return projection.Rebuild(cmd.Context(), args[0])
+		},
+	}
+}
+
+func pauseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause [projection]",
+		Short: "stops the processing of events of the projection on all nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mustCreateProjections(cmd)
+			return projection.Pause(cmd.Context(), args[0])
+		},
+	}
+}
+
+func resumeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume [projection]",
+		Short: "continues the processing of events of a paused projection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mustCreateProjections(cmd)
+			return projection.Resume(cmd.Context(), args[0])
+		},
+	}
+}
+
+// mustCreateProjections creates the projections without starting them
+func mustCreateProjections(cmd *cobra.Command) {
+	ctx := cmd.Context()
+	config := MustNewConfig(viper.GetViper())
+
+	masterKey, err := key.MasterKey(cmd)
+	logging.OnError(err).Fatal("unable to read master key")
+
+	queryDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeQuery)
+	logging.OnError(err).Fatal("unable to connect to database")
+	projectionDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeProjectionSpooler)
+	logging.OnError(err).Fatal("unable to connect to database")
+	esPusherDBClient, err := database.Connect(config.Database, false, dialect.DBPurposeEventPusher)
+	logging.OnError(err).Fatal("unable to connect to database")
+
+	keyStorage, err := crypto_db.NewKeyStorage(queryDBClient, masterKey)
+	logging.OnError(err).Fatal("unable to start key storage")
+	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
+	logging.OnError(err).Fatal("unable to read encryption keys")
+
+	esPusher := new_es.NewEventstore(esPusherDBClient, new_es.WithPersonalData(keys.PersonalData))
+	config.Eventstore.Pusher = esPusher
+	config.Eventstore.Searcher = esPusher
+	config.Eventstore.PersonalData = esPusher
+	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
+	es := eventstore.NewEventstore(config.Eventstore)
+
+	err = projection.Create(ctx, projectionDBClient, es, config.Projections, keys.OIDC, keys.SAML, config.SystemAPIUsers)
+	logging.OnError(err).Fatal("unable to create projections")
+}
+
+func printStates(out io.Writer, states []*handler.ProjectionState) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECTION\tINSTANCE\tPOSITION\tEVENT DATE\tLAST RUN\tLAG\tPAUSED")
+	for _, state := range states {
+		fmt.Fprintf(w, "%s\t%s\t%f\t%s\t%s\t%s\t%t\n",
+			state.ProjectionName,
+			state.InstanceID,
+			state.Position,
+			formatTime(state.EventDate),
+			formatTime(state.LastUpdated),
+			state.Lag.Round(time.Millisecond),
+			state.Paused,
+		)
+	}
+	return w.Flush()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
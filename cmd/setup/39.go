@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 39.sql
+	addLDAPSyncColumns string
+)
+
+type IDPTemplate6LDAP2SyncOptions struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6LDAP2SyncOptions) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPSyncColumns)
+	return err
+}
+
+func (mig *IDPTemplate6LDAP2SyncOptions) String() string {
+	return "39_idp_templates6_ldap2_add_sync_options"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 40.sql
+	addIDPAttributeMappingsColumn string
+)
+
+type IDPTemplate6AttributeMappings struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplate6AttributeMappings) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addIDPAttributeMappingsColumn)
+	return err
+}
+
+func (mig *IDPTemplate6AttributeMappings) String() string {
+	return "40_idp_templates6_add_attribute_mappings"
+}
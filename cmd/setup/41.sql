@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS eventstore.personal_data_keys (
+    instance_id TEXT NOT NULL
+    , subject_id TEXT NOT NULL
+    -- id of the encryption key used to encrypt the data key
+    , key_id TEXT NOT NULL
+    , "key" BYTEA NOT NULL
+    , creation_date TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, subject_id)
+);
@@ -0,0 +1,39 @@
+package setup
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 42/*.sql
+	addEventArchive embed.FS
+)
+
+type AddEventArchive struct {
+	dbClient *database.DB
+}
+
+func (mig *AddEventArchive) Execute(ctx context.Context, _ eventstore.Event) error {
+	statements, err := readStatements(addEventArchive, "42", "")
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		logging.WithFields("file", stmt.file, "migration", mig.String()).Info("execute statement")
+		if _, err := mig.dbClient.ExecContext(ctx, stmt.query); err != nil {
+			return fmt.Errorf("%s %s: %w", mig.String(), stmt.file, err)
+		}
+	}
+	return nil
+}
+
+func (mig *AddEventArchive) String() string {
+	return "42_add_event_archive"
+}
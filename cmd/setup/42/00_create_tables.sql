@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS eventstore.events2_archive (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+
+    , event_type TEXT NOT NULL
+    , "sequence" BIGINT NOT NULL
+    , revision SMALLINT NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+    , payload JSONB
+    , creator TEXT NOT NULL
+    , "owner" TEXT NOT NULL
+
+    , "position" DECIMAL NOT NULL
+    , in_tx_order INTEGER NOT NULL
+
+    , PRIMARY KEY (instance_id, aggregate_type, aggregate_id, "sequence")
+);
+
+CREATE INDEX IF NOT EXISTS es_archive_projection ON eventstore.events2_archive (instance_id, aggregate_type, event_type, "position");
+
+-- manifest of the archived events
+CREATE TABLE IF NOT EXISTS eventstore.event_archives (
+    id TEXT NOT NULL DEFAULT gen_random_uuid()
+    , instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_count INTEGER NOT NULL
+    , event_count INTEGER NOT NULL
+    , min_position DECIMAL NOT NULL
+    , max_position DECIMAL NOT NULL
+    , oldest_created_at TIMESTAMPTZ NOT NULL
+    , newest_created_at TIMESTAMPTZ NOT NULL
+    , archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, id)
+);
+
+CREATE OR REPLACE VIEW eventstore.events2_with_archive AS
+    SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2
+    UNION ALL
+    SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2_archive;
@@ -0,0 +1,3 @@
+-- bounds the search for archive candidates to the events of the aggregate type created before the retention
+CREATE INDEX CONCURRENTLY IF NOT EXISTS es_archive_candidates
+    ON eventstore.events2 (aggregate_type, created_at) INCLUDE (instance_id, aggregate_id, "sequence", event_type);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 43.sql
+	addSnapshotsTable string
+)
+
+type AddSnapshotsTable struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSnapshotsTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSnapshotsTable)
+	return err
+}
+
+func (mig *AddSnapshotsTable) String() string {
+	return "43_add_snapshots_table"
+}
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+    instance_id TEXT NOT NULL
+    , key TEXT NOT NULL
+    , version SMALLINT NOT NULL
+    , "position" DECIMAL NOT NULL
+    , payload JSONB NOT NULL
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, key)
+);
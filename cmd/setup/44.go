@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 44.sql
+	addPausedProjectionsTable string
+)
+
+type AddPausedProjectionsTable struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPausedProjectionsTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPausedProjectionsTable)
+	return err
+}
+
+func (mig *AddPausedProjectionsTable) String() string {
+	return "44_add_paused_projections_table"
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS projections.paused_projections (
+    projection_name TEXT NOT NULL
+    , paused_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (projection_name)
+);
+
+-- contains the tables of projections while they are rebuilt
+CREATE SCHEMA IF NOT EXISTS projections_shadow;
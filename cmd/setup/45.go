@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 45.sql
+	addMagicLinkColumns string
+)
+
+type AddMagicLinkColumns struct {
+	dbClient *database.DB
+}
+
+func (mig *AddMagicLinkColumns) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addMagicLinkColumns)
+	return err
+}
+
+func (mig *AddMagicLinkColumns) String() string {
+	return "45_add_magic_link_columns"
+}
@@ -0,0 +1,29 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 46.sql
+	addPositionIndex string
+)
+
+// AddPositionIndex supports querying the latest events by position,
+// which is done to poll for new events if the database does not support LISTEN.
+type AddPositionIndex struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPositionIndex) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPositionIndex)
+	return err
+}
+
+func (mig *AddPositionIndex) String() string {
+	return "46_add_position_index"
+}
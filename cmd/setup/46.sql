@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS es_position ON eventstore.events2 ("position");
@@ -125,6 +125,14 @@ type Steps struct {
 	s36FillV2Milestones                     *FillV2Milestones
 	s37Apps7OIDConfigsBackChannelLogoutURI  *Apps7OIDConfigsBackChannelLogoutURI
 	s38BackChannelLogoutNotificationStart   *BackChannelLogoutNotificationStart
+	s39IDPTemplate6LDAP2SyncOptions         *IDPTemplate6LDAP2SyncOptions
+	s40IDPTemplate6AttributeMappings        *IDPTemplate6AttributeMappings
+	s41AddPersonalDataKeysTable             *AddPersonalDataKeysTable
+	s42AddEventArchive                      *AddEventArchive
+	s43AddSnapshotsTable                    *AddSnapshotsTable
+	s44AddPausedProjectionsTable            *AddPausedProjectionsTable
+	s45AddMagicLinkColumns                  *AddMagicLinkColumns
+	s46AddPositionIndex                     *AddPositionIndex
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -110,9 +110,12 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	logging.OnError(err).Fatal("unable to connect to database")
 
 	config.Eventstore.Querier = old_es.NewCRDB(queryDBClient)
-	esV3 := new_es.NewEventstore(esPusherDBClient)
+	esV3 := new_es.NewEventstore(esPusherDBClient, personalDataOption(ctx, config, queryDBClient, masterKey)...)
 	config.Eventstore.Pusher = esV3
 	config.Eventstore.Searcher = esV3
+	if !config.ForMirror {
+		config.Eventstore.PersonalData = esV3
+	}
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 
 	logging.OnError(err).Fatal("unable to start eventstore")
@@ -169,6 +172,14 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s36FillV2Milestones = &FillV2Milestones{dbClient: queryDBClient, eventstore: eventstoreClient}
 	steps.s37Apps7OIDConfigsBackChannelLogoutURI = &Apps7OIDConfigsBackChannelLogoutURI{dbClient: esPusherDBClient}
 	steps.s38BackChannelLogoutNotificationStart = &BackChannelLogoutNotificationStart{dbClient: esPusherDBClient, esClient: eventstoreClient}
+	steps.s39IDPTemplate6LDAP2SyncOptions = &IDPTemplate6LDAP2SyncOptions{dbClient: esPusherDBClient}
+	steps.s40IDPTemplate6AttributeMappings = &IDPTemplate6AttributeMappings{dbClient: esPusherDBClient}
+	steps.s41AddPersonalDataKeysTable = &AddPersonalDataKeysTable{dbClient: esPusherDBClient}
+	steps.s42AddEventArchive = &AddEventArchive{dbClient: esPusherDBClient}
+	steps.s43AddSnapshotsTable = &AddSnapshotsTable{dbClient: esPusherDBClient}
+	steps.s44AddPausedProjectionsTable = &AddPausedProjectionsTable{dbClient: queryDBClient}
+	steps.s45AddMagicLinkColumns = &AddMagicLinkColumns{dbClient: esPusherDBClient}
+	steps.s46AddPositionIndex = &AddPositionIndex{dbClient: esPusherDBClient}
 
 	err = projection.Create(ctx, projectionDBClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -193,6 +204,10 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s2AssetsTable,
 		steps.s28AddFieldTable,
 		steps.s31AddAggregateIndexToFields,
+		steps.s41AddPersonalDataKeysTable,
+		steps.s42AddEventArchive,
+		steps.s43AddSnapshotsTable,
+		steps.s44AddPausedProjectionsTable,
 		steps.FirstInstance,
 		steps.s5LastFailed,
 		steps.s6OwnerRemoveColumns,
@@ -215,6 +230,7 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s35AddPositionToIndexEsWm,
 		steps.s36FillV2Milestones,
 		steps.s38BackChannelLogoutNotificationStart,
+		steps.s46AddPositionIndex,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -232,6 +248,9 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s32AddAuthSessionID,
 		steps.s33SMSConfigs3TwilioAddVerifyServiceSid,
 		steps.s37Apps7OIDConfigsBackChannelLogoutURI,
+		steps.s39IDPTemplate6LDAP2SyncOptions,
+		steps.s40IDPTemplate6AttributeMappings,
+		steps.s45AddMagicLinkColumns,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -250,6 +269,20 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	}
 }
 
+// personalDataOption enables the encryption of personal data in events.
+// If setup runs for a mirror the encryption keys are copied from the source afterwards,
+// therefore no keys are created.
+func personalDataOption(ctx context.Context, config *Config, dbClient *database.DB, masterKey string) []new_es.Option {
+	if config.ForMirror {
+		return nil
+	}
+	keyStorage, err := cryptoDB.NewKeyStorage(dbClient, masterKey)
+	logging.OnError(err).Fatal("unable to start key storage")
+	keys, err := encryption.EnsureEncryptionKeys(ctx, config.EncryptionKeys, keyStorage)
+	logging.OnError(err).Fatal("unable to ensure encryption keys")
+	return []new_es.Option{new_es.WithPersonalData(keys.PersonalData)}
+}
+
 func mustExecuteMigration(ctx context.Context, eventstoreClient *eventstore.Eventstore, step migration.Migration, errorMsg string) {
 	err := migration.Migrate(ctx, eventstoreClient, step)
 	logging.WithFields("name", step.String()).OnError(err).Fatal(errorMsg)
@@ -899,7 +899,7 @@ module.exports = {
             type: "doc",
             id: "self-hosting/manage/cli/overview",
           },
-          items: ["self-hosting/manage/cli/mirror"],
+          items: ["self-hosting/manage/cli/mirror", "self-hosting/manage/cli/apply", "self-hosting/manage/cli/backup"],
         },
       ],
     },
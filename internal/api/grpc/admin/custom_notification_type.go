@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListCustomNotificationTypes(ctx context.Context, req *admin_pb.ListCustomNotificationTypesRequest) (*admin_pb.ListCustomNotificationTypesResponse, error) {
+	types, err := s.query.SearchCustomNotificationTypes(ctx, ListCustomNotificationTypesRequestToModel(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListCustomNotificationTypesResponse{
+		Result: CustomNotificationTypesToPb(types.Types),
+		Details: object.ToListDetails(
+			types.Count,
+			types.Sequence,
+			types.LastRun,
+		),
+	}, nil
+}
+
+func (s *Server) AddCustomNotificationType(ctx context.Context, req *admin_pb.AddCustomNotificationTypeRequest) (*admin_pb.AddCustomNotificationTypeResponse, error) {
+	details, err := s.command.AddCustomNotificationType(ctx, &domain.CustomNotificationType{
+		Type:        req.Type,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddCustomNotificationTypeResponse{
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateCustomNotificationType(ctx context.Context, req *admin_pb.UpdateCustomNotificationTypeRequest) (*admin_pb.UpdateCustomNotificationTypeResponse, error) {
+	details, err := s.command.ChangeCustomNotificationType(ctx, &domain.CustomNotificationType{
+		Type:        req.Type,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateCustomNotificationTypeResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveCustomNotificationType(ctx context.Context, req *admin_pb.RemoveCustomNotificationTypeRequest) (*admin_pb.RemoveCustomNotificationTypeResponse, error) {
+	details, err := s.command.RemoveCustomNotificationType(ctx, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveCustomNotificationTypeResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetCustomNotificationTypeText(ctx context.Context, req *admin_pb.GetCustomNotificationTypeTextRequest) (*admin_pb.GetCustomNotificationTypeTextResponse, error) {
+	if !domain.IsCustomMessageType(req.Type) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-xoo8E", "Errors.CustomNotificationType.InvalidType")
+	}
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), req.Type, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomNotificationTypeTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultCustomNotificationTypeText(ctx context.Context, req *admin_pb.SetDefaultCustomNotificationTypeTextRequest) (*admin_pb.SetDefaultCustomNotificationTypeTextResponse, error) {
+	result, err := s.command.SetDefaultCustomNotificationText(ctx, SetCustomNotificationTypeTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultCustomNotificationTypeTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomNotificationTypeTextToDefault(ctx context.Context, req *admin_pb.ResetCustomNotificationTypeTextToDefaultRequest) (*admin_pb.ResetCustomNotificationTypeTextToDefaultResponse, error) {
+	if !domain.IsCustomMessageType(req.Type) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ua7ie", "Errors.CustomNotificationType.InvalidType")
+	}
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, req.Type, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomNotificationTypeTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
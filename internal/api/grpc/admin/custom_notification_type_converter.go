@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	text_pb "github.com/zitadel/zitadel/pkg/grpc/text"
+)
+
+func ListCustomNotificationTypesRequestToModel(req *admin_pb.ListCustomNotificationTypesRequest) *query.CustomNotificationTypeSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.CustomNotificationTypeSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.CustomNotificationTypeTypeCol,
+		},
+	}
+}
+
+func CustomNotificationTypesToPb(types []*query.CustomNotificationType) []*text_pb.CustomNotificationType {
+	result := make([]*text_pb.CustomNotificationType, len(types))
+	for i, notificationType := range types {
+		result[i] = &text_pb.CustomNotificationType{
+			Details: object.ToViewDetailsPb(
+				notificationType.Sequence,
+				notificationType.CreationDate,
+				notificationType.ChangeDate,
+				notificationType.InstanceID,
+			),
+			Type:        notificationType.Type,
+			Description: notificationType.Description,
+		}
+	}
+	return result
+}
+
+func SetCustomNotificationTypeTextToDomain(msg *admin_pb.SetDefaultCustomNotificationTypeTextRequest) *domain.CustomMessageText {
+	return &domain.CustomMessageText{
+		MessageTextType: msg.Type,
+		Language:        language.Make(msg.Language),
+		Title:           msg.Title,
+		PreHeader:       msg.PreHeader,
+		Subject:         msg.Subject,
+		Greeting:        msg.Greeting,
+		Text:            msg.Text,
+		ButtonText:      msg.ButtonText,
+		FooterText:      msg.FooterText,
+	}
+}
@@ -444,6 +444,390 @@ func (s *Server) ResetCustomInviteUserMessageTextToDefault(ctx context.Context,
 	}, nil
 }
 
+func (s *Server) GetDefaultLoginRiskMessageText(ctx context.Context, req *admin_pb.GetDefaultLoginRiskMessageTextRequest) (*admin_pb.GetDefaultLoginRiskMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.LoginRiskMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultLoginRiskMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomLoginRiskMessageText(ctx context.Context, req *admin_pb.GetCustomLoginRiskMessageTextRequest) (*admin_pb.GetCustomLoginRiskMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.LoginRiskMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomLoginRiskMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultLoginRiskMessageText(ctx context.Context, req *admin_pb.SetDefaultLoginRiskMessageTextRequest) (*admin_pb.SetDefaultLoginRiskMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetLoginRiskCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultLoginRiskMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomLoginRiskMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomLoginRiskMessageTextToDefaultRequest) (*admin_pb.ResetCustomLoginRiskMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.LoginRiskMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomLoginRiskMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultMFAAddedMessageText(ctx context.Context, req *admin_pb.GetDefaultMFAAddedMessageTextRequest) (*admin_pb.GetDefaultMFAAddedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.MFAAddedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultMFAAddedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomMFAAddedMessageText(ctx context.Context, req *admin_pb.GetCustomMFAAddedMessageTextRequest) (*admin_pb.GetCustomMFAAddedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.MFAAddedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomMFAAddedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultMFAAddedMessageText(ctx context.Context, req *admin_pb.SetDefaultMFAAddedMessageTextRequest) (*admin_pb.SetDefaultMFAAddedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetMFAAddedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMFAAddedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMFAAddedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomMFAAddedMessageTextToDefaultRequest) (*admin_pb.ResetCustomMFAAddedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.MFAAddedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomMFAAddedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultMFARemovedMessageText(ctx context.Context, req *admin_pb.GetDefaultMFARemovedMessageTextRequest) (*admin_pb.GetDefaultMFARemovedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.MFARemovedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultMFARemovedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomMFARemovedMessageText(ctx context.Context, req *admin_pb.GetCustomMFARemovedMessageTextRequest) (*admin_pb.GetCustomMFARemovedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.MFARemovedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomMFARemovedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultMFARemovedMessageText(ctx context.Context, req *admin_pb.SetDefaultMFARemovedMessageTextRequest) (*admin_pb.SetDefaultMFARemovedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetMFARemovedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMFARemovedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMFARemovedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomMFARemovedMessageTextToDefaultRequest) (*admin_pb.ResetCustomMFARemovedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.MFARemovedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomMFARemovedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultPasskeyAddedMessageText(ctx context.Context, req *admin_pb.GetDefaultPasskeyAddedMessageTextRequest) (*admin_pb.GetDefaultPasskeyAddedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.PasskeyAddedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultPasskeyAddedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomPasskeyAddedMessageText(ctx context.Context, req *admin_pb.GetCustomPasskeyAddedMessageTextRequest) (*admin_pb.GetCustomPasskeyAddedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.PasskeyAddedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomPasskeyAddedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultPasskeyAddedMessageText(ctx context.Context, req *admin_pb.SetDefaultPasskeyAddedMessageTextRequest) (*admin_pb.SetDefaultPasskeyAddedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetPasskeyAddedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultPasskeyAddedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomPasskeyAddedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomPasskeyAddedMessageTextToDefaultRequest) (*admin_pb.ResetCustomPasskeyAddedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.PasskeyAddedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomPasskeyAddedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultEmailChangedMessageText(ctx context.Context, req *admin_pb.GetDefaultEmailChangedMessageTextRequest) (*admin_pb.GetDefaultEmailChangedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.EmailChangedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultEmailChangedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomEmailChangedMessageText(ctx context.Context, req *admin_pb.GetCustomEmailChangedMessageTextRequest) (*admin_pb.GetCustomEmailChangedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.EmailChangedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomEmailChangedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultEmailChangedMessageText(ctx context.Context, req *admin_pb.SetDefaultEmailChangedMessageTextRequest) (*admin_pb.SetDefaultEmailChangedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetEmailChangedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultEmailChangedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomEmailChangedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomEmailChangedMessageTextToDefaultRequest) (*admin_pb.ResetCustomEmailChangedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.EmailChangedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomEmailChangedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultPhoneChangedMessageText(ctx context.Context, req *admin_pb.GetDefaultPhoneChangedMessageTextRequest) (*admin_pb.GetDefaultPhoneChangedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.PhoneChangedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultPhoneChangedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomPhoneChangedMessageText(ctx context.Context, req *admin_pb.GetCustomPhoneChangedMessageTextRequest) (*admin_pb.GetCustomPhoneChangedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.PhoneChangedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomPhoneChangedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultPhoneChangedMessageText(ctx context.Context, req *admin_pb.SetDefaultPhoneChangedMessageTextRequest) (*admin_pb.SetDefaultPhoneChangedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetPhoneChangedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultPhoneChangedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomPhoneChangedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomPhoneChangedMessageTextToDefaultRequest) (*admin_pb.ResetCustomPhoneChangedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.PhoneChangedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomPhoneChangedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultAccountLockedMessageText(ctx context.Context, req *admin_pb.GetDefaultAccountLockedMessageTextRequest) (*admin_pb.GetDefaultAccountLockedMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.AccountLockedMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultAccountLockedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomAccountLockedMessageText(ctx context.Context, req *admin_pb.GetCustomAccountLockedMessageTextRequest) (*admin_pb.GetCustomAccountLockedMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.AccountLockedMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomAccountLockedMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultAccountLockedMessageText(ctx context.Context, req *admin_pb.SetDefaultAccountLockedMessageTextRequest) (*admin_pb.SetDefaultAccountLockedMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetAccountLockedCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultAccountLockedMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomAccountLockedMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomAccountLockedMessageTextToDefaultRequest) (*admin_pb.ResetCustomAccountLockedMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.AccountLockedMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomAccountLockedMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetDefaultNewDeviceLoginMessageText(ctx context.Context, req *admin_pb.GetDefaultNewDeviceLoginMessageTextRequest) (*admin_pb.GetDefaultNewDeviceLoginMessageTextResponse, error) {
+	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.NewDeviceLoginMessageType, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultNewDeviceLoginMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) GetCustomNewDeviceLoginMessageText(ctx context.Context, req *admin_pb.GetCustomNewDeviceLoginMessageTextRequest) (*admin_pb.GetCustomNewDeviceLoginMessageTextResponse, error) {
+	msg, err := s.query.CustomMessageTextByTypeAndLanguage(ctx, authz.GetInstance(ctx).InstanceID(), domain.NewDeviceLoginMessageType, req.Language, false)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomNewDeviceLoginMessageTextResponse{
+		CustomText: text_grpc.ModelCustomMessageTextToPb(msg),
+	}, nil
+}
+
+func (s *Server) SetDefaultNewDeviceLoginMessageText(ctx context.Context, req *admin_pb.SetDefaultNewDeviceLoginMessageTextRequest) (*admin_pb.SetDefaultNewDeviceLoginMessageTextResponse, error) {
+	result, err := s.command.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), SetNewDeviceLoginCustomTextToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultNewDeviceLoginMessageTextResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomNewDeviceLoginMessageTextToDefault(ctx context.Context, req *admin_pb.ResetCustomNewDeviceLoginMessageTextToDefaultRequest) (*admin_pb.ResetCustomNewDeviceLoginMessageTextToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTexts(ctx, domain.NewDeviceLoginMessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomNewDeviceLoginMessageTextToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) GetDefaultPasswordlessRegistrationMessageText(ctx context.Context, req *admin_pb.GetDefaultPasswordlessRegistrationMessageTextRequest) (*admin_pb.GetDefaultPasswordlessRegistrationMessageTextResponse, error) {
 	msg, err := s.query.DefaultMessageTextByTypeAndLanguageFromFileSystem(ctx, domain.PasswordlessRegistrationMessageType, req.Language)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/settings"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -14,7 +15,7 @@ func (s *Server) GetEmailProvider(ctx context.Context, req *admin_pb.GetEmailPro
 		return nil, err
 	}
 	return &admin_pb.GetEmailProviderResponse{
-		Config: emailProviderToProviderPb(smtp),
+		Config: settings.EmailProviderToPb(smtp),
 	}, nil
 }
 
@@ -24,7 +25,7 @@ func (s *Server) GetEmailProviderById(ctx context.Context, req *admin_pb.GetEmai
 		return nil, err
 	}
 	return &admin_pb.GetEmailProviderByIdResponse{
-		Config: emailProviderToProviderPb(smtp),
+		Config: settings.EmailProviderToPb(smtp),
 	}, nil
 }
 
@@ -91,7 +92,7 @@ func (s *Server) UpdateEmailProviderSMTPPassword(ctx context.Context, req *admin
 }
 
 func (s *Server) ListEmailProviders(ctx context.Context, req *admin_pb.ListEmailProvidersRequest) (*admin_pb.ListEmailProvidersResponse, error) {
-	queries, err := listEmailProvidersToModel(req)
+	queries, err := listEmailProvidersToModel(authz.GetInstance(ctx).InstanceID(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +102,7 @@ func (s *Server) ListEmailProviders(ctx context.Context, req *admin_pb.ListEmail
 	}
 	return &admin_pb.ListEmailProvidersResponse{
 		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
-		Result:  emailProvidersToPb(result.Configs),
+		Result:  settings.EmailProvidersToPb(result.Configs),
 	}, nil
 }
 
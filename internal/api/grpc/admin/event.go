@@ -5,13 +5,20 @@ import (
 	"slices"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
+	event_grpc "github.com/zitadel/zitadel/internal/api/grpc/event"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
 const (
 	maxLimit = 1000
+
+	defaultHeartbeatInterval = 30 * time.Second
+	streamEventsQueueSize    = 100
 )
 
 func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest) (*admin_pb.ListEventsResponse, error) {
@@ -27,6 +34,195 @@ func (s *Server) ListEvents(ctx context.Context, in *admin_pb.ListEventsRequest)
 	return admin_pb.EventsToPb(ctx, events)
 }
 
+// StreamEvents sends the stored events after the requested position
+// and afterwards the new events as soon as they are pushed.
+// The subscription only wakes up the stream, the events are always queried from the eventstore
+// to guarantee the order and to respect the permissions and the audit log retention.
+func (s *Server) StreamEvents(req *admin_pb.StreamEventsRequest, stream admin_pb.AdminService_StreamEventsServer) error {
+	ctx := stream.Context()
+
+	queue := make(chan eventstore.Event, streamEventsQueueSize)
+	subscription := eventstore.SubscribeEventTypes(queue, s.streamEventsSubscription(ctx, req))
+	defer subscription.Unsubscribe()
+
+	heartbeatInterval := defaultHeartbeatInterval
+	if interval := req.GetHeartbeatInterval(); interval != nil {
+		heartbeatInterval = interval.AsDuration()
+	}
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	position := req.GetPosition()
+	for {
+		var err error
+		position, err = s.sendEvents(ctx, stream, req, position)
+		if err != nil {
+			return err
+		}
+		sendHeartbeat, done := awaitEvents(ctx, queue, heartbeat.C, authz.GetInstance(ctx).InstanceID())
+		if done {
+			return nil
+		}
+		if !sendHeartbeat {
+			continue
+		}
+		err = stream.Send(&admin_pb.StreamEventsResponse{
+			Response: &admin_pb.StreamEventsResponse_Heartbeat{
+				Heartbeat: &admin_pb.StreamEventsHeartbeat{
+					Position:  position,
+					Timestamp: timestamppb.Now(),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// sendEvents sends all events after the position and returns the position of the last sent event.
+// A transaction exceeding the limit is paged by its order inside the transaction (in_tx_order),
+// the returned position is only advanced after all events of the transaction were sent.
+func (s *Server) sendEvents(ctx context.Context, stream admin_pb.AdminService_StreamEventsServer, req *admin_pb.StreamEventsRequest, position float64) (float64, error) {
+	// offset counts the already sent events of a transaction exceeding the limit
+	var offset uint32
+	for {
+		events, err := s.query.SearchEvents(ctx, streamEventsRequestToFilter(ctx, req, position).Offset(offset))
+		if err != nil {
+			return position, err
+		}
+		hasMore := len(events) == maxLimit
+		complete := completeTransactions(events, maxLimit)
+		if len(complete) == 0 && hasMore {
+			if err = sendStreamEvents(stream, events); err != nil {
+				return position, err
+			}
+			offset += uint32(len(events))
+			continue
+		}
+		if err = sendStreamEvents(stream, complete); err != nil {
+			return position, err
+		}
+		if len(complete) > 0 {
+			position = complete[len(complete)-1].Position
+			offset = 0
+		}
+		if !hasMore {
+			return position, nil
+		}
+	}
+}
+
+func sendStreamEvents(stream admin_pb.AdminService_StreamEventsServer, events []*query.Event) error {
+	for _, event := range events {
+		pb, err := event_grpc.EventToPb(event)
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(&admin_pb.StreamEventsResponse{Response: &admin_pb.StreamEventsResponse_Event{Event: pb}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// awaitEvents blocks until an event of the instance was pushed, a heartbeat is due or the stream is done
+func awaitEvents(ctx context.Context, queue <-chan eventstore.Event, heartbeat <-chan time.Time, instanceID string) (sendHeartbeat, done bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return false, true
+		case <-heartbeat:
+			return true, false
+		case event, ok := <-queue:
+			if !ok {
+				return false, true
+			}
+			if event.Aggregate().InstanceID == instanceID {
+				return false, false
+			}
+		}
+	}
+}
+
+// completeTransactions removes the events of the last position if the result was limited,
+// because the remaining events of the same transaction share the position and would be skipped by the next query.
+// If a single transaction exceeds the limit, no events are returned and the caller has to page inside the transaction.
+func completeTransactions(events []*query.Event, limit int) []*query.Event {
+	if len(events) < limit || len(events) == 0 {
+		return events
+	}
+	lastPosition := events[len(events)-1].Position
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Position != lastPosition {
+			return events[:i+1]
+		}
+	}
+	return nil
+}
+
+func (s *Server) streamEventsSubscription(ctx context.Context, req *admin_pb.StreamEventsRequest) map[eventstore.AggregateType][]eventstore.EventType {
+	aggregateTypes, eventTypes := streamEventsRequestTypes(req)
+	if len(aggregateTypes) == 0 {
+		for _, aggregateType := range s.query.SearchAggregateTypes(ctx) {
+			aggregateTypes = append(aggregateTypes, eventstore.AggregateType(aggregateType))
+		}
+	}
+	return subscriptionTypes(aggregateTypes, eventTypes)
+}
+
+// subscriptionTypes assigns the event types to their aggregate types,
+// aggregate types without requested event types are subscribed for all events.
+func subscriptionTypes(aggregateTypes []eventstore.AggregateType, eventTypes []eventstore.EventType) map[eventstore.AggregateType][]eventstore.EventType {
+	types := make(map[eventstore.AggregateType][]eventstore.EventType, len(aggregateTypes))
+	for _, aggregateType := range aggregateTypes {
+		types[aggregateType] = nil
+	}
+	for _, eventType := range eventTypes {
+		aggregateType := eventstore.AggregateTypeFromEventType(eventType)
+		if _, ok := types[aggregateType]; ok {
+			types[aggregateType] = append(types[aggregateType], eventType)
+		}
+	}
+	return types
+}
+
+func streamEventsRequestTypes(req *admin_pb.StreamEventsRequest) ([]eventstore.AggregateType, []eventstore.EventType) {
+	eventTypes := make([]eventstore.EventType, len(req.GetEventTypes()))
+	for i, eventType := range req.GetEventTypes() {
+		eventTypes[i] = eventstore.EventType(eventType)
+	}
+	aggregateTypes := make([]eventstore.AggregateType, len(req.GetAggregateTypes()))
+	for i, aggregateType := range req.GetAggregateTypes() {
+		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	if len(aggregateTypes) == 0 {
+		aggregateTypes = aggregateTypesFromEventTypes(eventTypes)
+	}
+	return slices.Compact(aggregateTypes), eventTypes
+}
+
+func streamEventsRequestToFilter(ctx context.Context, req *admin_pb.StreamEventsRequest, position float64) *eventstore.SearchQueryBuilder {
+	aggregateTypes, eventTypes := streamEventsRequestTypes(req)
+
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderAsc().
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		Limit(maxLimit).
+		AwaitOpenTransactions().
+		ResourceOwner(req.GetResourceOwner()).
+		EditorUser(req.GetEditorUserId()).
+		PositionAfter(position)
+
+	if len(aggregateTypes) > 0 || len(eventTypes) > 0 {
+		builder.AddQuery().
+			AggregateTypes(aggregateTypes...).
+			EventTypes(eventTypes...).
+			Builder()
+	}
+	return builder
+}
+
 func (s *Server) ListEventTypes(ctx context.Context, in *admin_pb.ListEventTypesRequest) (*admin_pb.ListEventTypesResponse, error) {
 	eventTypes := s.query.SearchEventTypes(ctx)
 	return admin_pb.EventTypesToPb(eventTypes), nil
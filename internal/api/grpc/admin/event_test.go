@@ -1,10 +1,13 @@
 package admin
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/deviceauth"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
@@ -56,3 +59,154 @@ func Test_aggregateTypesFromEventTypes(t *testing.T) {
 		})
 	}
 }
+
+func Test_completeTransactions(t *testing.T) {
+	type args struct {
+		events []*query.Event
+		limit  int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []*query.Event
+	}{
+		{
+			name: "not limited",
+			args: args{
+				events: []*query.Event{{Position: 1}, {Position: 2}, {Position: 2}},
+				limit:  4,
+			},
+			want: []*query.Event{{Position: 1}, {Position: 2}, {Position: 2}},
+		},
+		{
+			name: "limited, last transaction removed",
+			args: args{
+				events: []*query.Event{{Position: 1}, {Position: 2}, {Position: 2}},
+				limit:  3,
+			},
+			want: []*query.Event{{Position: 1}},
+		},
+		{
+			name: "limited, single transaction",
+			args: args{
+				events: []*query.Event{{Position: 2}, {Position: 2}},
+				limit:  2,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := completeTransactions(tt.args.events, tt.args.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("completeTransactions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_subscriptionTypes(t *testing.T) {
+	type args struct {
+		aggregateTypes []eventstore.AggregateType
+		eventTypes     []eventstore.EventType
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[eventstore.AggregateType][]eventstore.EventType
+	}{
+		{
+			name: "all events of aggregates",
+			args: args{
+				aggregateTypes: []eventstore.AggregateType{user.AggregateType, org.AggregateType},
+			},
+			want: map[eventstore.AggregateType][]eventstore.EventType{
+				user.AggregateType: nil,
+				org.AggregateType:  nil,
+			},
+		},
+		{
+			name: "event types assigned to aggregates",
+			args: args{
+				aggregateTypes: []eventstore.AggregateType{user.AggregateType, org.AggregateType},
+				eventTypes:     []eventstore.EventType{user.MachineAddedEventType, user.HumanAddedType},
+			},
+			want: map[eventstore.AggregateType][]eventstore.EventType{
+				user.AggregateType: {user.MachineAddedEventType, user.HumanAddedType},
+				org.AggregateType:  nil,
+			},
+		},
+		{
+			name: "event types of other aggregates ignored",
+			args: args{
+				aggregateTypes: []eventstore.AggregateType{user.AggregateType},
+				eventTypes:     []eventstore.EventType{org.OrgAddedEventType},
+			},
+			want: map[eventstore.AggregateType][]eventstore.EventType{
+				user.AggregateType: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := subscriptionTypes(tt.args.aggregateTypes, tt.args.eventTypes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("subscriptionTypes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_awaitEvents(t *testing.T) {
+	otherInstance := &eventstore.BaseEvent{Agg: &eventstore.Aggregate{InstanceID: "other"}}
+	ownInstance := &eventstore.BaseEvent{Agg: &eventstore.Aggregate{InstanceID: "instance"}}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		queued            []eventstore.Event
+		heartbeat         bool
+		wantSendHeartbeat bool
+		wantDone          bool
+	}{
+		{
+			name:     "done",
+			ctx:      canceled,
+			wantDone: true,
+		},
+		{
+			name:   "event of instance",
+			ctx:    context.Background(),
+			queued: []eventstore.Event{otherInstance, ownInstance},
+		},
+		{
+			name:              "events of other instances ignored",
+			ctx:               context.Background(),
+			queued:            []eventstore.Event{otherInstance},
+			heartbeat:         true,
+			wantSendHeartbeat: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := make(chan eventstore.Event, len(tt.queued))
+			for _, event := range tt.queued {
+				queue <- event
+			}
+			heartbeat := make(chan time.Time, 1)
+			if tt.heartbeat {
+				// the heartbeat is sent after the queue was consumed
+				go func() {
+					for len(queue) > 0 {
+						time.Sleep(time.Millisecond)
+					}
+					heartbeat <- time.Now()
+				}()
+			}
+			sendHeartbeat, done := awaitEvents(tt.ctx, queue, heartbeat, "instance")
+			if sendHeartbeat != tt.wantSendHeartbeat || done != tt.wantDone {
+				t.Errorf("awaitEvents() = %v, %v, want %v, %v", sendHeartbeat, done, tt.wantSendHeartbeat, tt.wantDone)
+			}
+		})
+	}
+}
@@ -2,12 +2,14 @@ package admin
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
+	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
 	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -24,6 +26,10 @@ import (
 	v1_pb "github.com/zitadel/zitadel/pkg/grpc/v1"
 )
 
+// exportVersion is the version of the format of the exported data,
+// it must be increased if data of the previous version cannot be imported anymore as is
+const exportVersion = 2
+
 func (s *Server) ExportData(ctx context.Context, req *admin_pb.ExportDataRequest) (_ *admin_pb.ExportDataResponse, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -92,6 +98,13 @@ func (s *Server) ExportData(ctx context.Context, req *admin_pb.ExportDataRequest
 		for _, idp := range org.JwtIdps {
 			orgIDPs = append(orgIDPs, idp.GetIdpId())
 		}
+		org.IdpTemplates, err = s.getIDPTemplates(ctx, org.GetOrgId(), orgIDPs)
+		if err != nil {
+			return nil, err
+		}
+		for _, idp := range org.IdpTemplates {
+			orgIDPs = append(orgIDPs, idp.GetIdpId())
+		}
 
 		org.LabelPolicy, err = s.getLabelPolicy(ctx, org.GetOrgId())
 		if err != nil {
@@ -190,7 +203,7 @@ func (s *Server) ExportData(ctx context.Context, req *admin_pb.ExportDataRequest
 		/******************************************************************************************************************
 		Project and Applications
 		******************************************************************************************************************/
-		org.Projects, org.ProjectRoles, org.OidcApps, org.ApiApps, org.AppKeys, err = s.getProjectsAndApps(ctx, org.GetOrgId())
+		org.Projects, org.ProjectRoles, org.OidcApps, org.ApiApps, org.SamlApps, org.AppKeys, err = s.getProjectsAndApps(ctx, org.GetOrgId())
 		if err != nil {
 			return nil, err
 		}
@@ -256,8 +269,18 @@ func (s *Server) ExportData(ctx context.Context, req *admin_pb.ExportDataRequest
 		}
 	}
 
+	var instance *admin_pb.DataInstance
+	if req.GetWithInstanceResources() {
+		instance, err = s.getInstanceResources(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &admin_pb.ExportDataResponse{
-		Orgs: orgs,
+		Orgs:     orgs,
+		Version:  exportVersion,
+		Instance: instance,
 	}, nil
 }
 
@@ -361,6 +384,171 @@ func (s *Server) getIDPs(ctx context.Context, orgID string) (_ []*v1_pb.DataOIDC
 	return oidcIdps, jwtIdps, nil
 }
 
+// getIDPTemplates returns the identity providers of the organization which are not already part of the exported oidc and jwt idps
+func (s *Server) getIDPTemplates(ctx context.Context, orgID string, exportedIDPs []string) (_ []*v1_pb.DataIDPTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ownerType, err := query.NewIDPTemplateOwnerTypeSearchQuery(domain.IdentityProviderTypeOrg)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwner, err := query.NewIDPTemplateResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.query.IDPTemplates(ctx, &query.IDPTemplateSearchQueries{Queries: []query.SearchQuery{resourceOwner, ownerType}}, false)
+	if err != nil {
+		return nil, err
+	}
+	idpTemplates := make([]*v1_pb.DataIDPTemplate, 0, len(templates.Templates))
+	for _, template := range templates.Templates {
+		if slices.Contains(exportedIDPs, template.ID) {
+			continue
+		}
+		secret, err := s.query.IDPTemplateSecret(template)
+		if err != nil {
+			return nil, err
+		}
+		if idpTemplate := idpTemplateToData(idp_grpc.ProviderToPb(template), secret); idpTemplate != nil {
+			idpTemplates = append(idpTemplates, idpTemplate)
+		}
+	}
+	return idpTemplates, nil
+}
+
+func idpTemplateToData(provider *idp_pb.Provider, secret string) *v1_pb.DataIDPTemplate {
+	data := &v1_pb.DataIDPTemplate{IdpId: provider.GetId()}
+	options := provider.GetConfig().GetOptions()
+	switch config := provider.GetConfig().GetConfig().(type) {
+	case *idp_pb.ProviderConfig_Oauth:
+		data.Idp = &v1_pb.DataIDPTemplate_Oauth{Oauth: &management_pb.AddGenericOAuthProviderRequest{
+			Name:                  provider.GetName(),
+			ClientId:              config.Oauth.GetClientId(),
+			ClientSecret:          secret,
+			AuthorizationEndpoint: config.Oauth.GetAuthorizationEndpoint(),
+			TokenEndpoint:         config.Oauth.GetTokenEndpoint(),
+			UserEndpoint:          config.Oauth.GetUserEndpoint(),
+			Scopes:                config.Oauth.GetScopes(),
+			IdAttribute:           config.Oauth.GetIdAttribute(),
+			ProviderOptions:       options,
+		}}
+	case *idp_pb.ProviderConfig_Oidc:
+		data.Idp = &v1_pb.DataIDPTemplate_Oidc{Oidc: &management_pb.AddGenericOIDCProviderRequest{
+			Name:             provider.GetName(),
+			Issuer:           config.Oidc.GetIssuer(),
+			ClientId:         config.Oidc.GetClientId(),
+			ClientSecret:     secret,
+			Scopes:           config.Oidc.GetScopes(),
+			IsIdTokenMapping: config.Oidc.GetIsIdTokenMapping(),
+			ProviderOptions:  options,
+		}}
+	case *idp_pb.ProviderConfig_Jwt:
+		data.Idp = &v1_pb.DataIDPTemplate_Jwt{Jwt: &management_pb.AddJWTProviderRequest{
+			Name:            provider.GetName(),
+			Issuer:          config.Jwt.GetIssuer(),
+			JwtEndpoint:     config.Jwt.GetJwtEndpoint(),
+			KeysEndpoint:    config.Jwt.GetKeysEndpoint(),
+			HeaderName:      config.Jwt.GetHeaderName(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_AzureAd:
+		data.Idp = &v1_pb.DataIDPTemplate_AzureAd{AzureAd: &management_pb.AddAzureADProviderRequest{
+			Name:            provider.GetName(),
+			ClientId:        config.AzureAd.GetClientId(),
+			ClientSecret:    secret,
+			Tenant:          config.AzureAd.GetTenant(),
+			EmailVerified:   config.AzureAd.GetEmailVerified(),
+			Scopes:          config.AzureAd.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_Github:
+		data.Idp = &v1_pb.DataIDPTemplate_Github{Github: &management_pb.AddGitHubProviderRequest{
+			Name:            provider.GetName(),
+			ClientId:        config.Github.GetClientId(),
+			ClientSecret:    secret,
+			Scopes:          config.Github.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_GithubEs:
+		data.Idp = &v1_pb.DataIDPTemplate_GithubEs{GithubEs: &management_pb.AddGitHubEnterpriseServerProviderRequest{
+			Name:                  provider.GetName(),
+			ClientId:              config.GithubEs.GetClientId(),
+			ClientSecret:          secret,
+			AuthorizationEndpoint: config.GithubEs.GetAuthorizationEndpoint(),
+			TokenEndpoint:         config.GithubEs.GetTokenEndpoint(),
+			UserEndpoint:          config.GithubEs.GetUserEndpoint(),
+			Scopes:                config.GithubEs.GetScopes(),
+			ProviderOptions:       options,
+		}}
+	case *idp_pb.ProviderConfig_Gitlab:
+		data.Idp = &v1_pb.DataIDPTemplate_Gitlab{Gitlab: &management_pb.AddGitLabProviderRequest{
+			Name:            provider.GetName(),
+			ClientId:        config.Gitlab.GetClientId(),
+			ClientSecret:    secret,
+			Scopes:          config.Gitlab.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_GitlabSelfHosted:
+		data.Idp = &v1_pb.DataIDPTemplate_GitlabSelfHosted{GitlabSelfHosted: &management_pb.AddGitLabSelfHostedProviderRequest{
+			Name:            provider.GetName(),
+			Issuer:          config.GitlabSelfHosted.GetIssuer(),
+			ClientId:        config.GitlabSelfHosted.GetClientId(),
+			ClientSecret:    secret,
+			Scopes:          config.GitlabSelfHosted.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_Google:
+		data.Idp = &v1_pb.DataIDPTemplate_Google{Google: &management_pb.AddGoogleProviderRequest{
+			Name:            provider.GetName(),
+			ClientId:        config.Google.GetClientId(),
+			ClientSecret:    secret,
+			Scopes:          config.Google.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_Ldap:
+		data.Idp = &v1_pb.DataIDPTemplate_Ldap{Ldap: &management_pb.AddLDAPProviderRequest{
+			Name:              provider.GetName(),
+			Servers:           config.Ldap.GetServers(),
+			StartTls:          config.Ldap.GetStartTls(),
+			BaseDn:            config.Ldap.GetBaseDn(),
+			BindDn:            config.Ldap.GetBindDn(),
+			BindPassword:      secret,
+			UserBase:          config.Ldap.GetUserBase(),
+			UserObjectClasses: config.Ldap.GetUserObjectClasses(),
+			UserFilters:       config.Ldap.GetUserFilters(),
+			Timeout:           config.Ldap.GetTimeout(),
+			Attributes:        config.Ldap.GetAttributes(),
+			SyncOptions:       config.Ldap.GetSyncOptions(),
+			ProviderOptions:   options,
+		}}
+	case *idp_pb.ProviderConfig_Apple:
+		data.Idp = &v1_pb.DataIDPTemplate_Apple{Apple: &management_pb.AddAppleProviderRequest{
+			Name:            provider.GetName(),
+			ClientId:        config.Apple.GetClientId(),
+			TeamId:          config.Apple.GetTeamId(),
+			KeyId:           config.Apple.GetKeyId(),
+			PrivateKey:      []byte(secret),
+			Scopes:          config.Apple.GetScopes(),
+			ProviderOptions: options,
+		}}
+	case *idp_pb.ProviderConfig_Saml:
+		data.Idp = &v1_pb.DataIDPTemplate_Saml{Saml: &management_pb.AddSAMLProviderRequest{
+			Name:                          provider.GetName(),
+			Metadata:                      &management_pb.AddSAMLProviderRequest_MetadataXml{MetadataXml: config.Saml.GetMetadataXml()},
+			Binding:                       config.Saml.GetBinding(),
+			WithSignedRequest:             config.Saml.GetWithSignedRequest(),
+			NameIdFormat:                  config.Saml.NameIdFormat.Enum(),
+			TransientMappingAttributeName: config.Saml.TransientMappingAttributeName,
+			ProviderOptions:               options,
+		}}
+	default:
+		return nil
+	}
+	data.AttributeMappings = provider.GetAttributeMappings()
+	return data
+}
+
 func (s *Server) getLabelPolicy(ctx context.Context, orgID string) (_ *management_pb.AddCustomLabelPolicyRequest, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -731,20 +919,21 @@ func (s *Server) getActions(ctx context.Context, org string) ([]*v1_pb.DataActio
 	return actions, nil
 }
 
-func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.DataProject, []*management_pb.AddProjectRoleRequest, []*v1_pb.DataOIDCApplication, []*v1_pb.DataAPIApplication, []*v1_pb.DataAppKey, error) {
+func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.DataProject, []*management_pb.AddProjectRoleRequest, []*v1_pb.DataOIDCApplication, []*v1_pb.DataAPIApplication, []*v1_pb.DataSAMLApplication, []*v1_pb.DataAppKey, error) {
 	projectSearch, err := query.NewProjectResourceOwnerSearchQuery(org)
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	queriedProjects, err := s.query.SearchProjects(ctx, &query.ProjectSearchQueries{Queries: []query.SearchQuery{projectSearch}})
 	if err != nil {
-		return nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	projects := make([]*v1_pb.DataProject, len(queriedProjects.Projects))
 	orgProjectRoles := make([]*management_pb.AddProjectRoleRequest, 0)
 	oidcApps := make([]*v1_pb.DataOIDCApplication, 0)
 	apiApps := make([]*v1_pb.DataAPIApplication, 0)
+	samlApps := make([]*v1_pb.DataSAMLApplication, 0)
 	appKeys := make([]*v1_pb.DataAppKey, 0)
 	for i, queriedProject := range queriedProjects.Projects {
 		projects[i] = &v1_pb.DataProject{
@@ -760,12 +949,12 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 
 		projectRoleSearch, err := query.NewProjectRoleProjectIDSearchQuery(queriedProject.ID)
 		if err != nil {
-			return nil, nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 
 		queriedProjectRoles, err := s.query.SearchProjectRoles(ctx, false, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectRoleSearch}})
 		if err != nil {
-			return nil, nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 		for _, role := range queriedProjectRoles.ProjectRoles {
 			orgProjectRoles = append(orgProjectRoles, &management_pb.AddProjectRoleRequest{
@@ -778,11 +967,11 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 
 		appSearch, err := query.NewAppProjectIDSearchQuery(queriedProject.ID)
 		if err != nil {
-			return nil, nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 		apps, err := s.query.SearchApps(ctx, &query.AppSearchQueries{Queries: []query.SearchQuery{appSearch}}, false)
 		if err != nil {
-			return nil, nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, nil, err
 		}
 		for _, app := range apps.Apps {
 			if app.OIDCConfig != nil {
@@ -829,21 +1018,31 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 					},
 				})
 			}
+			if app.SAMLConfig != nil {
+				samlApps = append(samlApps, &v1_pb.DataSAMLApplication{
+					AppId: app.ID,
+					App: &management_pb.AddSAMLAppRequest{
+						ProjectId: app.ProjectID,
+						Name:      app.Name,
+						Metadata:  &management_pb.AddSAMLAppRequest_MetadataXml{MetadataXml: app.SAMLConfig.Metadata},
+					},
+				})
+			}
 			appIDQuery, err := query.NewAuthNKeyObjectIDQuery(app.ID)
 			if err != nil {
-				return nil, nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, nil, err
 			}
 			projectIDQuery, err := query.NewAuthNKeyAggregateIDQuery(app.ProjectID)
 			if err != nil {
-				return nil, nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, nil, err
 			}
 			orgIDQuery, err := query.NewAuthNKeyResourceOwnerQuery(org)
 			if err != nil {
-				return nil, nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, nil, err
 			}
 			keys, err := s.query.SearchAuthNKeysData(ctx, &query.AuthNKeySearchQueries{Queries: []query.SearchQuery{appIDQuery, projectIDQuery, orgIDQuery}})
 			if err != nil {
-				return nil, nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, nil, err
 			}
 			for _, key := range keys.AuthNKeysData {
 				appKeys = append(appKeys, &v1_pb.DataAppKey{
@@ -858,7 +1057,7 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 			}
 		}
 	}
-	return projects, orgProjectRoles, oidcApps, apiApps, appKeys, nil
+	return projects, orgProjectRoles, oidcApps, apiApps, samlApps, appKeys, nil
 }
 
 func (s *Server) getNecessaryProjectGrantMembersForOrg(ctx context.Context, org string, processedProjects []string, processedGrants []string, processedUsers []string) ([]*management_pb.AddProjectGrantMemberRequest, error) {
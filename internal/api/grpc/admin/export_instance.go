@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
+	action_grpc "github.com/zitadel/zitadel/internal/api/grpc/resources/action/v3alpha"
+	userschema_grpc "github.com/zitadel/zitadel/internal/api/grpc/resources/userschema/v3alpha"
+	webkey_grpc "github.com/zitadel/zitadel/internal/api/grpc/resources/webkey/v3"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) getInstanceResources(ctx context.Context) (_ *admin_pb.DataInstance, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instance := new(admin_pb.DataInstance)
+	if instance.Targets, err = s.getTargets(ctx); err != nil {
+		return nil, err
+	}
+	if instance.Executions, err = s.getExecutions(ctx); err != nil {
+		return nil, err
+	}
+	if instance.UserSchemas, err = s.getUserSchemas(ctx); err != nil {
+		return nil, err
+	}
+	if instance.WebKeys, err = s.getWebKeys(ctx); err != nil {
+		return nil, err
+	}
+	if instance.IdpDiscoveryDomains, err = s.getIDPDiscoveryDomains(ctx); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func (s *Server) getTargets(ctx context.Context) ([]*admin_pb.DataTarget, error) {
+	queriedTargets, err := s.query.SearchTargets(ctx, &query.TargetSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*admin_pb.DataTarget, len(queriedTargets.Targets))
+	for i, target := range queriedTargets.Targets {
+		targets[i] = &admin_pb.DataTarget{
+			TargetId: target.ID,
+			Target:   action_grpc.TargetToPb(target),
+		}
+	}
+	return targets, nil
+}
+
+func (s *Server) getExecutions(ctx context.Context) ([]*admin_pb.DataExecution, error) {
+	queriedExecutions, err := s.query.SearchExecutions(ctx, &query.ExecutionSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*admin_pb.DataExecution, len(queriedExecutions.Executions))
+	for i, execution := range queriedExecutions.Executions {
+		targets := make([]*admin_pb.DataExecution_Target, len(execution.Targets))
+		for j, target := range execution.Targets {
+			switch target.Type {
+			case domain.ExecutionTargetTypeInclude:
+				targets[j] = &admin_pb.DataExecution_Target{Type: &admin_pb.DataExecution_Target_Include{Include: target.Target}}
+			case domain.ExecutionTargetTypeTarget,
+				domain.ExecutionTargetTypeUnspecified:
+				targets[j] = &admin_pb.DataExecution_Target{Type: &admin_pb.DataExecution_Target_TargetId{TargetId: target.Target}}
+			}
+		}
+		executions[i] = &admin_pb.DataExecution{
+			ExecutionId: execution.ID,
+			Targets:     targets,
+		}
+	}
+	return sortExecutionsByIncludes(executions), nil
+}
+
+// sortExecutionsByIncludes orders the executions so included executions are listed before the executions including them,
+// the order of executions without dependencies is kept
+func sortExecutionsByIncludes(executions []*admin_pb.DataExecution) []*admin_pb.DataExecution {
+	byID := make(map[string]*admin_pb.DataExecution, len(executions))
+	for _, execution := range executions {
+		byID[execution.GetExecutionId()] = execution
+	}
+	sorted := make([]*admin_pb.DataExecution, 0, len(executions))
+	visited := make(map[string]bool, len(executions))
+	var visit func(execution *admin_pb.DataExecution)
+	visit = func(execution *admin_pb.DataExecution) {
+		if visited[execution.GetExecutionId()] {
+			return
+		}
+		// circular includes are prevented by the commands, marking before visiting the includes avoids endless recursion anyway
+		visited[execution.GetExecutionId()] = true
+		for _, target := range execution.GetTargets() {
+			if included, ok := byID[target.GetInclude()]; ok {
+				visit(included)
+			}
+		}
+		sorted = append(sorted, execution)
+	}
+	for _, execution := range executions {
+		visit(execution)
+	}
+	return sorted
+}
+
+func (s *Server) getUserSchemas(ctx context.Context) ([]*admin_pb.DataUserSchema, error) {
+	queriedSchemas, err := s.query.SearchUserSchema(ctx, &query.UserSchemaSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	schemas := make([]*admin_pb.DataUserSchema, len(queriedSchemas.UserSchemas))
+	for i, schema := range queriedSchemas.UserSchemas {
+		userSchema, err := userschema_grpc.UserSchemaToPb(schema)
+		if err != nil {
+			return nil, err
+		}
+		schemas[i] = &admin_pb.DataUserSchema{
+			SchemaId:   schema.ID,
+			UserSchema: userSchema.GetConfig(),
+			State:      userSchema.GetState(),
+		}
+	}
+	return schemas, nil
+}
+
+func (s *Server) getWebKeys(ctx context.Context) ([]*admin_pb.DataWebKey, error) {
+	queriedKeys, err := s.query.ListWebKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*admin_pb.DataWebKey, len(queriedKeys))
+	for i, key := range queriedKeys {
+		keys[i] = &admin_pb.DataWebKey{
+			KeyId:  key.KeyID,
+			Config: webkey_grpc.WebKeyConfigToPb(key.Config),
+			Active: key.State == domain.WebKeyStateActive,
+		}
+	}
+	return keys, nil
+}
+
+func (s *Server) getIDPDiscoveryDomains(ctx context.Context) ([]*admin_pb.DataIDPDiscoveryDomain, error) {
+	queriedDomains, err := s.query.SearchIDPDiscoveryDomains(ctx, &query.IDPDiscoveryDomainSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	discoveryDomains := idp_grpc.DiscoveryDomainsToPb(queriedDomains.Domains, authz.GetInstance(ctx).InstanceID())
+	domains := make([]*admin_pb.DataIDPDiscoveryDomain, len(discoveryDomains))
+	for i, discovery := range discoveryDomains {
+		domains[i] = &admin_pb.DataIDPDiscoveryDomain{
+			Domain:       discovery.GetDomain(),
+			IdpId:        discovery.GetIdpId(),
+			IdpOwnerType: discovery.GetIdpOwnerType(),
+		}
+	}
+	return domains, nil
+}
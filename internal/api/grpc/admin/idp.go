@@ -405,6 +405,17 @@ func (s *Server) UpdateLDAPProvider(ctx context.Context, req *admin_pb.UpdateLDA
 	}, nil
 }
 
+func (s *Server) SyncLDAPProvider(ctx context.Context, req *admin_pb.SyncLDAPProviderRequest) (*admin_pb.SyncLDAPProviderResponse, error) {
+	report, err := s.command.SyncLDAPProvider(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, 0, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SyncLDAPProviderResponse{
+		DryRun: report.DryRun,
+		Users:  idp_grpc.LDAPSyncReportToPb(report),
+	}, nil
+}
+
 func (s *Server) AddAppleProvider(ctx context.Context, req *admin_pb.AddAppleProviderRequest) (*admin_pb.AddAppleProviderResponse, error) {
 	id, details, err := s.command.AddInstanceAppleProvider(ctx, addAppleProviderToCommand(req))
 	if err != nil {
@@ -466,3 +477,52 @@ func (s *Server) DeleteProvider(ctx context.Context, req *admin_pb.DeleteProvide
 		Details: object_pb.DomainToChangeDetailsPb(details),
 	}, nil
 }
+
+func (s *Server) SetProviderAttributeMappings(ctx context.Context, req *admin_pb.SetProviderAttributeMappingsRequest) (*admin_pb.SetProviderAttributeMappingsResponse, error) {
+	details, err := s.command.SetInstanceIDPAttributeMappings(ctx, req.Id, idp_grpc.AttributeMappingsToCommand(req.AttributeMappings))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetProviderAttributeMappingsResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListIDPDiscoveryDomains(ctx context.Context, req *admin_pb.ListIDPDiscoveryDomainsRequest) (*admin_pb.ListIDPDiscoveryDomainsResponse, error) {
+	offset, limit, asc := object_pb.ListQueryToModel(req.Query)
+	resp, err := s.query.SearchIDPDiscoveryDomains(ctx, &query.IDPDiscoveryDomainSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.IDPDiscoveryDomainDomainCol,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListIDPDiscoveryDomainsResponse{
+		Result:  idp_grpc.DiscoveryDomainsToPb(resp.Domains, authz.GetInstance(ctx).InstanceID()),
+		Details: object_pb.ToListDetails(resp.Count, resp.Sequence, resp.LastRun),
+	}, nil
+}
+
+func (s *Server) SetIDPDiscoveryDomain(ctx context.Context, req *admin_pb.SetIDPDiscoveryDomainRequest) (*admin_pb.SetIDPDiscoveryDomainResponse, error) {
+	details, err := s.command.SetIDPDiscoveryDomain(ctx, req.Domain, req.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetIDPDiscoveryDomainResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveIDPDiscoveryDomain(ctx context.Context, req *admin_pb.RemoveIDPDiscoveryDomainRequest) (*admin_pb.RemoveIDPDiscoveryDomainResponse, error) {
+	details, err := s.command.RemoveIDPDiscoveryDomain(ctx, req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveIDPDiscoveryDomainResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -424,6 +424,7 @@ func addLDAPProviderToCommand(req *admin_pb.AddLDAPProviderRequest) command.LDAP
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		SyncOptions:       idp_grpc.LDAPSyncOptionsToCommand(req.SyncOptions),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -441,6 +442,7 @@ func updateLDAPProviderToCommand(req *admin_pb.UpdateLDAPProviderRequest) comman
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		SyncOptions:       idp_grpc.LDAPSyncOptionsToCommand(req.SyncOptions),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -20,12 +20,14 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	action_grpc "github.com/zitadel/zitadel/internal/api/grpc/action"
 	"github.com/zitadel/zitadel/internal/api/grpc/authn"
+	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
 	"github.com/zitadel/zitadel/internal/api/grpc/management"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	management_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 	"github.com/zitadel/zitadel/pkg/grpc/policy"
@@ -52,6 +54,10 @@ type counts struct {
 	oidcAppLen              int
 	apiAppCount             int
 	apiAppLen               int
+	samlAppCount            int
+	samlAppLen              int
+	idpTemplateCount        int
+	idpTemplateLen          int
 	actionCount             int
 	actionLen               int
 	projectRolesCount       int
@@ -68,6 +74,16 @@ type counts struct {
 	projectGrantMemberLen   int
 	appKeysCount            int
 	machineKeysCount        int
+	targetCount             int
+	targetLen               int
+	executionCount          int
+	executionLen            int
+	userSchemaCount         int
+	userSchemaLen           int
+	webKeyCount             int
+	webKeyLen               int
+	idpDiscoveryDomainCount int
+	idpDiscoveryDomainLen   int
 }
 
 func (c *counts) getProgress() string {
@@ -79,13 +95,50 @@ func (c *counts) getProgress() string {
 		"projects " + strconv.Itoa(c.projectCount) + "/" + strconv.Itoa(c.projectLen) + ", " +
 		"oidc_apps " + strconv.Itoa(c.oidcAppCount) + "/" + strconv.Itoa(c.oidcAppLen) + ", " +
 		"api_apps " + strconv.Itoa(c.apiAppCount) + "/" + strconv.Itoa(c.apiAppLen) + ", " +
+		"saml_apps " + strconv.Itoa(c.samlAppCount) + "/" + strconv.Itoa(c.samlAppLen) + ", " +
+		"idp_templates " + strconv.Itoa(c.idpTemplateCount) + "/" + strconv.Itoa(c.idpTemplateLen) + ", " +
 		"actions " + strconv.Itoa(c.actionCount) + "/" + strconv.Itoa(c.actionLen) + ", " +
 		"project_roles " + strconv.Itoa(c.projectRolesCount) + "/" + strconv.Itoa(c.projectRolesLen) + ", " +
 		"project_grant " + strconv.Itoa(c.projectGrantCount) + "/" + strconv.Itoa(c.projectGrantLen) + ", " +
 		"user_grants " + strconv.Itoa(c.userGrantCount) + "/" + strconv.Itoa(c.userGrantLen) + ", " +
 		"project_members " + strconv.Itoa(c.projectMembersCount) + "/" + strconv.Itoa(c.projectMembersLen) + ", " +
 		"org_members " + strconv.Itoa(c.orgMemberCount) + "/" + strconv.Itoa(c.orgMemberLen) + ", " +
-		"project_grant_members " + strconv.Itoa(c.projectGrantMemberCount) + "/" + strconv.Itoa(c.projectGrantMemberLen)
+		"project_grant_members " + strconv.Itoa(c.projectGrantMemberCount) + "/" + strconv.Itoa(c.projectGrantMemberLen) + ", " +
+		"targets " + strconv.Itoa(c.targetCount) + "/" + strconv.Itoa(c.targetLen) + ", " +
+		"executions " + strconv.Itoa(c.executionCount) + "/" + strconv.Itoa(c.executionLen) + ", " +
+		"user_schemas " + strconv.Itoa(c.userSchemaCount) + "/" + strconv.Itoa(c.userSchemaLen) + ", " +
+		"web_keys " + strconv.Itoa(c.webKeyCount) + "/" + strconv.Itoa(c.webKeyLen) + ", " +
+		"idp_discovery_domains " + strconv.Itoa(c.idpDiscoveryDomainCount) + "/" + strconv.Itoa(c.idpDiscoveryDomainLen)
+}
+
+// reportResources returns the number of resources in the data and how many of them were imported
+func (c *counts) reportResources() []*admin_pb.ImportDataReportResource {
+	resource := func(resourceType string, imported, total int) *admin_pb.ImportDataReportResource {
+		return &admin_pb.ImportDataReportResource{Type: resourceType, Total: uint32(total), Imported: uint32(imported)}
+	}
+	return []*admin_pb.ImportDataReportResource{
+		resource("human_users", c.humanUserCount, c.humanUserLen),
+		resource("machine_users", c.machineUserCount, c.machineUserLen),
+		resource("user_metadata", c.userMetadataCount, c.userMetadataLen),
+		resource("user_links", c.userLinksCount, c.userLinksLen),
+		resource("projects", c.projectCount, c.projectLen),
+		resource("oidc_apps", c.oidcAppCount, c.oidcAppLen),
+		resource("api_apps", c.apiAppCount, c.apiAppLen),
+		resource("saml_apps", c.samlAppCount, c.samlAppLen),
+		resource("idp_templates", c.idpTemplateCount, c.idpTemplateLen),
+		resource("actions", c.actionCount, c.actionLen),
+		resource("project_roles", c.projectRolesCount, c.projectRolesLen),
+		resource("project_grants", c.projectGrantCount, c.projectGrantLen),
+		resource("user_grants", c.userGrantCount, c.userGrantLen),
+		resource("project_members", c.projectMembersCount, c.projectMembersLen),
+		resource("org_members", c.orgMemberCount, c.orgMemberLen),
+		resource("project_grant_members", c.projectGrantMemberCount, c.projectGrantMemberLen),
+		resource("targets", c.targetCount, c.targetLen),
+		resource("executions", c.executionCount, c.executionLen),
+		resource("user_schemas", c.userSchemaCount, c.userSchemaLen),
+		resource("web_keys", c.webKeyCount, c.webKeyLen),
+		resource("idp_discovery_domains", c.idpDiscoveryDomainCount, c.idpDiscoveryDomainLen),
+	}
 }
 
 func (s *Server) ImportData(ctx context.Context, req *admin_pb.ImportDataRequest) (_ *admin_pb.ImportDataResponse, err error) {
@@ -101,19 +154,17 @@ func (s *Server) ImportData(ctx context.Context, req *admin_pb.ImportDataRequest
 		defer cancel()
 
 		go func() {
-			orgs := make([]*admin_pb.DataOrg, 0)
+			data := req.GetDataOrgs()
 			if req.GetDataOrgsv1() != nil {
 				dataOrgs, err := s.dataOrgsV1ToDataOrgs(ctx, req.GetDataOrgsv1())
 				if err != nil {
 					ch <- importResponse{ret: nil, err: err}
 					return
 				}
-				orgs = dataOrgs.GetOrgs()
-			} else {
-				orgs = req.GetDataOrgs().GetOrgs()
+				data = dataOrgs
 			}
 
-			ret, count, err := s.importData(ctx, orgs)
+			ret, count, err := s.importData(ctx, data, req.GetValidateOnly())
 			ch <- importResponse{ret: ret, count: count, err: err}
 		}()
 
@@ -159,18 +210,28 @@ func (s *Server) ImportData(ctx context.Context, req *admin_pb.ImportDataRequest
 		if err != nil {
 			return nil, err
 		}
+		if req.GetValidateOnly() {
+			ctxTimeout, cancel := context.WithTimeout(ctx, timeoutDuration)
+			defer cancel()
+			data, err := s.transportDataFromFile(ctxTimeout, v1Transformation, gcsInput, s3Input, localInput)
+			if err != nil {
+				return nil, err
+			}
+			resp, _, err := s.importData(ctxTimeout, data, true)
+			return resp, err
+		}
 		dctx := authz.Detach(ctx)
 		go func() {
 			ch := make(chan importResponse, 1)
 			ctxTimeout, cancel := context.WithTimeout(dctx, timeoutDuration)
 			defer cancel()
 			go func() {
-				dataOrgs, err := s.transportDataFromFile(ctxTimeout, v1Transformation, gcsInput, s3Input, localInput)
+				data, err := s.transportDataFromFile(ctxTimeout, v1Transformation, gcsInput, s3Input, localInput)
 				if err != nil {
 					ch <- importResponse{nil, nil, err}
 					return
 				}
-				resp, count, err := s.importData(ctxTimeout, dataOrgs)
+				resp, count, err := s.importData(ctxTimeout, data, false)
 				if err != nil {
 					ch <- importResponse{nil, count, err}
 					return
@@ -193,11 +254,10 @@ func (s *Server) ImportData(ctx context.Context, req *admin_pb.ImportDataRequest
 	return &admin_pb.ImportDataResponse{}, nil
 }
 
-func (s *Server) transportDataFromFile(ctx context.Context, v1Transformation bool, gcsInput *admin_pb.ImportDataRequest_GCSInput, s3Input *admin_pb.ImportDataRequest_S3Input, localInput *admin_pb.ImportDataRequest_LocalInput) (_ []*admin_pb.DataOrg, err error) {
+func (s *Server) transportDataFromFile(ctx context.Context, v1Transformation bool, gcsInput *admin_pb.ImportDataRequest_GCSInput, s3Input *admin_pb.ImportDataRequest_S3Input, localInput *admin_pb.ImportDataRequest_LocalInput) (_ *admin_pb.ImportDataOrg, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	dataOrgs := make([]*admin_pb.DataOrg, 0)
 	data := make([]byte, 0)
 	if gcsInput != nil {
 		gcsData, err := getFileFromGCS(ctx, gcsInput)
@@ -232,20 +292,13 @@ func (s *Server) transportDataFromFile(ctx context.Context, v1Transformation boo
 			return nil, err
 		}
 
-		dataImport, err := s.dataOrgsV1ToDataOrgs(ctx, dataImportV1)
-		if err != nil {
-			return nil, err
-		}
-		dataOrgs = dataImport.Orgs
-	} else {
-		dataImport := new(admin_pb.ImportDataOrg)
-		if err := jsonpb.Unmarshal(data, dataImport); err != nil {
-			return nil, err
-		}
-		dataOrgs = dataImport.Orgs
+		return s.dataOrgsV1ToDataOrgs(ctx, dataImportV1)
 	}
-
-	return dataOrgs, nil
+	dataImport := new(admin_pb.ImportDataOrg)
+	if err := jsonpb.Unmarshal(data, dataImport); err != nil {
+		return nil, err
+	}
+	return dataImport, nil
 }
 
 func getFileFromS3(ctx context.Context, input *admin_pb.ImportDataRequest_S3Input) (_ []byte, err error) {
@@ -317,6 +370,8 @@ func importOrg1(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataEr
 		ProjectIds:          []string{},
 		OidcAppIds:          []string{},
 		ApiAppIds:           []string{},
+		SamlAppIds:          []string{},
+		IdpTemplateIds:      []string{},
 		HumanUserIds:        []string{},
 		MachineUserIds:      []string{},
 		ActionIds:           []string{},
@@ -423,6 +478,65 @@ func importJwtIdps(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDat
 	return nil
 }
 
+func importIDPTemplates(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successOrg *admin_pb.ImportDataSuccessOrg, org *admin_pb.DataOrg, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if org.IdpTemplates == nil {
+		return nil
+	}
+	for _, idp := range org.GetIdpTemplates() {
+		logging.Debugf("import idp: %s", idp.GetIdpId())
+		if err := importIDPTemplate(ctx, s, org.GetOrgId(), idp); err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "idp_template", Id: idp.GetIdpId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.idpTemplateCount += 1
+		logging.Debugf("successful idp %d: %s", count.idpTemplateCount, idp.GetIdpId())
+		successOrg.IdpTemplateIds = append(successOrg.IdpTemplateIds, idp.GetIdpId())
+	}
+	return nil
+}
+
+func importIDPTemplate(ctx context.Context, s *Server, orgID string, idp *v1_pb.DataIDPTemplate) (err error) {
+	switch t := idp.GetIdp().(type) {
+	case *v1_pb.DataIDPTemplate_Oauth:
+		_, err = s.command.ImportOrgGenericOAuthProvider(ctx, orgID, idp.GetIdpId(), management.AddGenericOAuthProviderToCommand(t.Oauth))
+	case *v1_pb.DataIDPTemplate_Oidc:
+		_, err = s.command.ImportOrgGenericOIDCProvider(ctx, orgID, idp.GetIdpId(), management.AddGenericOIDCProviderToCommand(t.Oidc))
+	case *v1_pb.DataIDPTemplate_Jwt:
+		_, err = s.command.ImportOrgJWTProvider(ctx, orgID, idp.GetIdpId(), management.AddJWTProviderToCommand(t.Jwt))
+	case *v1_pb.DataIDPTemplate_AzureAd:
+		_, err = s.command.ImportOrgAzureADProvider(ctx, orgID, idp.GetIdpId(), management.AddAzureADProviderToCommand(t.AzureAd))
+	case *v1_pb.DataIDPTemplate_Github:
+		_, err = s.command.ImportOrgGitHubProvider(ctx, orgID, idp.GetIdpId(), management.AddGitHubProviderToCommand(t.Github))
+	case *v1_pb.DataIDPTemplate_GithubEs:
+		_, err = s.command.ImportOrgGitHubEnterpriseProvider(ctx, orgID, idp.GetIdpId(), management.AddGitHubEnterpriseProviderToCommand(t.GithubEs))
+	case *v1_pb.DataIDPTemplate_Gitlab:
+		_, err = s.command.ImportOrgGitLabProvider(ctx, orgID, idp.GetIdpId(), management.AddGitLabProviderToCommand(t.Gitlab))
+	case *v1_pb.DataIDPTemplate_GitlabSelfHosted:
+		_, err = s.command.ImportOrgGitLabSelfHostedProvider(ctx, orgID, idp.GetIdpId(), management.AddGitLabSelfHostedProviderToCommand(t.GitlabSelfHosted))
+	case *v1_pb.DataIDPTemplate_Google:
+		_, err = s.command.ImportOrgGoogleProvider(ctx, orgID, idp.GetIdpId(), management.AddGoogleProviderToCommand(t.Google))
+	case *v1_pb.DataIDPTemplate_Ldap:
+		_, err = s.command.ImportOrgLDAPProvider(ctx, orgID, idp.GetIdpId(), management.AddLDAPProviderToCommand(t.Ldap))
+	case *v1_pb.DataIDPTemplate_Apple:
+		_, err = s.command.ImportOrgAppleProvider(ctx, orgID, idp.GetIdpId(), management.AddAppleProviderToCommand(t.Apple))
+	case *v1_pb.DataIDPTemplate_Saml:
+		_, err = s.command.ImportOrgSAMLProvider(ctx, orgID, idp.GetIdpId(), management.AddSAMLProviderToCommand(t.Saml))
+	default:
+		err = zerrors.ThrowInvalidArgument(nil, "ADMIN-Aiy3o", "Errors.Invalid.Argument")
+	}
+	if err != nil || len(idp.GetAttributeMappings()) == 0 {
+		return err
+	}
+	_, err = s.command.SetOrgIDPAttributeMappings(ctx, orgID, idp.GetIdpId(), idp_grpc.AttributeMappingsToCommand(idp.GetAttributeMappings()))
+	return err
+}
+
 func importLoginPolicy(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, org *admin_pb.DataOrg) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.End() }()
@@ -659,6 +773,30 @@ func importOIDCApps(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDa
 	return nil
 }
 
+func importSAMLApps(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successOrg *admin_pb.ImportDataSuccessOrg, org *admin_pb.DataOrg, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if org.SamlApps == nil {
+		return nil
+	}
+	for _, app := range org.GetSamlApps() {
+		logging.Debugf("import samlapplication: %s", app.GetAppId())
+		_, err := s.command.AddSAMLApplicationWithID(ctx, management.AddSAMLAppRequestToDomain(app.App), org.GetOrgId(), app.GetAppId())
+		if err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "saml_app", Id: app.GetAppId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.samlAppCount += 1
+		logging.Debugf("successful samlapplication %d: %s", count.samlAppCount, app.GetAppId())
+		successOrg.SamlAppIds = append(successOrg.SamlAppIds, app.GetAppId())
+	}
+	return nil
+}
+
 func importAPIApps(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successOrg *admin_pb.ImportDataSuccessOrg, org *admin_pb.DataOrg, count *counts) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -785,6 +923,9 @@ func importResources(ctx context.Context, s *Server, errors *[]*admin_pb.ImportD
 	if err := importJwtIdps(ctx, s, errors, successOrg, org); err != nil {
 		return err
 	}
+	if err := importIDPTemplates(ctx, s, errors, successOrg, org, count); err != nil {
+		return err
+	}
 	importLoginPolicy(ctx, s, errors, org)
 	importPwComlexityPolicy(ctx, s, errors, org)
 	importPrivacyPolicy(ctx, s, errors, org)
@@ -820,6 +961,9 @@ func importResources(ctx context.Context, s *Server, errors *[]*admin_pb.ImportD
 	if err := importAPIApps(ctx, s, errors, successOrg, org, count); err != nil {
 		return err
 	}
+	if err := importSAMLApps(ctx, s, errors, successOrg, org, count); err != nil {
+		return err
+	}
 	if err := importAppKeys(ctx, s, errors, successOrg, org, count); err != nil {
 		return err
 	}
@@ -1144,32 +1288,27 @@ func findOldOrg(success *admin_pb.ImportDataSuccess, orgId string) *admin_pb.Imp
 	return nil
 }
 
-func (s *Server) importData(ctx context.Context, orgs []*admin_pb.DataOrg) (_ *admin_pb.ImportDataResponse, _ *counts, err error) {
+func (s *Server) importData(ctx context.Context, data *admin_pb.ImportDataOrg, validateOnly bool) (_ *admin_pb.ImportDataResponse, _ *counts, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	errors := make([]*admin_pb.ImportDataError, 0)
-	success := &admin_pb.ImportDataSuccess{}
-	count := &counts{}
-
-	initCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeInitCode, s.userCodeAlg)
-	if err != nil {
-		return nil, nil, err
-	}
-	emailCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, s.userCodeAlg)
+	version, err := importVersion(data.GetVersion())
 	if err != nil {
 		return nil, nil, err
 	}
-	phoneCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, s.userCodeAlg)
-	if err != nil {
-		return nil, nil, err
+	orgs := data.GetOrgs()
+	errors := make([]*admin_pb.ImportDataError, 0)
+	success := &admin_pb.ImportDataSuccess{}
+	count := &counts{}
+	report := &admin_pb.ImportDataReport{
+		Version:          version,
+		ValidationErrors: validateImportData(data),
 	}
-	passwordlessInitCode, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypePasswordlessInitCode, s.userCodeAlg)
-	if err != nil {
-		return nil, nil, err
+	response := func() *admin_pb.ImportDataResponse {
+		report.Resources = count.reportResources()
+		return &admin_pb.ImportDataResponse{Errors: errors, Success: success, Report: report}
 	}
 
-	ctxData := authz.GetCtxData(ctx)
 	for _, org := range orgs {
 		count.humanUserLen += len(org.GetHumanUsers())
 		count.machineUserLen += len(org.GetMachineUsers())
@@ -1178,6 +1317,8 @@ func (s *Server) importData(ctx context.Context, orgs []*admin_pb.DataOrg) (_ *a
 		count.projectLen += len(org.GetProjects())
 		count.oidcAppLen += len(org.GetOidcApps())
 		count.apiAppLen += len(org.GetApiApps())
+		count.samlAppLen += len(org.GetSamlApps())
+		count.idpTemplateLen += len(org.GetIdpTemplates())
 		count.actionLen += len(org.GetActions())
 		count.projectRolesLen += len(org.GetProjectRoles())
 		count.projectGrantLen += len(org.GetProjectGrants())
@@ -1188,25 +1329,68 @@ func (s *Server) importData(ctx context.Context, orgs []*admin_pb.DataOrg) (_ *a
 		count.machineKeysCount += len(org.GetMachineKeys())
 		count.appKeysCount += len(org.GetAppKeys())
 	}
+	count.targetLen = len(data.GetInstance().GetTargets())
+	count.executionLen = len(data.GetInstance().GetExecutions())
+	count.userSchemaLen = len(data.GetInstance().GetUserSchemas())
+	count.webKeyLen = len(data.GetInstance().GetWebKeys())
+	count.idpDiscoveryDomainLen = len(data.GetInstance().GetIdpDiscoveryDomains())
+	if validateOnly {
+		report.Resources = count.reportResources()
+		return &admin_pb.ImportDataResponse{Report: report}, count, nil
+	}
+
+	initCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeInitCode, s.userCodeAlg)
+	if err != nil {
+		return nil, nil, err
+	}
+	emailCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, s.userCodeAlg)
+	if err != nil {
+		return nil, nil, err
+	}
+	phoneCodeGenerator, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, s.userCodeAlg)
+	if err != nil {
+		return nil, nil, err
+	}
+	passwordlessInitCode, err := s.query.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypePasswordlessInitCode, s.userCodeAlg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctxData := authz.GetCtxData(ctx)
+	if err = importInstance(ctx, s, &errors, success, count, data.GetInstance()); err != nil {
+		return response(), count, err
+	}
 	for _, org := range orgs {
 		if err = importOrg1(ctx, s, &errors, ctxData, org, success, count, initCodeGenerator, emailCodeGenerator, phoneCodeGenerator, passwordlessInitCode); err != nil {
-			return &admin_pb.ImportDataResponse{Errors: errors, Success: success}, count, err
+			return response(), count, err
 		}
 	}
 	for _, org := range orgs {
 		if err = importOrg2(ctx, s, &errors, success, count, org); err != nil {
-			return &admin_pb.ImportDataResponse{Errors: errors, Success: success}, count, err
+			return response(), count, err
 		}
 	}
 	for _, org := range orgs {
 		if err = importOrg3(ctx, s, &errors, success, count, org); err != nil {
-			return &admin_pb.ImportDataResponse{Errors: errors, Success: success}, count, err
+			return response(), count, err
 		}
 	}
-	return &admin_pb.ImportDataResponse{
-		Errors:  errors,
-		Success: success,
-	}, count, nil
+	if err = importIDPDiscoveryDomains(ctx, s, &errors, success.GetInstance(), data.GetInstance(), count); err != nil {
+		return response(), count, err
+	}
+	return response(), count, nil
+}
+
+// importVersion returns the version of the export format of the data,
+// data without version was exported before the format was versioned
+func importVersion(version uint32) (uint32, error) {
+	if version == 0 {
+		return 1, nil
+	}
+	if version > exportVersion {
+		return 0, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ohx2e", "Errors.Import.VersionNotSupported")
+	}
+	return version, nil
 }
 
 func (s *Server) dataOrgsV1ToDataOrgs(ctx context.Context, dataOrgs *v1_pb.ImportDataOrg) (_ *admin_pb.ImportDataOrg, err error) {
@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	action_resource "github.com/zitadel/zitadel/internal/api/grpc/resources/action/v3alpha"
+	userschema_resource "github.com/zitadel/zitadel/internal/api/grpc/resources/userschema/v3alpha"
+	webkey_resource "github.com/zitadel/zitadel/internal/api/grpc/resources/webkey/v3"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	userschema_pb "github.com/zitadel/zitadel/pkg/grpc/resources/userschema/v3alpha"
+)
+
+// importInstance imports the resources of the instance before the organizations
+func importInstance(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, success *admin_pb.ImportDataSuccess, count *counts, instance *admin_pb.DataInstance) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if instance == nil {
+		return nil
+	}
+	success.Instance = &admin_pb.ImportDataSuccessInstance{
+		TargetIds:           []string{},
+		ExecutionIds:        []string{},
+		UserSchemaIds:       []string{},
+		WebKeyIds:           []string{},
+		IdpDiscoveryDomains: []string{},
+	}
+	if err := importTargets(ctx, s, errors, success.Instance, instance, count); err != nil {
+		return err
+	}
+	if err := importExecutions(ctx, s, errors, success.Instance, instance, count); err != nil {
+		return err
+	}
+	if err := importUserSchemas(ctx, s, errors, success.Instance, instance, count); err != nil {
+		return err
+	}
+	return importWebKeys(ctx, s, errors, success.Instance, instance, count)
+}
+
+func importTargets(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successInstance *admin_pb.ImportDataSuccessInstance, instance *admin_pb.DataInstance, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	for _, target := range instance.GetTargets() {
+		logging.Debugf("import target: %s", target.GetTargetId())
+		add := action_resource.TargetToCommand(target.GetTarget())
+		add.AggregateID = target.GetTargetId()
+		if _, err := s.command.AddTarget(ctx, add, instanceID); err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "target", Id: target.GetTargetId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.targetCount += 1
+		logging.Debugf("successful target %d: %s", count.targetCount, target.GetTargetId())
+		successInstance.TargetIds = append(successInstance.TargetIds, target.GetTargetId())
+	}
+	return nil
+}
+
+func importExecutions(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successInstance *admin_pb.ImportDataSuccessInstance, instance *admin_pb.DataInstance, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	for _, dataExecution := range instance.GetExecutions() {
+		logging.Debugf("import execution: %s", dataExecution.GetExecutionId())
+		set := &command.SetExecution{
+			ObjectRoot: models.ObjectRoot{AggregateID: dataExecution.GetExecutionId()},
+			Targets:    make([]*execution.Target, len(dataExecution.GetTargets())),
+		}
+		for i, target := range dataExecution.GetTargets() {
+			switch t := target.GetType().(type) {
+			case *admin_pb.DataExecution_Target_Include:
+				set.Targets[i] = &execution.Target{Type: domain.ExecutionTargetTypeInclude, Target: t.Include}
+			case *admin_pb.DataExecution_Target_TargetId:
+				set.Targets[i] = &execution.Target{Type: domain.ExecutionTargetTypeTarget, Target: t.TargetId}
+			default:
+				set.Targets[i] = &execution.Target{Type: domain.ExecutionTargetTypeUnspecified}
+			}
+		}
+		if _, err := s.command.ImportExecution(ctx, set, instanceID); err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "execution", Id: dataExecution.GetExecutionId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.executionCount += 1
+		logging.Debugf("successful execution %d: %s", count.executionCount, dataExecution.GetExecutionId())
+		successInstance.ExecutionIds = append(successInstance.ExecutionIds, dataExecution.GetExecutionId())
+	}
+	return nil
+}
+
+func importUserSchemas(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successInstance *admin_pb.ImportDataSuccessInstance, instance *admin_pb.DataInstance, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	for _, schema := range instance.GetUserSchemas() {
+		logging.Debugf("import user schema: %s", schema.GetSchemaId())
+		if err := importUserSchema(ctx, s, instanceID, schema); err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "user_schema", Id: schema.GetSchemaId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.userSchemaCount += 1
+		logging.Debugf("successful user schema %d: %s", count.userSchemaCount, schema.GetSchemaId())
+		successInstance.UserSchemaIds = append(successInstance.UserSchemaIds, schema.GetSchemaId())
+	}
+	return nil
+}
+
+func importUserSchema(ctx context.Context, s *Server, instanceID string, schema *admin_pb.DataUserSchema) error {
+	create, err := userschema_resource.UserSchemaToCommand(schema.GetUserSchema(), instanceID)
+	if err != nil {
+		return err
+	}
+	create.ID = schema.GetSchemaId()
+	if err = s.command.CreateUserSchema(ctx, create); err != nil {
+		return err
+	}
+	if schema.GetState() != userschema_pb.State_STATE_INACTIVE {
+		return nil
+	}
+	_, err = s.command.DeactivateUserSchema(ctx, schema.GetSchemaId(), instanceID)
+	return err
+}
+
+// importWebKeys generates new keys with the configuration of the exported keys,
+// the key which was active in the exported instance is activated.
+func importWebKeys(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successInstance *admin_pb.ImportDataSuccessInstance, instance *admin_pb.DataInstance, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, key := range instance.GetWebKeys() {
+		logging.Debugf("import web key: %s", key.GetKeyId())
+		details, err := s.command.CreateWebKey(ctx, webkey_resource.WebKeyToConfig(key.GetConfig()))
+		if err == nil && key.GetActive() {
+			_, err = s.command.ActivateWebKey(ctx, details.KeyID)
+		}
+		if err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "web_key", Id: key.GetKeyId(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.webKeyCount += 1
+		logging.Debugf("successful web key %d: %s", count.webKeyCount, details.KeyID)
+		successInstance.WebKeyIds = append(successInstance.WebKeyIds, details.KeyID)
+	}
+	return nil
+}
+
+// importIDPDiscoveryDomains imports the discovery domains after the organizations,
+// since they can route to identity providers of the organizations.
+func importIDPDiscoveryDomains(ctx context.Context, s *Server, errors *[]*admin_pb.ImportDataError, successInstance *admin_pb.ImportDataSuccessInstance, instance *admin_pb.DataInstance, count *counts) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for _, discovery := range instance.GetIdpDiscoveryDomains() {
+		logging.Debugf("import idp discovery domain: %s", discovery.GetDomain())
+		if _, err := s.command.SetIDPDiscoveryDomain(ctx, discovery.GetDomain(), discovery.GetIdpId()); err != nil {
+			*errors = append(*errors, &admin_pb.ImportDataError{Type: "idp_discovery_domain", Id: discovery.GetDomain(), Message: err.Error()})
+			if isCtxTimeout(ctx) {
+				return err
+			}
+			continue
+		}
+		count.idpDiscoveryDomainCount += 1
+		logging.Debugf("successful idp discovery domain %d: %s", count.idpDiscoveryDomainCount, discovery.GetDomain())
+		successInstance.IdpDiscoveryDomains = append(successInstance.IdpDiscoveryDomains, discovery.GetDomain())
+	}
+	return nil
+}
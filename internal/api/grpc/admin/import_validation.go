@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"fmt"
+
+	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
+	"github.com/zitadel/zitadel/internal/domain"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp"
+	v1_pb "github.com/zitadel/zitadel/pkg/grpc/v1"
+)
+
+// validateImportData checks the references between the resources of the data.
+// Referenced resources which are not part of the data must already exist in the instance,
+// otherwise the import of the referencing resource fails.
+func validateImportData(data *admin_pb.ImportDataOrg) []*admin_pb.ImportDataError {
+	errors := make([]*admin_pb.ImportDataError, 0)
+	report := func(resourceType, id, format string, args ...any) {
+		errors = append(errors, &admin_pb.ImportDataError{Type: resourceType, Id: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	orgs := make(map[string]bool, len(data.GetOrgs()))
+	projects := make(map[string]bool)
+	apps := make(map[string]bool)
+	users := make(map[string]bool)
+	orgIDPs := make(map[string]bool)
+	for _, org := range data.GetOrgs() {
+		if orgs[org.GetOrgId()] {
+			report("org", org.GetOrgId(), "organization is contained multiple times")
+		}
+		orgs[org.GetOrgId()] = true
+		for _, project := range org.GetProjects() {
+			projects[project.GetProjectId()] = true
+		}
+		for _, app := range org.GetOidcApps() {
+			apps[app.GetAppId()] = true
+		}
+		for _, app := range org.GetApiApps() {
+			apps[app.GetAppId()] = true
+		}
+		for _, app := range org.GetSamlApps() {
+			apps[app.GetAppId()] = true
+		}
+		for _, user := range org.GetHumanUsers() {
+			users[user.GetUserId()] = true
+		}
+		for _, user := range org.GetMachineUsers() {
+			users[user.GetUserId()] = true
+		}
+		for _, idp := range org.GetOidcIdps() {
+			orgIDPs[idp.GetIdpId()] = true
+		}
+		for _, idp := range org.GetJwtIdps() {
+			orgIDPs[idp.GetIdpId()] = true
+		}
+		for _, idp := range org.GetIdpTemplates() {
+			orgIDPs[idp.GetIdpId()] = true
+		}
+	}
+
+	for _, org := range data.GetOrgs() {
+		for _, app := range org.GetOidcApps() {
+			if !projects[app.GetApp().GetProjectId()] {
+				report("oidc_app", app.GetAppId(), "project %s is not part of the data", app.GetApp().GetProjectId())
+			}
+		}
+		for _, app := range org.GetApiApps() {
+			if !projects[app.GetApp().GetProjectId()] {
+				report("api_app", app.GetAppId(), "project %s is not part of the data", app.GetApp().GetProjectId())
+			}
+		}
+		for _, app := range org.GetSamlApps() {
+			if !projects[app.GetApp().GetProjectId()] {
+				report("saml_app", app.GetAppId(), "project %s is not part of the data", app.GetApp().GetProjectId())
+			}
+		}
+		for _, key := range org.GetAppKeys() {
+			if !apps[key.GetAppId()] {
+				report("app_key", key.GetId(), "app %s is not part of the data", key.GetAppId())
+			}
+		}
+
+		actions := make(map[string]bool, len(org.GetActions()))
+		for _, action := range org.GetActions() {
+			actions[action.GetActionId()] = true
+		}
+		for _, trigger := range org.GetTriggerActions() {
+			for _, actionID := range trigger.GetActionIds() {
+				if !actions[actionID] {
+					report("trigger_action", org.GetOrgId(), "action %s is not part of the organization", actionID)
+				}
+			}
+		}
+
+		idps := make(map[string]bool)
+		for _, idp := range org.GetOidcIdps() {
+			idps[idp.GetIdpId()] = true
+		}
+		for _, idp := range org.GetJwtIdps() {
+			idps[idp.GetIdpId()] = true
+		}
+		for _, idp := range org.GetIdpTemplates() {
+			idps[idp.GetIdpId()] = true
+			validateAttributeMappings(idp, report)
+		}
+		for _, idp := range org.GetLoginPolicy().GetIdps() {
+			if idp.GetOwnerType() == idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG && !idps[idp.GetIdpId()] {
+				report("login_policy", org.GetOrgId(), "identity provider %s is not part of the organization", idp.GetIdpId())
+			}
+		}
+
+		for _, grant := range org.GetUserGrants() {
+			if !users[grant.GetUserId()] {
+				report("user_grant", grant.GetUserId(), "user %s is not part of the data", grant.GetUserId())
+			}
+		}
+	}
+
+	targets := make(map[string]bool, len(data.GetInstance().GetTargets()))
+	for _, target := range data.GetInstance().GetTargets() {
+		targets[target.GetTargetId()] = true
+	}
+	executions := make(map[string]bool, len(data.GetInstance().GetExecutions()))
+	for _, execution := range data.GetInstance().GetExecutions() {
+		for _, target := range execution.GetTargets() {
+			switch t := target.GetType().(type) {
+			case *admin_pb.DataExecution_Target_TargetId:
+				if !targets[t.TargetId] {
+					report("execution", execution.GetExecutionId(), "target %s is not part of the data", t.TargetId)
+				}
+			case *admin_pb.DataExecution_Target_Include:
+				// includes must be imported before the executions including them
+				if !executions[t.Include] {
+					report("execution", execution.GetExecutionId(), "included execution %s is not listed before", t.Include)
+				}
+			}
+		}
+		executions[execution.GetExecutionId()] = true
+	}
+	discoveryDomains := make(map[string]bool, len(data.GetInstance().GetIdpDiscoveryDomains()))
+	for _, discovery := range data.GetInstance().GetIdpDiscoveryDomains() {
+		if discoveryDomains[discovery.GetDomain()] {
+			report("idp_discovery_domain", discovery.GetDomain(), "domain is contained multiple times")
+		}
+		discoveryDomains[discovery.GetDomain()] = true
+		if discovery.GetIdpOwnerType() == idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG && !orgIDPs[discovery.GetIdpId()] {
+			report("idp_discovery_domain", discovery.GetDomain(), "identity provider %s is not part of the data", discovery.GetIdpId())
+		}
+	}
+	return errors
+}
+
+// validateAttributeMappings checks the attribute mappings of the identity provider,
+// every field and metadata key can only be targeted once.
+func validateAttributeMappings(idp *v1_pb.DataIDPTemplate, report func(resourceType, id, format string, args ...any)) {
+	type target struct {
+		target domain.IDPAttributeMappingTarget
+		key    string
+	}
+	targets := make(map[target]bool, len(idp.GetAttributeMappings()))
+	for _, mapping := range idp_grpc.AttributeMappingsToCommand(idp.GetAttributeMappings()) {
+		if !mapping.IsValid() {
+			report("idp_template", idp.GetIdpId(), "attribute mapping of %s is invalid", mapping.Source)
+			continue
+		}
+		t := target{target: mapping.Target, key: mapping.Key}
+		if targets[t] {
+			report("idp_template", idp.GetIdpId(), "attribute mapping of %s targets a field which is already mapped", mapping.Source)
+		}
+		targets[t] = true
+	}
+}
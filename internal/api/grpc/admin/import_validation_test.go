@@ -0,0 +1,198 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp"
+	management_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	v1_pb "github.com/zitadel/zitadel/pkg/grpc/v1"
+)
+
+func Test_validateImportData(t *testing.T) {
+	tests := []struct {
+		name string
+		data *admin_pb.ImportDataOrg
+		want []*admin_pb.ImportDataError
+	}{
+		{
+			name: "empty",
+			data: &admin_pb.ImportDataOrg{},
+			want: []*admin_pb.ImportDataError{},
+		},
+		{
+			name: "consistent",
+			data: &admin_pb.ImportDataOrg{
+				Orgs: []*admin_pb.DataOrg{
+					{
+						OrgId:    "org1",
+						Projects: []*v1_pb.DataProject{{ProjectId: "project1"}},
+						SamlApps: []*v1_pb.DataSAMLApplication{{AppId: "app1", App: &management_pb.AddSAMLAppRequest{ProjectId: "project1"}}},
+						AppKeys:  []*v1_pb.DataAppKey{{Id: "key1", AppId: "app1"}},
+						IdpTemplates: []*v1_pb.DataIDPTemplate{
+							{
+								IdpId: "idp1",
+								AttributeMappings: []*idp_pb.AttributeMapping{
+									{Source: "given_name", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_FIRST_NAME},
+									{Source: "department", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_METADATA, Key: "department"},
+									{Source: "groups", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_METADATA, Key: "groups"},
+								},
+							},
+						},
+						LoginPolicy: &management_pb.AddCustomLoginPolicyRequest{
+							Idps: []*management_pb.AddCustomLoginPolicyRequest_IDP{
+								{IdpId: "idp1", OwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG},
+								{IdpId: "instance", OwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_SYSTEM},
+							},
+						},
+					},
+					{
+						OrgId:      "org2",
+						HumanUsers: []*v1_pb.DataHumanUser{{UserId: "user1"}},
+						UserGrants: []*management_pb.AddUserGrantRequest{{UserId: "user1", ProjectId: "project1"}},
+					},
+				},
+				Instance: &admin_pb.DataInstance{
+					Targets: []*admin_pb.DataTarget{{TargetId: "target1"}},
+					Executions: []*admin_pb.DataExecution{
+						{
+							ExecutionId: "function/validate",
+							Targets:     []*admin_pb.DataExecution_Target{{Type: &admin_pb.DataExecution_Target_TargetId{TargetId: "target1"}}},
+						},
+						{
+							ExecutionId: "request",
+							Targets:     []*admin_pb.DataExecution_Target{{Type: &admin_pb.DataExecution_Target_Include{Include: "function/validate"}}},
+						},
+					},
+					IdpDiscoveryDomains: []*admin_pb.DataIDPDiscoveryDomain{
+						{Domain: "org.com", IdpId: "idp1", IdpOwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG},
+						{Domain: "instance.com", IdpId: "instance", IdpOwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_SYSTEM},
+					},
+				},
+			},
+			want: []*admin_pb.ImportDataError{},
+		},
+		{
+			name: "missing references",
+			data: &admin_pb.ImportDataOrg{
+				Orgs: []*admin_pb.DataOrg{
+					{
+						OrgId:    "org1",
+						OidcApps: []*v1_pb.DataOIDCApplication{{AppId: "app1", App: &management_pb.AddOIDCAppRequest{ProjectId: "project1"}}},
+						AppKeys:  []*v1_pb.DataAppKey{{Id: "key1", AppId: "app2"}},
+						TriggerActions: []*management_pb.SetTriggerActionsRequest{
+							{ActionIds: []string{"action1"}},
+						},
+						IdpTemplates: []*v1_pb.DataIDPTemplate{
+							{
+								IdpId: "idp2",
+								AttributeMappings: []*idp_pb.AttributeMapping{
+									{Source: "given_name", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_FIRST_NAME},
+									{Source: "name", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_FIRST_NAME},
+									{Source: "department", Target: idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_METADATA},
+								},
+							},
+						},
+						LoginPolicy: &management_pb.AddCustomLoginPolicyRequest{
+							Idps: []*management_pb.AddCustomLoginPolicyRequest_IDP{
+								{IdpId: "idp1", OwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG},
+							},
+						},
+						UserGrants: []*management_pb.AddUserGrantRequest{{UserId: "user1"}},
+					},
+					{
+						OrgId: "org1",
+					},
+				},
+				Instance: &admin_pb.DataInstance{
+					Executions: []*admin_pb.DataExecution{
+						{
+							ExecutionId: "request",
+							Targets: []*admin_pb.DataExecution_Target{
+								{Type: &admin_pb.DataExecution_Target_Include{Include: "function/validate"}},
+								{Type: &admin_pb.DataExecution_Target_TargetId{TargetId: "target1"}},
+							},
+						},
+						{
+							ExecutionId: "function/validate",
+						},
+					},
+					IdpDiscoveryDomains: []*admin_pb.DataIDPDiscoveryDomain{
+						{Domain: "org.com", IdpId: "idp2", IdpOwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG},
+						{Domain: "org.com", IdpId: "idp1", IdpOwnerType: idp_pb.IDPOwnerType_IDP_OWNER_TYPE_ORG},
+					},
+				},
+			},
+			want: []*admin_pb.ImportDataError{
+				{Type: "org", Id: "org1", Message: "organization is contained multiple times"},
+				{Type: "oidc_app", Id: "app1", Message: "project project1 is not part of the data"},
+				{Type: "app_key", Id: "key1", Message: "app app2 is not part of the data"},
+				{Type: "trigger_action", Id: "org1", Message: "action action1 is not part of the organization"},
+				{Type: "idp_template", Id: "idp2", Message: "attribute mapping of name targets a field which is already mapped"},
+				{Type: "idp_template", Id: "idp2", Message: "attribute mapping of department is invalid"},
+				{Type: "login_policy", Id: "org1", Message: "identity provider idp1 is not part of the organization"},
+				{Type: "user_grant", Id: "user1", Message: "user user1 is not part of the data"},
+				{Type: "execution", Id: "request", Message: "included execution function/validate is not listed before"},
+				{Type: "execution", Id: "request", Message: "target target1 is not part of the data"},
+				{Type: "idp_discovery_domain", Id: "org.com", Message: "domain is contained multiple times"},
+				{Type: "idp_discovery_domain", Id: "org.com", Message: "identity provider idp1 is not part of the data"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateImportData(tt.data))
+		})
+	}
+}
+
+func Test_sortExecutionsByIncludes(t *testing.T) {
+	include := func(id string) *admin_pb.DataExecution_Target {
+		return &admin_pb.DataExecution_Target{Type: &admin_pb.DataExecution_Target_Include{Include: id}}
+	}
+	request := &admin_pb.DataExecution{ExecutionId: "request", Targets: []*admin_pb.DataExecution_Target{include("function/a")}}
+	functionA := &admin_pb.DataExecution{ExecutionId: "function/a", Targets: []*admin_pb.DataExecution_Target{include("function/b")}}
+	functionB := &admin_pb.DataExecution{ExecutionId: "function/b"}
+	event := &admin_pb.DataExecution{ExecutionId: "event", Targets: []*admin_pb.DataExecution_Target{include("missing")}}
+
+	got := sortExecutionsByIncludes([]*admin_pb.DataExecution{request, event, functionA, functionB})
+	assert.Equal(t, []*admin_pb.DataExecution{functionB, functionA, request, event}, got)
+}
+
+func Test_importVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version uint32
+		want    uint32
+		wantErr bool
+	}{
+		{
+			name:    "legacy data",
+			version: 0,
+			want:    1,
+		},
+		{
+			name:    "current version",
+			version: exportVersion,
+			want:    exportVersion,
+		},
+		{
+			name:    "newer version",
+			version: exportVersion + 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := importVersion(tt.version)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
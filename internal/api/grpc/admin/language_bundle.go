@@ -0,0 +1,102 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListLanguageBundles(ctx context.Context, req *admin_pb.ListLanguageBundlesRequest) (*admin_pb.ListLanguageBundlesResponse, error) {
+	namespace, err := languageBundleNamespaceToDomain(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	bundles, err := s.query.LanguageBundles(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListLanguageBundlesResponse{
+		Result: languageBundlesToPb(authz.GetInstance(ctx).InstanceID(), bundles),
+	}, nil
+}
+
+func (s *Server) GetLanguageBundleTemplate(ctx context.Context, req *admin_pb.GetLanguageBundleTemplateRequest) (*admin_pb.GetLanguageBundleTemplateResponse, error) {
+	namespace, err := languageBundleNamespaceToDomain(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	content, err := i18n.BundleTemplate(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetLanguageBundleTemplateResponse{
+		Content: content,
+	}, nil
+}
+
+func (s *Server) SetLanguageBundle(ctx context.Context, req *admin_pb.SetLanguageBundleRequest) (*admin_pb.SetLanguageBundleResponse, error) {
+	namespace, err := languageBundleNamespaceToDomain(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	lang, err := domain.ParseLanguage(req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.SetLanguageBundle(ctx, namespace, lang[0], req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetLanguageBundleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveLanguageBundle(ctx context.Context, req *admin_pb.RemoveLanguageBundleRequest) (*admin_pb.RemoveLanguageBundleResponse, error) {
+	namespace, err := languageBundleNamespaceToDomain(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	lang, err := domain.ParseLanguage(req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveLanguageBundle(ctx, namespace, lang[0])
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveLanguageBundleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func languageBundleNamespaceToDomain(namespace admin_pb.LanguageBundleNamespace) (i18n.Namespace, error) {
+	switch namespace {
+	case admin_pb.LanguageBundleNamespace_LANGUAGE_BUNDLE_NAMESPACE_LOGIN:
+		return i18n.LOGIN, nil
+	case admin_pb.LanguageBundleNamespace_LANGUAGE_BUNDLE_NAMESPACE_NOTIFICATION:
+		return i18n.NOTIFICATION, nil
+	case admin_pb.LanguageBundleNamespace_LANGUAGE_BUNDLE_NAMESPACE_UNSPECIFIED:
+		fallthrough
+	default:
+		return "", zerrors.ThrowInvalidArgument(nil, "ADMIN-Ie4ah", "Errors.LanguageBundle.InvalidNamespace")
+	}
+}
+
+func languageBundlesToPb(instanceID string, bundles []*query.LanguageBundle) []*admin_pb.LanguageBundle {
+	result := make([]*admin_pb.LanguageBundle, len(bundles))
+	for i, bundle := range bundles {
+		result[i] = &admin_pb.LanguageBundle{
+			Details:   object.ChangeToDetailsPb(bundle.Sequence, bundle.ChangeDate, instanceID),
+			Language:  bundle.Language.String(),
+			Direction: i18n.Direction(bundle.Language),
+		}
+	}
+	return result
+}
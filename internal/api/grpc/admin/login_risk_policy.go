@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/domain"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetLoginRiskPolicy(ctx context.Context, _ *admin_pb.GetLoginRiskPolicyRequest) (*admin_pb.GetLoginRiskPolicyResponse, error) {
+	policy, err := s.query.DefaultLoginRiskPolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetLoginRiskPolicyResponse{Policy: policy_grpc.ModelLoginRiskPolicyToPb(policy)}, nil
+}
+
+func (s *Server) UpdateLoginRiskPolicy(ctx context.Context, req *admin_pb.UpdateLoginRiskPolicyRequest) (*admin_pb.UpdateLoginRiskPolicyResponse, error) {
+	details, err := s.command.SetDefaultLoginRiskPolicy(ctx, updateLoginRiskPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateLoginRiskPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func updateLoginRiskPolicyToDomain(req *admin_pb.UpdateLoginRiskPolicyRequest) *domain.LoginRiskPolicy {
+	return &domain.LoginRiskPolicy{
+		NewDevice:        policy_grpc.LoginRiskActionToDomain(req.GetNewDevice()),
+		NewNetwork:       policy_grpc.LoginRiskActionToDomain(req.GetNewNetwork()),
+		ImpossibleTravel: policy_grpc.LoginRiskActionToDomain(req.GetImpossibleTravel()),
+	}
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetCustomMessageTemplate(ctx context.Context, req *admin_pb.GetCustomMessageTemplateRequest) (*admin_pb.GetCustomMessageTemplateResponse, error) {
+	template, err := s.query.CustomMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetCustomMessageTemplateResponse{
+		Template: text_grpc.MessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetDefaultMessageTemplate(ctx context.Context, req *admin_pb.SetDefaultMessageTemplateRequest) (*admin_pb.SetDefaultMessageTemplateResponse, error) {
+	result, err := s.command.SetDefaultMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), SetDefaultMessageTemplateToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMessageTemplateResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMessageTemplateToDefault(ctx context.Context, req *admin_pb.ResetCustomMessageTemplateToDefaultRequest) (*admin_pb.ResetCustomMessageTemplateToDefaultResponse, error) {
+	result, err := s.command.RemoveInstanceMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomMessageTemplateToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) PreviewMessageTemplate(ctx context.Context, req *admin_pb.PreviewMessageTemplateRequest) (*admin_pb.PreviewMessageTemplateResponse, error) {
+	subject, html, plainText, err := types.PreviewEmail(ctx, s.query, s.staticStorage, authz.GetInstance(ctx).InstanceID(), req.MessageType, language.Make(req.Language), req.Html, req.PlainText)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.PreviewMessageTemplateResponse{
+		Subject:   subject,
+		Html:      html,
+		PlainText: plainText,
+	}, nil
+}
+
+func SetDefaultMessageTemplateToDomain(req *admin_pb.SetDefaultMessageTemplateRequest) *domain.MessageTemplate {
+	return &domain.MessageTemplate{
+		MessageType: req.MessageType,
+		Language:    language.Make(req.Language),
+		HTML:        req.Html,
+		PlainText:   req.PlainText,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -27,6 +28,7 @@ type Server struct {
 	database          string
 	command           *command.Commands
 	query             *query.Queries
+	staticStorage     static.Storage
 	assetsAPIDomain   func(context.Context) string
 	userCodeAlg       crypto.EncryptionAlgorithm
 	auditLogRetention time.Duration
@@ -40,6 +42,7 @@ func CreateServer(
 	database string,
 	command *command.Commands,
 	query *query.Queries,
+	staticStorage static.Storage,
 	userCodeAlg crypto.EncryptionAlgorithm,
 	auditLogRetention time.Duration,
 ) *Server {
@@ -47,6 +50,7 @@ func CreateServer(
 		database:          database,
 		command:           command,
 		query:             query,
+		staticStorage:     staticStorage,
 		assetsAPIDomain:   assets.AssetAPI(),
 		userCodeAlg:       userCodeAlg,
 		auditLogRetention: auditLogRetention,
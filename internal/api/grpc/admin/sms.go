@@ -5,11 +5,12 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/settings"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
 func (s *Server) ListSMSProviders(ctx context.Context, req *admin_pb.ListSMSProvidersRequest) (*admin_pb.ListSMSProvidersResponse, error) {
-	queries, err := listSMSConfigsToModel(req)
+	queries, err := listSMSConfigsToModel(authz.GetInstance(ctx).InstanceID(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -19,17 +20,17 @@ func (s *Server) ListSMSProviders(ctx context.Context, req *admin_pb.ListSMSProv
 	}
 	return &admin_pb.ListSMSProvidersResponse{
 		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
-		Result:  SMSConfigsToPb(result.Configs),
+		Result:  settings.SMSProvidersToPb(result.Configs),
 	}, nil
 }
 
 func (s *Server) GetSMSProvider(ctx context.Context, req *admin_pb.GetSMSProviderRequest) (*admin_pb.GetSMSProviderResponse, error) {
-	result, err := s.query.SMSProviderConfigByID(ctx, req.Id)
+	result, err := s.query.SMSProviderConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
 		return nil, err
 	}
 	return &admin_pb.GetSMSProviderResponse{
-		Config: SMSConfigToProviderPb(result),
+		Config: settings.SMSProviderToPb(result),
 	}, nil
 }
 
@@ -43,6 +43,7 @@ func EventToPb(event *query.Event) (response *eventpb.Event, err error) {
 			ResourceOwner: event.Aggregate.ResourceOwner,
 		},
 		Sequence:     event.Sequence,
+		Position:     event.Position,
 		CreationDate: timestamppb.New(event.CreationDate),
 		Payload:      payload,
 		Type:         EventTypeToPb(event.Type),
@@ -0,0 +1,123 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	group_pb "github.com/zitadel/zitadel/pkg/grpc/group"
+)
+
+func GroupsToPb(groups []*query.Group) []*group_pb.Group {
+	g := make([]*group_pb.Group, len(groups))
+	for i, group := range groups {
+		g[i] = GroupToPb(group)
+	}
+	return g
+}
+
+func GroupToPb(g *query.Group) *group_pb.Group {
+	return &group_pb.Group{
+		Id:          g.ID,
+		Details:     object.ToViewDetailsPb(g.Sequence, g.CreationDate, g.ChangeDate, g.ResourceOwner),
+		State:       groupStateToPb(g.State),
+		Name:        g.Name,
+		Description: g.Description,
+	}
+}
+
+func groupStateToPb(state domain.GroupState) group_pb.GroupState {
+	switch state {
+	case domain.GroupStateActive:
+		return group_pb.GroupState_GROUP_STATE_ACTIVE
+	case domain.GroupStateUnspecified, domain.GroupStateRemoved:
+		return group_pb.GroupState_GROUP_STATE_UNSPECIFIED
+	default:
+		return group_pb.GroupState_GROUP_STATE_UNSPECIFIED
+	}
+}
+
+func GroupMembersToPb(members []*query.GroupMember) []*group_pb.GroupMember {
+	m := make([]*group_pb.GroupMember, len(members))
+	for i, member := range members {
+		m[i] = &group_pb.GroupMember{
+			MemberId:   member.MemberID,
+			MemberType: groupMemberTypeToPb(member.MemberType),
+			Details:    object.ToViewDetailsPb(member.Sequence, member.CreationDate, member.CreationDate, ""),
+		}
+	}
+	return m
+}
+
+func groupMemberTypeToPb(memberType domain.GroupMemberType) group_pb.GroupMemberType {
+	switch memberType {
+	case domain.GroupMemberTypeUser:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_USER
+	case domain.GroupMemberTypeGroup:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_GROUP
+	case domain.GroupMemberTypeUnspecified:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_UNSPECIFIED
+	default:
+		return group_pb.GroupMemberType_GROUP_MEMBER_TYPE_UNSPECIFIED
+	}
+}
+
+func GroupGrantsToPb(grants []*query.GroupGrant) []*group_pb.GroupGrant {
+	g := make([]*group_pb.GroupGrant, len(grants))
+	for i, grant := range grants {
+		g[i] = &group_pb.GroupGrant{
+			Id:             grant.ID,
+			GroupId:        grant.GroupID,
+			Details:        object.ToViewDetailsPb(grant.Sequence, grant.CreationDate, grant.ChangeDate, grant.ResourceOwner),
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: grant.GrantID,
+			RoleKeys:       grant.Roles,
+		}
+	}
+	return g
+}
+
+func GroupMembershipsToPb(memberships []*query.GroupMembership) []*group_pb.GroupMembership {
+	m := make([]*group_pb.GroupMembership, len(memberships))
+	for i, membership := range memberships {
+		m[i] = &group_pb.GroupMembership{
+			GroupId: membership.GroupID,
+			Details: object.ToViewDetailsPb(membership.Sequence, membership.CreationDate, membership.ChangeDate, membership.ResourceOwner),
+			Roles:   membership.Roles,
+		}
+	}
+	return m
+}
+
+func GroupQueriesToModel(queries []*group_pb.GroupQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = GroupQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func GroupQueryToModel(apiQuery *group_pb.GroupQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *group_pb.GroupQuery_NameQuery:
+		return query.NewGroupNameSearchQuery(object.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GROUP-Gr0q1", "List.Query.Invalid")
+	}
+}
+
+func GroupFieldNameToSortingColumn(field group_pb.GroupFieldName) query.Column {
+	switch field {
+	case group_pb.GroupFieldName_GROUP_FIELD_NAME_NAME:
+		return query.GroupColumnName
+	case group_pb.GroupFieldName_GROUP_FIELD_NAME_CREATION_DATE:
+		return query.GroupColumnCreationDate
+	case group_pb.GroupFieldName_GROUP_FIELD_NAME_UNSPECIFIED:
+		return query.Column{}
+	default:
+		return query.Column{}
+	}
+}
@@ -313,6 +313,120 @@ func LDAPAttributesToCommand(attributes *idp_pb.LDAPAttributes) idp.LDAPAttribut
 	}
 }
 
+func AttributeMappingsToCommand(mappings []*idp_pb.AttributeMapping) []domain.IDPAttributeMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	result := make([]domain.IDPAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		result[i] = domain.IDPAttributeMapping{
+			Source:    mapping.Source,
+			Target:    attributeMappingTargetToCommand(mapping.Target),
+			Key:       mapping.Key,
+			Transform: attributeTransformToCommand(mapping.Transform),
+		}
+	}
+	return result
+}
+
+func attributeMappingTargetToCommand(target idp_pb.AttributeMappingTarget) domain.IDPAttributeMappingTarget {
+	switch target {
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_FIRST_NAME:
+		return domain.IDPAttributeMappingTargetFirstName
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_LAST_NAME:
+		return domain.IDPAttributeMappingTargetLastName
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_DISPLAY_NAME:
+		return domain.IDPAttributeMappingTargetDisplayName
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_NICK_NAME:
+		return domain.IDPAttributeMappingTargetNickName
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PREFERRED_USERNAME:
+		return domain.IDPAttributeMappingTargetPreferredUsername
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_EMAIL:
+		return domain.IDPAttributeMappingTargetEmail
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_EMAIL_VERIFIED:
+		return domain.IDPAttributeMappingTargetEmailVerified
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PHONE:
+		return domain.IDPAttributeMappingTargetPhone
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PHONE_VERIFIED:
+		return domain.IDPAttributeMappingTargetPhoneVerified
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PREFERRED_LANGUAGE:
+		return domain.IDPAttributeMappingTargetPreferredLanguage
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_METADATA:
+		return domain.IDPAttributeMappingTargetMetadata
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_SCHEMA_PROPERTY:
+		return domain.IDPAttributeMappingTargetSchemaProperty
+	case idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_UNSPECIFIED:
+		return domain.IDPAttributeMappingTargetUnspecified
+	default:
+		return domain.IDPAttributeMappingTargetUnspecified
+	}
+}
+
+func attributeTransformToCommand(transform idp_pb.AttributeTransform) domain.IDPAttributeTransform {
+	switch transform {
+	case idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_LOWERCASE:
+		return domain.IDPAttributeTransformLowercase
+	case idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_UPPERCASE:
+		return domain.IDPAttributeTransformUppercase
+	case idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_TRIM:
+		return domain.IDPAttributeTransformTrim
+	case idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_REMOVE_DOMAIN:
+		return domain.IDPAttributeTransformRemoveDomain
+	case idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_NONE:
+		return domain.IDPAttributeTransformNone
+	default:
+		return domain.IDPAttributeTransformNone
+	}
+}
+
+func LDAPSyncOptionsToCommand(options *idp_pb.LDAPSyncOptions) idp.LDAPSyncOptions {
+	if options == nil {
+		return idp.LDAPSyncOptions{}
+	}
+	return idp.LDAPSyncOptions{
+		SyncEnabled:  options.SyncEnabled,
+		SyncFilter:   options.SyncFilter,
+		SyncInterval: options.SyncInterval.AsDuration(),
+	}
+}
+
+func LDAPSyncReportToPb(report *domain.LDAPSyncReport) []*idp_pb.LDAPSyncUserResult {
+	results := make([]*idp_pb.LDAPSyncUserResult, len(report.Users))
+	for i, user := range report.Users {
+		results[i] = &idp_pb.LDAPSyncUserResult{
+			UserId:         user.UserID,
+			ExternalUserId: user.ExternalUserID,
+			Username:       user.Username,
+			Action:         ldapSyncActionToPb(user.Action),
+		}
+		if user.Error != nil {
+			results[i].Error = user.Error.Error()
+		}
+	}
+	return results
+}
+
+func ldapSyncActionToPb(action domain.LDAPSyncAction) idp_pb.LDAPSyncAction {
+	switch action {
+	case domain.LDAPSyncActionUnchanged:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_UNCHANGED
+	case domain.LDAPSyncActionCreated:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_CREATED
+	case domain.LDAPSyncActionUpdated:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_UPDATED
+	case domain.LDAPSyncActionDeactivated:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_DEACTIVATED
+	case domain.LDAPSyncActionReactivated:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_REACTIVATED
+	case domain.LDAPSyncActionFailed:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_FAILED
+	case domain.LDAPSyncActionUnspecified:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_UNSPECIFIED
+	default:
+		return idp_pb.LDAPSyncAction_LDAP_SYNC_ACTION_UNSPECIFIED
+	}
+}
+
 func AzureADTenantToCommand(tenant *idp_pb.AzureADTenant) string {
 	if tenant == nil {
 		return string(azuread.CommonTenant)
@@ -365,13 +479,91 @@ func ProvidersToPb(providers []*query.IDPTemplate) []*idp_pb.Provider {
 
 func ProviderToPb(provider *query.IDPTemplate) *idp_pb.Provider {
 	return &idp_pb.Provider{
-		Id:      provider.ID,
-		Details: obj_grpc.ToViewDetailsPb(provider.Sequence, provider.CreationDate, provider.ChangeDate, provider.ResourceOwner),
-		State:   providerStateToPb(provider.State),
-		Name:    provider.Name,
-		Owner:   ownerTypeToPB(provider.OwnerType),
-		Type:    providerTypeToPb(provider.Type),
-		Config:  configToPb(provider),
+		Id:                provider.ID,
+		Details:           obj_grpc.ToViewDetailsPb(provider.Sequence, provider.CreationDate, provider.ChangeDate, provider.ResourceOwner),
+		State:             providerStateToPb(provider.State),
+		Name:              provider.Name,
+		Owner:             ownerTypeToPB(provider.OwnerType),
+		Type:              providerTypeToPb(provider.Type),
+		Config:            configToPb(provider),
+		AttributeMappings: attributeMappingsToPb(provider.AttributeMappings),
+	}
+}
+
+func DiscoveryDomainsToPb(domains []*query.IDPDiscoveryDomain, instanceID string) []*idp_pb.DiscoveryDomain {
+	list := make([]*idp_pb.DiscoveryDomain, len(domains))
+	for i, discovery := range domains {
+		list[i] = &idp_pb.DiscoveryDomain{
+			Details:      obj_grpc.ToViewDetailsPb(discovery.Sequence, discovery.CreationDate, discovery.ChangeDate, instanceID),
+			Domain:       discovery.Domain,
+			IdpId:        discovery.IDPID,
+			IdpName:      discovery.IDPName,
+			IdpOwnerType: ownerTypeToPB(discovery.IDPOwnerType),
+		}
+	}
+	return list
+}
+
+func attributeMappingsToPb(mappings []domain.IDPAttributeMapping) []*idp_pb.AttributeMapping {
+	result := make([]*idp_pb.AttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		result[i] = &idp_pb.AttributeMapping{
+			Source:    mapping.Source,
+			Target:    attributeMappingTargetToPb(mapping.Target),
+			Key:       mapping.Key,
+			Transform: attributeTransformToPb(mapping.Transform),
+		}
+	}
+	return result
+}
+
+func attributeMappingTargetToPb(target domain.IDPAttributeMappingTarget) idp_pb.AttributeMappingTarget {
+	switch target {
+	case domain.IDPAttributeMappingTargetFirstName:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_FIRST_NAME
+	case domain.IDPAttributeMappingTargetLastName:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_LAST_NAME
+	case domain.IDPAttributeMappingTargetDisplayName:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_DISPLAY_NAME
+	case domain.IDPAttributeMappingTargetNickName:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_NICK_NAME
+	case domain.IDPAttributeMappingTargetPreferredUsername:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PREFERRED_USERNAME
+	case domain.IDPAttributeMappingTargetEmail:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_EMAIL
+	case domain.IDPAttributeMappingTargetEmailVerified:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_EMAIL_VERIFIED
+	case domain.IDPAttributeMappingTargetPhone:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PHONE
+	case domain.IDPAttributeMappingTargetPhoneVerified:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PHONE_VERIFIED
+	case domain.IDPAttributeMappingTargetPreferredLanguage:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_PREFERRED_LANGUAGE
+	case domain.IDPAttributeMappingTargetMetadata:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_METADATA
+	case domain.IDPAttributeMappingTargetSchemaProperty:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_SCHEMA_PROPERTY
+	case domain.IDPAttributeMappingTargetUnspecified:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_UNSPECIFIED
+	default:
+		return idp_pb.AttributeMappingTarget_ATTRIBUTE_MAPPING_TARGET_UNSPECIFIED
+	}
+}
+
+func attributeTransformToPb(transform domain.IDPAttributeTransform) idp_pb.AttributeTransform {
+	switch transform {
+	case domain.IDPAttributeTransformLowercase:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_LOWERCASE
+	case domain.IDPAttributeTransformUppercase:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_UPPERCASE
+	case domain.IDPAttributeTransformTrim:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_TRIM
+	case domain.IDPAttributeTransformRemoveDomain:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_REMOVE_DOMAIN
+	case domain.IDPAttributeTransformNone:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_NONE
+	default:
+		return idp_pb.AttributeTransform_ATTRIBUTE_TRANSFORM_NONE
 	}
 }
 
@@ -621,10 +813,23 @@ func ldapConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.LDAPI
 			UserFilters:       template.UserFilters,
 			Timeout:           timeout,
 			Attributes:        ldapAttributesToPb(template.LDAPAttributes),
+			SyncOptions:       ldapSyncOptionsToPb(template.LDAPSyncOptions),
 		},
 	}
 }
 
+func ldapSyncOptionsToPb(options idp.LDAPSyncOptions) *idp_pb.LDAPSyncOptions {
+	var interval *durationpb.Duration
+	if options.SyncInterval != 0 {
+		interval = durationpb.New(options.SyncInterval)
+	}
+	return &idp_pb.LDAPSyncOptions{
+		SyncEnabled:  options.SyncEnabled,
+		SyncFilter:   options.SyncFilter,
+		SyncInterval: interval,
+	}
+}
+
 func ldapAttributesToPb(attributes idp.LDAPAttributes) *idp_pb.LDAPAttributes {
 	return &idp_pb.LDAPAttributes{
 		IdAttribute:                attributes.IDAttribute,
@@ -0,0 +1,106 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/settings"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListOrgEmailProviders(ctx context.Context, req *mgmt_pb.ListOrgEmailProvidersRequest) (*mgmt_pb.ListOrgEmailProvidersResponse, error) {
+	queries, err := listOrgEmailProvidersToModel(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMTPConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgEmailProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  settings.EmailProvidersToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetOrgEmailProvider(ctx context.Context, _ *mgmt_pb.GetOrgEmailProviderRequest) (*mgmt_pb.GetOrgEmailProviderResponse, error) {
+	smtp, err := s.query.SMTPConfigActive(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgEmailProviderResponse{
+		Config: settings.EmailProviderToPb(smtp),
+	}, nil
+}
+
+func (s *Server) GetOrgEmailProviderById(ctx context.Context, req *mgmt_pb.GetOrgEmailProviderByIdRequest) (*mgmt_pb.GetOrgEmailProviderByIdResponse, error) {
+	smtp, err := s.query.SMTPConfigByID(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgEmailProviderByIdResponse{
+		Config: settings.EmailProviderToPb(smtp),
+	}, nil
+}
+
+func (s *Server) AddOrgEmailProviderSMTP(ctx context.Context, req *mgmt_pb.AddOrgEmailProviderSMTPRequest) (*mgmt_pb.AddOrgEmailProviderSMTPResponse, error) {
+	config := addOrgEmailProviderSMTPToConfig(ctx, req)
+	if err := s.command.AddOrgSMTPConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgEmailProviderSMTPResponse{
+		Details: object.DomainToAddDetailsPb(config.Details),
+		Id:      config.ID,
+	}, nil
+}
+
+func (s *Server) UpdateOrgEmailProviderSMTP(ctx context.Context, req *mgmt_pb.UpdateOrgEmailProviderSMTPRequest) (*mgmt_pb.UpdateOrgEmailProviderSMTPResponse, error) {
+	config := updateOrgEmailProviderSMTPToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMTPConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgEmailProviderSMTPResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+	}, nil
+}
+
+func (s *Server) UpdateOrgEmailProviderSMTPPassword(ctx context.Context, req *mgmt_pb.UpdateOrgEmailProviderSMTPPasswordRequest) (*mgmt_pb.UpdateOrgEmailProviderSMTPPasswordResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfigPassword(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgEmailProviderSMTPPasswordResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ActivateOrgEmailProvider(ctx context.Context, req *mgmt_pb.ActivateOrgEmailProviderRequest) (*mgmt_pb.ActivateOrgEmailProviderResponse, error) {
+	details, err := s.command.ActivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateOrgEmailProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateOrgEmailProvider(ctx context.Context, req *mgmt_pb.DeactivateOrgEmailProviderRequest) (*mgmt_pb.DeactivateOrgEmailProviderResponse, error) {
+	details, err := s.command.DeactivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateOrgEmailProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgEmailProvider(ctx context.Context, req *mgmt_pb.RemoveOrgEmailProviderRequest) (*mgmt_pb.RemoveOrgEmailProviderResponse, error) {
+	details, err := s.command.RemoveOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgEmailProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
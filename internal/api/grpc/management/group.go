@@ -0,0 +1,266 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListGroups(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*mgmt_pb.ListGroupsResponse, error) {
+	queries, err := listGroupsRequestToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.query.SearchGroups(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupsResponse{
+		Result:  group_grpc.GroupsToPb(groups.Groups),
+		Details: object_grpc.ToListDetails(groups.Count, groups.Sequence, groups.LastRun),
+	}, nil
+}
+
+func (s *Server) GetGroupByID(ctx context.Context, req *mgmt_pb.GetGroupByIDRequest) (*mgmt_pb.GetGroupByIDResponse, error) {
+	group, err := s.query.GroupByID(ctx, true, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetGroupByIDResponse{
+		Group: group_grpc.GroupToPb(group),
+	}, nil
+}
+
+func (s *Server) AddGroup(ctx context.Context, req *mgmt_pb.AddGroupRequest) (*mgmt_pb.AddGroupResponse, error) {
+	group, err := s.command.AddGroup(ctx, AddGroupRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupResponse{
+		Id:      group.AggregateID,
+		Details: object_grpc.AddToDetailsPb(group.Sequence, group.ChangeDate, group.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) (*mgmt_pb.UpdateGroupResponse, error) {
+	group, err := s.command.ChangeGroup(ctx, UpdateGroupRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupResponse{
+		Details: object_grpc.ChangeToDetailsPb(group.Sequence, group.ChangeDate, group.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveGroup(ctx context.Context, req *mgmt_pb.RemoveGroupRequest) (*mgmt_pb.RemoveGroupResponse, error) {
+	details, err := s.command.RemoveGroup(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupMembers(ctx context.Context, req *mgmt_pb.ListGroupMembersRequest) (*mgmt_pb.ListGroupMembersResponse, error) {
+	// ensure the group belongs to the organization
+	if _, err := s.query.GroupByID(ctx, true, req.GroupId, authz.GetCtxData(ctx).OrgID); err != nil {
+		return nil, err
+	}
+	queries, err := listGroupMembersRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.query.SearchGroupMembers(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupMembersResponse{
+		Result:  group_grpc.GroupMembersToPb(members.Members),
+		Details: object_grpc.ToListDetails(members.Count, members.Sequence, members.LastRun),
+	}, nil
+}
+
+func (s *Server) AddGroupUserMember(ctx context.Context, req *mgmt_pb.AddGroupUserMemberRequest) (*mgmt_pb.AddGroupUserMemberResponse, error) {
+	details, err := s.command.AddGroupMember(ctx, req.GroupId, authz.GetCtxData(ctx).OrgID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupUserMemberResponse{
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveGroupUserMember(ctx context.Context, req *mgmt_pb.RemoveGroupUserMemberRequest) (*mgmt_pb.RemoveGroupUserMemberResponse, error) {
+	details, err := s.command.RemoveGroupMember(ctx, req.GroupId, authz.GetCtxData(ctx).OrgID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupUserMemberResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddSubgroup(ctx context.Context, req *mgmt_pb.AddSubgroupRequest) (*mgmt_pb.AddSubgroupResponse, error) {
+	details, err := s.command.AddSubgroup(ctx, req.GroupId, authz.GetCtxData(ctx).OrgID, req.SubgroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSubgroupResponse{
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSubgroup(ctx context.Context, req *mgmt_pb.RemoveSubgroupRequest) (*mgmt_pb.RemoveSubgroupResponse, error) {
+	details, err := s.command.RemoveSubgroup(ctx, req.GroupId, authz.GetCtxData(ctx).OrgID, req.SubgroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveSubgroupResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListGroupGrants(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*mgmt_pb.ListGroupGrantsResponse, error) {
+	queries, err := listGroupGrantsRequestToModel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.query.SearchGroupGrants(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListGroupGrantsResponse{
+		Result:  group_grpc.GroupGrantsToPb(grants.Grants),
+		Details: object_grpc.ToListDetails(grants.Count, grants.Sequence, grants.LastRun),
+	}, nil
+}
+
+func (s *Server) AddGroupGrant(ctx context.Context, req *mgmt_pb.AddGroupGrantRequest) (*mgmt_pb.AddGroupGrantResponse, error) {
+	grant, err := s.command.AddGroupGrant(ctx, AddGroupGrantRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddGroupGrantResponse{
+		GrantId: grant.GrantID,
+		Details: object_grpc.AddToDetailsPb(grant.Sequence, grant.ChangeDate, grant.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateGroupGrant(ctx context.Context, req *mgmt_pb.UpdateGroupGrantRequest) (*mgmt_pb.UpdateGroupGrantResponse, error) {
+	grant, err := s.command.ChangeGroupGrant(ctx, UpdateGroupGrantRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateGroupGrantResponse{
+		Details: object_grpc.ChangeToDetailsPb(grant.Sequence, grant.ChangeDate, grant.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveGroupGrant(ctx context.Context, req *mgmt_pb.RemoveGroupGrantRequest) (*mgmt_pb.RemoveGroupGrantResponse, error) {
+	details, err := s.command.RemoveGroupGrant(ctx, req.GroupId, req.GrantId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveGroupGrantResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListOrgGroupMembers(ctx context.Context, req *mgmt_pb.ListOrgGroupMembersRequest) (*mgmt_pb.ListOrgGroupMembersResponse, error) {
+	orgQuery, err := query.NewGroupMembershipOrgIDSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.query.SearchGroupMemberships(ctx, listGroupMembershipsToModel(req.Query, orgQuery))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgGroupMembersResponse{
+		Result:  group_grpc.GroupMembershipsToPb(memberships.Memberships),
+		Details: object_grpc.ToListDetails(memberships.Count, memberships.Sequence, memberships.LastRun),
+	}, nil
+}
+
+func (s *Server) AddOrgGroupMember(ctx context.Context, req *mgmt_pb.AddOrgGroupMemberRequest) (*mgmt_pb.AddOrgGroupMemberResponse, error) {
+	member, err := s.command.AddOrgGroupMember(ctx, AddOrgGroupMemberRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgGroupMemberResponse{
+		Details: object_grpc.AddToDetailsPb(member.Sequence, member.ChangeDate, member.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateOrgGroupMember(ctx context.Context, req *mgmt_pb.UpdateOrgGroupMemberRequest) (*mgmt_pb.UpdateOrgGroupMemberResponse, error) {
+	member, err := s.command.ChangeOrgGroupMember(ctx, UpdateOrgGroupMemberRequestToDomain(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgGroupMemberResponse{
+		Details: object_grpc.ChangeToDetailsPb(member.Sequence, member.ChangeDate, member.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveOrgGroupMember(ctx context.Context, req *mgmt_pb.RemoveOrgGroupMemberRequest) (*mgmt_pb.RemoveOrgGroupMemberResponse, error) {
+	details, err := s.command.RemoveOrgGroupMember(ctx, authz.GetCtxData(ctx).OrgID, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgGroupMemberResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListProjectGroupMembers(ctx context.Context, req *mgmt_pb.ListProjectGroupMembersRequest) (*mgmt_pb.ListProjectGroupMembersResponse, error) {
+	projectQuery, err := query.NewGroupMembershipProjectIDSearchQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupMembershipResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.query.SearchGroupMemberships(ctx, listGroupMembershipsToModel(req.Query, projectQuery, ownerQuery))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectGroupMembersResponse{
+		Result:  group_grpc.GroupMembershipsToPb(memberships.Memberships),
+		Details: object_grpc.ToListDetails(memberships.Count, memberships.Sequence, memberships.LastRun),
+	}, nil
+}
+
+func (s *Server) AddProjectGroupMember(ctx context.Context, req *mgmt_pb.AddProjectGroupMemberRequest) (*mgmt_pb.AddProjectGroupMemberResponse, error) {
+	member, err := s.command.AddProjectGroupMember(ctx, AddProjectGroupMemberRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectGroupMemberResponse{
+		Details: object_grpc.AddToDetailsPb(member.Sequence, member.ChangeDate, member.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateProjectGroupMember(ctx context.Context, req *mgmt_pb.UpdateProjectGroupMemberRequest) (*mgmt_pb.UpdateProjectGroupMemberResponse, error) {
+	member, err := s.command.ChangeProjectGroupMember(ctx, UpdateProjectGroupMemberRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectGroupMemberResponse{
+		Details: object_grpc.ChangeToDetailsPb(member.Sequence, member.ChangeDate, member.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveProjectGroupMember(ctx context.Context, req *mgmt_pb.RemoveProjectGroupMemberRequest) (*mgmt_pb.RemoveProjectGroupMemberResponse, error) {
+	details, err := s.command.RemoveProjectGroupMember(ctx, req.ProjectId, req.GroupId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectGroupMemberResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
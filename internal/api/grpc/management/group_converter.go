@@ -0,0 +1,140 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	group_grpc "github.com/zitadel/zitadel/internal/api/grpc/group"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object"
+)
+
+func listGroupsRequestToModel(ctx context.Context, req *mgmt_pb.ListGroupsRequest) (*query.GroupSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := group_grpc.GroupQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: group_grpc.GroupFieldNameToSortingColumn(req.SortingColumn),
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func listGroupMembersRequestToModel(req *mgmt_pb.ListGroupMembersRequest) (*query.GroupMemberSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupMemberGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupMemberSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery},
+	}, nil
+}
+
+func listGroupGrantsRequestToModel(ctx context.Context, req *mgmt_pb.ListGroupGrantsRequest) (*query.GroupGrantSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	groupQuery, err := query.NewGroupGrantGroupIDSearchQuery(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupGrantResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupGrantSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{groupQuery, ownerQuery},
+	}, nil
+}
+
+func listGroupMembershipsToModel(listQuery *object_pb.ListQuery, queries ...query.SearchQuery) *query.GroupMembershipSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(listQuery)
+	return &query.GroupMembershipSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}
+}
+
+func AddGroupRequestToDomain(req *mgmt_pb.AddGroupRequest) *domain.Group {
+	return &domain.Group{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func UpdateGroupRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateGroupRequest) *domain.Group {
+	return &domain.Group{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.Id,
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+func AddGroupGrantRequestToDomain(ctx context.Context, req *mgmt_pb.AddGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.GroupId,
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		ProjectID:      req.ProjectId,
+		ProjectGrantID: req.ProjectGrantId,
+		RoleKeys:       req.RoleKeys,
+	}
+}
+
+func UpdateGroupGrantRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateGroupGrantRequest) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.GroupId,
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		GrantID:  req.GrantId,
+		RoleKeys: req.RoleKeys,
+	}
+}
+
+func AddOrgGroupMemberRequestToDomain(ctx context.Context, req *mgmt_pb.AddOrgGroupMemberRequest) *domain.GroupMember {
+	return domain.NewGroupMember(authz.GetCtxData(ctx).OrgID, req.GroupId, req.Roles...)
+}
+
+func UpdateOrgGroupMemberRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateOrgGroupMemberRequest) *domain.GroupMember {
+	return domain.NewGroupMember(authz.GetCtxData(ctx).OrgID, req.GroupId, req.Roles...)
+}
+
+func AddProjectGroupMemberRequestToDomain(req *mgmt_pb.AddProjectGroupMemberRequest) *domain.GroupMember {
+	return domain.NewGroupMember(req.ProjectId, req.GroupId, req.Roles...)
+}
+
+func UpdateProjectGroupMemberRequestToDomain(req *mgmt_pb.UpdateProjectGroupMemberRequest) *domain.GroupMember {
+	return domain.NewGroupMember(req.ProjectId, req.GroupId, req.Roles...)
+}
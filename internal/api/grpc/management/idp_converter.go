@@ -198,7 +198,7 @@ func providerQueryToQuery(idpQuery *mgmt_pb.ProviderQuery) (query.SearchQuery, e
 	}
 }
 
-func addGenericOAuthProviderToCommand(req *mgmt_pb.AddGenericOAuthProviderRequest) command.GenericOAuthProvider {
+func AddGenericOAuthProviderToCommand(req *mgmt_pb.AddGenericOAuthProviderRequest) command.GenericOAuthProvider {
 	return command.GenericOAuthProvider{
 		Name:                  req.Name,
 		ClientID:              req.ClientId,
@@ -226,7 +226,7 @@ func updateGenericOAuthProviderToCommand(req *mgmt_pb.UpdateGenericOAuthProvider
 	}
 }
 
-func addGenericOIDCProviderToCommand(req *mgmt_pb.AddGenericOIDCProviderRequest) command.GenericOIDCProvider {
+func AddGenericOIDCProviderToCommand(req *mgmt_pb.AddGenericOIDCProviderRequest) command.GenericOIDCProvider {
 	return command.GenericOIDCProvider{
 		Name:             req.Name,
 		Issuer:           req.Issuer,
@@ -250,7 +250,7 @@ func updateGenericOIDCProviderToCommand(req *mgmt_pb.UpdateGenericOIDCProviderRe
 	}
 }
 
-func addJWTProviderToCommand(req *mgmt_pb.AddJWTProviderRequest) command.JWTProvider {
+func AddJWTProviderToCommand(req *mgmt_pb.AddJWTProviderRequest) command.JWTProvider {
 	return command.JWTProvider{
 		Name:        req.Name,
 		Issuer:      req.Issuer,
@@ -272,7 +272,7 @@ func updateJWTProviderToCommand(req *mgmt_pb.UpdateJWTProviderRequest) command.J
 	}
 }
 
-func addAzureADProviderToCommand(req *mgmt_pb.AddAzureADProviderRequest) command.AzureADProvider {
+func AddAzureADProviderToCommand(req *mgmt_pb.AddAzureADProviderRequest) command.AzureADProvider {
 	return command.AzureADProvider{
 		Name:          req.Name,
 		ClientID:      req.ClientId,
@@ -296,7 +296,7 @@ func updateAzureADProviderToCommand(req *mgmt_pb.UpdateAzureADProviderRequest) c
 	}
 }
 
-func addGitHubProviderToCommand(req *mgmt_pb.AddGitHubProviderRequest) command.GitHubProvider {
+func AddGitHubProviderToCommand(req *mgmt_pb.AddGitHubProviderRequest) command.GitHubProvider {
 	return command.GitHubProvider{
 		Name:         req.Name,
 		ClientID:     req.ClientId,
@@ -316,7 +316,7 @@ func updateGitHubProviderToCommand(req *mgmt_pb.UpdateGitHubProviderRequest) com
 	}
 }
 
-func addGitHubEnterpriseProviderToCommand(req *mgmt_pb.AddGitHubEnterpriseServerProviderRequest) command.GitHubEnterpriseProvider {
+func AddGitHubEnterpriseProviderToCommand(req *mgmt_pb.AddGitHubEnterpriseServerProviderRequest) command.GitHubEnterpriseProvider {
 	return command.GitHubEnterpriseProvider{
 		Name:                  req.Name,
 		ClientID:              req.ClientId,
@@ -342,7 +342,7 @@ func updateGitHubEnterpriseProviderToCommand(req *mgmt_pb.UpdateGitHubEnterprise
 	}
 }
 
-func addGitLabProviderToCommand(req *mgmt_pb.AddGitLabProviderRequest) command.GitLabProvider {
+func AddGitLabProviderToCommand(req *mgmt_pb.AddGitLabProviderRequest) command.GitLabProvider {
 	return command.GitLabProvider{
 		Name:         req.Name,
 		ClientID:     req.ClientId,
@@ -362,7 +362,7 @@ func updateGitLabProviderToCommand(req *mgmt_pb.UpdateGitLabProviderRequest) com
 	}
 }
 
-func addGitLabSelfHostedProviderToCommand(req *mgmt_pb.AddGitLabSelfHostedProviderRequest) command.GitLabSelfHostedProvider {
+func AddGitLabSelfHostedProviderToCommand(req *mgmt_pb.AddGitLabSelfHostedProviderRequest) command.GitLabSelfHostedProvider {
 	return command.GitLabSelfHostedProvider{
 		Name:         req.Name,
 		Issuer:       req.Issuer,
@@ -384,7 +384,7 @@ func updateGitLabSelfHostedProviderToCommand(req *mgmt_pb.UpdateGitLabSelfHosted
 	}
 }
 
-func addGoogleProviderToCommand(req *mgmt_pb.AddGoogleProviderRequest) command.GoogleProvider {
+func AddGoogleProviderToCommand(req *mgmt_pb.AddGoogleProviderRequest) command.GoogleProvider {
 	return command.GoogleProvider{
 		Name:         req.Name,
 		ClientID:     req.ClientId,
@@ -404,7 +404,7 @@ func updateGoogleProviderToCommand(req *mgmt_pb.UpdateGoogleProviderRequest) com
 	}
 }
 
-func addLDAPProviderToCommand(req *mgmt_pb.AddLDAPProviderRequest) command.LDAPProvider {
+func AddLDAPProviderToCommand(req *mgmt_pb.AddLDAPProviderRequest) command.LDAPProvider {
 	return command.LDAPProvider{
 		Name:              req.Name,
 		Servers:           req.Servers,
@@ -417,6 +417,7 @@ func addLDAPProviderToCommand(req *mgmt_pb.AddLDAPProviderRequest) command.LDAPP
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		SyncOptions:       idp_grpc.LDAPSyncOptionsToCommand(req.SyncOptions),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -434,11 +435,12 @@ func updateLDAPProviderToCommand(req *mgmt_pb.UpdateLDAPProviderRequest) command
 		UserFilters:       req.UserFilters,
 		Timeout:           req.Timeout.AsDuration(),
 		LDAPAttributes:    idp_grpc.LDAPAttributesToCommand(req.Attributes),
+		SyncOptions:       idp_grpc.LDAPSyncOptionsToCommand(req.SyncOptions),
 		IDPOptions:        idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
 
-func addAppleProviderToCommand(req *mgmt_pb.AddAppleProviderRequest) command.AppleProvider {
+func AddAppleProviderToCommand(req *mgmt_pb.AddAppleProviderRequest) command.AppleProvider {
 	return command.AppleProvider{
 		Name:       req.Name,
 		ClientID:   req.ClientId,
@@ -462,7 +464,7 @@ func updateAppleProviderToCommand(req *mgmt_pb.UpdateAppleProviderRequest) comma
 	}
 }
 
-func addSAMLProviderToCommand(req *mgmt_pb.AddSAMLProviderRequest) command.SAMLProvider {
+func AddSAMLProviderToCommand(req *mgmt_pb.AddSAMLProviderRequest) command.SAMLProvider {
 	var nameIDFormat *domain.SAMLNameIDFormat
 	if req.NameIdFormat != nil {
 		nameIDFormat = gu.Ptr(idp_grpc.SAMLNameIDFormatToDomain(req.GetNameIdFormat()))
@@ -0,0 +1,83 @@
+package management
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetCustomMessageTemplate(ctx context.Context, req *mgmt_pb.GetCustomMessageTemplateRequest) (*mgmt_pb.GetCustomMessageTemplateResponse, error) {
+	template, err := s.query.CustomMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetCustomMessageTemplateResponse{
+		Template: text_grpc.MessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) GetDefaultMessageTemplate(ctx context.Context, req *mgmt_pb.GetDefaultMessageTemplateRequest) (*mgmt_pb.GetDefaultMessageTemplateResponse, error) {
+	template, err := s.query.CustomMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetDefaultMessageTemplateResponse{
+		Template: text_grpc.MessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetCustomMessageTemplate(ctx context.Context, req *mgmt_pb.SetCustomMessageTemplateRequest) (*mgmt_pb.SetCustomMessageTemplateResponse, error) {
+	result, err := s.command.SetOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, SetCustomMessageTemplateToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomMessageTemplateResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMessageTemplateToDefault(ctx context.Context, req *mgmt_pb.ResetCustomMessageTemplateToDefaultRequest) (*mgmt_pb.ResetCustomMessageTemplateToDefaultResponse, error) {
+	result, err := s.command.RemoveOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetCustomMessageTemplateToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) PreviewMessageTemplate(ctx context.Context, req *mgmt_pb.PreviewMessageTemplateRequest) (*mgmt_pb.PreviewMessageTemplateResponse, error) {
+	subject, html, plainText, err := types.PreviewEmail(ctx, s.query, s.staticStorage, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language), req.Html, req.PlainText)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.PreviewMessageTemplateResponse{
+		Subject:   subject,
+		Html:      html,
+		PlainText: plainText,
+	}, nil
+}
+
+func SetCustomMessageTemplateToDomain(req *mgmt_pb.SetCustomMessageTemplateRequest) *domain.MessageTemplate {
+	return &domain.MessageTemplate{
+		MessageType: req.MessageType,
+		Language:    language.Make(req.Language),
+		HTML:        req.Html,
+		PlainText:   req.PlainText,
+	}
+}
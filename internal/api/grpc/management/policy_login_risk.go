@@ -0,0 +1,81 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetLoginRiskPolicy(ctx context.Context, _ *mgmt_pb.GetLoginRiskPolicyRequest) (*mgmt_pb.GetLoginRiskPolicyResponse, error) {
+	policy, err := s.query.LoginRiskPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetLoginRiskPolicyResponse{Policy: policy_grpc.ModelLoginRiskPolicyToPb(policy)}, nil
+}
+
+func (s *Server) GetDefaultLoginRiskPolicy(ctx context.Context, _ *mgmt_pb.GetDefaultLoginRiskPolicyRequest) (*mgmt_pb.GetDefaultLoginRiskPolicyResponse, error) {
+	policy, err := s.query.DefaultLoginRiskPolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetDefaultLoginRiskPolicyResponse{Policy: policy_grpc.ModelLoginRiskPolicyToPb(policy)}, nil
+}
+
+func (s *Server) AddCustomLoginRiskPolicy(ctx context.Context, req *mgmt_pb.AddCustomLoginRiskPolicyRequest) (*mgmt_pb.AddCustomLoginRiskPolicyResponse, error) {
+	policy, err := s.command.AddLoginRiskPolicy(ctx, authz.GetCtxData(ctx).OrgID, addLoginRiskPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddCustomLoginRiskPolicyResponse{
+		Details: object.AddToDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateCustomLoginRiskPolicy(ctx context.Context, req *mgmt_pb.UpdateCustomLoginRiskPolicyRequest) (*mgmt_pb.UpdateCustomLoginRiskPolicyResponse, error) {
+	policy, err := s.command.ChangeLoginRiskPolicy(ctx, authz.GetCtxData(ctx).OrgID, updateLoginRiskPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateCustomLoginRiskPolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			policy.Sequence,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetLoginRiskPolicyToDefault(ctx context.Context, _ *mgmt_pb.ResetLoginRiskPolicyToDefaultRequest) (*mgmt_pb.ResetLoginRiskPolicyToDefaultResponse, error) {
+	objectDetails, err := s.command.RemoveLoginRiskPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetLoginRiskPolicyToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func addLoginRiskPolicyToDomain(req *mgmt_pb.AddCustomLoginRiskPolicyRequest) *domain.LoginRiskPolicy {
+	return &domain.LoginRiskPolicy{
+		NewDevice:        policy_grpc.LoginRiskActionToDomain(req.GetNewDevice()),
+		NewNetwork:       policy_grpc.LoginRiskActionToDomain(req.GetNewNetwork()),
+		ImpossibleTravel: policy_grpc.LoginRiskActionToDomain(req.GetImpossibleTravel()),
+	}
+}
+
+func updateLoginRiskPolicyToDomain(req *mgmt_pb.UpdateCustomLoginRiskPolicyRequest) *domain.LoginRiskPolicy {
+	return &domain.LoginRiskPolicy{
+		NewDevice:        policy_grpc.LoginRiskActionToDomain(req.GetNewDevice()),
+		NewNetwork:       policy_grpc.LoginRiskActionToDomain(req.GetNewNetwork()),
+		ImpossibleTravel: policy_grpc.LoginRiskActionToDomain(req.GetImpossibleTravel()),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/pkg/grpc/management"
 )
 
@@ -25,6 +26,7 @@ type Server struct {
 	management.UnimplementedManagementServiceServer
 	command        *command.Commands
 	query          *query.Queries
+	staticStorage  static.Storage
 	systemDefaults systemdefaults.SystemDefaults
 	assetAPIPrefix func(context.Context) string
 	userCodeAlg    crypto.EncryptionAlgorithm
@@ -33,12 +35,14 @@ type Server struct {
 func CreateServer(
 	command *command.Commands,
 	query *query.Queries,
+	staticStorage static.Storage,
 	sd systemdefaults.SystemDefaults,
 	userCodeAlg crypto.EncryptionAlgorithm,
 ) *Server {
 	return &Server{
 		command:        command,
 		query:          query,
+		staticStorage:  staticStorage,
 		systemDefaults: sd,
 		assetAPIPrefix: assets.AssetAPI(),
 		userCodeAlg:    userCodeAlg,
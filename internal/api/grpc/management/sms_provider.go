@@ -0,0 +1,96 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/settings"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListOrgSMSProviders(ctx context.Context, req *mgmt_pb.ListOrgSMSProvidersRequest) (*mgmt_pb.ListOrgSMSProvidersResponse, error) {
+	queries, err := listOrgSMSProvidersToModel(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMSConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgSMSProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  settings.SMSProvidersToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetOrgSMSProvider(ctx context.Context, req *mgmt_pb.GetOrgSMSProviderRequest) (*mgmt_pb.GetOrgSMSProviderResponse, error) {
+	result, err := s.query.SMSProviderConfigByID(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgSMSProviderResponse{
+		Config: settings.SMSProviderToPb(result),
+	}, nil
+}
+
+func (s *Server) AddOrgSMSProviderTwilio(ctx context.Context, req *mgmt_pb.AddOrgSMSProviderTwilioRequest) (*mgmt_pb.AddOrgSMSProviderTwilioResponse, error) {
+	smsConfig := addOrgSMSProviderTwilioToConfig(ctx, req)
+	if err := s.command.AddOrgSMSConfigTwilio(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSMSProviderTwilioResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMSProviderTwilio(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderTwilioRequest) (*mgmt_pb.UpdateOrgSMSProviderTwilioResponse, error) {
+	smsConfig := updateOrgSMSProviderTwilioToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMSConfigTwilio(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMSProviderTwilioResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateOrgSMSProviderTwilioToken(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderTwilioTokenRequest) (*mgmt_pb.UpdateOrgSMSProviderTwilioTokenResponse, error) {
+	details, err := s.command.ChangeOrgSMSConfigTwilioToken(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgSMSProviderTwilioTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ActivateOrgSMSProvider(ctx context.Context, req *mgmt_pb.ActivateOrgSMSProviderRequest) (*mgmt_pb.ActivateOrgSMSProviderResponse, error) {
+	details, err := s.command.ActivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateOrgSMSProvider(ctx context.Context, req *mgmt_pb.DeactivateOrgSMSProviderRequest) (*mgmt_pb.DeactivateOrgSMSProviderResponse, error) {
+	details, err := s.command.DeactivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgSMSProvider(ctx context.Context, req *mgmt_pb.RemoveOrgSMSProviderRequest) (*mgmt_pb.RemoveOrgSMSProviderResponse, error) {
+	details, err := s.command.RemoveOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
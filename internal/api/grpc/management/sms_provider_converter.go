@@ -0,0 +1,49 @@
+package management
+
+import (
+	"context"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func listOrgSMSProvidersToModel(orgID string, req *mgmt_pb.ListOrgSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMSProviderResourceOwnerQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SMSConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	}, nil
+}
+
+func addOrgSMSProviderTwilioToConfig(ctx context.Context, req *mgmt_pb.AddOrgSMSProviderTwilioRequest) *command.AddTwilioConfig {
+	return &command.AddTwilioConfig{
+		ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		Description:   req.Description,
+		SID:           req.Sid,
+		SenderNumber:  req.SenderNumber,
+		Token:         req.Token,
+	}
+}
+
+func updateOrgSMSProviderTwilioToConfig(ctx context.Context, req *mgmt_pb.UpdateOrgSMSProviderTwilioRequest) *command.ChangeTwilioConfig {
+	return &command.ChangeTwilioConfig{
+		ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		SID:           gu.Ptr(req.Sid),
+		SenderNumber:  gu.Ptr(req.SenderNumber),
+	}
+}
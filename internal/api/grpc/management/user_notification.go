@@ -0,0 +1,79 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	obj_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListUserNotifications(ctx context.Context, req *mgmt_pb.ListUserNotificationsRequest) (*mgmt_pb.ListUserNotificationsResponse, error) {
+	queries, err := ListUserNotificationsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchNotificationLogs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserNotificationsResponse{
+		Result:  user_grpc.NotificationsToPb(res.Notifications),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) ResendUserNotification(ctx context.Context, req *mgmt_pb.ResendUserNotificationRequest) (*mgmt_pb.ResendUserNotificationResponse, error) {
+	details, err := s.command.ResendNotification(ctx, req.UserId, req.NotificationId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResendUserNotificationResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) SendCustomUserNotification(ctx context.Context, req *mgmt_pb.SendCustomUserNotificationRequest) (*mgmt_pb.SendCustomUserNotificationResponse, error) {
+	details, err := s.command.SendCustomNotification(ctx, &command.CustomNotification{
+		UserID:        req.UserId,
+		ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		MessageType:   req.Type,
+		Channel:       user_grpc.NotificationChannelToDomain(req.Channel),
+		Args:          req.Args,
+		URL:           req.Url,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SendCustomUserNotificationResponse{
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func ListUserNotificationsRequestToQuery(ctx context.Context, req *mgmt_pb.ListUserNotificationsRequest) (*query.NotificationLogSearchQueries, error) {
+	offset, limit, asc := obj_grpc.ListQueryToModel(req.Query)
+	queries, err := user_grpc.NotificationQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewNotificationLogUserIDSearchQuery(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewNotificationLogResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationLogSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.NotificationLogColumnChangeDate,
+		},
+		Queries: append(queries, userIDQuery, ownerQuery),
+	}, nil
+}
@@ -39,6 +39,7 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 		UiLocales:    a.UiLocales,
 		LoginHint:    a.LoginHint,
 		HintUserId:   a.HintUserID,
+		AcrValues:    levelsOfAssuranceToACRValues(a.PossibleLOAs),
 	}
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
@@ -46,6 +47,19 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	return pba
 }
 
+func levelsOfAssuranceToACRValues(levels []domain.LevelOfAssurance) []string {
+	if len(levels) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(levels))
+	for _, level := range levels {
+		if acr := oidc.LevelOfAssuranceToACR(level); acr != "" {
+			values = append(values, acr)
+		}
+	}
+	return values
+}
+
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
 	out := make([]oidc_pb.Prompt, len(promps))
 	for i, p := range promps {
@@ -36,6 +36,10 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     gu.Ptr(time.Minute),
 		HintUserID: gu.Ptr("userID"),
+		PossibleLOAs: []domain.LevelOfAssurance{
+			domain.LevelOfAssuranceMultiFactor,
+			domain.LevelOfAssurancePhishingResistant,
+		},
 	}
 	want := &oidc_pb.AuthRequest{
 		Id:           "authID",
@@ -56,6 +60,7 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     durationpb.New(time.Minute),
 		HintUserId: gu.Ptr("userID"),
+		AcrValues:  []string{"urn:zitadel:iam:loa:mfa", "urn:zitadel:iam:loa:phr"},
 	}
 	got := authRequestToPb(arg)
 	if !proto.Equal(want, got) {
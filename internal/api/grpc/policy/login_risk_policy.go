@@ -0,0 +1,53 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelLoginRiskPolicyToPb(policy *query.LoginRiskPolicy) *policy_pb.LoginRiskPolicy {
+	return &policy_pb.LoginRiskPolicy{
+		IsDefault:        policy.IsDefault,
+		NewDevice:        LoginRiskActionToPb(policy.NewDevice),
+		NewNetwork:       LoginRiskActionToPb(policy.NewNetwork),
+		ImpossibleTravel: LoginRiskActionToPb(policy.ImpossibleTravel),
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func LoginRiskActionToPb(action domain.LoginRiskAction) policy_pb.LoginRiskAction {
+	switch action {
+	case domain.LoginRiskActionNotify:
+		return policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_NOTIFY
+	case domain.LoginRiskActionRequireMFA:
+		return policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_REQUIRE_MFA
+	case domain.LoginRiskActionBlock:
+		return policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_BLOCK
+	case domain.LoginRiskActionNone:
+		fallthrough
+	default:
+		return policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_NONE
+	}
+}
+
+func LoginRiskActionToDomain(action policy_pb.LoginRiskAction) domain.LoginRiskAction {
+	switch action {
+	case policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_NOTIFY:
+		return domain.LoginRiskActionNotify
+	case policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_REQUIRE_MFA:
+		return domain.LoginRiskActionRequireMFA
+	case policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_BLOCK:
+		return domain.LoginRiskActionBlock
+	case policy_pb.LoginRiskAction_LOGIN_RISK_ACTION_NONE:
+		fallthrough
+	default:
+		return domain.LoginRiskActionNone
+	}
+}
@@ -90,23 +90,28 @@ func targetsToPb(targets []*query.Target) []*action.GetTarget {
 }
 
 func targetToPb(t *query.Target) *action.GetTarget {
-	target := &action.GetTarget{
+	return &action.GetTarget{
 		Details: resource_object.DomainToDetailsPb(&t.ObjectDetails, object.OwnerType_OWNER_TYPE_INSTANCE, t.ResourceOwner),
-		Config: &action.Target{
-			Name:     t.Name,
-			Timeout:  durationpb.New(t.Timeout),
-			Endpoint: t.Endpoint,
-		},
+		Config:  TargetToPb(t),
+	}
+}
+
+// TargetToPb converts the configuration of a target, it is also used by the export of the admin API
+func TargetToPb(t *query.Target) *action.Target {
+	target := &action.Target{
+		Name:     t.Name,
+		Timeout:  durationpb.New(t.Timeout),
+		Endpoint: t.Endpoint,
 	}
 	switch t.TargetType {
 	case domain.TargetTypeWebhook:
-		target.Config.TargetType = &action.Target_RestWebhook{RestWebhook: &action.SetRESTWebhook{InterruptOnError: t.InterruptOnError}}
+		target.TargetType = &action.Target_RestWebhook{RestWebhook: &action.SetRESTWebhook{InterruptOnError: t.InterruptOnError}}
 	case domain.TargetTypeCall:
-		target.Config.TargetType = &action.Target_RestCall{RestCall: &action.SetRESTCall{InterruptOnError: t.InterruptOnError}}
+		target.TargetType = &action.Target_RestCall{RestCall: &action.SetRESTCall{InterruptOnError: t.InterruptOnError}}
 	case domain.TargetTypeAsync:
-		target.Config.TargetType = &action.Target_RestAsync{RestAsync: &action.SetRESTAsync{}}
+		target.TargetType = &action.Target_RestAsync{RestAsync: &action.SetRESTAsync{}}
 	default:
-		target.Config.TargetType = nil
+		target.TargetType = nil
 	}
 	return target
 }
@@ -58,7 +58,11 @@ func (s *Server) DeleteTarget(ctx context.Context, req *action.DeleteTargetReque
 }
 
 func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
-	reqTarget := req.GetTarget()
+	return TargetToCommand(req.GetTarget())
+}
+
+// TargetToCommand converts the configuration of a target, it is also used by the import of the admin API
+func TargetToCommand(reqTarget *action.Target) *command.AddTarget {
 	var (
 		targetType       domain.TargetType
 		interruptOnError bool
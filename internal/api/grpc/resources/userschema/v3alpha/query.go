@@ -43,7 +43,7 @@ func (s *Server) GetUserSchema(ctx context.Context, req *schema.GetUserSchemaReq
 	if err != nil {
 		return nil, err
 	}
-	userSchema, err := userSchemaToPb(res)
+	userSchema, err := UserSchemaToPb(res)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +97,7 @@ func userSchemaFieldNameToSortingColumn(field *schema.FieldName) query.Column {
 func userSchemasToPb(schemas []*query.UserSchema) (_ []*schema.GetUserSchema, err error) {
 	userSchemas := make([]*schema.GetUserSchema, len(schemas))
 	for i, userSchema := range schemas {
-		userSchemas[i], err = userSchemaToPb(userSchema)
+		userSchemas[i], err = UserSchemaToPb(userSchema)
 		if err != nil {
 			return nil, err
 		}
@@ -105,7 +105,8 @@ func userSchemasToPb(schemas []*query.UserSchema) (_ []*schema.GetUserSchema, er
 	return userSchemas, nil
 }
 
-func userSchemaToPb(userSchema *query.UserSchema) (*schema.GetUserSchema, error) {
+// UserSchemaToPb converts the user schema, it is also used by the export of the admin API
+func UserSchemaToPb(userSchema *query.UserSchema) (*schema.GetUserSchema, error) {
 	s := new(structpb.Struct)
 	if err := s.UnmarshalJSON(userSchema.Schema); err != nil {
 		return nil, err
@@ -91,15 +91,20 @@ func (s *Server) DeleteUserSchema(ctx context.Context, req *schema.DeleteUserSch
 }
 
 func createUserSchemaToCommand(req *schema.CreateUserSchemaRequest, resourceOwner string) (*command.CreateUserSchema, error) {
-	schema, err := req.GetUserSchema().GetSchema().MarshalJSON()
+	return UserSchemaToCommand(req.GetUserSchema(), resourceOwner)
+}
+
+// UserSchemaToCommand converts the configuration of a user schema, it is also used by the import of the admin API
+func UserSchemaToCommand(userSchema *schema.UserSchema, resourceOwner string) (*command.CreateUserSchema, error) {
+	schema, err := userSchema.GetSchema().MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
 	return &command.CreateUserSchema{
 		ResourceOwner:          resourceOwner,
-		Type:                   req.GetUserSchema().GetType(),
+		Type:                   userSchema.GetType(),
 		Schema:                 schema,
-		PossibleAuthenticators: authenticatorsToDomain(req.GetUserSchema().GetPossibleAuthenticators()),
+		PossibleAuthenticators: authenticatorsToDomain(userSchema.GetPossibleAuthenticators()),
 	}, nil
 }
 
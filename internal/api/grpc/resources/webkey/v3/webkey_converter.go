@@ -10,7 +10,12 @@ import (
 )
 
 func createWebKeyRequestToConfig(req *webkey.CreateWebKeyRequest) crypto.WebKeyConfig {
-	switch config := req.GetKey().GetConfig().(type) {
+	return WebKeyToConfig(req.GetKey())
+}
+
+// WebKeyToConfig converts the configuration of a web key, it is also used by the import of the admin API
+func WebKeyToConfig(key *webkey.WebKey) crypto.WebKeyConfig {
+	switch config := key.GetConfig().(type) {
 	case *webkey.WebKey_Rsa:
 		return webKeyRSAConfigToCrypto(config.Rsa)
 	case *webkey.WebKey_Ecdsa:
@@ -89,24 +94,28 @@ func webKeyDetailsToPb(details *query.WebKeyDetails, instanceID string) *webkey.
 			EventDate:    details.ChangeDate,
 		}, object.OwnerType_OWNER_TYPE_INSTANCE, instanceID),
 		State:  webKeyStateToPb(details.State),
-		Config: &webkey.WebKey{},
+		Config: WebKeyConfigToPb(details.Config),
 	}
+	return out
+}
 
-	switch config := details.Config.(type) {
+// WebKeyConfigToPb converts the configuration of a web key, it is also used by the export of the admin API
+func WebKeyConfigToPb(config crypto.WebKeyConfig) *webkey.WebKey {
+	out := &webkey.WebKey{}
+	switch config := config.(type) {
 	case *crypto.WebKeyRSAConfig:
-		out.Config.Config = &webkey.WebKey_Rsa{
+		out.Config = &webkey.WebKey_Rsa{
 			Rsa: webKeyRSAConfigToPb(config),
 		}
 	case *crypto.WebKeyECDSAConfig:
-		out.Config.Config = &webkey.WebKey_Ecdsa{
+		out.Config = &webkey.WebKey_Ecdsa{
 			Ecdsa: webKeyECDSAConfigToPb(config),
 		}
 	case *crypto.WebKeyED25519Config:
-		out.Config.Config = &webkey.WebKey_Ed25519{
+		out.Config = &webkey.WebKey_Ed25519{
 			Ed25519: new(webkey.WebKeyED25519Config),
 		}
 	}
-
 	return out
 }
 
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
+	"github.com/zitadel/zitadel/internal/i18n"
+)
+
+// StreamInterceptor executes the unary interceptor on the request of a server stream.
+// The interceptor runs as soon as the request is received
+// and the context it passes to the handler is used for the rest of the stream.
+// Only interceptors which check the request and enrich the context (e.g. instance and authorization) are supported,
+// the response passed to the interceptor is always nil.
+func StreamInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &interceptedStream{
+			ServerStream: stream,
+			interceptor:  interceptor,
+			info: &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: info.FullMethod,
+			},
+		})
+	}
+}
+
+// StreamErrorHandler maps the errors returned by the stream to grpc errors
+func StreamErrorHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return gerrors.ZITADELToGRPCError(handler(srv, stream))
+	}
+}
+
+// StreamTranslationHandler translates the localized fields of the sent messages.
+// It must be chained after [StreamInterceptor] to be able to use the language of the instance.
+func StreamTranslationHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &translatedStream{ServerStream: stream})
+	}
+}
+
+type translatedStream struct {
+	grpc.ServerStream
+	translator *i18n.Translator
+}
+
+func (s *translatedStream) SendMsg(m interface{}) error {
+	if loc, ok := m.(localizers); ok && m != nil {
+		if s.translator == nil {
+			s.translator, _ = getTranslator(s.Context())
+		}
+		translateFields(s.Context(), loc, s.translator)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+type interceptedStream struct {
+	grpc.ServerStream
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+
+	ctx      context.Context
+	received bool
+}
+
+func (s *interceptedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *interceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.received {
+		return nil
+	}
+	s.received = true
+	_, err := s.interceptor(s.ServerStream.Context(), m, s.info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		s.ctx = ctx
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type ctxKey struct{}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []interface{}
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	if len(s.requests) == 0 {
+		return errors.New("no request")
+	}
+	*(m.(*string)) = s.requests[0].(string)
+	s.requests = s.requests[1:]
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	var interceptedRequests []interface{}
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		interceptedRequests = append(interceptedRequests, *(req.(*string)))
+		if *(req.(*string)) == "denied" {
+			return nil, errors.New("denied")
+		}
+		return handler(context.WithValue(ctx, ctxKey{}, info.FullMethod), req)
+	}
+
+	t.Run("context enriched", func(t *testing.T) {
+		interceptedRequests = nil
+		stream := &mockServerStream{ctx: context.Background(), requests: []interface{}{"first", "second"}}
+		err := StreamInterceptor(interceptor)(nil, stream, &grpc.StreamServerInfo{FullMethod: "/service/method"}, func(_ interface{}, stream grpc.ServerStream) error {
+			var req string
+			require.NoError(t, stream.RecvMsg(&req))
+			assert.Equal(t, "/service/method", stream.Context().Value(ctxKey{}))
+			return stream.RecvMsg(&req)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"first"}, interceptedRequests, "interceptor must only run for the first request")
+	})
+	t.Run("interceptor error", func(t *testing.T) {
+		interceptedRequests = nil
+		stream := &mockServerStream{ctx: context.Background(), requests: []interface{}{"denied"}}
+		err := StreamInterceptor(interceptor)(nil, stream, &grpc.StreamServerInfo{FullMethod: "/service/method"}, func(_ interface{}, stream grpc.ServerStream) error {
+			var req string
+			return stream.RecvMsg(&req)
+		})
+		assert.EqualError(t, err, "denied")
+	})
+}
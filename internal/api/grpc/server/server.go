@@ -63,6 +63,22 @@ func CreateServer(
 				middleware.ActivityInterceptor(),
 			),
 		),
+		grpc.StreamInterceptor(
+			grpc_middleware.ChainStreamServer(
+				middleware.StreamErrorHandler(),
+				middleware.StreamInterceptor(
+					grpc_middleware.ChainUnaryServer(
+						middleware.CallDurationHandler(),
+						middleware.InstanceInterceptor(queries, externalDomain, system_pb.SystemService_ServiceDesc.ServiceName, healthpb.Health_ServiceDesc.ServiceName),
+						middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
+						middleware.AuthorizationInterceptor(verifier, authConfig),
+						middleware.ValidationHandler(),
+						middleware.ServiceHandler(),
+					),
+				),
+				middleware.StreamTranslationHandler(),
+			),
+		),
 	}
 	if tlsConfig != nil {
 		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
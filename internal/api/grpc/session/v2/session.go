@@ -128,6 +128,52 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		Risk:           riskToPb(s.Risk),
+	}
+}
+
+func riskToPb(risk *query.SessionRisk) *session.LoginRisk {
+	if risk == nil {
+		return nil
+	}
+	signals := make([]session.LoginRiskSignal, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = riskSignalToPb(signal)
+	}
+	return &session.LoginRisk{
+		Signals: signals,
+		Action:  riskActionToPb(risk.Action),
+		Country: risk.Country,
+	}
+}
+
+func riskSignalToPb(signal domain.LoginRiskSignal) session.LoginRiskSignal {
+	switch signal {
+	case domain.LoginRiskSignalNewDevice:
+		return session.LoginRiskSignal_LOGIN_RISK_SIGNAL_NEW_DEVICE
+	case domain.LoginRiskSignalNewNetwork:
+		return session.LoginRiskSignal_LOGIN_RISK_SIGNAL_NEW_NETWORK
+	case domain.LoginRiskSignalImpossibleTravel:
+		return session.LoginRiskSignal_LOGIN_RISK_SIGNAL_IMPOSSIBLE_TRAVEL
+	case domain.LoginRiskSignalUnspecified:
+		fallthrough
+	default:
+		return session.LoginRiskSignal_LOGIN_RISK_SIGNAL_UNSPECIFIED
+	}
+}
+
+func riskActionToPb(action domain.LoginRiskAction) session.LoginRiskAction {
+	switch action {
+	case domain.LoginRiskActionNotify:
+		return session.LoginRiskAction_LOGIN_RISK_ACTION_NOTIFY
+	case domain.LoginRiskActionRequireMFA:
+		return session.LoginRiskAction_LOGIN_RISK_ACTION_REQUIRE_MFA
+	case domain.LoginRiskActionBlock:
+		return session.LoginRiskAction_LOGIN_RISK_ACTION_BLOCK
+	case domain.LoginRiskActionNone:
+		fallthrough
+	default:
+		return session.LoginRiskAction_LOGIN_RISK_ACTION_NONE
 	}
 }
 
@@ -168,13 +214,15 @@ func factorsToPb(s *query.Session) *session.Factors {
 		return nil
 	}
 	return &session.Factors{
-		User:     user,
-		Password: passwordFactorToPb(s.PasswordFactor),
-		WebAuthN: webAuthNFactorToPb(s.WebAuthNFactor),
-		Intent:   intentFactorToPb(s.IntentFactor),
-		Totp:     totpFactorToPb(s.TOTPFactor),
-		OtpSms:   otpFactorToPb(s.OTPSMSFactor),
-		OtpEmail: otpFactorToPb(s.OTPEmailFactor),
+		User:      user,
+		Password:  passwordFactorToPb(s.PasswordFactor),
+		WebAuthN:  webAuthNFactorToPb(s.WebAuthNFactor),
+		Intent:    intentFactorToPb(s.IntentFactor),
+		Totp:      totpFactorToPb(s.TOTPFactor),
+		OtpSms:    otpFactorToPb(s.OTPSMSFactor),
+		OtpEmail:  otpFactorToPb(s.OTPEmailFactor),
+		Push:      pushFactorToPb(s.PushFactor),
+		MagicLink: magicLinkFactorToPb(s.MagicLinkFactor),
 	}
 }
 
@@ -224,6 +272,24 @@ func otpFactorToPb(factor query.SessionOTPFactor) *session.OTPFactor {
 	}
 }
 
+func pushFactorToPb(factor query.SessionPushFactor) *session.PushFactor {
+	if factor.PushCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.PushFactor{
+		VerifiedAt: timestamppb.New(factor.PushCheckedAt),
+	}
+}
+
+func magicLinkFactorToPb(factor query.SessionMagicLinkFactor) *session.MagicLinkFactor {
+	if factor.MagicLinkCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.MagicLinkFactor{
+		VerifiedAt: timestamppb.New(factor.MagicLinkCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
@@ -302,7 +368,7 @@ func fieldNameToSessionColumn(field session.SessionFieldName) query.Column {
 }
 
 func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session.CreateSessionRequest) ([]command.SessionCommand, map[string][]byte, *domain.UserAgent, time.Duration, error) {
-	checks, err := s.checksToCommand(ctx, req.Checks)
+	checks, err := s.checksToCommand(ctx, "", "", req.Checks)
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
@@ -328,19 +394,23 @@ func userAgentToCommand(userAgent *session.UserAgent) *domain.UserAgent {
 }
 
 func (s *Server) setSessionRequestToCommand(ctx context.Context, req *session.SetSessionRequest) ([]command.SessionCommand, error) {
-	checks, err := s.checksToCommand(ctx, req.Checks)
+	checks, err := s.checksToCommand(ctx, req.GetSessionId(), req.GetSessionToken(), req.Checks)
 	if err != nil {
 		return nil, err
 	}
 	return checks, nil
 }
 
-func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([]command.SessionCommand, error) {
+func (s *Server) checksToCommand(ctx context.Context, sessionID, sessionToken string, checks *session.Checks) ([]command.SessionCommand, error) {
 	checkUser, err := userCheck(checks.GetUser())
 	if err != nil {
 		return nil, err
 	}
 	sessionChecks := make([]command.SessionCommand, 0, 7)
+	var (
+		loginNames    []string
+		resourceOwner string
+	)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
 		if err != nil {
@@ -355,8 +425,16 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 			preferredLanguage = &user.Human.PreferredLanguage
 		}
 		sessionChecks = append(sessionChecks, command.CheckUser(user.ID, user.ResourceOwner, preferredLanguage))
+		loginNames = append(loginNames, user.PreferredLoginName)
+		resourceOwner = user.ResourceOwner
+		if user.Human != nil {
+			loginNames = append(loginNames, string(user.Human.Email))
+		}
 	}
 	if password := checks.GetPassword(); password != nil {
+		if err := s.checkIDPDiscovery(ctx, sessionID, sessionToken, resourceOwner, loginNames); err != nil {
+			return nil, err
+		}
 		sessionChecks = append(sessionChecks, command.CheckPassword(password.GetPassword()))
 	}
 	if intent := checks.GetIdpIntent(); intent != nil {
@@ -374,9 +452,67 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if otp := checks.GetOtpEmail(); otp != nil {
 		sessionChecks = append(sessionChecks, command.CheckOTPEmail(otp.GetCode()))
 	}
+	if push := checks.GetPush(); push != nil {
+		sessionChecks = append(sessionChecks, command.CheckPush(push.GetDeviceId(), push.GetSignature()))
+	}
+	if magicLink := checks.GetMagicLink(); magicLink != nil {
+		sessionChecks = append(sessionChecks, s.command.CheckSessionMagicLink(magicLink.GetCode()))
+	}
 	return sessionChecks, nil
 }
 
+// checkIDPDiscovery prevents password checks for users, whose domain is routed to an identity provider (home realm discovery).
+// As in the login, the routing is ignored if the identity provider is not allowed by the login policy of the user's organization.
+// If the user is not checked in the same request, the already checked user of the session is used.
+func (s *Server) checkIDPDiscovery(ctx context.Context, sessionID, sessionToken, resourceOwner string, loginNames []string) error {
+	if len(loginNames) == 0 && sessionID != "" {
+		sess, err := s.query.SessionByID(ctx, true, sessionID, sessionToken)
+		if err != nil {
+			return err
+		}
+		loginNames = append(loginNames, sess.UserFactor.LoginName)
+		resourceOwner = sess.UserFactor.ResourceOwner
+	}
+	for _, loginName := range loginNames {
+		discovery, err := s.query.IDPDiscoveryDomainByLoginName(ctx, loginName)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		allowed, err := s.isAllowedExternalIDP(ctx, resourceOwner, discovery.IDPID)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			return zerrors.ThrowPreconditionFailed(nil, "SESSION-Hrd9p", "Errors.IDPConfig.DiscoveryDomainRequiresIDP")
+		}
+	}
+	return nil
+}
+
+// isAllowedExternalIDP checks if the identity provider is allowed by the (active) login policy of the organization.
+func (s *Server) isAllowedExternalIDP(ctx context.Context, orgID, idpID string) (bool, error) {
+	policy, err := s.query.LoginPolicyByID(ctx, true, orgID, false)
+	if err != nil {
+		return false, err
+	}
+	if !policy.AllowExternalIDPs {
+		return false, nil
+	}
+	links, err := s.query.IDPLoginPolicyLinks(ctx, orgID, &query.IDPLoginPolicyLinksSearchQuery{}, false)
+	if err != nil {
+		return false, err
+	}
+	for _, link := range links.Links {
+		if link.IDPID == idpID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds []command.SessionCommand) (*session.Challenges, []command.SessionCommand, error) {
 	if challenges == nil {
 		return nil, cmds, nil
@@ -400,6 +536,17 @@ func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds
 		resp.OtpEmail = challenge
 		cmds = append(cmds, cmd)
 	}
+	if req := challenges.GetPush(); req != nil {
+		cmds = append(cmds, s.command.CreatePushChallenge())
+	}
+	if req := challenges.GetMagicLink(); req != nil {
+		challenge, cmd, err := s.createMagicLinkChallengeCommand(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.MagicLink = challenge
+		cmds = append(cmds, cmd)
+	}
 	return resp, cmds, nil
 }
 
@@ -454,6 +601,24 @@ func (s *Server) createOTPEmailChallengeCommand(req *session.RequestChallenges_O
 	}
 }
 
+func (s *Server) createMagicLinkChallengeCommand(req *session.RequestChallenges_MagicLink) (*string, command.SessionCommand, error) {
+	switch t := req.GetDeliveryType().(type) {
+	case *session.RequestChallenges_MagicLink_SendLink_:
+		cmd, err := s.command.CreateMagicLinkChallengeURLTemplate(t.SendLink.GetUrlTemplate())
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, cmd, nil
+	case *session.RequestChallenges_MagicLink_ReturnCode_:
+		challenge := new(string)
+		return challenge, s.command.CreateMagicLinkChallengeReturnCode(challenge), nil
+	case nil:
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "SESSION-Aeg4o", "Errors.User.MagicLink.URLTemplateMissing")
+	default:
+		return nil, nil, zerrors.ThrowUnimplementedf(nil, "SESSION-Ohg2u", "delivery_type oneOf %T in MagicLinkChallenge not implemented", t)
+	}
+}
+
 func userCheck(user *session.CheckUser) (userSearch, error) {
 	if user == nil {
 		return nil, nil
@@ -0,0 +1,126 @@
+package settings
+
+import (
+	obj_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+)
+
+func EmailProvidersToPb(configs []*query.SMTPConfig) []*settings_pb.EmailProvider {
+	c := make([]*settings_pb.EmailProvider, len(configs))
+	for i, config := range configs {
+		c[i] = EmailProviderToPb(config)
+	}
+	return c
+}
+
+func EmailProviderToPb(config *query.SMTPConfig) *settings_pb.EmailProvider {
+	return &settings_pb.EmailProvider{
+		Details:     obj_pb.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:          config.ID,
+		Description: config.Description,
+		State:       emailProviderStateToPb(config.State),
+		Config:      emailProviderConfigToPb(config),
+	}
+}
+
+func emailProviderStateToPb(state domain.SMTPConfigState) settings_pb.EmailProviderState {
+	switch state {
+	case domain.SMTPConfigStateUnspecified, domain.SMTPConfigStateRemoved:
+		return settings_pb.EmailProviderState_EMAIL_PROVIDER_STATE_UNSPECIFIED
+	case domain.SMTPConfigStateActive:
+		return settings_pb.EmailProviderState_EMAIL_PROVIDER_ACTIVE
+	case domain.SMTPConfigStateInactive:
+		return settings_pb.EmailProviderState_EMAIL_PROVIDER_INACTIVE
+	default:
+		return settings_pb.EmailProviderState_EMAIL_PROVIDER_STATE_UNSPECIFIED
+	}
+}
+
+func emailProviderConfigToPb(config *query.SMTPConfig) settings_pb.EmailConfig {
+	if config.SMTPConfig != nil {
+		return emailProviderSMTPToPb(config.SMTPConfig)
+	}
+	if config.HTTPConfig != nil {
+		return emailProviderHTTPToPb(config.HTTPConfig)
+	}
+	return nil
+}
+
+func emailProviderHTTPToPb(http *query.HTTP) *settings_pb.EmailProvider_Http {
+	return &settings_pb.EmailProvider_Http{
+		Http: &settings_pb.EmailProviderHTTP{
+			Endpoint: http.Endpoint,
+		},
+	}
+}
+
+func emailProviderSMTPToPb(config *query.SMTP) *settings_pb.EmailProvider_Smtp {
+	return &settings_pb.EmailProvider_Smtp{
+		Smtp: &settings_pb.EmailProviderSMTP{
+			Tls:           config.TLS,
+			Host:          config.Host,
+			User:          config.User,
+			SenderAddress: config.SenderAddress,
+			SenderName:    config.SenderName,
+		},
+	}
+}
+
+func SMSProvidersToPb(configs []*query.SMSConfig) []*settings_pb.SMSProvider {
+	c := make([]*settings_pb.SMSProvider, len(configs))
+	for i, config := range configs {
+		c[i] = SMSProviderToPb(config)
+	}
+	return c
+}
+
+func SMSProviderToPb(config *query.SMSConfig) *settings_pb.SMSProvider {
+	return &settings_pb.SMSProvider{
+		Details:     obj_pb.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:          config.ID,
+		Description: config.Description,
+		State:       smsProviderStateToPb(config.State),
+		Config:      smsProviderConfigToPb(config),
+	}
+}
+
+func smsProviderConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
+	if config.TwilioConfig != nil {
+		return smsProviderTwilioToPb(config.TwilioConfig)
+	}
+	if config.HTTPConfig != nil {
+		return smsProviderHTTPToPb(config.HTTPConfig)
+	}
+	return nil
+}
+
+func smsProviderHTTPToPb(http *query.HTTP) *settings_pb.SMSProvider_Http {
+	return &settings_pb.SMSProvider_Http{
+		Http: &settings_pb.HTTPConfig{
+			Endpoint: http.Endpoint,
+		},
+	}
+}
+
+func smsProviderTwilioToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
+	return &settings_pb.SMSProvider_Twilio{
+		Twilio: &settings_pb.TwilioConfig{
+			Sid:              twilio.SID,
+			SenderNumber:     twilio.SenderNumber,
+			VerifyServiceSid: twilio.VerifyServiceSID,
+		},
+	}
+}
+
+func smsProviderStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
+	switch state {
+	case domain.SMSConfigStateInactive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_INACTIVE
+	case domain.SMSConfigStateActive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_ACTIVE
+	default:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_INACTIVE
+	}
+}
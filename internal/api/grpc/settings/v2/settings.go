@@ -131,6 +131,14 @@ func (s *Server) GetActiveIdentityProviders(ctx context.Context, req *settings.G
 	}, nil
 }
 
+func (s *Server) GetDiscoveredIdentityProvider(ctx context.Context, req *settings.GetDiscoveredIdentityProviderRequest) (*settings.GetDiscoveredIdentityProviderResponse, error) {
+	discovery, err := s.query.IDPDiscoveryDomainByLoginName(ctx, req.GetLoginName())
+	if err != nil {
+		return nil, err
+	}
+	return discoveredIdentityProviderToPb(discovery), nil
+}
+
 func (s *Server) GetGeneralSettings(ctx context.Context, _ *settings.GetGeneralSettingsRequest) (*settings.GetGeneralSettingsResponse, error) {
 	instance := authz.GetInstance(ctx)
 	return &settings.GetGeneralSettingsResponse{
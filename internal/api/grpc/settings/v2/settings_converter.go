@@ -33,6 +33,7 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		AllowDomainDiscovery:       current.AllowDomainDiscovery,
 		DisableLoginWithEmail:      current.DisableLoginWithEmail,
 		DisableLoginWithPhone:      current.DisableLoginWithPhone,
+		AllowMagicLink:             current.AllowMagicLink,
 		DefaultRedirectUri:         current.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(time.Duration(current.PasswordCheckLifetime)),
 		ExternalLoginCheckLifetime: durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
@@ -192,6 +193,20 @@ func identityProviderToPb(idp *query.IDPLoginPolicyLink) *settings.IdentityProvi
 	}
 }
 
+func discoveredIdentityProviderToPb(discovery *query.IDPDiscoveryDomain) *settings.GetDiscoveredIdentityProviderResponse {
+	resp := &settings.GetDiscoveredIdentityProviderResponse{
+		IdentityProvider: &settings.IdentityProvider{
+			Id:   discovery.IDPID,
+			Name: domain.IDPName(discovery.IDPName, discovery.IDPType),
+			Type: idpTypeToPb(discovery.IDPType),
+		},
+	}
+	if discovery.IDPOwnerType == domain.IdentityProviderTypeOrg {
+		resp.OrganizationId = discovery.IDPResourceOwner
+	}
+	return resp
+}
+
 func idpTypeToPb(idpType domain.IDPType) settings.IdentityProviderType {
 	switch idpType {
 	case domain.IDPTypeUnspecified:
@@ -34,6 +34,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      database.Duration(time.Hour),
 		ExternalLoginCheckLifetime: database.Duration(time.Minute),
@@ -64,6 +65,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
@@ -414,6 +416,60 @@ func Test_identityProvidersToPb(t *testing.T) {
 	}
 }
 
+func Test_discoveredIdentityProviderToPb(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  *query.IDPDiscoveryDomain
+		want *settings.GetDiscoveredIdentityProviderResponse
+	}{
+		{
+			name: "instance idp",
+			arg: &query.IDPDiscoveryDomain{
+				Domain:           "customer.com",
+				IDPID:            "1",
+				IDPName:          "foo",
+				IDPResourceOwner: "instance1",
+				IDPOwnerType:     domain.IdentityProviderTypeSystem,
+				IDPType:          domain.IDPTypeSAML,
+			},
+			want: &settings.GetDiscoveredIdentityProviderResponse{
+				IdentityProvider: &settings.IdentityProvider{
+					Id:   "1",
+					Name: "foo",
+					Type: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML,
+				},
+			},
+		},
+		{
+			name: "org idp",
+			arg: &query.IDPDiscoveryDomain{
+				Domain:           "customer.com",
+				IDPID:            "1",
+				IDPName:          "foo",
+				IDPResourceOwner: "org1",
+				IDPOwnerType:     domain.IdentityProviderTypeOrg,
+				IDPType:          domain.IDPTypeAzureAD,
+			},
+			want: &settings.GetDiscoveredIdentityProviderResponse{
+				IdentityProvider: &settings.IdentityProvider{
+					Id:   "1",
+					Name: "foo",
+					Type: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_AZURE_AD,
+				},
+				OrganizationId: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := discoveredIdentityProviderToPb(tt.arg)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("discoveredIdentityProviderToPb() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_idpTypeToPb(t *testing.T) {
 	type args struct {
 		idpType domain.IDPType
@@ -34,6 +34,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      database.Duration(time.Hour),
 		ExternalLoginCheckLifetime: database.Duration(time.Minute),
@@ -64,6 +65,7 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
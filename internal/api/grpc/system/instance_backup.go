@@ -0,0 +1,117 @@
+package system
+
+import (
+	"bufio"
+	"context"
+
+	"github.com/zitadel/logging"
+
+	admin_handler "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing/handler"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	auth_handler "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+// backupChunkSize is the maximum size of the archive sent in a single message
+const backupChunkSize = 1 << 20
+
+func (s *Server) BackupInstance(req *system_pb.BackupInstanceRequest, stream system_pb.SystemService_BackupInstanceServer) error {
+	archive := bufio.NewWriterSize(&backupStreamWriter{stream: stream}, backupChunkSize)
+	backup, err := s.command.BackupInstance(stream.Context(), req.GetInstanceId(), archive)
+	if err != nil {
+		return err
+	}
+	if err = archive.Flush(); err != nil {
+		return err
+	}
+	return stream.Send(&system_pb.BackupInstanceResponse{
+		Position: backup.Position,
+	})
+}
+
+func (s *Server) RestoreInstance(stream system_pb.SystemService_RestoreInstanceServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	restored, err := s.command.RestoreInstance(ctx, &restoreStreamReader{stream: stream, chunk: req.GetArchive()}, &command.InstanceRestore{
+		InstanceID: req.GetInstanceId(),
+		Position:   req.GetPosition(),
+		Replace:    req.GetReplace(),
+	})
+	if err != nil {
+		return err
+	}
+	if err = resetInstanceProjections(ctx, restored.InstanceID); err != nil {
+		return err
+	}
+	go triggerInstanceProjections(context.WithoutCancel(ctx), restored.InstanceID)
+	return stream.SendAndClose(&system_pb.RestoreInstanceResponse{
+		InstanceId: restored.InstanceID,
+		Position:   restored.Position,
+	})
+}
+
+// backupStreamWriter sends the written data as chunks of the archive
+type backupStreamWriter struct {
+	stream system_pb.SystemService_BackupInstanceServer
+}
+
+func (w *backupStreamWriter) Write(p []byte) (n int, err error) {
+	for n < len(p) {
+		chunk := p[n:min(n+backupChunkSize, len(p))]
+		if err = w.stream.Send(&system_pb.BackupInstanceResponse{Archive: chunk}); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+	}
+	return n, nil
+}
+
+// restoreStreamReader reads the archive from the chunks of the received messages
+type restoreStreamReader struct {
+	stream system_pb.SystemService_RestoreInstanceServer
+	chunk  []byte
+}
+
+func (r *restoreStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.GetArchive()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// resetInstanceProjections removes the data of the instance from the projections,
+// the notification handlers skip the restored events so no notifications are sent again
+func resetInstanceProjections(ctx context.Context, instanceID string) error {
+	if err := projection.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	if err := admin_handler.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	if err := auth_handler.ResetInstance(ctx, instanceID); err != nil {
+		return err
+	}
+	return notification.SkipInstance(ctx, instanceID)
+}
+
+func triggerInstanceProjections(ctx context.Context, instanceID string) {
+	ctx = authz.WithInstanceID(ctx, instanceID)
+	err := projection.ProjectInstance(ctx)
+	logging.WithFields("instance", instanceID).OnError(err).Error("unable to project restored instance")
+	err = admin_handler.ProjectInstance(ctx)
+	logging.WithFields("instance", instanceID).OnError(err).Error("unable to project restored instance")
+	err = auth_handler.ProjectInstance(ctx)
+	logging.WithFields("instance", instanceID).OnError(err).Error("unable to project restored instance")
+}
@@ -0,0 +1,37 @@
+package system
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/query/projection"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) ListProjections(ctx context.Context, req *system_pb.ListProjectionsRequest) (*system_pb.ListProjectionsResponse, error) {
+	states, err := projection.States(ctx, req.GetProjectionName(), req.GetInstanceIds()...)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ListProjectionsResponse{Result: ProjectionStatesToPb(states)}, nil
+}
+
+func (s *Server) RebuildProjection(ctx context.Context, req *system_pb.RebuildProjectionRequest) (*system_pb.RebuildProjectionResponse, error) {
+	if err := projection.StartRebuild(ctx, req.GetProjectionName()); err != nil {
+		return nil, err
+	}
+	return &system_pb.RebuildProjectionResponse{}, nil
+}
+
+func (s *Server) PauseProjection(ctx context.Context, req *system_pb.PauseProjectionRequest) (*system_pb.PauseProjectionResponse, error) {
+	if err := projection.Pause(ctx, req.GetProjectionName()); err != nil {
+		return nil, err
+	}
+	return &system_pb.PauseProjectionResponse{}, nil
+}
+
+func (s *Server) ResumeProjection(ctx context.Context, req *system_pb.ResumeProjectionRequest) (*system_pb.ResumeProjectionResponse, error) {
+	if err := projection.Resume(ctx, req.GetProjectionName()); err != nil {
+		return nil, err
+	}
+	return &system_pb.ResumeProjectionResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package system
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func ProjectionStatesToPb(states []*handler.ProjectionState) []*system_pb.ProjectionState {
+	p := make([]*system_pb.ProjectionState, len(states))
+	for i, state := range states {
+		p[i] = ProjectionStateToPb(state)
+	}
+	return p
+}
+
+func ProjectionStateToPb(state *handler.ProjectionState) *system_pb.ProjectionState {
+	return &system_pb.ProjectionState{
+		ProjectionName: state.ProjectionName,
+		InstanceId:     state.InstanceID,
+		Position:       state.Position,
+		EventTimestamp: timestamppb.New(state.EventDate),
+		LastRun:        timestamppb.New(state.LastUpdated),
+		Lag:            durationpb.New(state.Lag),
+		Paused:         state.Paused,
+	}
+}
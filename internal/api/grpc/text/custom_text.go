@@ -955,3 +955,23 @@ func FooterTextPbToDomain(text *text_pb.FooterText) domain.FooterText {
 		SupportEmail:  text.SupportEmail,
 	}
 }
+
+func MessageTemplateToPb(template *query.MessageTemplate) *text_pb.MessageTemplate {
+	lang := template.Language.String()
+	if template.Language.IsRoot() {
+		lang = ""
+	}
+	return &text_pb.MessageTemplate{
+		Details: object.ToViewDetailsPb(
+			template.Sequence,
+			template.CreationDate,
+			template.ChangeDate,
+			template.AggregateID,
+		),
+		MessageType: template.Type,
+		Language:    lang,
+		Html:        template.HTML,
+		PlainText:   template.PlainText,
+		IsDefault:   template.IsDefault,
+	}
+}
@@ -0,0 +1,105 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func NotificationQueriesToQuery(queries []*user_pb.NotificationQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = NotificationQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func NotificationQueryToQuery(req *user_pb.NotificationQuery) (query.SearchQuery, error) {
+	switch q := req.Query.(type) {
+	case *user_pb.NotificationQuery_StateQuery:
+		return query.NewNotificationLogStateSearchQuery(NotificationStateToDomain(q.StateQuery.State))
+	case *user_pb.NotificationQuery_MessageTypeQuery:
+		return query.NewNotificationLogMessageTypeSearchQuery(q.MessageTypeQuery.MessageType)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-Quai3", "Errors.List.Query.Invalid")
+	}
+}
+
+func NotificationsToPb(notifications []*query.NotificationLog) []*user_pb.Notification {
+	n := make([]*user_pb.Notification, len(notifications))
+	for i, notification := range notifications {
+		n[i] = NotificationToPb(notification)
+	}
+	return n
+}
+
+func NotificationToPb(notification *query.NotificationLog) *user_pb.Notification {
+	return &user_pb.Notification{
+		Id:          notification.ID,
+		Details:     object.ToViewDetailsPb(notification.Sequence, notification.CreationDate, notification.ChangeDate, notification.ResourceOwner),
+		State:       NotificationStateToPb(notification.State),
+		MessageType: notification.MessageType,
+		Channel:     NotificationChannelToPb(notification.Channel),
+		ProviderId:  notification.ProviderID,
+		Recipient:   notification.Recipient,
+		Error:       notification.Error,
+		Attempts:    notification.Attempts,
+	}
+}
+
+func NotificationStateToPb(state domain.NotificationState) user_pb.NotificationState {
+	switch state {
+	case domain.NotificationStateSent:
+		return user_pb.NotificationState_NOTIFICATION_STATE_SENT
+	case domain.NotificationStateFailed:
+		return user_pb.NotificationState_NOTIFICATION_STATE_FAILED
+	case domain.NotificationStateResendRequested:
+		return user_pb.NotificationState_NOTIFICATION_STATE_RESEND_REQUESTED
+	case domain.NotificationStateUnspecified:
+		return user_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	default:
+		return user_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	}
+}
+
+func NotificationStateToDomain(state user_pb.NotificationState) domain.NotificationState {
+	switch state {
+	case user_pb.NotificationState_NOTIFICATION_STATE_SENT:
+		return domain.NotificationStateSent
+	case user_pb.NotificationState_NOTIFICATION_STATE_FAILED:
+		return domain.NotificationStateFailed
+	case user_pb.NotificationState_NOTIFICATION_STATE_RESEND_REQUESTED:
+		return domain.NotificationStateResendRequested
+	case user_pb.NotificationState_NOTIFICATION_STATE_UNSPECIFIED:
+		return domain.NotificationStateUnspecified
+	default:
+		return domain.NotificationStateUnspecified
+	}
+}
+
+func NotificationChannelToPb(channel domain.NotificationType) user_pb.NotificationChannel {
+	switch channel {
+	case domain.NotificationTypeSms:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	case domain.NotificationTypeEmail:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	default:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	}
+}
+
+func NotificationChannelToDomain(channel user_pb.NotificationChannel) domain.NotificationType {
+	switch channel {
+	case user_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS:
+		return domain.NotificationTypeSms
+	case user_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+		return domain.NotificationTypeEmail
+	default:
+		return domain.NotificationTypeEmail
+	}
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) GetNotificationPreferences(ctx context.Context, req *user.GetNotificationPreferencesRequest) (*user.GetNotificationPreferencesResponse, error) {
+	resp, err := s.query.GetUserByIDWithPermission(ctx, true, req.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	preferences, err := s.query.NotificationPreferencesByUserID(ctx, resp.ID, resp.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetNotificationPreferencesResponse{
+		Preferences: notificationPreferencesToPb(preferences),
+	}, nil
+}
+
+func (s *Server) SetNotificationPreferences(ctx context.Context, req *user.SetNotificationPreferencesRequest) (*user.SetNotificationPreferencesResponse, error) {
+	details, err := s.command.SetUserNotificationPreferences(ctx, req.GetUserId(), "", notificationPreferencesToDomain(req.GetPreferences()))
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetNotificationPreferencesResponse{Details: object.DomainToDetailsPb(details)}, nil
+}
+
+func notificationPreferencesToDomain(preferences *user.NotificationPreferences) *domain.NotificationPreferences {
+	if preferences == nil {
+		return nil
+	}
+	channels := make([]domain.NotificationType, len(preferences.GetCodeChannels()))
+	for i, channel := range preferences.GetCodeChannels() {
+		channels[i] = notificationTypeToDomain(channel)
+	}
+	return &domain.NotificationPreferences{
+		CodeChannels:       channels,
+		OptOutMessageTypes: preferences.GetOptOutMessageTypes(),
+		SMSQuietHours:      quietHoursToDomain(preferences.GetSmsQuietHours()),
+	}
+}
+
+func quietHoursToDomain(quietHours *user.QuietHours) *domain.QuietHours {
+	if quietHours == nil {
+		return nil
+	}
+	return &domain.QuietHours{
+		Start:    quietHours.GetStart(),
+		End:      quietHours.GetEnd(),
+		TimeZone: quietHours.GetTimeZone(),
+	}
+}
+
+func notificationPreferencesToPb(preferences *domain.NotificationPreferences) *user.NotificationPreferences {
+	channels := make([]user.NotificationType, len(preferences.CodeChannels))
+	for i, channel := range preferences.CodeChannels {
+		channels[i] = notificationTypeToPb(channel)
+	}
+	return &user.NotificationPreferences{
+		CodeChannels:       channels,
+		OptOutMessageTypes: preferences.OptOutMessageTypes,
+		SmsQuietHours:      quietHoursToPb(preferences.SMSQuietHours),
+	}
+}
+
+func notificationTypeToPb(notificationType domain.NotificationType) user.NotificationType {
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		return user.NotificationType_NOTIFICATION_TYPE_Email
+	case domain.NotificationTypeSms:
+		return user.NotificationType_NOTIFICATION_TYPE_SMS
+	default:
+		return user.NotificationType_NOTIFICATION_TYPE_Unspecified
+	}
+}
+
+func quietHoursToPb(quietHours *domain.QuietHours) *user.QuietHours {
+	if quietHours == nil {
+		return nil
+	}
+	return &user.QuietHours{
+		Start:    quietHours.Start,
+		End:      quietHours.End,
+		TimeZone: quietHours.TimeZone,
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) AddPushDevice(ctx context.Context, req *user.AddPushDeviceRequest) (*user.AddPushDeviceResponse, error) {
+	deviceID, details, err := s.command.AddUserPushDevice(ctx, req.GetUserId(), "", req.GetName(), req.GetDeviceToken(), req.GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddPushDeviceResponse{
+		Details:  object.DomainToDetailsPb(details),
+		DeviceId: deviceID,
+	}, nil
+}
+
+func (s *Server) RemovePushDevice(ctx context.Context, req *user.RemovePushDeviceRequest) (*user.RemovePushDeviceResponse, error) {
+	details, err := s.command.RemoveUserPushDevice(ctx, req.GetUserId(), "", req.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemovePushDeviceResponse{Details: object.DomainToDetailsPb(details)}, nil
+}
@@ -2,9 +2,12 @@ package user
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 
+	"github.com/muhlemmer/gu"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/text/language"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,7 +18,15 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/github"
+	"github.com/zitadel/zitadel/internal/idp/providers/google"
+	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
+	idp_oidc "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2"
@@ -481,7 +492,116 @@ func (s *Server) RetrieveIdentityProviderIntent(ctx context.Context, req *user.R
 	if intent.State != domain.IDPIntentStateSucceeded {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-nme4gszsvx", "Errors.Intent.NotSucceeded")
 	}
-	return idpIntentToIDPIntentPb(intent, s.idpAlg)
+	idpIntent, err := idpIntentToIDPIntentPb(intent, s.idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	if intent.UserID != "" {
+		return idpIntent, nil
+	}
+	idpTemplate, err := s.query.IDPTemplateByID(ctx, true, intent.IDPID, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	idpIntent.AddHumanUser, err = idpIntentToAddHumanUserPb(intent, idpTemplate.Type, idpTemplate.AttributeMappings)
+	if err != nil {
+		return nil, err
+	}
+	return idpIntent, nil
+}
+
+// idpIntentToAddHumanUserPb prefills the request to create the user with the information of the federated user,
+// after the attribute mappings of the identity provider are applied.
+func idpIntentToAddHumanUserPb(intent *command.IDPIntentWriteModel, idpType domain.IDPType, mappings []domain.IDPAttributeMapping) (*user.AddHumanUserRequest, error) {
+	idpUser, err := unmarshalIDPUser(intent.IDPUser, idpType)
+	if err != nil {
+		return nil, err
+	}
+	externalUser := idp.MapExternalUser(intent.IDPID, idpUser, mappings)
+	req := &user.AddHumanUserRequest{
+		Profile: &user.SetHumanProfile{
+			GivenName:  externalUser.FirstName,
+			FamilyName: externalUser.LastName,
+		},
+		Email: &user.SetHumanEmail{
+			Email: string(externalUser.Email),
+		},
+		IdpLinks: []*user.IDPLink{
+			{
+				IdpId:    intent.IDPID,
+				UserId:   intent.IDPUserID,
+				UserName: intent.IDPUserName,
+			},
+		},
+	}
+	if externalUser.PreferredUsername != "" {
+		req.Username = gu.Ptr(externalUser.PreferredUsername)
+	}
+	if externalUser.NickName != "" {
+		req.Profile.NickName = gu.Ptr(externalUser.NickName)
+	}
+	if externalUser.DisplayName != "" {
+		req.Profile.DisplayName = gu.Ptr(externalUser.DisplayName)
+	}
+	if externalUser.PreferredLanguage != language.Und {
+		req.Profile.PreferredLanguage = gu.Ptr(externalUser.PreferredLanguage.String())
+	}
+	if externalUser.IsEmailVerified {
+		req.Email.Verification = &user.SetHumanEmail_IsVerified{IsVerified: true}
+	}
+	if externalUser.Phone != "" {
+		req.Phone = &user.SetHumanPhone{
+			Phone: string(externalUser.Phone),
+		}
+		if externalUser.IsPhoneVerified {
+			req.Phone.Verification = &user.SetHumanPhone_IsVerified{IsVerified: true}
+		}
+	}
+	for _, metadata := range externalUser.Metadatas {
+		req.Metadata = append(req.Metadata, &user.SetMetadataEntry{
+			Key:   metadata.Key,
+			Value: metadata.Value,
+		})
+	}
+	return req, nil
+}
+
+// unmarshalIDPUser restores the federated user of the identity provider type from the information stored on the intent.
+func unmarshalIDPUser(data []byte, idpType domain.IDPType) (idpUser idp.User, err error) {
+	var target any
+	switch idpType {
+	case domain.IDPTypeOIDC, domain.IDPTypeGitLab, domain.IDPTypeGitLabSelfHosted:
+		idpUser = idp_oidc.NewUser(new(oidc.UserInfo))
+	case domain.IDPTypeJWT:
+		idpUser = &jwt.User{IDTokenClaims: new(oidc.IDTokenClaims)}
+	case domain.IDPTypeOAuth:
+		mapper := oauth.NewUserMapper("")
+		// the mapper is stored with its fields, but only unmarshals the plain claims itself
+		idpUser, target = mapper, &struct{ RawInfo *map[string]any }{RawInfo: &mapper.RawInfo}
+	case domain.IDPTypeLDAP:
+		idpUser = new(ldap.User)
+	case domain.IDPTypeAzureAD:
+		idpUser = new(azuread.User)
+	case domain.IDPTypeGitHub, domain.IDPTypeGitHubEnterprise:
+		idpUser = new(github.User)
+	case domain.IDPTypeGoogle:
+		idpUser = &google.User{User: idp_oidc.NewUser(new(oidc.UserInfo))}
+	case domain.IDPTypeApple:
+		idpUser = &apple.User{User: idp_oidc.NewUser(new(oidc.UserInfo))}
+	case domain.IDPTypeSAML:
+		idpUser = saml.NewUser()
+	case domain.IDPTypeUnspecified:
+		fallthrough
+	default:
+		return nil, zerrors.ThrowInternal(nil, "USERv2-Ohn3u", "Errors.ExternalIDP.IDPTypeNotImplemented")
+	}
+	if target == nil {
+		target = idpUser
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, zerrors.ThrowInternal(err, "USERv2-Iegh4", "Errors.Internal")
+	}
+	return idpUser, nil
 }
 
 func idpIntentToIDPIntentPb(intent *command.IDPIntentWriteModel, alg crypto.EncryptionAlgorithm) (_ *user.RetrieveIdentityProviderIntentResponse, err error) {
@@ -621,6 +741,10 @@ func authMethodTypeToPb(methodType domain.UserAuthMethodType) user.Authenticatio
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_SMS
 	case domain.UserAuthMethodTypeOTPEmail:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL
+	case domain.UserAuthMethodTypePush:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_PUSH
+	case domain.UserAuthMethodTypeMagicLink:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_MAGIC_LINK
 	case domain.UserAuthMethodTypeUnspecified:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	default:
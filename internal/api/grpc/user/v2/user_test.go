@@ -327,6 +327,104 @@ func Test_idpIntentToIDPIntentPb(t *testing.T) {
 	}
 }
 
+func Test_idpIntentToAddHumanUserPb(t *testing.T) {
+	type args struct {
+		intent   *command.IDPIntentWriteModel
+		idpType  domain.IDPType
+		mappings []domain.IDPAttributeMapping
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *user.AddHumanUserRequest
+		wantErr error
+	}{
+		{
+			"unspecified type, error",
+			args{
+				intent: &command.IDPIntentWriteModel{
+					IDPID:   "idpID",
+					IDPUser: []byte(`{"sub": "idpUserID"}`),
+				},
+				idpType: domain.IDPTypeUnspecified,
+			},
+			nil,
+			zerrors.ThrowInternal(nil, "USERv2-Ohn3u", "Errors.ExternalIDP.IDPTypeNotImplemented"),
+		},
+		{
+			"oidc user",
+			args{
+				intent: &command.IDPIntentWriteModel{
+					IDPID:       "idpID",
+					IDPUser:     []byte(`{"sub": "idpUserID", "preferred_username": "username", "given_name": "firstname", "family_name": "lastname", "email": "email@test.ch", "email_verified": true, "locale": "de"}`),
+					IDPUserID:   "idpUserID",
+					IDPUserName: "username",
+				},
+				idpType: domain.IDPTypeOIDC,
+			},
+			&user.AddHumanUserRequest{
+				Username: gu.Ptr("username"),
+				Profile: &user.SetHumanProfile{
+					GivenName:         "firstname",
+					FamilyName:        "lastname",
+					PreferredLanguage: gu.Ptr("de"),
+				},
+				Email: &user.SetHumanEmail{
+					Email:        "email@test.ch",
+					Verification: &user.SetHumanEmail_IsVerified{IsVerified: true},
+				},
+				IdpLinks: []*user.IDPLink{
+					{IdpId: "idpID", UserId: "idpUserID", UserName: "username"},
+				},
+			},
+			nil,
+		},
+		{
+			"saml user, attribute mappings applied",
+			args{
+				intent: &command.IDPIntentWriteModel{
+					IDPID:       "idpID",
+					IDPUser:     []byte(`{"id": "idpUserID", "attributes": {"givenname": ["firstname"], "surname": ["lastname"], "mail": ["email@test.ch"], "groups": ["admins", "users"]}}`),
+					IDPUserID:   "idpUserID",
+					IDPUserName: "username",
+				},
+				idpType: domain.IDPTypeSAML,
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "givenname", Target: domain.IDPAttributeMappingTargetFirstName},
+					{Source: "surname", Target: domain.IDPAttributeMappingTargetLastName},
+					{Source: "mail", Target: domain.IDPAttributeMappingTargetEmail},
+					{Source: "mail", Target: domain.IDPAttributeMappingTargetPreferredUsername, Transform: domain.IDPAttributeTransformRemoveDomain},
+					{Source: "groups", Target: domain.IDPAttributeMappingTargetMetadata, Key: "groups"},
+				},
+			},
+			&user.AddHumanUserRequest{
+				Username: gu.Ptr("email"),
+				Profile: &user.SetHumanProfile{
+					GivenName:  "firstname",
+					FamilyName: "lastname",
+				},
+				Email: &user.SetHumanEmail{
+					Email: "email@test.ch",
+				},
+				Metadata: []*user.SetMetadataEntry{
+					{Key: "groups", Value: []byte("admins,users")},
+				},
+				IdpLinks: []*user.IDPLink{
+					{IdpId: "idpID", UserId: "idpUserID", UserName: "username"},
+				},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := idpIntentToAddHumanUserPb(tt.args.intent, tt.args.idpType, tt.args.mappings)
+			require.ErrorIs(t, err, tt.wantErr)
+			grpc.AllFieldsEqual(t, tt.want.ProtoReflect(), got.ProtoReflect(), grpc.CustomMappers)
+		})
+	}
+}
+
 func Test_authMethodTypesToPb(t *testing.T) {
 	tests := []struct {
 		name        string
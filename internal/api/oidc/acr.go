@@ -0,0 +1,73 @@
+package oidc
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	// ACRSingleFactor states that at least one factor has been verified
+	ACRSingleFactor = "urn:zitadel:iam:loa:1fa"
+	// ACRMultiFactor states that at least two factors have been verified
+	ACRMultiFactor = "urn:zitadel:iam:loa:mfa"
+	// ACRPhishingResistant states that at least two factors have been verified,
+	// one of them being a webauthn credential (passkey or u2f)
+	ACRPhishingResistant = "urn:zitadel:iam:loa:phr"
+
+	// ClaimACR is the Authentication Context Class Reference claim as defined in [OpenID Connect Core 1.0, section 2]
+	//
+	// [OpenID Connect Core 1.0, section 2]: https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+	ClaimACR = "acr"
+)
+
+// ACRValuesSupported are the Authentication Context Class Reference values
+// which can be requested by clients using the `acr_values` parameter.
+var ACRValuesSupported = []string{ACRSingleFactor, ACRMultiFactor, ACRPhishingResistant}
+
+// ACRValuesToBusiness maps the requested Authentication Context Class Reference values
+// to zitadel levels of assurance. Unknown values are ignored.
+func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
+	if len(values) == 0 {
+		return nil
+	}
+	levels := make([]domain.LevelOfAssurance, 0, len(values))
+	for _, value := range values {
+		if level := ACRToLevelOfAssurance(value); level != domain.LevelOfAssuranceNone {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+func ACRToLevelOfAssurance(acr string) domain.LevelOfAssurance {
+	switch acr {
+	case ACRSingleFactor:
+		return domain.LevelOfAssuranceSingleFactor
+	case ACRMultiFactor:
+		return domain.LevelOfAssuranceMultiFactor
+	case ACRPhishingResistant:
+		return domain.LevelOfAssurancePhishingResistant
+	default:
+		return domain.LevelOfAssuranceNone
+	}
+}
+
+func LevelOfAssuranceToACR(level domain.LevelOfAssurance) string {
+	switch level {
+	case domain.LevelOfAssuranceSingleFactor:
+		return ACRSingleFactor
+	case domain.LevelOfAssuranceMultiFactor:
+		return ACRMultiFactor
+	case domain.LevelOfAssurancePhishingResistant:
+		return ACRPhishingResistant
+	case domain.LevelOfAssuranceNone:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// AuthMethodTypesToACR returns the Authentication Context Class Reference
+// achieved by the provided (verified) auth methods.
+func AuthMethodTypesToACR(methodTypes []domain.UserAuthMethodType) string {
+	return LevelOfAssuranceToACR(domain.LevelOfAssuranceFromAuthMethods(methodTypes))
+}
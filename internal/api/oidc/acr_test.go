@@ -0,0 +1,108 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestAuthMethodTypesToACR(t *testing.T) {
+	type args struct {
+		methodTypes []domain.UserAuthMethodType
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			"no checks, empty",
+			args{
+				nil,
+			},
+			"",
+		},
+		{
+			"pw checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+			},
+			ACRSingleFactor,
+		},
+		{
+			"pw and otp checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+			},
+			ACRMultiFactor,
+		},
+		{
+			"idp and otp checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeIDP, domain.UserAuthMethodTypeOTPEmail},
+			},
+			ACRMultiFactor,
+		},
+		{
+			"pw and u2f checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeU2F},
+			},
+			ACRPhishingResistant,
+		},
+		{
+			"passkey checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePasswordless},
+			},
+			ACRPhishingResistant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AuthMethodTypesToACR(tt.args.methodTypes)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestACRValuesToBusiness(t *testing.T) {
+	type args struct {
+		values []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []domain.LevelOfAssurance
+	}{
+		{
+			"no values, nil",
+			args{
+				nil,
+			},
+			nil,
+		},
+		{
+			"unknown values, ignored",
+			args{
+				[]string{"urn:mace:incommon:iap:silver"},
+			},
+			[]domain.LevelOfAssurance{},
+		},
+		{
+			"known values",
+			args{
+				[]string{ACRPhishingResistant, "unknown", ACRMultiFactor},
+			},
+			[]domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant, domain.LevelOfAssuranceMultiFactor},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ACRValuesToBusiness(tt.args.values)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -18,6 +18,8 @@ const (
 	OTP = "otp"
 	// UserPresence states that the end users presence has been verified (e.g. passkey and u2f)
 	UserPresence = "user"
+	// SWK states that the possession of a software-secured key has been verified (e.g. push approval)
+	SWK = "swk"
 )
 
 // AuthMethodTypesToAMR maps zitadel auth method types to Authentication Method Reference Values
@@ -51,6 +53,13 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 		case domain.UserAuthMethodTypeIDP:
 			// no AMR value according to specification
 			factors++
+		case domain.UserAuthMethodTypePush:
+			// proof-of-possession of the key of the enrolled device
+			amr = append(amr, SWK)
+			factors++
+		case domain.UserAuthMethodTypeMagicLink:
+			// the link contains a one-time code, but doesn't count as additional factor (see [domain.HasMFA])
+			otp++
 		case domain.UserAuthMethodTypeUnspecified:
 			// ignore
 		}
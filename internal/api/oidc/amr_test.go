@@ -66,6 +66,34 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{OTP},
 		},
+		{
+			"push checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePush},
+			},
+			[]string{SWK},
+		},
+		{
+			"pw and push checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypePush},
+			},
+			[]string{PWD, SWK, MFA},
+		},
+		{
+			"magic link checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeMagicLink},
+			},
+			[]string{OTP},
+		},
+		{
+			"magic link and otp email checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeMagicLink, domain.UserAuthMethodTypeOTPEmail},
+			},
+			[]string{OTP},
+		},
 		{
 			"multiple (t)otp checked",
 			args{
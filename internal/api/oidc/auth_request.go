@@ -81,6 +81,7 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		Prompt:           PromptToBusiness(req.Prompt),
 		UILocales:        UILocalesToBusiness(req.UILocales),
 		MaxAge:           MaxAgeToBusiness(req.MaxAge),
+		PossibleLOAs:     ACRValuesToBusiness(req.ACRValues),
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
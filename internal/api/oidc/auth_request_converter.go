@@ -27,8 +27,7 @@ func (a *AuthRequest) GetID() string {
 }
 
 func (a *AuthRequest) GetACR() string {
-	// return a.
-	return "" //PLANNED: impl
+	return AuthMethodTypesToACR(a.AuthMethods())
 }
 
 func (a *AuthRequest) GetAMR() []string {
@@ -36,6 +35,9 @@ func (a *AuthRequest) GetAMR() []string {
 	if a.PasswordVerified {
 		list = append(list, Password, PWD)
 	}
+	if a.MagicLinkVerified {
+		list = append(list, OTP)
+	}
 	if len(a.MFAsVerified) > 0 {
 		list = append(list, MFA)
 		for _, mfa := range a.MFAsVerified {
@@ -176,10 +178,6 @@ func PromptToBusiness(oidcPrompt []string) []domain.Prompt {
 	return prompts
 }
 
-func ACRValuesToBusiness(values []string) []domain.LevelOfAssurance {
-	return nil
-}
-
 func UILocalesToBusiness(tags []language.Tag) []string {
 	if tags == nil {
 		return nil
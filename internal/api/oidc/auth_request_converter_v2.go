@@ -17,7 +17,7 @@ func (a *AuthRequestV2) GetID() string {
 }
 
 func (a *AuthRequestV2) GetACR() string {
-	return "" //PLANNED: impl
+	return AuthMethodTypesToACR(a.AuthMethods)
 }
 
 func (a *AuthRequestV2) GetAMR() []string {
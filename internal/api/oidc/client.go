@@ -824,6 +824,12 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, nil, err
 	}
+	// add the roles granted to groups the user is a (indirect) member of
+	groupGrants, err := o.query.UserGroupGrants(ctx, userID, roleAudience)
+	if err != nil {
+		return nil, nil, err
+	}
+	grants.UserGrants = append(grants.UserGrants, groupGrants...)
 	roles := new(projectsRoles)
 	// if specific roles where requested, check if they are granted and append them in the roles list
 	if len(requestedRoles) > 0 {
@@ -132,6 +132,12 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	if acr := AuthMethodTypesToACR(token.authMethods); acr != "" {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[ClaimACR] = acr
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -187,6 +187,7 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 			string(oidc.ResponseModeFormPost),
 		},
 		GrantTypesSupported:                                op.GrantTypes(s.Provider()),
+		ACRValuesSupported:                                 ACRValuesSupported,
 		SubjectTypesSupported:                              op.SubjectTypes(s.Provider()),
 		IDTokenSigningAlgValuesSupported:                   supportedSigningAlgs(ctx),
 		RequestObjectSigningAlgValuesSupported:             op.RequestObjectSigAlgorithms(s.Provider()),
@@ -155,7 +155,7 @@ func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo
 		expTime,
 		authTime,
 		nonce,
-		"",
+		AuthMethodTypesToACR(authMethods),
 		AuthMethodTypesToAMR(authMethods),
 		client.GetID(),
 		client.ClockSkew(),
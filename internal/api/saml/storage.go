@@ -317,7 +317,19 @@ func (p *Storage) getCustomAttributes(ctx context.Context, user *query.User, use
 }
 
 func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (*query.UserGrants, error) {
-	projectID, err := p.query.ProjectIDFromClientID(ctx, applicationID)
+	return getGrants(ctx, p.query, userID, applicationID)
+}
+
+type grantsQueries interface {
+	ProjectIDFromClientID(ctx context.Context, appID string) (id string, err error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+	UserGroupGrants(ctx context.Context, userID string, projectIDs []string) ([]*query.UserGrant, error)
+}
+
+// getGrants returns the active grants of the user on the project of the application,
+// including the ones granted to groups the user is a (indirect) member of.
+func getGrants(ctx context.Context, queries grantsQueries, userID, applicationID string) (*query.UserGrants, error) {
+	projectID, err := queries.ProjectIDFromClientID(ctx, applicationID)
 	if err != nil {
 		return nil, err
 	}
@@ -334,13 +346,22 @@ func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (
 	if err != nil {
 		return nil, err
 	}
-	return p.query.UserGrants(ctx, &query.UserGrantsQueries{
+	grants, err := queries.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: []query.SearchQuery{
 			projectQuery,
 			userIDQuery,
 			activeQuery,
 		},
 	}, true)
+	if err != nil {
+		return nil, err
+	}
+	groupGrants, err := queries.UserGroupGrants(ctx, userID, []string{projectID})
+	if err != nil {
+		return nil, err
+	}
+	grants.UserGrants = append(grants.UserGrants, groupGrants...)
+	return grants, nil
 }
 
 type customAttribute struct {
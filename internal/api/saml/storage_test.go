@@ -0,0 +1,87 @@
+package saml
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type mockGrantsQueries struct {
+	userGrants     []*query.UserGrant
+	groupGrants    []*query.UserGrant
+	groupGrantsErr error
+
+	groupGrantsProjectIDs []string
+}
+
+func (m *mockGrantsQueries) ProjectIDFromClientID(context.Context, string) (string, error) {
+	return "projectID", nil
+}
+
+func (m *mockGrantsQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: m.userGrants}, nil
+}
+
+func (m *mockGrantsQueries) UserGroupGrants(_ context.Context, _ string, projectIDs []string) ([]*query.UserGrant, error) {
+	m.groupGrantsProjectIDs = projectIDs
+	return m.groupGrants, m.groupGrantsErr
+}
+
+func Test_getGrants(t *testing.T) {
+	tests := []struct {
+		name    string
+		queries *mockGrantsQueries
+		want    []*query.UserGrant
+		wantErr error
+	}{
+		{
+			name: "user grants only",
+			queries: &mockGrantsQueries{
+				userGrants: []*query.UserGrant{{ID: "grant1", ProjectID: "projectID"}},
+			},
+			want: []*query.UserGrant{{ID: "grant1", ProjectID: "projectID"}},
+		},
+		{
+			name: "group grants only",
+			queries: &mockGrantsQueries{
+				groupGrants: []*query.UserGrant{{ID: "groupGrant1", GrantID: "group1", ProjectID: "projectID"}},
+			},
+			want: []*query.UserGrant{{ID: "groupGrant1", GrantID: "group1", ProjectID: "projectID"}},
+		},
+		{
+			name: "user and group grants",
+			queries: &mockGrantsQueries{
+				userGrants:  []*query.UserGrant{{ID: "grant1", ProjectID: "projectID"}},
+				groupGrants: []*query.UserGrant{{ID: "groupGrant1", GrantID: "group1", ProjectID: "projectID"}},
+			},
+			want: []*query.UserGrant{
+				{ID: "grant1", ProjectID: "projectID"},
+				{ID: "groupGrant1", GrantID: "group1", ProjectID: "projectID"},
+			},
+		},
+		{
+			name: "group grants error",
+			queries: &mockGrantsQueries{
+				groupGrantsErr: zerrors.ThrowInternal(nil, "id", "error"),
+			},
+			wantErr: zerrors.ThrowInternal(nil, "id", "error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getGrants(context.Background(), tt.queries, "userID", "appID")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.UserGrants)
+			assert.Equal(t, []string{"projectID"}, tt.queries.groupGrantsProjectIDs)
+		})
+	}
+}
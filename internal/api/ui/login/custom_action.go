@@ -171,6 +171,7 @@ const (
 	authMethodOTPEmail     authMethod = "OTP Email"
 	authMethodU2F          authMethod = "U2F"
 	authMethodPasswordless authMethod = "passwordless"
+	authMethodMagicLink    authMethod = "magic link"
 )
 
 func (l *Login) runPostInternalAuthenticationActions(
@@ -395,7 +395,7 @@ func (l *Login) handleExternalUserAuthenticated(
 	user idp.User,
 	callback func(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest),
 ) {
-	externalUser := mapIDPUserToExternalUser(user, provider.ID)
+	externalUser := idp.MapExternalUser(provider.ID, user, provider.AttributeMappings)
 	// ensure the linked IDP is added to the login policy
 	if err := l.authRepo.SelectExternalIDP(r.Context(), authReq.ID, provider.ID, authReq.AgentID); err != nil {
 		l.renderError(w, r, authReq, err)
@@ -1202,23 +1202,6 @@ func tokens(session idp.Session) *oidc.Tokens[*oidc.IDTokenClaims] {
 	return nil
 }
 
-func mapIDPUserToExternalUser(user idp.User, id string) *domain.ExternalUser {
-	return &domain.ExternalUser{
-		IDPConfigID:       id,
-		ExternalUserID:    user.GetID(),
-		PreferredUsername: user.GetPreferredUsername(),
-		DisplayName:       user.GetDisplayName(),
-		FirstName:         user.GetFirstName(),
-		LastName:          user.GetLastName(),
-		NickName:          user.GetNickname(),
-		Email:             user.GetEmail(),
-		IsEmailVerified:   user.IsEmailVerified(),
-		PreferredLanguage: user.GetPreferredLanguage(),
-		Phone:             user.GetPhone(),
-		IsPhoneVerified:   user.IsPhoneVerified(),
-	}
-}
-
 func mapExternalUserToLoginUser(externalUser *domain.ExternalUser, mustBeDomain bool) (*domain.Human, *domain.UserIDPLink, []*domain.Metadata) {
 	username := externalUser.PreferredUsername
 	if mustBeDomain {
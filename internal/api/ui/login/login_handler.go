@@ -82,6 +82,22 @@ func (l *Login) handleLoginNameCheck(w http.ResponseWriter, r *http.Request) {
 	}
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
 	loginName := data.LoginName
+	idpID, err := l.authRepo.DiscoverExternalIDP(r.Context(), authReq.ID, loginName, userAgentID)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	if idpID != "" {
+		// the domain of the login name is routed to an identity provider (home realm discovery),
+		// so the password (or any other local authentication) is skipped
+		authReq, err = l.authRepo.AuthRequestByID(r.Context(), authReq.ID, userAgentID)
+		if err != nil {
+			l.renderLogin(w, r, authReq, err)
+			return
+		}
+		l.handleIDP(w, r, authReq, idpID)
+		return
+	}
 	err = l.authRepo.CheckLoginName(r.Context(), authReq.ID, loginName, userAgentID)
 	if err != nil {
 		l.renderLogin(w, r, authReq, err)
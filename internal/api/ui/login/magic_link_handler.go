@@ -0,0 +1,77 @@
+package login
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplMagicLinkSent = "magiclinksent"
+
+	queryMagicLinkUserID = "userID"
+	queryMagicLinkCode   = "code"
+)
+
+// MagicLink returns the link sent to the user by email.
+// It can only complete the auth request it was requested for and only in the same browser.
+func MagicLink(origin, userID, code, authRequestID string) string {
+	v := url.Values{}
+	v.Set(queryMagicLinkUserID, userID)
+	v.Set(queryMagicLinkCode, code)
+	v.Set(QueryAuthRequestID, authRequestID)
+	return externalLink(origin) + EndpointMagicLinkVerify + "?" + v.Encode()
+}
+
+func (l *Login) handleMagicLink(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq.UserID == "" {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Eeg8a", "Errors.User.NotFound"))
+		return
+	}
+	err = l.command.RequestMagicLink(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.WithCurrentInfo(domain.BrowserInfoFromRequest(r)))
+	l.renderMagicLinkSent(w, r, authReq, err)
+}
+
+func (l *Login) handleMagicLinkVerify(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userID := r.FormValue(queryMagicLinkUserID)
+	if userID == "" || userID != authReq.UserID {
+		l.renderError(w, r, authReq, zerrors.ThrowInvalidArgument(nil, "LOGIN-aiB0e", "Errors.User.Code.Invalid"))
+		return
+	}
+	err = l.command.CheckMagicLink(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, r.FormValue(queryMagicLinkCode), authReq.UserOrgID, authReq.WithCurrentInfo(domain.BrowserInfoFromRequest(r)))
+
+	metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodMagicLink, err)
+	if err == nil && actionErr == nil && len(metadata) > 0 {
+		_, err = l.command.BulkSetUserMetadata(r.Context(), authReq.UserID, authReq.UserOrgID, metadata...)
+	} else if actionErr != nil && err == nil {
+		err = actionErr
+	}
+
+	if err != nil {
+		l.renderPassword(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderMagicLinkSent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "MagicLinkSent.Title", "MagicLinkSent.Description", errID, errMessage)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMagicLinkSent], data, nil)
+}
@@ -0,0 +1,142 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplNotificationPreferences = "notificationpreferences"
+
+	codeChannelEmail = "email"
+	codeChannelSMS   = "sms"
+)
+
+type notificationPreferencesFormData struct {
+	CodeChannel     string   `schema:"codeChannel"`
+	OptOuts         []string `schema:"optOuts"`
+	QuietHoursStart string   `schema:"quietHoursStart"`
+	QuietHoursEnd   string   `schema:"quietHoursEnd"`
+	TimeZone        string   `schema:"timeZone"`
+}
+
+type notificationPreferencesData struct {
+	userData
+	CodeChannel     string
+	MessageTypes    []*notificationMessageType
+	QuietHoursStart string
+	QuietHoursEnd   string
+	TimeZone        string
+}
+
+type notificationMessageType struct {
+	Type        string
+	Description string
+	OptedOut    bool
+}
+
+func (l *Login) handleNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNotificationPreferences(w, r, authReq, nil)
+}
+
+func (l *Login) handleNotificationPreferencesCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(notificationPreferencesFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq.UserID == "" {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-ohK5e", "Errors.User.UserIDMissing"))
+		return
+	}
+	ctx := setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID)
+	_, err = l.command.SetUserNotificationPreferences(ctx, authReq.UserID, authReq.UserOrgID, data.toDomain())
+	if err != nil {
+		l.renderNotificationPreferences(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderNotificationPreferences(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	if authReq.UserID == "" {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Ooj3a", "Errors.User.UserIDMissing"))
+		return
+	}
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	preferences, err := l.query.NotificationPreferencesByUserID(r.Context(), authReq.UserID, authReq.UserOrgID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	types, err := l.query.SearchCustomNotificationTypes(r.Context(), &query.CustomNotificationTypeSearchQueries{})
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := &notificationPreferencesData{
+		userData:     l.getUserData(r, authReq, translator, "NotificationPreferences.Title", "NotificationPreferences.Description", errID, errMessage),
+		CodeChannel:  codeChannelToForm(preferences.CodeChannels),
+		MessageTypes: make([]*notificationMessageType, len(types.Types)),
+	}
+	for i, messageType := range types.Types {
+		data.MessageTypes[i] = &notificationMessageType{
+			Type:        messageType.Type,
+			Description: messageType.Description,
+			OptedOut:    preferences.OptedOut(messageType.Type),
+		}
+	}
+	if preferences.SMSQuietHours != nil {
+		data.QuietHoursStart = preferences.SMSQuietHours.Start
+		data.QuietHoursEnd = preferences.SMSQuietHours.End
+		data.TimeZone = preferences.SMSQuietHours.TimeZone
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplNotificationPreferences], data, nil)
+}
+
+func (d *notificationPreferencesFormData) toDomain() *domain.NotificationPreferences {
+	preferences := &domain.NotificationPreferences{
+		OptOutMessageTypes: d.OptOuts,
+	}
+	switch d.CodeChannel {
+	case codeChannelEmail:
+		preferences.CodeChannels = []domain.NotificationType{domain.NotificationTypeEmail, domain.NotificationTypeSms}
+	case codeChannelSMS:
+		preferences.CodeChannels = []domain.NotificationType{domain.NotificationTypeSms, domain.NotificationTypeEmail}
+	}
+	if d.QuietHoursStart != "" || d.QuietHoursEnd != "" {
+		preferences.SMSQuietHours = &domain.QuietHours{
+			Start:    d.QuietHoursStart,
+			End:      d.QuietHoursEnd,
+			TimeZone: d.TimeZone,
+		}
+	}
+	return preferences
+}
+
+func codeChannelToForm(channels []domain.NotificationType) string {
+	if len(channels) == 0 {
+		return ""
+	}
+	switch channels[0] {
+	case domain.NotificationTypeEmail:
+		return codeChannelEmail
+	case domain.NotificationTypeSms:
+		return codeChannelSMS
+	default:
+		return ""
+	}
+}
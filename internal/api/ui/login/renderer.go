@@ -70,6 +70,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplInitUserDone:                 "init_user_done.html",
 		tmplInviteUser:                   "invite_user.html",
 		tmplPasswordResetDone:            "password_reset_done.html",
+		tmplMagicLinkSent:                "magic_link_sent.html",
 		tmplChangePassword:               "change_password.html",
 		tmplChangePasswordDone:           "change_password_done.html",
 		tmplRegisterOption:               "register_option.html",
@@ -78,6 +79,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplRegisterOrg:                  "register_org.html",
 		tmplChangeUsername:               "change_username.html",
 		tmplChangeUsernameDone:           "change_username_done.html",
+		tmplNotificationPreferences:      "notification_preferences.html",
 		tmplLinkUsersDone:                "link_users_done.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
@@ -158,6 +160,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"passwordResetUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointPasswordReset, QueryAuthRequestID, id))
 		},
+		"magicLinkUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointMagicLink, QueryAuthRequestID, id))
+		},
 		"passwordUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointPassword)
 		},
@@ -212,6 +217,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"changeUsernameUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointChangeUsername)
 		},
+		"notificationPreferencesUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointNotificationPreferences, QueryAuthRequestID, id))
+		},
 		"externalNotFoundOptionUrl": func(action string) string {
 			return path.Join(r.pathPrefix, EndpointExternalNotFoundOption+"?"+action+"=true")
 		},
@@ -227,6 +235,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"showPasswordReset": func() bool {
 			return true
 		},
+		"showMagicLink": func() bool {
+			return false
+		},
 		"hasExternalLogin": func() bool {
 			return false
 		},
@@ -382,13 +393,15 @@ func (l *Login) getBaseData(r *http.Request, authReq *domain.AuthRequest, transl
 		description = translator.LocalizeWithoutArgs(descriptionI18nKey)
 	}
 
-	lang, _ := l.renderer.ReqLang(translator, r).Base()
+	reqLang := l.renderer.ReqLang(translator, r)
+	lang, _ := reqLang.Base()
 	baseData := baseData{
 		errorData: errorData{
 			ErrID:      errType,
 			ErrMessage: errMessage,
 		},
 		Lang:                   lang.String(),
+		Dir:                    i18n.Direction(reqLang),
 		Title:                  title,
 		Description:            description,
 		Theme:                  l.getTheme(r),
@@ -435,6 +448,7 @@ func (l *Login) getTranslator(ctx context.Context, authReq *domain.AuthRequest)
 	}
 	translator, err := l.renderer.NewTranslator(ctx, restrictions.AllowedLanguages)
 	logging.OnError(err).Warn("cannot load translator")
+	l.addLanguageBundles(ctx, translator)
 	if authReq != nil {
 		l.addLoginTranslations(translator, authReq.DefaultTranslations)
 		l.addLoginTranslations(translator, authReq.OrgTranslations)
@@ -593,6 +607,27 @@ func (l *Login) addLoginTranslations(translator *i18n.Translator, customTexts []
 	}
 }
 
+// addLanguageBundles adds the language bundles uploaded to the instance.
+// The parsed bundles are cached per instance until they are changed.
+// Bundles which cannot be loaded are skipped, so the login is still rendered with the built-in texts.
+func (l *Login) addLanguageBundles(ctx context.Context, translator *i18n.Translator) {
+	bundles, err := l.query.LanguageBundles(ctx, i18n.LOGIN)
+	if err != nil {
+		logging.WithError(err).Warn("cannot load language bundles")
+		return
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	for _, bundle := range bundles {
+		parsed, err := l.languageBundles.Get(instanceID, bundle.Language, bundle.Sequence, func() ([]byte, error) {
+			return bundle.Content(ctx, l.staticStorage)
+		})
+		if err == nil {
+			err = translator.AddBundle(parsed)
+		}
+		logging.WithFields("language", bundle.Language).OnError(err).Warn("cannot add language bundle")
+	}
+}
+
 func (l *Login) customTexts(ctx context.Context, translator *i18n.Translator, orgID string) {
 	instanceID := authz.GetInstance(ctx).InstanceID()
 	instanceTexts, err := l.query.CustomTextListByTemplate(ctx, instanceID, domain.LoginCustomText, false)
@@ -635,6 +670,7 @@ func (l *Login) cspErrorHandler(err error) http.Handler {
 type baseData struct {
 	errorData
 	Lang                   string
+	Dir                    string
 	Title                  string
 	Description            string
 	Theme                  string
@@ -20,6 +20,7 @@ type AuthRequestRepository interface {
 	SetExternalUserLogin(ctx context.Context, authReqID, userAgentID string, user *domain.ExternalUser) error
 	SetLinkingUser(ctx context.Context, request *domain.AuthRequest, externalUser *domain.ExternalUser) error
 	SelectUser(ctx context.Context, authReqID, userID, userAgentID string) error
+	DiscoverExternalIDP(ctx context.Context, authReqID, loginName, userAgentID string) (string, error)
 	SelectExternalIDP(ctx context.Context, authReqID, idpConfigID, userAgentID string) error
 	VerifyPassword(ctx context.Context, id, userID, resourceOwner, password, userAgentID string, info *domain.BrowserInfo) error
 
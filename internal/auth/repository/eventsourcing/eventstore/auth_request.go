@@ -121,6 +121,7 @@ type orgViewProvider interface {
 type userGrantProvider interface {
 	ProjectByClientID(context.Context, string) (*query.Project, error)
 	UserGrantsByProjectAndUserID(context.Context, string, string) ([]*query.UserGrant, error)
+	UserGroupGrants(ctx context.Context, userID string, projectIDs []string) ([]*query.UserGrant, error)
 }
 
 type projectProvider interface {
@@ -167,8 +168,12 @@ func (repo *AuthRequestRepo) CreateAuthRequest(ctx context.Context, request *dom
 		return nil, err
 	}
 	if request.LoginHint != "" {
-		err = repo.checkLoginName(ctx, request, request.LoginHint)
-		logging.WithFields("login name", request.LoginHint, "id", request.ID, "applicationID", request.ApplicationID, "traceID", tracing.TraceIDFromCtx(ctx)).OnError(err).Info("login hint invalid")
+		discovered, err := repo.checkIDPDiscovery(ctx, request, request.LoginHint)
+		logging.WithFields("login name", request.LoginHint, "id", request.ID, "applicationID", request.ApplicationID, "traceID", tracing.TraceIDFromCtx(ctx)).OnError(err).Info("idp discovery of login hint failed")
+		if !discovered {
+			err = repo.checkLoginName(ctx, request, request.LoginHint)
+			logging.WithFields("login name", request.LoginHint, "id", request.ID, "applicationID", request.ApplicationID, "traceID", tracing.TraceIDFromCtx(ctx)).OnError(err).Info("login hint invalid")
+		}
 	}
 	if request.UserID == "" && request.LoginHint == "" && domain.IsPrompt(request.Prompt, domain.PromptNone) {
 		err = repo.tryUsingOnlyUserSession(ctx, request)
@@ -255,6 +260,26 @@ func (repo *AuthRequestRepo) CheckLoginName(ctx context.Context, id, loginName,
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+// DiscoverExternalIDP checks if users with the login name have to authenticate with an identity provider (home realm discovery).
+// If so, the identity provider is selected on the auth request and its id is returned,
+// otherwise the returned id is empty and the login continues as usual.
+func (repo *AuthRequestRepo) DiscoverExternalIDP(ctx context.Context, authReqID, loginName, userAgentID string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return "", err
+	}
+	discovered, err := repo.checkIDPDiscovery(ctx, request, loginName)
+	if err != nil || !discovered {
+		return "", err
+	}
+	if err = repo.AuthRequests.UpdateAuthRequest(ctx, request); err != nil {
+		return "", err
+	}
+	return request.SelectedIDPConfigID, nil
+}
+
 func (repo *AuthRequestRepo) SelectExternalIDP(ctx context.Context, authReqID, idpConfigID, userAgentID string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -853,6 +878,52 @@ func (repo *AuthRequestRepo) checkDomainDiscovery(ctx context.Context, request *
 	return true, nil
 }
 
+// checkIDPDiscovery checks if the domain of the login name is routed to an identity provider.
+// In case of an organization identity provider, the auth request is switched to its organization,
+// but only if no (other) organization was requested.
+// The identity provider must still be allowed by the login policy, otherwise the discovery is ignored
+// and the auth request is left unchanged.
+func (repo *AuthRequestRepo) checkIDPDiscovery(ctx context.Context, request *domain.AuthRequest, loginName string) (bool, error) {
+	loginName = strings.TrimSpace(loginName)
+	discovery, err := repo.Query.IDPDiscoveryDomainByLoginName(ctx, loginName)
+	if err != nil {
+		if zerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if discovery.IDPOwnerType == domain.IdentityProviderTypeOrg && request.RequestedOrgID != discovery.IDPResourceOwner {
+		if request.RequestedOrgID != "" {
+			return false, nil
+		}
+		// the login policy of the organization is checked before the auth request is switched to it
+		_, idpProviders, err := repo.getLoginPolicyAndIDPProviders(ctx, discovery.IDPResourceOwner)
+		if err != nil {
+			return false, err
+		}
+		if !isIDPAllowed(idpProviders, discovery.IDPID) {
+			logging.WithFields("authRequest", request.ID, "idpID", discovery.IDPID).Warn("discovered idp not allowed by login policy")
+			return false, nil
+		}
+		org, err := repo.OrgViewProvider.OrgByID(ctx, false, discovery.IDPResourceOwner)
+		if err != nil {
+			return false, err
+		}
+		request.SetOrgInformation(org.ID, org.Name, org.Domain, false)
+		if err = repo.fillPolicies(ctx, request); err != nil {
+			return false, err
+		}
+	}
+	if err = repo.checkSelectedExternalIDP(request, discovery.IDPID); err != nil {
+		logging.WithFields("authRequest", request.ID, "idpID", discovery.IDPID).Warn("discovered idp not allowed by login policy")
+		return false, nil
+	}
+	// clear all potentially existing user information, the user will be identified by the identity provider
+	request.SetUserInfo("", "", "", "", "", request.RequestedOrgID)
+	request.LoginHint = loginName
+	return true, nil
+}
+
 func (repo *AuthRequestRepo) checkLoginNameInput(ctx context.Context, request *domain.AuthRequest, loginNameInput, preferredLoginName string) (*user_view_model.UserView, error) {
 	// always check the preferred / suffixed loginname first
 	user, err := repo.View.UserByLoginName(ctx, preferredLoginName, request.InstanceID)
@@ -972,17 +1043,25 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MultiFactorCheckLifetime:   time.Duration(policy.MultiFactorCheckLifetime),
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		AllowMagicLink:             policy.AllowMagicLink,
 	}
 }
 
 func (repo *AuthRequestRepo) checkSelectedExternalIDP(request *domain.AuthRequest, idpConfigID string) error {
-	for _, externalIDP := range request.AllowedExternalIDPs {
-		if externalIDP.IDPConfigID == idpConfigID {
-			request.SelectedIDPConfigID = idpConfigID
-			return nil
+	if !isIDPAllowed(request.AllowedExternalIDPs, idpConfigID) {
+		return zerrors.ThrowNotFound(nil, "LOGIN-Nsm8r", "Errors.User.ExternalIDP.NotAllowed")
+	}
+	request.SelectedIDPConfigID = idpConfigID
+	return nil
+}
+
+func isIDPAllowed(idpProviders []*domain.IDPProvider, idpConfigID string) bool {
+	for _, idpProvider := range idpProviders {
+		if idpProvider.IDPConfigID == idpConfigID {
+			return true
 		}
 	}
-	return zerrors.ThrowNotFound(nil, "LOGIN-Nsm8r", "Errors.User.ExternalIDP.NotAllowed")
+	return false
 }
 
 func (repo *AuthRequestRepo) checkExternalUserLogin(ctx context.Context, request *domain.AuthRequest, idpConfigID, externalUserID string) (err error) {
@@ -1252,6 +1331,12 @@ func (repo *AuthRequestRepo) firstFactorChecked(ctx context.Context, request *do
 		}
 	}
 
+	if request.LoginPolicy.AllowMagicLink && checkVerificationTimeMaxAge(userSession.MagicLinkVerification, request.LoginPolicy.PasswordCheckLifetime, request) {
+		request.MagicLinkVerified = true
+		request.AuthTime = userSession.MagicLinkVerification
+		return nil
+	}
+
 	if user.PasswordlessInitRequired {
 		return &domain.PasswordlessRegistrationPromptStep{}
 	}
@@ -1320,10 +1405,17 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) {
 		return nil, true, nil
 	}
+	phishingResistant := request.RequiredLevelOfAssurance() == domain.LevelOfAssurancePhishingResistant
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy, isInternalAuthentication)
+	if phishingResistant {
+		allowedProviders = phishingResistantMFATypes(allowedProviders)
+	}
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
 	if promptRequired || !repo.mfaSkippedOrSetUp(user, request) {
 		types := user.MFATypesSetupPossible(mfaLevel, request.LoginPolicy)
+		if phishingResistant {
+			types = phishingResistantMFATypes(types)
+		}
 		if promptRequired && len(types) == 0 {
 			return nil, false, zerrors.ThrowPreconditionFailed(nil, "LOGIN-5Hm8s", "Errors.Login.LoginPolicy.MFA.ForceAndNotConfigured")
 		}
@@ -1344,7 +1436,8 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 		}
 		fallthrough
 	case domain.MFALevelSecondFactor:
-		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+		if (!phishingResistant || userSession.SecondFactorVerificationType == domain.MFATypeU2F) &&
+			checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.SecondFactorVerificationType)
 			request.AuthTime = userSession.SecondFactorVerification
 			return nil, true, nil
@@ -1362,6 +1455,14 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 	}, false, nil
 }
 
+// phishingResistantMFATypes filters the provided types to the ones satisfying
+// [domain.LevelOfAssurancePhishingResistant] when used as second factor.
+func phishingResistantMFATypes(types []domain.MFAType) []domain.MFAType {
+	return slices.DeleteFunc(types, func(mfaType domain.MFAType) bool {
+		return mfaType != domain.MFATypeU2F
+	})
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView, request *domain.AuthRequest) bool {
 	if user.MFAMaxSetUp > domain.MFALevelNotSetUp {
 		return true
@@ -1586,6 +1687,8 @@ var (
 		user_repo.HumanPasswordlessTokenCheckFailedType,
 		user_repo.HumanU2FTokenCheckSucceededType,
 		user_repo.HumanU2FTokenCheckFailedType,
+		user_repo.HumanMagicLinkCheckSucceededType,
+		user_repo.HumanMagicLinkCheckFailedType,
 		user_repo.UserRemovedType,
 	}
 )
@@ -1634,7 +1737,9 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 			user_repo.HumanPasswordlessTokenCheckSucceededType,
 			user_repo.HumanPasswordlessTokenCheckFailedType,
 			user_repo.HumanU2FTokenCheckSucceededType,
-			user_repo.HumanU2FTokenCheckFailedType:
+			user_repo.HumanU2FTokenCheckFailedType,
+			user_repo.HumanMagicLinkCheckSucceededType,
+			user_repo.HumanMagicLinkCheckFailedType:
 			userAgentID, err := user_view_model.UserAgentIDFromEvent(event)
 			if err != nil {
 				logging.WithFields("traceID", tracing.TraceIDFromCtx(ctx)).WithError(err).Debug("error getting event data")
@@ -1768,7 +1873,15 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 	if err != nil {
 		return false, err
 	}
-	return len(grants) == 0, nil
+	if len(grants) > 0 {
+		return false, nil
+	}
+	// the user might also be granted through a group they are a (indirect) member of
+	groupGrants, err := userGrantProvider.UserGroupGrants(ctx, user.ID, []string{project.ID})
+	if err != nil {
+		return false, err
+	}
+	return len(groupGrants) == 0, nil
 }
 
 func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (missingGrant bool, err error) {
@@ -66,6 +66,7 @@ type mockViewUserSession struct {
 	PasswordVerification      time.Time
 	SecondFactorVerification  time.Time
 	MultiFactorVerification   time.Time
+	MagicLinkVerification     time.Time
 	Users                     []mockUser
 }
 
@@ -83,6 +84,7 @@ func (m *mockViewUserSession) UserSessionByIDs(context.Context, string, string,
 		PasswordVerification:      sql.NullTime{Time: m.PasswordVerification},
 		SecondFactorVerification:  sql.NullTime{Time: m.SecondFactorVerification},
 		MultiFactorVerification:   sql.NullTime{Time: m.MultiFactorVerification},
+		MagicLinkVerification:     sql.NullTime{Time: m.MagicLinkVerification},
 	}, nil
 }
 
@@ -258,8 +260,9 @@ func (m *mockViewErrOrg) OrgByPrimaryDomain(context.Context, string) (*query.Org
 }
 
 type mockUserGrants struct {
-	roleCheck  bool
-	userGrants int
+	roleCheck   bool
+	userGrants  int
+	groupGrants int
 }
 
 func (m *mockUserGrants) ProjectByClientID(ctx context.Context, s string) (*query.Project, error) {
@@ -274,6 +277,14 @@ func (m *mockUserGrants) UserGrantsByProjectAndUserID(ctx context.Context, s str
 	return grants, nil
 }
 
+func (m *mockUserGrants) UserGroupGrants(ctx context.Context, userID string, projectIDs []string) ([]*query.UserGrant, error) {
+	var grants []*query.UserGrant
+	if m.groupGrants > 0 {
+		grants = make([]*query.UserGrant, m.groupGrants)
+	}
+	return grants, nil
+}
+
 type mockProject struct {
 	hasProject    bool
 	projectCheck  bool
@@ -1432,6 +1443,71 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			}},
 			nil,
 		},
+		{
+			"magic link verified, not allowed, password step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					MagicLinkVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						AllowUsernamePassword: true,
+						PasswordCheckLifetime: 10 * 24 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
+		{
+			"magic link verified, mfa check step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					MagicLinkVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet: true,
+					OTPState:    int32(user_model.MFAStateReady),
+					MFAMaxSetUp: int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID: "UserID",
+					LoginPolicy: &domain.LoginPolicy{
+						AllowUsernamePassword:     true,
+						AllowMagicLink:            true,
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			}},
+			nil,
+		},
 		{
 			"mfa not verified, mfa check step",
 			fields{
@@ -1842,6 +1918,47 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required grants exist through group, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:   true,
+					groupGrants: 1,
+				},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
 		{
 			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
 			fields{
@@ -2044,17 +2161,149 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 		errFunc         func(err error) bool
 		wantMFAVerified []domain.MFAType
 	}{
-		//{
-		//	"required, prompt and false", //TODO: enable when LevelsOfAssurance is checked
-		//	fields{},
-		//	args{
-		//		request: &domain.AuthRequest{PossibleLOAs: []model.LevelOfAssurance{}},
-		//		user: &user_model.UserView{
-		//			OTPState: user_model.MFAStateReady,
-		//		},
-		//	},
-		//	false,
-		//},
+		{
+			"not set up, mfa requested by client, required prompt and false",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelNotSetUp,
+					},
+				},
+				isInternal: true,
+			},
+			&domain.MFAPromptStep{
+				Required: true,
+				MFAProviders: []domain.MFAType{
+					domain.MFATypeTOTP,
+				},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"set up, mfa requested by client, check and false",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp:    domain.MFALevelSecondFactor,
+						OTPState:       user_model.MFAStateReady,
+						MFAInitSkipped: testNow,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"checked otp, phishing resistant requested by client, check u2f and false",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+						U2FTokens: []*user_model.WebAuthNView{
+							{
+								TokenID: "tokenID",
+								State:   user_model.MFAStateReady,
+							},
+						},
+					},
+				},
+				userSession: &user_model.UserSessionView{
+					SecondFactorVerification:     testNow.Add(-5 * time.Hour),
+					SecondFactorVerificationType: domain.MFATypeTOTP,
+				},
+				isInternal: true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeU2F},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"checked u2f, phishing resistant requested by client, true",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP, domain.SecondFactorTypeU2F},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						U2FTokens: []*user_model.WebAuthNView{
+							{
+								TokenID: "tokenID",
+								State:   user_model.MFAStateReady,
+							},
+						},
+					},
+				},
+				userSession: &user_model.UserSessionView{
+					SecondFactorVerification:     testNow.Add(-5 * time.Hour),
+					SecondFactorVerificationType: domain.MFATypeU2F,
+				},
+				isInternal: true,
+			},
+			nil,
+			true,
+			nil,
+			[]domain.MFAType{domain.MFATypeU2F},
+		},
+		{
+			"not set up, phishing resistant requested by client, no u2f allowed, error",
+			args{
+				request: &domain.AuthRequest{
+					PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssurancePhishingResistant},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelNotSetUp,
+					},
+				},
+				isInternal: true,
+			},
+			nil,
+			false,
+			zerrors.IsPreconditionFailed,
+			nil,
+		},
 		{
 			"not set up, forced by policy, no mfas configured, error",
 			args{
@@ -75,6 +75,16 @@ func ProjectInstance(ctx context.Context) error {
 	return nil
 }
 
+// ResetInstance removes the data of the instance from the projections
+func ResetInstance(ctx context.Context, instanceID string) error {
+	for _, projection := range projections {
+		if err := projection.ResetInstance(ctx, instanceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (config Config) overwrite(viewModel string) handler2.Config {
 	c := handler2.Config{
 		Client:                config.Client,
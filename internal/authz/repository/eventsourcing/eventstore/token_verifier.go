@@ -231,6 +231,12 @@ func authMethodsFromSession(session *query.Session) []domain.UserAuthMethodType
 	if !session.OTPEmailFactor.OTPCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !session.PushFactor.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
+	if !session.MagicLinkFactor.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
 	return types
 }
 
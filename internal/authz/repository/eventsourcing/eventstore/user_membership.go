@@ -45,7 +45,12 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
-	return memberships.Memberships, nil
+	// roles granted to groups the user is a (indirect) member of
+	groupMemberships, err := repo.Queries.UserGroupMemberships(ctx, ctxData.UserID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return append(memberships.Memberships, groupMemberships...), nil
 }
 
 func userMembershipToMembership(membership *query.Membership) *authz.Membership {
@@ -199,6 +199,17 @@ func (c *Commands) SetExecutionEvent(ctx context.Context, cond *ExecutionEventCo
 	return c.setExecution(ctx, set, resourceOwner)
 }
 
+// ImportExecution sets the execution with the id it had in an exported instance,
+// the id contains the condition of the execution, e.g. request/zitadel.session.v2.SessionService
+func (c *Commands) ImportExecution(ctx context.Context, set *SetExecution, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	for _, target := range set.Targets {
+		if err = target.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return c.setExecution(ctx, set, resourceOwner)
+}
+
 type SetExecution struct {
 	models.ObjectRoot
 
@@ -29,6 +29,7 @@ type AuthRequest struct {
 	LoginHint        *string
 	HintUserID       *string
 	NeedRefreshToken bool
+	PossibleLOAs     []domain.LevelOfAssurance
 }
 
 type CurrentAuthRequest struct {
@@ -73,6 +74,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.LoginHint,
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
+		authRequest.PossibleLOAs,
 	))
 	if err != nil {
 		return nil, err
@@ -105,6 +107,9 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err := checkSessionSatisfiesAuthRequest(writeModel, sessionWriteModel); err != nil {
+		return nil, nil, err
+	}
 
 	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
 		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
@@ -118,6 +123,23 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// checkSessionSatisfiesAuthRequest ensures the session fulfills the level of assurance (acr_values)
+// and the authentication freshness (max_age) requested by the client.
+// A session with a login risk requiring MFA, must have been authenticated by multiple factors ([SessionWriteModel.CheckLoginRisk]).
+func checkSessionSatisfiesAuthRequest(authRequest *AuthRequestWriteModel, session *SessionWriteModel) error {
+	required := domain.RequiredLevelOfAssurance(authRequest.PossibleLOAs)
+	if domain.LevelOfAssuranceFromAuthMethods(session.AuthMethodTypes()) < required {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aec3u", "Errors.AuthRequest.LevelOfAssuranceNotSatisfied")
+	}
+	if err := session.CheckLoginRisk(); err != nil {
+		return err
+	}
+	if authRequest.MaxAge != nil && session.AuthenticationTime().Before(authRequest.CreationDate.Add(-*authRequest.MaxAge)) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooK5a", "Errors.AuthRequest.MaxAgeExceeded")
+	}
+	return nil
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -171,6 +193,7 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			MaxAge:        writeModel.MaxAge,
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
+			PossibleLOAs:  writeModel.PossibleLOAs,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
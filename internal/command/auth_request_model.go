@@ -36,6 +36,8 @@ type AuthRequestWriteModel struct {
 	AuthMethods      []domain.UserAuthMethodType
 	AuthRequestState domain.AuthRequestState
 	NeedRefreshToken bool
+	PossibleLOAs     []domain.LevelOfAssurance
+	CreationDate     time.Time
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -68,6 +70,8 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.HintUserID = e.HintUserID
 			m.AuthRequestState = domain.AuthRequestStateAdded
 			m.NeedRefreshToken = e.NeedRefreshToken
+			m.PossibleLOAs = e.PossibleLOAs
+			m.CreationDate = e.CreationDate()
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
@@ -26,6 +26,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
@@ -66,9 +67,11 @@ type Commands struct {
 	defaultAccessTokenLifetime      time.Duration
 	defaultRefreshTokenLifetime     time.Duration
 	defaultRefreshTokenIdleLifetime time.Duration
-	phoneCodeVerifier               func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	phoneCodeVerifier               func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
+	loginRisk                       *risk.Evaluator
 
 	multifactors            domain.MultifactorConfigs
+	magicLink               domain.MagicLinkConfig
 	webauthnConfig          *webauthn_helper.Config
 	keySize                 int
 	keyAlgorithm            crypto.EncryptionAlgorithm
@@ -136,6 +139,10 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("caches: %w", err)
 	}
+	loginRisk, err := risk.NewEvaluator(defaults.LoginRisk)
+	if err != nil {
+		return nil, fmt.Errorf("login risk: %w", err)
+	}
 	repo = &Commands{
 		eventstore:                      es,
 		static:                          staticStore,
@@ -175,6 +182,7 @@ func StartCommands(
 		defaultSecretGenerators:         defaultSecretGenerators,
 		samlCertificateAndKeyGenerator:  samlCertificateAndKeyGenerator(defaults.KeyConfig.CertificateSize, defaults.KeyConfig.CertificateLifetime),
 		webKeyGenerator:                 crypto.GenerateEncryptedWebKey,
+		loginRisk:                       loginRisk,
 		// always true for now until we can check with an eventlist
 		EventExisting: func(event string) bool { return true },
 		// always true for now until we can check with an eventlist
@@ -187,6 +195,13 @@ func StartCommands(
 				CryptoMFA: otpEncryption,
 				Issuer:    defaults.Multifactors.OTP.Issuer,
 			},
+			Push: domain.PushConfig{
+				ChallengeLifetime: defaults.Multifactors.Push.ChallengeLifetime,
+			},
+		},
+		magicLink: domain.MagicLinkConfig{
+			MaxRequests:     defaults.MagicLink.MaxRequests,
+			RateLimitWindow: defaults.MagicLink.RateLimitWindow,
 		},
 		GenerateDomain: domain.NewGeneratedInstanceDomain,
 		caches:         caches,
@@ -0,0 +1,224 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroup adds a group to the organization, the name must be unique inside the organization.
+func (c *Commands) AddGroup(ctx context.Context, addGroup *domain.Group, resourceOwner string) (_ *domain.Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	addGroup.Name = strings.TrimSpace(addGroup.Name)
+	if resourceOwner == "" || !addGroup.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro1i", "Errors.Group.Invalid")
+	}
+	if addGroup.AggregateID == "" {
+		addGroup.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	writeModel, err := c.groupWriteModelByID(ctx, addGroup.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.GroupStateUnspecified {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gro2a", "Errors.Group.AlreadyExists")
+	}
+	groupAgg := group.NewAggregate(addGroup.AggregateID, resourceOwner)
+	if err = c.pushAppendAndReduce(ctx, writeModel, group.NewGroupAddedEvent(ctx, &groupAgg.Aggregate, addGroup.Name, addGroup.Description)); err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroup(writeModel), nil
+}
+
+// ChangeGroup changes the name and description of the group
+func (c *Commands) ChangeGroup(ctx context.Context, changeGroup *domain.Group) (_ *domain.Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	changeGroup.Name = strings.TrimSpace(changeGroup.Name)
+	if changeGroup.AggregateID == "" || !changeGroup.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro3i", "Errors.Group.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, changeGroup.AggregateID, changeGroup.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	changes := writeModel.NewChanges(changeGroup.Name, changeGroup.Description)
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gro3c", "Errors.Group.NotChanged")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, group.NewGroupChangedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), changes)); err != nil {
+		return nil, err
+	}
+	return groupWriteModelToGroup(writeModel), nil
+}
+
+// RemoveGroup removes the group including its members and grants.
+// The group is removed from all groups it is nested in.
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro4i", "Errors.IDMissing")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	hierarchy, err := c.groupHierarchy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	parents := hierarchy.Parents(groupID)
+	cmds := make([]eventstore.Command, 0, len(parents)+1)
+	for _, parentID := range parents {
+		cmds = append(cmds, group.NewSubgroupRemovedEvent(ctx, &group.NewAggregate(parentID, writeModel.ResourceOwner).Aggregate, groupID))
+	}
+	cmds = append(cmds, group.NewGroupRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), writeModel.Name))
+	return c.pushAppendAndReduceDetails(ctx, writeModel, cmds...)
+}
+
+// AddGroupMember adds a user to the group.
+func (c *Commands) AddGroupMember(ctx context.Context, groupID, resourceOwner, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro5i", "Errors.Group.Member.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(writeModel.UserIDs, userID) {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gro5a", "Errors.Group.Member.AlreadyExists")
+	}
+	if err = c.checkUserExists(ctx, userID, ""); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, group.NewMemberAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), userID))
+}
+
+// RemoveGroupMember removes a user from the group.
+func (c *Commands) RemoveGroupMember(ctx context.Context, groupID, resourceOwner, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro6i", "Errors.Group.Member.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(writeModel.UserIDs, userID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gro6n", "Errors.Group.Member.NotFound")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, group.NewMemberRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), userID))
+}
+
+// AddSubgroup nests a group of the same organization into the group.
+// The members of the subgroup inherit the grants and memberships of the group.
+// A group cannot be nested into itself or one of its subgroups.
+func (c *Commands) AddSubgroup(ctx context.Context, groupID, resourceOwner, subgroupID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || subgroupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro7i", "Errors.Group.Member.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(writeModel.SubgroupIDs, subgroupID) {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Gro7a", "Errors.Group.Member.AlreadyExists")
+	}
+	subgroup, err := c.groupWriteModelByID(ctx, subgroupID, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !subgroup.State.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gro7p", "Errors.Group.NotFound")
+	}
+	hierarchy, err := c.groupHierarchy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if hierarchy.Contains(subgroupID, groupID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Gro7c", "Errors.Group.Member.Cycle")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, group.NewSubgroupAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), subgroupID))
+}
+
+// RemoveSubgroup removes a nested group from the group.
+func (c *Commands) RemoveSubgroup(ctx context.Context, groupID, resourceOwner, subgroupID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || subgroupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gro8i", "Errors.Group.Member.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(writeModel.SubgroupIDs, subgroupID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gro8n", "Errors.Group.Member.NotFound")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, group.NewSubgroupRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), subgroupID))
+}
+
+func (c *Commands) groupWriteModelByID(ctx context.Context, groupID, resourceOwner string) (writeModel *GroupWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewGroupWriteModel(groupID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) existingGroupWriteModelByID(ctx context.Context, groupID, resourceOwner string) (*GroupWriteModel, error) {
+	writeModel, err := c.groupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gro9n", "Errors.Group.NotFound")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) groupHierarchy(ctx context.Context, resourceOwner string) (_ *GroupHierarchyReadModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	hierarchy := NewGroupHierarchyReadModel(resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, hierarchy); err != nil {
+		return nil, err
+	}
+	return hierarchy, nil
+}
+
+func groupWriteModelToGroup(writeModel *GroupWriteModel) *domain.Group {
+	return &domain.Group{
+		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
+		State:       writeModel.State,
+		Name:        writeModel.Name,
+		Description: writeModel.Description,
+	}
+}
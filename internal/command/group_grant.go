@@ -0,0 +1,127 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroupGrant grants roles of a project to all (indirect) members of the group.
+// The project must either belong to the organization of the group or be granted to it.
+func (c *Commands) AddGroupGrant(ctx context.Context, grant *domain.GroupGrant) (_ *domain.GroupGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !grant.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GGr1i", "Errors.Group.Grant.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, grant.AggregateID, grant.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.projectGrant(grant.ProjectID, grant.ProjectGrantID) != nil {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-GGr1a", "Errors.Group.Grant.AlreadyExists")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, grant, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	grant.GrantID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		group.NewGrantAddedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), grant.GrantID, grant.ProjectID, grant.ProjectGrantID, grant.RoleKeys),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return groupGrantWriteModelToGroupGrant(writeModel, grant.GrantID), nil
+}
+
+// ChangeGroupGrant replaces the granted roles
+func (c *Commands) ChangeGroupGrant(ctx context.Context, grant *domain.GroupGrant) (_ *domain.GroupGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grant.AggregateID == "" || grant.GrantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GGr2i", "Errors.Group.Grant.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, grant.AggregateID, grant.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	existing := writeModel.grant(grant.GrantID)
+	if existing == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-GGr2n", "Errors.Group.Grant.NotFound")
+	}
+	if slices.Equal(existing.RoleKeys, grant.RoleKeys) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-GGr2c", "Errors.Group.Grant.NotChanged")
+	}
+	grant.ProjectID = existing.ProjectID
+	grant.ProjectGrantID = existing.ProjectGrantID
+	if err = c.checkGroupGrantPreCondition(ctx, grant, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		group.NewGrantChangedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), grant.GrantID, grant.RoleKeys),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return groupGrantWriteModelToGroupGrant(writeModel, grant.GrantID), nil
+}
+
+// RemoveGroupGrant removes the grant, the members of the group lose the roles unless they are granted otherwise
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, grantID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GGr3i", "Errors.Group.Grant.Invalid")
+	}
+	writeModel, err := c.existingGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.grant(grantID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-GGr3n", "Errors.Group.Grant.NotFound")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, group.NewGrantRemovedEvent(ctx, GroupAggregateFromWriteModel(&writeModel.WriteModel), grantID))
+}
+
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, grant *domain.GroupGrant, groupResourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	preConditions := NewGroupGrantPreConditionReadModel(grant.ProjectID, grant.ProjectGrantID, groupResourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, preConditions); err != nil {
+		return err
+	}
+	if !preConditions.Exists() {
+		if grant.ProjectGrantID != "" {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-GGr4g", "Errors.Project.Grant.NotFound")
+		}
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-GGr4p", "Errors.Project.NotFound")
+	}
+	if grant.HasInvalidRoles(preConditions.ExistingRoleKeys) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-GGr4r", "Errors.Project.Role.NotFound")
+	}
+	return nil
+}
+
+func groupGrantWriteModelToGroupGrant(writeModel *GroupWriteModel, grantID string) *domain.GroupGrant {
+	grant := &domain.GroupGrant{
+		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
+		GrantID:    grantID,
+	}
+	if existing := writeModel.grant(grantID); existing != nil {
+		grant.ProjectID = existing.ProjectID
+		grant.ProjectGrantID = existing.ProjectGrantID
+		grant.RoleKeys = existing.RoleKeys
+	}
+	return grant
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// GroupGrantPreConditionReadModel checks if the project (grant) exists for the organization of the group
+// and which roles can be granted
+type GroupGrantPreConditionReadModel struct {
+	eventstore.WriteModel
+
+	ProjectID          string
+	ProjectGrantID     string
+	GroupResourceOwner string
+	ProjectExists      bool
+	ProjectGrantExists bool
+	ExistingRoleKeys   []string
+}
+
+func NewGroupGrantPreConditionReadModel(projectID, projectGrantID, groupResourceOwner string) *GroupGrantPreConditionReadModel {
+	return &GroupGrantPreConditionReadModel{
+		ProjectID:          projectID,
+		ProjectGrantID:     projectGrantID,
+		GroupResourceOwner: groupResourceOwner,
+	}
+}
+
+func (rm *GroupGrantPreConditionReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			rm.ProjectExists = rm.ProjectGrantID != "" || rm.GroupResourceOwner == e.Aggregate().ResourceOwner
+		case *project.ProjectRemovedEvent:
+			rm.ProjectExists = false
+			rm.ProjectGrantExists = false
+			rm.ExistingRoleKeys = nil
+		case *project.GrantAddedEvent:
+			if rm.ProjectGrantID == e.GrantID && rm.GroupResourceOwner == e.GrantedOrgID {
+				rm.ProjectGrantExists = true
+				rm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantChangedEvent:
+			if rm.ProjectGrantID == e.GrantID {
+				rm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantCascadeChangedEvent:
+			if rm.ProjectGrantID == e.GrantID {
+				rm.ExistingRoleKeys = e.RoleKeys
+			}
+		case *project.GrantRemovedEvent:
+			if rm.ProjectGrantID == e.GrantID {
+				rm.ProjectGrantExists = false
+				rm.ExistingRoleKeys = nil
+			}
+		case *project.RoleAddedEvent:
+			if rm.ProjectGrantID == "" {
+				rm.ExistingRoleKeys = append(rm.ExistingRoleKeys, e.Key)
+			}
+		case *project.RoleRemovedEvent:
+			if rm.ProjectGrantID == "" {
+				rm.ExistingRoleKeys = slices.DeleteFunc(rm.ExistingRoleKeys, func(key string) bool { return key == e.Key })
+			}
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *GroupGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.ProjectID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.GrantAddedType,
+			project.GrantChangedType,
+			project.GrantCascadeChangedType,
+			project.GrantRemovedType,
+			project.RoleAddedType,
+			project.RoleRemovedType,
+		).
+		Builder()
+}
+
+func (rm *GroupGrantPreConditionReadModel) Exists() bool {
+	if rm.ProjectGrantID != "" {
+		return rm.ProjectExists && rm.ProjectGrantExists
+	}
+	return rm.ProjectExists
+}
@@ -0,0 +1,354 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		grant *domain.GroupGrant
+	}
+	type res struct {
+		want *domain.GroupGrant
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing project, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project already granted, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"grant1", "project1", "", []string{"key1"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					ProjectID:  "project1",
+					RoleKeys:   []string{"key2"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "project of other organization, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					ProjectID:  "project1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "role not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1", "key", "",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					ProjectID:  "project1",
+					RoleKeys:   []string{"key2"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "grant granted project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org2").Aggregate,
+								"projectgrant1", "org1", []string{"key1"},
+							),
+						),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"grant1", "project1", "projectgrant1", []string{"key1"},
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "grant1"),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot:     models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					ProjectID:      "project1",
+					ProjectGrantID: "projectgrant1",
+					RoleKeys:       []string{"key1"},
+				},
+			},
+			res: res{
+				want: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					GrantID:        "grant1",
+					ProjectID:      "project1",
+					ProjectGrantID: "projectgrant1",
+					RoleKeys:       []string{"key1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddGroupGrant(context.Background(), tt.args.grant)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		grant *domain.GroupGrant
+	}
+	type res struct {
+		want *domain.GroupGrant
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "grant not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					GrantID:    "grant1",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "roles not changed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"grant1", "project1", "", []string{"key1"},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					GrantID:    "grant1",
+					RoleKeys:   []string{"key1"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change roles, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+						eventFromEventPusher(
+							group.NewGrantAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"grant1", "project1", "", []string{"key1"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key1", "key", "",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"key2", "key", "",
+							),
+						),
+					),
+					expectPush(
+						group.NewGrantChangedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"grant1", []string{"key1", "key2"},
+						),
+					),
+				),
+			},
+			args: args{
+				grant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					GrantID:    "grant1",
+					RoleKeys:   []string{"key1", "key2"},
+				},
+			},
+			res: res{
+				want: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					GrantID:   "grant1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"key1", "key2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeGroupGrant(context.Background(), tt.args.grant)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgGroupMember adds a group of the organization as member,
+// all (indirect) members of the group get the roles on the organization.
+func (c *Commands) AddOrgGroupMember(ctx context.Context, member *domain.GroupMember) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !member.IsValid() || len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe1i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewOrgGroupMemberWriteModel(member.AggregateID, member.GroupID)
+	return c.addGroupMember(ctx, writeModel, member, func(agg *eventstore.Aggregate) eventstore.Command {
+		return org.NewGroupMemberAddedEvent(ctx, agg, member.GroupID, member.Roles...)
+	})
+}
+
+// ChangeOrgGroupMember replaces the roles of the group on the organization
+func (c *Commands) ChangeOrgGroupMember(ctx context.Context, member *domain.GroupMember) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !member.IsValid() || len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe2i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewOrgGroupMemberWriteModel(member.AggregateID, member.GroupID)
+	return c.changeGroupMember(ctx, writeModel, member, func(agg *eventstore.Aggregate) eventstore.Command {
+		return org.NewGroupMemberChangedEvent(ctx, agg, member.GroupID, member.Roles...)
+	})
+}
+
+// RemoveOrgGroupMember removes the membership of the group on the organization
+func (c *Commands) RemoveOrgGroupMember(ctx context.Context, orgID, groupID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || groupID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe3i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewOrgGroupMemberWriteModel(orgID, groupID)
+	return c.removeGroupMember(ctx, writeModel, func(agg *eventstore.Aggregate) eventstore.Command {
+		return org.NewGroupMemberRemovedEvent(ctx, agg, groupID)
+	})
+}
+
+// AddProjectGroupMember adds a group of the organization owning the project as member,
+// all (indirect) members of the group get the roles on the project.
+func (c *Commands) AddProjectGroupMember(ctx context.Context, member *domain.GroupMember, resourceOwner string) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !member.IsValid() || resourceOwner == "" || len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe4i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewProjectGroupMemberWriteModel(member.AggregateID, member.GroupID, resourceOwner)
+	return c.addGroupMember(ctx, writeModel, member, func(agg *eventstore.Aggregate) eventstore.Command {
+		return project.NewGroupMemberAddedEvent(ctx, agg, member.GroupID, member.Roles...)
+	})
+}
+
+// ChangeProjectGroupMember replaces the roles of the group on the project
+func (c *Commands) ChangeProjectGroupMember(ctx context.Context, member *domain.GroupMember, resourceOwner string) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !member.IsValid() || resourceOwner == "" || len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe5i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewProjectGroupMemberWriteModel(member.AggregateID, member.GroupID, resourceOwner)
+	return c.changeGroupMember(ctx, writeModel, member, func(agg *eventstore.Aggregate) eventstore.Command {
+		return project.NewGroupMemberChangedEvent(ctx, agg, member.GroupID, member.Roles...)
+	})
+}
+
+// RemoveProjectGroupMember removes the membership of the group on the project
+func (c *Commands) RemoveProjectGroupMember(ctx context.Context, projectID, groupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GMe6i", "Errors.Group.Membership.Invalid")
+	}
+	writeModel := NewProjectGroupMemberWriteModel(projectID, groupID, resourceOwner)
+	return c.removeGroupMember(ctx, writeModel, func(agg *eventstore.Aggregate) eventstore.Command {
+		return project.NewGroupMemberRemovedEvent(ctx, agg, groupID)
+	})
+}
+
+func (c *Commands) addGroupMember(ctx context.Context, writeModel *GroupMemberWriteModel, member *domain.GroupMember, added func(*eventstore.Aggregate) eventstore.Command) (*domain.GroupMember, error) {
+	// the group must belong to the organization of the organization or project
+	if _, err := c.existingGroupWriteModelByID(ctx, member.GroupID, writeModel.ResourceOwner); err != nil {
+		if zerrors.IsNotFound(err) {
+			return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-GMe7p", "Errors.Group.NotFound")
+		}
+		return nil, err
+	}
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.MemberStateActive {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-GMe7a", "Errors.Group.Membership.AlreadyExists")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, added(groupMemberAggregate(writeModel))); err != nil {
+		return nil, err
+	}
+	return groupMemberWriteModelToGroupMember(writeModel), nil
+}
+
+func (c *Commands) changeGroupMember(ctx context.Context, writeModel *GroupMemberWriteModel, member *domain.GroupMember, changed func(*eventstore.Aggregate) eventstore.Command) (*domain.GroupMember, error) {
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.MemberStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-GMe8n", "Errors.Group.Membership.NotFound")
+	}
+	if slices.Equal(writeModel.Roles, member.Roles) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-GMe8c", "Errors.Group.Membership.RolesNotChanged")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, changed(groupMemberAggregate(writeModel))); err != nil {
+		return nil, err
+	}
+	return groupMemberWriteModelToGroupMember(writeModel), nil
+}
+
+func (c *Commands) removeGroupMember(ctx context.Context, writeModel *GroupMemberWriteModel, removed func(*eventstore.Aggregate) eventstore.Command) (*domain.ObjectDetails, error) {
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.MemberStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-GMe9n", "Errors.Group.Membership.NotFound")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, removed(groupMemberAggregate(writeModel)))
+}
+
+func groupMemberAggregate(writeModel *GroupMemberWriteModel) *eventstore.Aggregate {
+	if writeModel.aggregateType == org.AggregateType {
+		return OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	}
+	return ProjectAggregateFromWriteModel(&writeModel.WriteModel)
+}
+
+func groupMemberWriteModelToGroupMember(writeModel *GroupMemberWriteModel) *domain.GroupMember {
+	return &domain.GroupMember{
+		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
+		GroupID:    writeModel.GroupID,
+		Roles:      writeModel.Roles,
+	}
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// GroupMemberWriteModel is the membership of a group in an organization or project
+type GroupMemberWriteModel struct {
+	eventstore.WriteModel
+
+	GroupID string
+	Roles   []string
+	State   domain.MemberState
+
+	aggregateType eventstore.AggregateType
+	eventTypes    []eventstore.EventType
+}
+
+func NewOrgGroupMemberWriteModel(orgID, groupID string) *GroupMemberWriteModel {
+	return &GroupMemberWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		GroupID:       groupID,
+		aggregateType: org.AggregateType,
+		eventTypes: []eventstore.EventType{
+			org.GroupMemberAddedEventType,
+			org.GroupMemberChangedEventType,
+			org.GroupMemberRemovedEventType,
+		},
+	}
+}
+
+func NewProjectGroupMemberWriteModel(projectID, groupID, resourceOwner string) *GroupMemberWriteModel {
+	return &GroupMemberWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		GroupID:       groupID,
+		aggregateType: project.AggregateType,
+		eventTypes: []eventstore.EventType{
+			project.GroupMemberAddedEventType,
+			project.GroupMemberChangedEventType,
+			project.GroupMemberRemovedEventType,
+		},
+	}
+}
+
+func (wm *GroupMemberWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.GroupMemberAddedEvent:
+			wm.appendEvent(e.GroupID, e)
+		case *org.GroupMemberChangedEvent:
+			wm.appendEvent(e.GroupID, e)
+		case *org.GroupMemberRemovedEvent:
+			wm.appendEvent(e.GroupID, e)
+		case *project.GroupMemberAddedEvent:
+			wm.appendEvent(e.GroupID, e)
+		case *project.GroupMemberChangedEvent:
+			wm.appendEvent(e.GroupID, e)
+		case *project.GroupMemberRemovedEvent:
+			wm.appendEvent(e.GroupID, e)
+		}
+	}
+}
+
+func (wm *GroupMemberWriteModel) appendEvent(groupID string, event eventstore.Event) {
+	if groupID != wm.GroupID {
+		return
+	}
+	wm.WriteModel.AppendEvents(event)
+}
+
+func (wm *GroupMemberWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.GroupMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.State = domain.MemberStateActive
+		case *project.GroupMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.State = domain.MemberStateActive
+		case *org.GroupMemberChangedEvent:
+			wm.Roles = e.Roles
+		case *project.GroupMemberChangedEvent:
+			wm.Roles = e.Roles
+		case *org.GroupMemberRemovedEvent, *project.GroupMemberRemovedEvent:
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(wm.aggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(wm.eventTypes...).
+		Builder()
+}
@@ -0,0 +1,249 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddOrgGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore   func(t *testing.T) *eventstore.Eventstore
+		zitadelRoles []authz.RoleMapping
+	}
+	type args struct {
+		member *domain.GroupMember
+	}
+	type res struct {
+		want *domain.GroupMember
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid role, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_OWNER"},
+				},
+			},
+			args: args{
+				member: domain.NewGroupMember("org1", "group1", "PROJECT_OWNER"),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "group of other organization, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_OWNER"},
+				},
+			},
+			args: args{
+				member: domain.NewGroupMember("org1", "group1", "ORG_OWNER"),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already member, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGroupMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"group1", "ORG_OWNER",
+							),
+						),
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_OWNER"},
+				},
+			},
+			args: args{
+				member: domain.NewGroupMember("org1", "group1", "ORG_OWNER"),
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add group member, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewGroupMemberAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"group1", "ORG_OWNER",
+						),
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{Role: "ORG_OWNER"},
+				},
+			},
+			args: args{
+				member: domain.NewGroupMember("org1", "group1", "ORG_OWNER"),
+			},
+			res: res{
+				want: &domain.GroupMember{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					GroupID: "group1",
+					Roles:   []string{"ORG_OWNER"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				zitadelRoles: tt.fields.zitadelRoles,
+			}
+			got, err := c.AddOrgGroupMember(context.Background(), tt.args.member)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveProjectGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		projectID string
+		groupID   string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing group, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				projectID: "project1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not a member, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewGroupMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"group2", "PROJECT_OWNER",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				projectID: "project1",
+				groupID:   "group1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove group member, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewGroupMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"group1", "PROJECT_OWNER",
+							),
+						),
+					),
+					expectPush(
+						project.NewGroupMemberRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"group1",
+						),
+					),
+				),
+			},
+			args: args{
+				projectID: "project1",
+				groupID:   "group1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveProjectGroupMember(context.Background(), tt.args.projectID, tt.args.groupID, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
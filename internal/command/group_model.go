@@ -0,0 +1,214 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	State       domain.GroupState
+	UserIDs     []string
+	SubgroupIDs []string
+	Grants      []*GroupGrantWriteModel
+}
+
+type GroupGrantWriteModel struct {
+	GrantID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func NewGroupWriteModel(groupID, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   groupID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.GroupAddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.State = domain.GroupStateActive
+		case *group.GroupChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+		case *group.GroupRemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.UserIDs = nil
+			wm.SubgroupIDs = nil
+			wm.Grants = nil
+		case *group.MemberAddedEvent:
+			wm.UserIDs = append(wm.UserIDs, e.UserID)
+		case *group.MemberRemovedEvent:
+			wm.UserIDs = slices.DeleteFunc(wm.UserIDs, func(userID string) bool { return userID == e.UserID })
+		case *group.SubgroupAddedEvent:
+			wm.SubgroupIDs = append(wm.SubgroupIDs, e.GroupID)
+		case *group.SubgroupRemovedEvent:
+			wm.SubgroupIDs = slices.DeleteFunc(wm.SubgroupIDs, func(groupID string) bool { return groupID == e.GroupID })
+		case *group.GrantAddedEvent:
+			wm.Grants = append(wm.Grants, &GroupGrantWriteModel{
+				GrantID:        e.GrantID,
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+			})
+		case *group.GrantChangedEvent:
+			if grant := wm.grant(e.GrantID); grant != nil {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *group.GrantRemovedEvent:
+			wm.Grants = slices.DeleteFunc(wm.Grants, func(grant *GroupGrantWriteModel) bool { return grant.GrantID == e.GrantID })
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			group.GroupAddedType,
+			group.GroupChangedType,
+			group.GroupRemovedType,
+			group.MemberAddedType,
+			group.MemberRemovedType,
+			group.SubgroupAddedType,
+			group.SubgroupRemovedType,
+			group.GrantAddedType,
+			group.GrantChangedType,
+			group.GrantRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *GroupWriteModel) NewChanges(name, description string) []group.GroupChanges {
+	changes := make([]group.GroupChanges, 0, 2)
+	if wm.Name != name {
+		changes = append(changes, group.ChangeName(wm.Name, name))
+	}
+	if wm.Description != description {
+		changes = append(changes, group.ChangeDescription(description))
+	}
+	return changes
+}
+
+func (wm *GroupWriteModel) grant(grantID string) *GroupGrantWriteModel {
+	for _, grant := range wm.Grants {
+		if grant.GrantID == grantID {
+			return grant
+		}
+	}
+	return nil
+}
+
+func (wm *GroupWriteModel) projectGrant(projectID, projectGrantID string) *GroupGrantWriteModel {
+	for _, grant := range wm.Grants {
+		if grant.ProjectID == projectID && grant.ProjectGrantID == projectGrantID {
+			return grant
+		}
+	}
+	return nil
+}
+
+func GroupAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, group.AggregateType, group.AggregateVersion)
+}
+
+// GroupHierarchyReadModel contains the nested groups of an organization
+type GroupHierarchyReadModel struct {
+	eventstore.WriteModel
+
+	// Subgroups maps the id of a group to the ids of its direct subgroups
+	Subgroups map[string][]string
+}
+
+func NewGroupHierarchyReadModel(resourceOwner string) *GroupHierarchyReadModel {
+	return &GroupHierarchyReadModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		Subgroups: make(map[string][]string),
+	}
+}
+
+func (rm *GroupHierarchyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *group.SubgroupAddedEvent:
+			rm.Subgroups[e.Aggregate().ID] = append(rm.Subgroups[e.Aggregate().ID], e.GroupID)
+		case *group.SubgroupRemovedEvent:
+			rm.Subgroups[e.Aggregate().ID] = slices.DeleteFunc(rm.Subgroups[e.Aggregate().ID], func(groupID string) bool { return groupID == e.GroupID })
+		case *group.GroupRemovedEvent:
+			delete(rm.Subgroups, e.Aggregate().ID)
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *GroupHierarchyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		EventTypes(
+			group.SubgroupAddedType,
+			group.SubgroupRemovedType,
+			group.GroupRemovedType,
+		).
+		Builder()
+}
+
+// Contains checks if the group is the same or an (indirect) subgroup of the parent
+func (rm *GroupHierarchyReadModel) Contains(parentID, groupID string) bool {
+	visited := make(map[string]struct{})
+	queue := []string{parentID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == groupID {
+			return true
+		}
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+		queue = append(queue, rm.Subgroups[current]...)
+	}
+	return false
+}
+
+// Parents returns the ids of the groups which have the group as direct subgroup
+func (rm *GroupHierarchyReadModel) Parents(groupID string) []string {
+	parents := make([]string, 0)
+	for parentID, subgroups := range rm.Subgroups {
+		if slices.Contains(subgroups, groupID) {
+			parents = append(parents, parentID)
+		}
+	}
+	slices.Sort(parents)
+	return parents
+}
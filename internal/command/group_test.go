@@ -0,0 +1,643 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func groupAddedEvent(groupID, orgID, name string) *group.GroupAddedEvent {
+	return group.NewGroupAddedEvent(context.Background(),
+		&group.NewAggregate(groupID, orgID).Aggregate,
+		name,
+		"",
+	)
+}
+
+func TestCommands_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		group         *domain.Group
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.Group
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				group: &domain.Group{Name: "name"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "empty name, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				group:         &domain.Group{Name: " "},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "name already exists, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPushFailed(zerrors.ThrowAlreadyExists(nil, "id", "Errors.Group.AlreadyExists"),
+						groupAddedEvent("group1", "org1", "name"),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args: args{
+				group:         &domain.Group{Name: "name"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						group.NewGroupAddedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"name",
+							"description",
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "group1"),
+			},
+			args: args{
+				group:         &domain.Group{Name: " name ", Description: "description"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					State:       domain.GroupStateActive,
+					Name:        "name",
+					Description: "description",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddGroup(context.Background(), tt.args.group, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		group *domain.Group
+	}
+	type res struct {
+		want *domain.Group
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				group: &domain.Group{Name: "name"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				group: &domain.Group{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       "name",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+				),
+			},
+			args: args{
+				group: &domain.Group{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       "name",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change name, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectPush(
+						group.NewGroupChangedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							[]group.GroupChanges{group.ChangeName("name", "new name")},
+						),
+					),
+				),
+			},
+			args: args{
+				group: &domain.Group{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       "new name",
+				},
+			},
+			res: res{
+				want: &domain.Group{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "group1",
+						ResourceOwner: "org1",
+					},
+					State: domain.GroupStateActive,
+					Name:  "new name",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeGroup(context.Background(), tt.args.group)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveGroup(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		groupID       string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "group not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove group nested in other group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								&group.NewAggregate("parent", "org1").Aggregate,
+								"group1",
+							),
+						),
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								&group.NewAggregate("other", "org1").Aggregate,
+								"group2",
+							),
+						),
+					),
+					expectPush(
+						group.NewSubgroupRemovedEvent(context.Background(),
+							&group.NewAggregate("parent", "org1").Aggregate,
+							"group1",
+						),
+						group.NewGroupRemovedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"name",
+						),
+					),
+				),
+			},
+			args: args{
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveGroup(context.Background(), tt.args.groupID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupMember(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		groupID string
+		userID  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				groupID: "group1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "already member, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+						eventFromEventPusher(
+							group.NewMemberAddedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"user1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "user not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add user of other organization, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(addHumanEvent(context.Background(), "org2", "user1")),
+					),
+					expectPush(
+						group.NewMemberAddedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"user1",
+						),
+					),
+				),
+			},
+			args: args{
+				groupID: "group1",
+				userID:  "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddGroupMember(context.Background(), tt.args.groupID, "org1", tt.args.userID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_AddSubgroup(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		groupID    string
+		subgroupID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing subgroup, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				groupID: "group1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "subgroup not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupID:    "group1",
+				subgroupID: "group2",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "group is itself, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupID:    "group1",
+				subgroupID: "group1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "subgroup contains group, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group3", "org1", "name3")),
+					),
+					expectFilter(
+						// group3 > group2 > group1
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								&group.NewAggregate("group3", "org1").Aggregate,
+								"group2",
+							),
+						),
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								&group.NewAggregate("group2", "org1").Aggregate,
+								"group1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				groupID:    "group1",
+				subgroupID: "group3",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add subgroup, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1", "name")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group2", "org1", "name2")),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							group.NewSubgroupAddedEvent(context.Background(),
+								&group.NewAggregate("group2", "org1").Aggregate,
+								"group3",
+							),
+						),
+					),
+					expectPush(
+						group.NewSubgroupAddedEvent(context.Background(),
+							&group.NewAggregate("group1", "org1").Aggregate,
+							"group2",
+						),
+					),
+				),
+			},
+			args: args{
+				groupID:    "group1",
+				subgroupID: "group2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddSubgroup(context.Background(), tt.args.groupID, "org1", tt.args.subgroupID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -108,6 +109,7 @@ type LDAPProvider struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	LDAPAttributes    idp.LDAPAttributes
+	SyncOptions       idp.LDAPSyncOptions
 	IDPOptions        idp.Options
 }
 
@@ -217,3 +219,16 @@ func IDPProviderWriteModel(ctx context.Context, filter preparation.FilterToQuery
 
 	return allWriteModel, err
 }
+
+func validateLDAPSyncOptions(options idp.LDAPSyncOptions) error {
+	if options.SyncInterval < 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sy3nI", "Errors.Invalid.Argument")
+	}
+	if options.SyncFilter == "" {
+		return nil
+	}
+	if err := ldap.ValidateFilter(options.SyncFilter); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Sy4nF", "Errors.IDPConfig.LDAPSyncFilterInvalid")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetInstanceIDPAttributeMappings replaces the attribute mappings of the instance identity provider.
+// An empty list removes all mappings, so the default mapping of the provider is used.
+func (c *Commands) SetInstanceIDPAttributeMappings(ctx context.Context, id string, mappings []domain.IDPAttributeMapping) (*domain.ObjectDetails, error) {
+	mappings, err := prepareIDPAttributeMappings(mappings)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ExistsInstanceIDP(ctx, c.eventstore.Filter, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "INST-Atm2n", "Errors.IDPConfig.NotExisting")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	writeModel := NewInstanceIDPAttributeMappingsWriteModel(instanceID, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.Equal(writeModel.AttributeMappings, mappings) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	instanceAgg := instance.NewAggregate(instanceID)
+	if err := c.pushAppendAndReduce(ctx, writeModel, instance.NewIDPAttributeMappingsSetEvent(ctx, &instanceAgg.Aggregate, id, mappings)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetOrgIDPAttributeMappings replaces the attribute mappings of the organization identity provider.
+// An empty list removes all mappings, so the default mapping of the provider is used.
+func (c *Commands) SetOrgIDPAttributeMappings(ctx context.Context, resourceOwner, id string, mappings []domain.IDPAttributeMapping) (*domain.ObjectDetails, error) {
+	mappings, err := prepareIDPAttributeMappings(mappings)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ExistsOrgIDP(ctx, c.eventstore.Filter, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Atm2n", "Errors.IDPConfig.NotExisting")
+	}
+	writeModel := NewOrgIDPAttributeMappingsWriteModel(resourceOwner, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if slices.Equal(writeModel.AttributeMappings, mappings) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	if err := c.pushAppendAndReduce(ctx, writeModel, org.NewIDPAttributeMappingsSetEvent(ctx, &orgAgg.Aggregate, id, mappings)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// idpAttributeMappings returns the attribute mappings of the instance or organization identity provider.
+func (c *Commands) idpAttributeMappings(ctx context.Context, resourceOwner string, instance bool, id string) ([]domain.IDPAttributeMapping, error) {
+	if instance {
+		writeModel := NewInstanceIDPAttributeMappingsWriteModel(resourceOwner, id)
+		if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		return writeModel.AttributeMappings, nil
+	}
+	writeModel := NewOrgIDPAttributeMappingsWriteModel(resourceOwner, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel.AttributeMappings, nil
+}
+
+// prepareIDPAttributeMappings trims and validates the mappings.
+// A profile field can only be targeted once, metadata keys and schema properties only once per key.
+func prepareIDPAttributeMappings(mappings []domain.IDPAttributeMapping) ([]domain.IDPAttributeMapping, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+	type target struct {
+		target domain.IDPAttributeMappingTarget
+		key    string
+	}
+	targets := make(map[target]struct{}, len(mappings))
+	prepared := make([]domain.IDPAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		mapping.Source = strings.TrimSpace(mapping.Source)
+		mapping.Key = strings.TrimSpace(mapping.Key)
+		if !mapping.IsValid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Atm1s", "Errors.IDPConfig.AttributeMappingInvalid")
+		}
+		t := target{target: mapping.Target, key: mapping.Key}
+		if _, ok := targets[t]; ok {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Atm1d", "Errors.IDPConfig.AttributeMappingDuplicateTarget")
+		}
+		targets[t] = struct{}{}
+		prepared[i] = mapping
+	}
+	return prepared, nil
+}
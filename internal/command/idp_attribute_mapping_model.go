@@ -0,0 +1,120 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type IDPAttributeMappingsWriteModel struct {
+	eventstore.WriteModel
+
+	ID                string
+	AttributeMappings []domain.IDPAttributeMapping
+}
+
+func (wm *IDPAttributeMappingsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.AttributeMappingsSetEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.AttributeMappings = e.AttributeMappings
+		case *idp.RemovedEvent:
+			if e.ID != wm.ID {
+				continue
+			}
+			wm.AttributeMappings = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+type InstanceIDPAttributeMappingsWriteModel struct {
+	IDPAttributeMappingsWriteModel
+}
+
+func NewInstanceIDPAttributeMappingsWriteModel(instanceID, id string) *InstanceIDPAttributeMappingsWriteModel {
+	return &InstanceIDPAttributeMappingsWriteModel{
+		IDPAttributeMappingsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceIDPAttributeMappingsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPAttributeMappingsSetEvent:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(&e.AttributeMappingsSetEvent)
+		case *instance.IDPRemovedEvent:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPAttributeMappingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPAttributeMappingsSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+type OrgIDPAttributeMappingsWriteModel struct {
+	IDPAttributeMappingsWriteModel
+}
+
+func NewOrgIDPAttributeMappingsWriteModel(orgID, id string) *OrgIDPAttributeMappingsWriteModel {
+	return &OrgIDPAttributeMappingsWriteModel{
+		IDPAttributeMappingsWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgIDPAttributeMappingsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.IDPAttributeMappingsSetEvent:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(&e.AttributeMappingsSetEvent)
+		case *org.IDPRemovedEvent:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.IDPAttributeMappingsWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgIDPAttributeMappingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPAttributeMappingsSetEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
@@ -0,0 +1,343 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetInstanceIDPAttributeMappings(t *testing.T) {
+	mappings := []domain.IDPAttributeMapping{
+		{
+			Source: "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+			Target: domain.IDPAttributeMappingTargetFirstName,
+		},
+		{
+			Source:    "department",
+			Target:    domain.IDPAttributeMappingTargetMetadata,
+			Key:       "department",
+			Transform: domain.IDPAttributeTransformLowercase,
+		},
+	}
+	idpAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewGoogleIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+				"id1",
+				"",
+				"clientID",
+				nil,
+				nil,
+				idp.Options{},
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		mappings []domain.IDPAttributeMapping
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid mapping, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "department", Target: domain.IDPAttributeMappingTargetMetadata},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Atm1s", ""))
+				},
+			},
+		},
+		{
+			"schema property target, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "memberOf", Target: domain.IDPAttributeMappingTargetSchemaProperty, Key: "groups"},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Atm1s", ""))
+				},
+			},
+		},
+		{
+			"duplicate target, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "given_name", Target: domain.IDPAttributeMappingTargetFirstName},
+					{Source: "first_name", Target: domain.IDPAttributeMappingTargetFirstName},
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Atm1d", ""))
+				},
+			},
+		},
+		{
+			"idp not existing, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "id1",
+				mappings: mappings,
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"no changes, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(idpAdded()),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPAttributeMappingsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"id1",
+								mappings,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "id1",
+				mappings: mappings,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			"set mappings, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(idpAdded()),
+					expectFilter(),
+					expectPush(
+						instance.NewIDPAttributeMappingsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+							"id1",
+							mappings,
+						),
+					),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "id1",
+				mappings: mappings,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			"remove mappings, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(idpAdded()),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPAttributeMappingsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"id1",
+								mappings,
+							),
+						),
+					),
+					expectPush(
+						instance.NewIDPAttributeMappingsSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+							"id1",
+							nil,
+						),
+					),
+				),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				id:       "id1",
+				mappings: nil,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetInstanceIDPAttributeMappings(tt.args.ctx, tt.args.id, tt.args.mappings)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_SetOrgIDPAttributeMappings(t *testing.T) {
+	mappings := []domain.IDPAttributeMapping{
+		{
+			Source:    "upn",
+			Target:    domain.IDPAttributeMappingTargetPreferredUsername,
+			Transform: domain.IDPAttributeTransformRemoveDomain,
+		},
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+		mappings      []domain.IDPAttributeMapping
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"idp removed, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								"",
+								"clientID",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+						eventFromEventPusher(
+							org.NewIDPRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				id:            "id1",
+				mappings:      mappings,
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"set mappings, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"id1",
+								"",
+								"clientID",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewIDPAttributeMappingsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							"id1",
+							mappings,
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				id:            "id1",
+				mappings:      mappings,
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "org1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetOrgIDPAttributeMappings(tt.args.ctx, tt.args.resourceOwner, tt.args.id, tt.args.mappings)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
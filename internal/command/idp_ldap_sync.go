@@ -0,0 +1,303 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SyncLDAPProvider synchronizes the users of the directory of the LDAP identity provider into ZITADEL:
+//   - users found in the directory but not yet linked are created and linked
+//   - linked users are updated with the information of the directory
+//   - linked users which are disabled in or removed from the directory are deactivated
+//   - deactivated users which are enabled again are reactivated
+//
+// The attribute mappings of the identity provider are applied on the directory users.
+// Errors of single users do not abort the synchronization, but are returned as part of the report.
+// If dryRun is set, nothing is pushed and the report contains the actions which would have been executed.
+// If resourceOwner is set, the identity provider must belong to it (organization or instance).
+func (c *Commands) SyncLDAPProvider(ctx context.Context, resourceOwner, idpID string, pageSize uint32, dryRun bool) (_ *domain.LDAPSyncReport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if resourceOwner != "" && writeModel.ResourceOwner != resourceOwner {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Lds0n0", "Errors.IDPConfig.NotExisting")
+	}
+	ldapWriteModel, ok := writeModel.LDAPWriteModel()
+	if !ok || ldapWriteModel.State != domain.IDPStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lds0n1", "Errors.IDPConfig.LDAPSyncNotPossible")
+	}
+	provider, err := ldapWriteModel.ToProvider("", c.idpConfigEncryption)
+	if err != nil {
+		return nil, err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lds0n2", "Errors.IDPConfig.LDAPSyncNotPossible")
+	}
+	directoryUsers, err := ldapProvider.SearchUsers(ctx, ldapWriteModel.SyncFilter, pageSize)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Lds0n3", "Errors.Internal")
+	}
+	mappings, err := c.idpAttributeMappings(ctx, writeModel.ResourceOwner, writeModel.Instance, idpID)
+	if err != nil {
+		return nil, err
+	}
+	userResourceOwner := writeModel.ResourceOwner
+	if writeModel.Instance {
+		userResourceOwner = authz.GetInstance(ctx).DefaultOrganisationID()
+	}
+	return c.syncLDAPUsers(ctx, idpID, userResourceOwner, mappings, directoryUsers, dryRun)
+}
+
+func (c *Commands) syncLDAPUsers(ctx context.Context, idpID, resourceOwner string, mappings []domain.IDPAttributeMapping, directoryUsers []*ldap.SyncUser, dryRun bool) (*domain.LDAPSyncReport, error) {
+	links := newIDPUserLinksWriteModel(idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, links); err != nil {
+		return nil, err
+	}
+	// an empty result while users are linked is most likely a misconfiguration (e.g. of the sync filter)
+	// and would lead to the deactivation of all linked users
+	if len(directoryUsers) == 0 && len(links.Links) > 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Lds0n4", "Errors.IDPConfig.LDAPSyncEmptyResult")
+	}
+
+	report := &domain.LDAPSyncReport{IDPID: idpID, DryRun: dryRun}
+	found := make(map[string]struct{}, len(directoryUsers))
+	for _, directoryUser := range directoryUsers {
+		if directoryUser.GetID() == "" {
+			continue
+		}
+		found[directoryUser.GetID()] = struct{}{}
+		externalUser := idp.MapExternalUser(idpID, directoryUser.User, mappings)
+		link := links.link(directoryUser.GetID())
+		if link == nil {
+			if result := c.createLDAPSyncUser(ctx, resourceOwner, externalUser, directoryUser.Disabled, dryRun); result != nil {
+				report.Add(result)
+			}
+			continue
+		}
+		report.Add(c.updateLDAPSyncUser(ctx, resourceOwner, link, externalUser, directoryUser.Disabled, dryRun))
+	}
+	for _, link := range links.Links {
+		if _, ok := found[link.ExternalUserID]; ok {
+			continue
+		}
+		if result := c.deactivateLDAPSyncUser(ctx, link, dryRun); result != nil {
+			report.Add(result)
+		}
+	}
+	return report, nil
+}
+
+// createLDAPSyncUser creates and links a new user for the directory user.
+// Users which are disabled in the directory are not created, in which case nil is returned.
+func (c *Commands) createLDAPSyncUser(ctx context.Context, resourceOwner string, externalUser *domain.ExternalUser, disabled, dryRun bool) *domain.LDAPSyncUserResult {
+	if disabled {
+		return nil
+	}
+	human := ldapSyncUserToAddHuman(externalUser)
+	result := &domain.LDAPSyncUserResult{
+		ExternalUserID: externalUser.ExternalUserID,
+		Username:       human.Username,
+		Action:         domain.LDAPSyncActionCreated,
+	}
+	if dryRun {
+		return result
+	}
+	if err := c.AddHuman(ctx, resourceOwner, human, false); err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+		return result
+	}
+	result.UserID = human.ID
+	return result
+}
+
+func (c *Commands) updateLDAPSyncUser(ctx context.Context, resourceOwner string, link *idpUserLink, externalUser *domain.ExternalUser, disabled, dryRun bool) *domain.LDAPSyncUserResult {
+	result := &domain.LDAPSyncUserResult{
+		UserID:         link.UserID,
+		ExternalUserID: link.ExternalUserID,
+		Username:       externalUser.PreferredUsername,
+	}
+	writeModel, err := c.userHumanWriteModel(ctx, link.UserID, true, true, true, false, false, false)
+	if err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+		return result
+	}
+	// the link still exists on a removed user, the directory user is handled as new user
+	if !isUserStateExists(writeModel.UserState) {
+		if created := c.createLDAPSyncUser(ctx, resourceOwner, externalUser, disabled, dryRun); created != nil {
+			return created
+		}
+		result.Action = domain.LDAPSyncActionUnchanged
+		return result
+	}
+	cmds, action, err := ldapSyncUserChanges(ctx, writeModel, externalUser, disabled)
+	if err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+		return result
+	}
+	result.Action = action
+	if dryRun || len(cmds) == 0 {
+		return result
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+	}
+	return result
+}
+
+// deactivateLDAPSyncUser deactivates a linked user which no longer exists in the directory.
+// Users which are already removed from ZITADEL are ignored, in which case nil is returned.
+func (c *Commands) deactivateLDAPSyncUser(ctx context.Context, link *idpUserLink, dryRun bool) *domain.LDAPSyncUserResult {
+	result := &domain.LDAPSyncUserResult{
+		UserID:         link.UserID,
+		ExternalUserID: link.ExternalUserID,
+	}
+	writeModel, err := c.userHumanWriteModel(ctx, link.UserID, false, false, false, false, false, false)
+	if err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+		return result
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil
+	}
+	if !hasUserState(writeModel.UserState, domain.UserStateActive) {
+		result.Action = domain.LDAPSyncActionUnchanged
+		return result
+	}
+	result.Action = domain.LDAPSyncActionDeactivated
+	if dryRun {
+		return result
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, user.NewUserDeactivatedEvent(ctx, &writeModel.Aggregate().Aggregate)); err != nil {
+		result.Action = domain.LDAPSyncActionFailed
+		result.Error = err
+	}
+	return result
+}
+
+// ldapSyncUserChanges compares the user with the information of the directory and returns the necessary commands.
+// Empty attributes in the directory will not reset the corresponding information of the user.
+func ldapSyncUserChanges(ctx context.Context, writeModel *UserV2WriteModel, externalUser *domain.ExternalUser, disabled bool) (_ []eventstore.Command, _ domain.LDAPSyncAction, err error) {
+	cmds, err := changeUserProfile(ctx, make([]eventstore.Command, 0), writeModel, ldapSyncUserProfile(externalUser))
+	if err != nil {
+		return nil, domain.LDAPSyncActionUnspecified, err
+	}
+	aggregate := &writeModel.Aggregate().Aggregate
+	if email := externalUser.Email.Normalize(); email != "" {
+		if email != writeModel.Email {
+			if err := email.Validate(); err != nil {
+				return nil, domain.LDAPSyncActionUnspecified, err
+			}
+			cmds = append(cmds, user.NewHumanEmailChangedEvent(ctx, aggregate, email))
+		}
+		if externalUser.IsEmailVerified && (email != writeModel.Email || !writeModel.IsEmailVerified) {
+			cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, aggregate))
+		}
+	}
+	if externalUser.Phone != "" {
+		phone, err := externalUser.Phone.Normalize()
+		if err != nil {
+			return nil, domain.LDAPSyncActionUnspecified, err
+		}
+		if phone != writeModel.Phone {
+			cmds = append(cmds, user.NewHumanPhoneChangedEvent(ctx, aggregate, phone))
+		}
+		if externalUser.IsPhoneVerified && (phone != writeModel.Phone || !writeModel.IsPhoneVerified) {
+			cmds = append(cmds, user.NewHumanPhoneVerifiedEvent(ctx, aggregate))
+		}
+	}
+	switch {
+	case disabled && hasUserState(writeModel.UserState, domain.UserStateActive):
+		return append(cmds, user.NewUserDeactivatedEvent(ctx, aggregate)), domain.LDAPSyncActionDeactivated, nil
+	case !disabled && isUserStateInactive(writeModel.UserState):
+		return append(cmds, user.NewUserReactivatedEvent(ctx, aggregate)), domain.LDAPSyncActionReactivated, nil
+	case len(cmds) > 0:
+		return cmds, domain.LDAPSyncActionUpdated, nil
+	default:
+		return cmds, domain.LDAPSyncActionUnchanged, nil
+	}
+}
+
+func ldapSyncUserProfile(externalUser *domain.ExternalUser) *Profile {
+	profile := new(Profile)
+	if firstName := externalUser.FirstName; firstName != "" {
+		profile.FirstName = &firstName
+	}
+	if lastName := externalUser.LastName; lastName != "" {
+		profile.LastName = &lastName
+	}
+	if nickName := externalUser.NickName; nickName != "" {
+		profile.NickName = &nickName
+	}
+	if displayName := externalUser.DisplayName; displayName != "" {
+		profile.DisplayName = &displayName
+	}
+	if preferredLanguage := externalUser.PreferredLanguage; preferredLanguage != language.Und {
+		profile.PreferredLanguage = &preferredLanguage
+	}
+	return profile
+}
+
+// ldapSyncUserToAddHuman maps the directory user to a new human.
+// The phone number is only taken over if it is verified, since no verification code can be sent to the user.
+func ldapSyncUserToAddHuman(externalUser *domain.ExternalUser) *AddHuman {
+	username := externalUser.PreferredUsername
+	if username == "" {
+		username = string(externalUser.Email)
+	}
+	if username == "" {
+		username = externalUser.ExternalUserID
+	}
+	human := &AddHuman{
+		Username:          username,
+		FirstName:         externalUser.FirstName,
+		LastName:          externalUser.LastName,
+		NickName:          externalUser.NickName,
+		DisplayName:       externalUser.DisplayName,
+		PreferredLanguage: externalUser.PreferredLanguage,
+		Email: Email{
+			Address:             externalUser.Email,
+			Verified:            externalUser.IsEmailVerified,
+			NoEmailVerification: true,
+		},
+		Links: []*AddLink{
+			{
+				IDPID:         externalUser.IDPConfigID,
+				DisplayName:   externalUser.PreferredUsername,
+				IDPExternalID: externalUser.ExternalUserID,
+			},
+		},
+	}
+	if externalUser.IsPhoneVerified {
+		human.Phone = Phone{
+			Number:   externalUser.Phone,
+			Verified: true,
+		}
+	}
+	for _, metadata := range externalUser.Metadatas {
+		human.Metadata = append(human.Metadata, &AddMetadataEntry{
+			Key:   metadata.Key,
+			Value: metadata.Value,
+		})
+	}
+	return human
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// IDPUserLinksWriteModel contains all users linked to an identity provider
+type IDPUserLinksWriteModel struct {
+	eventstore.WriteModel
+
+	IDPID string
+	Links []*idpUserLink
+}
+
+type idpUserLink struct {
+	UserID         string
+	ResourceOwner  string
+	ExternalUserID string
+}
+
+func newIDPUserLinksWriteModel(idpID string) *IDPUserLinksWriteModel {
+	return &IDPUserLinksWriteModel{
+		IDPID: idpID,
+	}
+}
+
+func (wm *IDPUserLinksWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserIDPLinkAddedEvent:
+			wm.Links = append(wm.Links, &idpUserLink{
+				UserID:         e.Aggregate().ID,
+				ResourceOwner:  e.Aggregate().ResourceOwner,
+				ExternalUserID: e.ExternalUserID,
+			})
+		case *user.UserIDPExternalIDMigratedEvent:
+			if link := wm.userLink(e.Aggregate().ID, e.PreviousID); link != nil {
+				link.ExternalUserID = e.NewID
+			}
+		case *user.UserIDPLinkRemovedEvent:
+			wm.removeLink(e.Aggregate().ID, e.ExternalUserID)
+		case *user.UserIDPLinkCascadeRemovedEvent:
+			wm.removeLink(e.Aggregate().ID, e.ExternalUserID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPUserLinksWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.UserIDPLinkAddedType,
+			user.UserIDPExternalIDMigratedType,
+			user.UserIDPLinkRemovedType,
+			user.UserIDPLinkCascadeRemovedType,
+		).
+		EventData(map[string]interface{}{"idpConfigId": wm.IDPID}).
+		Builder()
+}
+
+// link returns the link of the external user or nil if the external user is not linked
+func (wm *IDPUserLinksWriteModel) link(externalUserID string) *idpUserLink {
+	for _, link := range wm.Links {
+		if link.ExternalUserID == externalUserID {
+			return link
+		}
+	}
+	return nil
+}
+
+func (wm *IDPUserLinksWriteModel) userLink(userID, externalUserID string) *idpUserLink {
+	for _, link := range wm.Links {
+		if link.UserID == userID && link.ExternalUserID == externalUserID {
+			return link
+		}
+	}
+	return nil
+}
+
+func (wm *IDPUserLinksWriteModel) removeLink(userID, externalUserID string) {
+	wm.Links = slices.DeleteFunc(wm.Links, func(link *idpUserLink) bool {
+		return link.UserID == userID && link.ExternalUserID == externalUserID
+	})
+}
@@ -0,0 +1,388 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_syncLDAPUsers(t *testing.T) {
+	userAgg := user.NewAggregate("user1", "org1")
+	directoryUser := func(firstName string, disabled bool) *ldap.SyncUser {
+		return &ldap.SyncUser{
+			User: ldap.NewUser(
+				"externalID",
+				firstName,
+				"lastname",
+				"",
+				"",
+				"username",
+				"email@test.ch",
+				true,
+				"",
+				false,
+				language.English,
+				"",
+				"",
+			),
+			Disabled: disabled,
+		}
+	}
+	linkAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewUserIDPLinkAddedEvent(context.Background(), &userAgg.Aggregate, "idpID", "username", "externalID"),
+		)
+	}
+	profileChanged := func(firstName string) eventstore.Command {
+		cmd, _ := user.NewHumanProfileChangedEvent(context.Background(),
+			&userAgg.Aggregate,
+			[]user.ProfileChanges{
+				user.ChangeFirstName(firstName),
+			},
+		)
+		return cmd
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		mappings []domain.IDPAttributeMapping
+		users    []*ldap.SyncUser
+		dryRun   bool
+	}
+	type res struct {
+		report *domain.LDAPSyncReport
+		err    func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"empty directory with linked users, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"new user, created",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"idpID",
+								"ldap",
+								"clientID",
+								nil,
+								[]string{"openid"},
+								idp.Options{},
+							),
+						),
+					),
+					expectPush(
+						newAddHumanEvent("", false, true, "", language.English),
+						user.NewHumanEmailVerifiedEvent(context.Background(),
+							&userAgg.Aggregate,
+						),
+						user.NewUserIDPLinkAddedEvent(context.Background(),
+							&userAgg.Aggregate,
+							"idpID",
+							"username",
+							"externalID",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("firstname", false)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionCreated},
+					},
+				},
+			},
+		},
+		{
+			"new disabled user, ignored",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("firstname", true)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+				},
+			},
+		},
+		{
+			"linked user unchanged",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("firstname", false)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionUnchanged},
+					},
+				},
+			},
+		},
+		{
+			"linked user changed, updated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+					expectPush(
+						profileChanged("changed"),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("changed", false)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionUpdated},
+					},
+				},
+			},
+		},
+		{
+			"linked user changed by attribute mapping, updated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+					expectPush(
+						profileChanged("lastname"),
+					),
+				),
+			},
+			args{
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "lastName", Target: domain.IDPAttributeMappingTargetFirstName},
+				},
+				users: []*ldap.SyncUser{directoryUser("firstname", false)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionUpdated},
+					},
+				},
+			},
+		},
+		{
+			"linked user changed, dry run",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+				),
+			},
+			args{
+				users:  []*ldap.SyncUser{directoryUser("changed", false)},
+				dryRun: true,
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID:  "idpID",
+					DryRun: true,
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionUpdated},
+					},
+				},
+			},
+		},
+		{
+			"linked user disabled in directory, deactivated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(context.Background(), &userAgg.Aggregate),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("firstname", true)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionDeactivated},
+					},
+				},
+			},
+		},
+		{
+			"deactivated user enabled in directory, reactivated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+						eventFromEventPusher(user.NewUserDeactivatedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+					expectPush(
+						user.NewUserReactivatedEvent(context.Background(), &userAgg.Aggregate),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{directoryUser("firstname", false)},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Username: "username", Action: domain.LDAPSyncActionReactivated},
+					},
+				},
+			},
+		},
+		{
+			"linked user removed from directory, deactivated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(linkAdded()),
+					expectFilter(
+						eventFromEventPusher(newAddHumanEvent("", false, true, "", language.English)),
+						eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), &userAgg.Aggregate)),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(context.Background(), &userAgg.Aggregate),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{
+					{User: &ldap.User{ID: "otherID"}, Disabled: true},
+				},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+					Users: []*domain.LDAPSyncUserResult{
+						{UserID: "user1", ExternalUserID: "externalID", Action: domain.LDAPSyncActionDeactivated},
+					},
+				},
+			},
+		},
+		{
+			"unlinked user not in directory, ignored",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						linkAdded(),
+						eventFromEventPusher(
+							user.NewUserIDPLinkRemovedEvent(context.Background(), &userAgg.Aggregate, "idpID", "externalID"),
+						),
+					),
+				),
+			},
+			args{
+				users: []*ldap.SyncUser{
+					{User: &ldap.User{ID: "otherID"}, Disabled: true},
+				},
+			},
+			res{
+				report: &domain.LDAPSyncReport{
+					IDPID: "idpID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			report, err := c.syncLDAPUsers(context.Background(), "idpID", "org1", tt.args.mappings, tt.args.users, tt.args.dryRun)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.report, report)
+			}
+		})
+	}
+}
@@ -1367,6 +1367,7 @@ type LDAPIDPWriteModel struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	idp.LDAPAttributes
+	idp.LDAPSyncOptions
 	idp.Options
 
 	State domain.IDPState
@@ -1407,6 +1408,7 @@ func (wm *LDAPIDPWriteModel) reduceAddedEvent(e *idp.LDAPIDPAddedEvent) {
 	wm.UserFilters = e.UserFilters
 	wm.Timeout = e.Timeout
 	wm.LDAPAttributes = e.LDAPAttributes
+	wm.LDAPSyncOptions = e.LDAPSyncOptions
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -1446,6 +1448,7 @@ func (wm *LDAPIDPWriteModel) reduceChangedEvent(e *idp.LDAPIDPChangedEvent) {
 		wm.Timeout = *e.Timeout
 	}
 	wm.LDAPAttributes.ReduceChanges(e.LDAPAttributeChanges)
+	wm.LDAPSyncOptions.ReduceChanges(e.LDAPSyncOptionChanges)
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -1462,6 +1465,7 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	timeout time.Duration,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
+	syncOptions idp.LDAPSyncOptions,
 	options idp.Options,
 ) ([]idp.LDAPIDPChanges, error) {
 	changes := make([]idp.LDAPIDPChanges, 0)
@@ -1505,6 +1509,10 @@ func (wm *LDAPIDPWriteModel) NewChanges(
 	if !attrs.IsZero() {
 		changes = append(changes, idp.ChangeLDAPAttributes(attrs))
 	}
+	syncOpts := wm.LDAPSyncOptions.Changes(syncOptions)
+	if !syncOpts.IsZero() {
+		changes = append(changes, idp.ChangeLDAPSyncOptions(syncOpts))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeLDAPOptions(opts))
@@ -2275,3 +2283,15 @@ func (wm *AllIDPWriteModel) ToSAMLProvider(callbackURL string, idpAlg crypto.Enc
 	}
 	return wm.samlModel.ToProvider(callbackURL, idpAlg, getRequest, addRequest)
 }
+
+// LDAPWriteModel returns the underlying LDAP write model, if the identity provider is of type LDAP
+func (wm *AllIDPWriteModel) LDAPWriteModel() (*LDAPIDPWriteModel, bool) {
+	switch model := wm.model.(type) {
+	case *InstanceLDAPIDPWriteModel:
+		return &model.LDAPIDPWriteModel, true
+	case *OrgLDAPIDPWriteModel:
+		return &model.LDAPIDPWriteModel, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,564 @@
+package command
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// instanceBackupVersion is the version of the archive format written by [Commands.BackupInstance]
+const instanceBackupVersion = 1
+
+// InstanceBackup describes the content of an instance backup
+type InstanceBackup struct {
+	InstanceID string
+	CreatedAt  time.Time
+	// Position of the latest event in the backup
+	Position          float64
+	Events            int
+	UniqueConstraints int
+	PersonalDataKeys  int
+	Assets            int
+}
+
+// InstanceRestore defines how a backup is restored
+type InstanceRestore struct {
+	// InstanceID the backup is restored to, the instance of the backup is used if empty.
+	// If it differs from the instance of the backup, a new generated domain is added to the instance.
+	InstanceID string
+	// Position restores the events up to and including the position, all events are restored if zero
+	Position float64
+	// Replace allows to restore into an existing instance
+	Replace bool
+}
+
+// BackupInstance writes all data of the instance needed to restore it to w.
+// The backup is a gzip compressed stream of JSON records containing the events, unique constraints,
+// personal data keys and assets of the instance.
+// The events and keys are stored as they are in the eventstore,
+// so the backup can only be restored with the same encryption keys.
+func (c *Commands) BackupInstance(ctx context.Context, instanceID string, w io.Writer) (_ *InstanceBackup, err error) {
+	writeModel, err := c.getInstanceWriteModelByID(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.InstanceStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMA-Aig8o", "Errors.Instance.NotFound")
+	}
+
+	archive := newInstanceBackupWriter(w)
+	backup := &InstanceBackup{
+		InstanceID: instanceID,
+		CreatedAt:  time.Now(),
+	}
+	if err = archive.write(&instanceBackupRecord{Header: &instanceBackupHeader{Version: instanceBackupVersion, InstanceID: instanceID, CreatedAt: backup.CreatedAt}}); err != nil {
+		return nil, err
+	}
+	if backup.Assets, err = c.backupInstanceAssets(ctx, instanceID, archive); err != nil {
+		return nil, err
+	}
+	if backup.Position, err = c.eventstore.ExportInstance(ctx, instanceID, archive); err != nil {
+		return nil, err
+	}
+	backup.Events, backup.UniqueConstraints, backup.PersonalDataKeys = archive.events, archive.uniqueConstraints, archive.personalDataKeys
+	err = archive.write(&instanceBackupRecord{Trailer: &instanceBackupTrailer{
+		Position:          backup.Position,
+		Events:            backup.Events,
+		UniqueConstraints: backup.UniqueConstraints,
+		PersonalDataKeys:  backup.PersonalDataKeys,
+		Assets:            backup.Assets,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if err = archive.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMA-Oob0e", "Errors.Internal")
+	}
+	return backup, nil
+}
+
+func (c *Commands) backupInstanceAssets(ctx context.Context, instanceID string, archive *instanceBackupWriter) (int, error) {
+	if c.static == nil {
+		return 0, nil
+	}
+	assets, err := c.static.ListInstanceObjects(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+	for _, asset := range assets {
+		data, _, err := c.static.GetObject(ctx, instanceID, asset.ResourceOwner, asset.Name)
+		if err != nil {
+			return 0, err
+		}
+		err = archive.write(&instanceBackupRecord{Asset: &instanceBackupAsset{
+			ResourceOwner: asset.ResourceOwner,
+			Name:          asset.Name,
+			ContentType:   asset.ContentType,
+			Location:      asset.Location,
+			Type:          asset.Type,
+			Data:          data,
+		}})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(assets), nil
+}
+
+// RestoreInstance restores a backup created by [Commands.BackupInstance].
+// The current data of the instance are replaced in a single transaction, the projections
+// of the instance must be reset afterwards to reflect the restored state.
+// If events after restore.Position are omitted, the unique constraints of the restored events are rebuilt
+// in addition to the unique constraints at the time of the backup (see [restoredUniqueConstraintsReadModel]).
+func (c *Commands) RestoreInstance(ctx context.Context, r io.Reader, restore *InstanceRestore) (_ *InstanceBackup, err error) {
+	archive, err := newInstanceBackupReader(r)
+	if err != nil {
+		return nil, err
+	}
+	header, err := archive.header()
+	if err != nil {
+		return nil, err
+	}
+	assets, err := archive.assets()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID := restore.InstanceID
+	if instanceID == "" {
+		instanceID = header.InstanceID
+	}
+	existing, err := c.getInstanceWriteModelByID(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.InstanceStateUnspecified && !restore.Replace {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMA-ieT3o", "Errors.Instance.AlreadyExists")
+	}
+	// the domains of the existing instance are globally unique and therefore not part of its data
+	release := make([]*eventstore.BackupUniqueConstraint, 0, len(existing.Domains))
+	if existing.State.Exists() {
+		for _, instanceDomain := range existing.Domains {
+			release = append(release, &eventstore.BackupUniqueConstraint{UniqueType: instance.UniqueInstanceDomain, UniqueField: instanceDomain, IsGlobal: true})
+		}
+	}
+
+	restorer := newInstanceRestoreReader(archive, header.InstanceID, instanceID, restore.Position)
+	if err = c.eventstore.ImportInstance(ctx, instanceID, release, restorer); err != nil {
+		return nil, err
+	}
+	if restorer.omitted > 0 {
+		if err = c.rebuildRestoredUniqueConstraints(ctx, instanceID); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.restoreInstanceAssets(ctx, header.InstanceID, instanceID, assets); err != nil {
+		return nil, err
+	}
+	err = c.caches.milestones.Invalidate(ctx, milestoneIndexInstanceID, instanceID)
+	logging.OnError(err).Error("milestone invalidate")
+
+	if restorer.remap {
+		if err = c.addRestoredInstanceDomain(authz.WithInstanceID(ctx, instanceID), instanceID); err != nil {
+			return nil, err
+		}
+	}
+	return &InstanceBackup{
+		InstanceID:        instanceID,
+		CreatedAt:         header.CreatedAt,
+		Position:          restorer.position,
+		Events:            restorer.events,
+		UniqueConstraints: archive.trailer.UniqueConstraints,
+		PersonalDataKeys:  archive.trailer.PersonalDataKeys,
+		Assets:            len(assets),
+	}, nil
+}
+
+func (c *Commands) restoreInstanceAssets(ctx context.Context, from, to string, assets []*instanceBackupAsset) error {
+	if c.static == nil {
+		return nil
+	}
+	err := c.static.RemoveInstanceObjects(ctx, to)
+	logging.WithFields("instance", to).OnError(err).Debug("unable to remove assets of instance")
+	for _, asset := range assets {
+		resourceOwner := asset.ResourceOwner
+		if resourceOwner == from {
+			resourceOwner = to
+		}
+		_, err = c.static.PutObject(ctx, to, asset.Location, resourceOwner, asset.Name, asset.ContentType, asset.Type, bytes.NewReader(asset.Data), int64(len(asset.Data)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildRestoredUniqueConstraints adds the unique constraints of the restored events,
+// which were removed by the events omitted by the restore
+func (c *Commands) rebuildRestoredUniqueConstraints(ctx context.Context, instanceID string) error {
+	readModel := newRestoredUniqueConstraintsReadModel(instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return err
+	}
+	return c.eventstore.ImportUniqueConstraints(ctx, instanceID, readModel.UniqueConstraints())
+}
+
+// addRestoredInstanceDomain adds a generated domain to an instance restored under a new id,
+// the domains of the backup still belong to the original instance
+func (c *Commands) addRestoredInstanceDomain(ctx context.Context, instanceID string) error {
+	writeModel, err := c.getInstanceWriteModelByID(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	validations, err := c.addGeneratedInstanceDomain(ctx, instance.NewAggregate(instanceID), writeModel.Name)
+	if err != nil {
+		return err
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validations...)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+type instanceBackupRecord struct {
+	Header           *instanceBackupHeader              `json:"header,omitempty"`
+	Asset            *instanceBackupAsset               `json:"asset,omitempty"`
+	Event            *eventstore.BackupEvent            `json:"event,omitempty"`
+	UniqueConstraint *eventstore.BackupUniqueConstraint `json:"uniqueConstraint,omitempty"`
+	PersonalDataKey  *eventstore.BackupPersonalDataKey  `json:"personalDataKey,omitempty"`
+	Trailer          *instanceBackupTrailer             `json:"trailer,omitempty"`
+}
+
+type instanceBackupHeader struct {
+	Version    int       `json:"version"`
+	InstanceID string    `json:"instanceId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type instanceBackupAsset struct {
+	ResourceOwner string            `json:"resourceOwner"`
+	Name          string            `json:"name"`
+	ContentType   string            `json:"contentType"`
+	Location      string            `json:"location,omitempty"`
+	Type          static.ObjectType `json:"type"`
+	Data          []byte            `json:"data"`
+}
+
+// instanceBackupTrailer is the last record of a backup, it is used to verify the backup is complete
+type instanceBackupTrailer struct {
+	Position          float64 `json:"position"`
+	Events            int     `json:"events"`
+	UniqueConstraints int     `json:"uniqueConstraints"`
+	PersonalDataKeys  int     `json:"personalDataKeys"`
+	Assets            int     `json:"assets"`
+}
+
+var _ eventstore.InstanceExportWriter = (*instanceBackupWriter)(nil)
+
+type instanceBackupWriter struct {
+	*gzip.Writer
+	encoder *json.Encoder
+
+	events            int
+	uniqueConstraints int
+	personalDataKeys  int
+}
+
+func newInstanceBackupWriter(w io.Writer) *instanceBackupWriter {
+	compressor := gzip.NewWriter(w)
+	return &instanceBackupWriter{
+		Writer:  compressor,
+		encoder: json.NewEncoder(compressor),
+	}
+}
+
+func (w *instanceBackupWriter) write(record *instanceBackupRecord) error {
+	if err := w.encoder.Encode(record); err != nil {
+		return zerrors.ThrowInternal(err, "COMMA-wu4Ch", "Errors.Internal")
+	}
+	return nil
+}
+
+// WriteEvent implements [eventstore.InstanceExportWriter]
+func (w *instanceBackupWriter) WriteEvent(event *eventstore.BackupEvent) error {
+	w.events++
+	return w.write(&instanceBackupRecord{Event: event})
+}
+
+// WriteUniqueConstraint implements [eventstore.InstanceExportWriter]
+func (w *instanceBackupWriter) WriteUniqueConstraint(constraint *eventstore.BackupUniqueConstraint) error {
+	w.uniqueConstraints++
+	return w.write(&instanceBackupRecord{UniqueConstraint: constraint})
+}
+
+// WritePersonalDataKey implements [eventstore.InstanceExportWriter]
+func (w *instanceBackupWriter) WritePersonalDataKey(key *eventstore.BackupPersonalDataKey) error {
+	w.personalDataKeys++
+	return w.write(&instanceBackupRecord{PersonalDataKey: key})
+}
+
+var _ eventstore.InstanceImportReader = (*instanceBackupReader)(nil)
+
+// instanceBackupReader reads the records of a backup in the order they were written.
+// The counts of the trailer are verified as soon as all personal data keys are read,
+// so an incomplete backup fails the import.
+type instanceBackupReader struct {
+	decoder *json.Decoder
+	peeked  *instanceBackupRecord
+	trailer *instanceBackupTrailer
+
+	events            int
+	uniqueConstraints int
+	personalDataKeys  int
+}
+
+func newInstanceBackupReader(r io.Reader) (*instanceBackupReader, error) {
+	decompressor, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMA-aiX4e", "Errors.Instance.Backup.Invalid")
+	}
+	return &instanceBackupReader{decoder: json.NewDecoder(decompressor)}, nil
+}
+
+func (r *instanceBackupReader) peek() (*instanceBackupRecord, error) {
+	if r.peeked != nil {
+		return r.peeked, nil
+	}
+	record := new(instanceBackupRecord)
+	if err := r.decoder.Decode(record); err != nil {
+		// the trailer is required, so the backup must not end before
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMA-Ea2ah", "Errors.Instance.Backup.Invalid")
+	}
+	r.peeked = record
+	return record, nil
+}
+
+func (r *instanceBackupReader) next() (*instanceBackupRecord, error) {
+	record, err := r.peek()
+	r.peeked = nil
+	return record, err
+}
+
+func (r *instanceBackupReader) header() (*instanceBackupHeader, error) {
+	record, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	if record.Header == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMA-Zei7u", "Errors.Instance.Backup.Invalid")
+	}
+	if record.Header.Version != instanceBackupVersion {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMA-Ohph8", "Errors.Instance.Backup.VersionNotSupported")
+	}
+	return record.Header, nil
+}
+
+func (r *instanceBackupReader) assets() (assets []*instanceBackupAsset, err error) {
+	for {
+		record, err := r.peek()
+		if err != nil {
+			return nil, err
+		}
+		if record.Asset == nil {
+			return assets, nil
+		}
+		r.peeked = nil
+		assets = append(assets, record.Asset)
+	}
+}
+
+// NextEvent implements [eventstore.InstanceImportReader]
+func (r *instanceBackupReader) NextEvent() (*eventstore.BackupEvent, error) {
+	record, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+	if record.Event == nil {
+		return nil, io.EOF
+	}
+	r.peeked = nil
+	r.events++
+	return record.Event, nil
+}
+
+// NextUniqueConstraint implements [eventstore.InstanceImportReader]
+func (r *instanceBackupReader) NextUniqueConstraint() (*eventstore.BackupUniqueConstraint, error) {
+	record, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+	if record.UniqueConstraint == nil {
+		return nil, io.EOF
+	}
+	r.peeked = nil
+	r.uniqueConstraints++
+	return record.UniqueConstraint, nil
+}
+
+// NextPersonalDataKey implements [eventstore.InstanceImportReader]
+func (r *instanceBackupReader) NextPersonalDataKey() (*eventstore.BackupPersonalDataKey, error) {
+	record, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	if record.PersonalDataKey != nil {
+		r.personalDataKeys++
+		return record.PersonalDataKey, nil
+	}
+	if err = r.verify(record.Trailer); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (r *instanceBackupReader) verify(trailer *instanceBackupTrailer) error {
+	if trailer == nil ||
+		trailer.Events != r.events ||
+		trailer.UniqueConstraints != r.uniqueConstraints ||
+		trailer.PersonalDataKeys != r.personalDataKeys {
+		return zerrors.ThrowInvalidArgument(nil, "COMMA-sha3O", "Errors.Instance.Backup.Invalid")
+	}
+	// the trailer must be the last record, reading to the end verifies the checksum of the archive
+	if err := r.decoder.Decode(new(instanceBackupRecord)); !errors.Is(err, io.EOF) {
+		return zerrors.ThrowInvalidArgument(err, "COMMA-Ieb4e", "Errors.Instance.Backup.Invalid")
+	}
+	r.trailer = trailer
+	return nil
+}
+
+var _ eventstore.InstanceImportReader = (*instanceRestoreReader)(nil)
+
+// instanceRestoreReader prepares the data of a backup for the import into an instance.
+// Events after the position are omitted, if the data are restored into another instance
+// the instance id is replaced and the domains of the original instance are omitted.
+// Otherwise the domains of the instance are restored as global unique constraints.
+type instanceRestoreReader struct {
+	*instanceBackupReader
+	from, to string
+	remap    bool
+	until    float64
+
+	domains  map[string]bool
+	released []*eventstore.BackupUniqueConstraint
+
+	// position of the last restored event
+	position float64
+	events   int
+	// omitted is the count of events after the position
+	omitted int
+}
+
+func newInstanceRestoreReader(r *instanceBackupReader, from, to string, until float64) *instanceRestoreReader {
+	return &instanceRestoreReader{
+		instanceBackupReader: r,
+		from:                 from,
+		to:                   to,
+		remap:                from != to,
+		until:                until,
+		domains:              make(map[string]bool),
+	}
+}
+
+// NextEvent implements [eventstore.InstanceImportReader]
+func (r *instanceRestoreReader) NextEvent() (*eventstore.BackupEvent, error) {
+	for {
+		event, err := r.instanceBackupReader.NextEvent()
+		if err != nil {
+			return nil, err
+		}
+		if r.until > 0 && event.Position > r.until {
+			r.omitted++
+			continue
+		}
+		if event.AggregateType == instance.AggregateType {
+			skip, err := r.reduceDomain(event)
+			if err != nil {
+				return nil, err
+			}
+			if skip {
+				continue
+			}
+		}
+		r.position = event.Position
+		r.events++
+		if r.remap {
+			event.AggregateID = r.replaceInstanceID(event.AggregateID)
+			event.Owner = r.replaceInstanceID(event.Owner)
+		}
+		return event, nil
+	}
+}
+
+// reduceDomain tracks the domains of the instance and returns if the event must be omitted
+func (r *instanceRestoreReader) reduceDomain(event *eventstore.BackupEvent) (skip bool, err error) {
+	switch event.EventType {
+	case instance.InstanceDomainAddedEventType, instance.InstanceDomainRemovedEventType:
+		payload := new(struct {
+			Domain string `json:"domain"`
+		})
+		if err = json.Unmarshal(event.Payload, payload); err != nil {
+			return false, zerrors.ThrowInvalidArgument(err, "COMMA-Chu7i", "Errors.Instance.Backup.Invalid")
+		}
+		r.domains[payload.Domain] = event.EventType == instance.InstanceDomainAddedEventType
+		return r.remap, nil
+	case instance.InstanceDomainPrimarySetEventType:
+		return r.remap, nil
+	case instance.InstanceRemovedEventType:
+		r.domains = make(map[string]bool)
+	}
+	return false, nil
+}
+
+// NextUniqueConstraint implements [eventstore.InstanceImportReader]
+func (r *instanceRestoreReader) NextUniqueConstraint() (*eventstore.BackupUniqueConstraint, error) {
+	constraint, err := r.instanceBackupReader.NextUniqueConstraint()
+	if !errors.Is(err, io.EOF) || r.remap {
+		return constraint, err
+	}
+	if r.released == nil {
+		r.released = r.domainConstraints()
+	}
+	if len(r.released) == 0 {
+		return nil, io.EOF
+	}
+	constraint, r.released = r.released[0], r.released[1:]
+	return constraint, nil
+}
+
+func (r *instanceRestoreReader) domainConstraints() []*eventstore.BackupUniqueConstraint {
+	constraints := make([]*eventstore.BackupUniqueConstraint, 0, len(r.domains))
+	for instanceDomain, active := range r.domains {
+		if !active {
+			continue
+		}
+		constraints = append(constraints, &eventstore.BackupUniqueConstraint{UniqueType: instance.UniqueInstanceDomain, UniqueField: instanceDomain, IsGlobal: true})
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		return constraints[i].UniqueField < constraints[j].UniqueField
+	})
+	return constraints
+}
+
+func (r *instanceRestoreReader) replaceInstanceID(id string) string {
+	if id == r.from {
+		return r.to
+	}
+	return id
+}
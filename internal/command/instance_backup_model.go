@@ -0,0 +1,85 @@
+package command
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+// restoredUniqueConstraintsReadModel replays the unique constraints of the events of a restored instance.
+// Values which are only known by the command creating the event (e.g. the previous username) are not part of the stored events,
+// therefore the replayed constraints complement the constraints at the time of the backup instead of replacing them.
+type restoredUniqueConstraintsReadModel struct {
+	eventstore.WriteModel
+
+	constraints map[eventstore.BackupUniqueConstraint]struct{}
+}
+
+func newRestoredUniqueConstraintsReadModel(instanceID string) *restoredUniqueConstraintsReadModel {
+	return &restoredUniqueConstraintsReadModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		constraints: make(map[eventstore.BackupUniqueConstraint]struct{}),
+	}
+}
+
+func (rm *restoredUniqueConstraintsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		e, ok := event.(interface {
+			UniqueConstraints() []*eventstore.UniqueConstraint
+		})
+		if !ok {
+			continue
+		}
+		for _, constraint := range e.UniqueConstraints() {
+			if constraint == nil {
+				continue
+			}
+			rm.reduceConstraint(constraint)
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *restoredUniqueConstraintsReadModel) reduceConstraint(constraint *eventstore.UniqueConstraint) {
+	key := eventstore.BackupUniqueConstraint{
+		UniqueType:  constraint.UniqueType,
+		UniqueField: strings.ToLower(constraint.UniqueField),
+		IsGlobal:    constraint.IsGlobal,
+	}
+	switch constraint.Action {
+	case eventstore.UniqueConstraintAdd:
+		rm.constraints[key] = struct{}{}
+	case eventstore.UniqueConstraintRemove:
+		delete(rm.constraints, key)
+	case eventstore.UniqueConstraintInstanceRemove:
+		for existing := range rm.constraints {
+			if !existing.IsGlobal {
+				delete(rm.constraints, existing)
+			}
+		}
+	}
+}
+
+func (rm *restoredUniqueConstraintsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(rm.InstanceID).
+		OrderAsc()
+}
+
+// UniqueConstraints returns the replayed constraints ordered by type and field
+func (rm *restoredUniqueConstraintsReadModel) UniqueConstraints() []*eventstore.BackupUniqueConstraint {
+	constraints := make([]*eventstore.BackupUniqueConstraint, 0, len(rm.constraints))
+	for constraint := range rm.constraints {
+		constraints = append(constraints, &constraint)
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		if constraints[i].UniqueType != constraints[j].UniqueType {
+			return constraints[i].UniqueType < constraints[j].UniqueType
+		}
+		return constraints[i].UniqueField < constraints[j].UniqueField
+	})
+	return constraints
+}
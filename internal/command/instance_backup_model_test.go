@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+func TestRestoredUniqueConstraintsReadModel_Reduce(t *testing.T) {
+	ctx := context.Background()
+	instanceAgg := &instance.NewAggregate("instance").Aggregate
+	orgAgg := &org.NewAggregate("org").Aggregate
+	tests := []struct {
+		name   string
+		events []eventstore.Command
+		want   []*eventstore.BackupUniqueConstraint
+	}{
+		{
+			name: "added",
+			events: []eventstore.Command{
+				instance.NewDomainAddedEvent(ctx, instanceAgg, "zitadel.cloud", false),
+				org.NewOrgAddedEvent(ctx, orgAgg, "ZITADEL"),
+			},
+			want: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: instance.UniqueInstanceDomain, UniqueField: "zitadel.cloud", IsGlobal: true},
+				{UniqueType: "org_name", UniqueField: "zitadel"},
+			},
+		},
+		{
+			name: "removed",
+			events: []eventstore.Command{
+				org.NewOrgAddedEvent(ctx, orgAgg, "ZITADEL"),
+				org.NewOrgChangedEvent(ctx, orgAgg, "ZITADEL", "caos"),
+			},
+			want: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: "org_name", UniqueField: "caos"},
+			},
+		},
+		{
+			name: "instance removed",
+			events: []eventstore.Command{
+				instance.NewDomainAddedEvent(ctx, instanceAgg, "zitadel.cloud", false),
+				org.NewOrgAddedEvent(ctx, orgAgg, "ZITADEL"),
+				instance.NewInstanceRemovedEvent(ctx, instanceAgg, "ZITADEL", nil),
+			},
+			want: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: instance.UniqueInstanceDomain, UniqueField: "zitadel.cloud", IsGlobal: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := newRestoredUniqueConstraintsReadModel("instance")
+			for _, event := range tt.events {
+				rm.AppendEvents(event.(eventstore.Event))
+			}
+			require.NoError(t, rm.Reduce())
+			assert.Equal(t, tt.want, rm.UniqueConstraints())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package command
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func writeTestInstanceBackup(t *testing.T, trailer *instanceBackupTrailer, events ...*eventstore.BackupEvent) []byte {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	w := newInstanceBackupWriter(buf)
+	require.NoError(t, w.write(&instanceBackupRecord{Header: &instanceBackupHeader{Version: instanceBackupVersion, InstanceID: "instance", CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}}))
+	require.NoError(t, w.write(&instanceBackupRecord{Asset: &instanceBackupAsset{ResourceOwner: "instance", Name: "policy/label/logo", ContentType: "image/png", Type: static.ObjectTypeStyling, Data: []byte("logo")}}))
+	for _, event := range events {
+		require.NoError(t, w.WriteEvent(event))
+	}
+	require.NoError(t, w.WriteUniqueConstraint(&eventstore.BackupUniqueConstraint{UniqueType: "usernames", UniqueField: "gigi"}))
+	require.NoError(t, w.WritePersonalDataKey(&eventstore.BackupPersonalDataKey{SubjectID: "user1", KeyID: "key1", Key: []byte("key")}))
+	if trailer == nil {
+		trailer = &instanceBackupTrailer{Position: 3, Events: w.events, UniqueConstraints: w.uniqueConstraints, PersonalDataKeys: w.personalDataKeys, Assets: 1}
+	}
+	require.NoError(t, w.write(&instanceBackupRecord{Trailer: trailer}))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func readAllEvents(t *testing.T, r eventstore.InstanceImportReader) []*eventstore.BackupEvent {
+	t.Helper()
+	var events []*eventstore.BackupEvent
+	for {
+		event, err := r.NextEvent()
+		if errors.Is(err, io.EOF) {
+			return events
+		}
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+}
+
+func readAllConstraints(t *testing.T, r eventstore.InstanceImportReader) []*eventstore.BackupUniqueConstraint {
+	t.Helper()
+	var constraints []*eventstore.BackupUniqueConstraint
+	for {
+		constraint, err := r.NextUniqueConstraint()
+		if errors.Is(err, io.EOF) {
+			return constraints
+		}
+		require.NoError(t, err)
+		constraints = append(constraints, constraint)
+	}
+}
+
+// readInstanceBackup reads all records of the backup and returns the first error
+func readInstanceBackup(r *instanceBackupReader) (err error) {
+	if _, err = r.header(); err != nil {
+		return err
+	}
+	if _, err = r.assets(); err != nil {
+		return err
+	}
+	for err == nil {
+		_, err = r.NextEvent()
+	}
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+	for err = nil; err == nil; {
+		_, err = r.NextUniqueConstraint()
+	}
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+	for err = nil; err == nil; {
+		_, err = r.NextPersonalDataKey()
+	}
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+func testBackupEvents() []*eventstore.BackupEvent {
+	return []*eventstore.BackupEvent{
+		{AggregateType: instance.AggregateType, AggregateID: "instance", EventType: instance.InstanceAddedEventType, Owner: "instance", Position: 1, Payload: []byte(`{"name":"ZITADEL"}`)},
+		{AggregateType: instance.AggregateType, AggregateID: "instance", EventType: instance.InstanceDomainAddedEventType, Owner: "instance", Position: 2, Payload: []byte(`{"domain":"zitadel.cloud"}`)},
+		{AggregateType: "user", AggregateID: "user1", EventType: "user.human.added", Owner: "org", Position: 3, Payload: []byte(`{"userName":"gigi"}`)},
+	}
+}
+
+func Test_instanceBackupReader(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		r, err := newInstanceBackupReader(bytes.NewReader(writeTestInstanceBackup(t, nil, testBackupEvents()...)))
+		require.NoError(t, err)
+
+		header, err := r.header()
+		require.NoError(t, err)
+		assert.Equal(t, "instance", header.InstanceID)
+		assets, err := r.assets()
+		require.NoError(t, err)
+		assert.Equal(t, []*instanceBackupAsset{{ResourceOwner: "instance", Name: "policy/label/logo", ContentType: "image/png", Type: static.ObjectTypeStyling, Data: []byte("logo")}}, assets)
+
+		assert.Equal(t, testBackupEvents(), readAllEvents(t, r))
+		assert.Equal(t, []*eventstore.BackupUniqueConstraint{{UniqueType: "usernames", UniqueField: "gigi"}}, readAllConstraints(t, r))
+		key, err := r.NextPersonalDataKey()
+		require.NoError(t, err)
+		assert.Equal(t, "user1", key.SubjectID)
+		_, err = r.NextPersonalDataKey()
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, float64(3), r.trailer.Position)
+	})
+	t.Run("trailer mismatch", func(t *testing.T) {
+		r, err := newInstanceBackupReader(bytes.NewReader(writeTestInstanceBackup(t, &instanceBackupTrailer{Events: 4, UniqueConstraints: 1, PersonalDataKeys: 1}, testBackupEvents()...)))
+		require.NoError(t, err)
+		_, err = r.header()
+		require.NoError(t, err)
+		_, err = r.assets()
+		require.NoError(t, err)
+		readAllEvents(t, r)
+		readAllConstraints(t, r)
+		_, err = r.NextPersonalDataKey()
+		require.NoError(t, err)
+		_, err = r.NextPersonalDataKey()
+		assert.True(t, zerrors.IsErrorInvalidArgument(err))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		archive := writeTestInstanceBackup(t, nil, testBackupEvents()...)
+		r, err := newInstanceBackupReader(bytes.NewReader(archive[:len(archive)-10]))
+		require.NoError(t, err)
+		err = readInstanceBackup(r)
+		assert.True(t, zerrors.IsErrorInvalidArgument(err))
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := newInstanceBackupWriter(buf)
+		require.NoError(t, w.write(&instanceBackupRecord{Header: &instanceBackupHeader{Version: instanceBackupVersion + 1}}))
+		require.NoError(t, w.Close())
+		r, err := newInstanceBackupReader(buf)
+		require.NoError(t, err)
+		_, err = r.header()
+		assert.True(t, zerrors.IsErrorInvalidArgument(err))
+	})
+	t.Run("no archive", func(t *testing.T) {
+		_, err := newInstanceBackupReader(bytes.NewReader([]byte("backup")))
+		assert.True(t, zerrors.IsErrorInvalidArgument(err))
+	})
+}
+
+func Test_instanceRestoreReader(t *testing.T) {
+	type args struct {
+		to    string
+		until float64
+	}
+	type want struct {
+		events      []*eventstore.BackupEvent
+		constraints []*eventstore.BackupUniqueConstraint
+		position    float64
+		omitted     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "same instance",
+			args: args{to: "instance"},
+			want: want{
+				events: testBackupEvents(),
+				constraints: []*eventstore.BackupUniqueConstraint{
+					{UniqueType: "usernames", UniqueField: "gigi"},
+					{UniqueType: instance.UniqueInstanceDomain, UniqueField: "zitadel.cloud", IsGlobal: true},
+				},
+				position: 3,
+			},
+		},
+		{
+			name: "point in time",
+			args: args{to: "instance", until: 2},
+			want: want{
+				events: testBackupEvents()[:2],
+				constraints: []*eventstore.BackupUniqueConstraint{
+					{UniqueType: "usernames", UniqueField: "gigi"},
+					{UniqueType: instance.UniqueInstanceDomain, UniqueField: "zitadel.cloud", IsGlobal: true},
+				},
+				position: 2,
+				omitted:  1,
+			},
+		},
+		{
+			name: "other instance",
+			args: args{to: "copy"},
+			want: want{
+				events: []*eventstore.BackupEvent{
+					{AggregateType: instance.AggregateType, AggregateID: "copy", EventType: instance.InstanceAddedEventType, Owner: "copy", Position: 1, Payload: []byte(`{"name":"ZITADEL"}`)},
+					{AggregateType: "user", AggregateID: "user1", EventType: "user.human.added", Owner: "org", Position: 3, Payload: []byte(`{"userName":"gigi"}`)},
+				},
+				constraints: []*eventstore.BackupUniqueConstraint{
+					{UniqueType: "usernames", UniqueField: "gigi"},
+				},
+				position: 3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive, err := newInstanceBackupReader(bytes.NewReader(writeTestInstanceBackup(t, nil, testBackupEvents()...)))
+			require.NoError(t, err)
+			_, err = archive.header()
+			require.NoError(t, err)
+			_, err = archive.assets()
+			require.NoError(t, err)
+
+			r := newInstanceRestoreReader(archive, "instance", tt.args.to, tt.args.until)
+			assert.Equal(t, tt.want.events, readAllEvents(t, r))
+			assert.Equal(t, tt.want.constraints, readAllConstraints(t, r))
+			assert.Equal(t, tt.want.position, r.position)
+			assert.Equal(t, tt.want.omitted, r.omitted)
+			_, err = r.NextPersonalDataKey()
+			require.NoError(t, err)
+			_, err = r.NextPersonalDataKey()
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CustomNotification is a notification of a custom notification type requested through the API.
+type CustomNotification struct {
+	UserID        string
+	ResourceOwner string
+	MessageType   string
+	Channel       domain.NotificationType
+	// Args can be used in the texts of the message type, e.g. {{.ExpiryDate}}
+	Args map[string]string
+	// URL is passed to the texts and templates as {{.URL}}
+	URL string
+}
+
+func (c *Commands) AddCustomNotificationType(ctx context.Context, notificationType *domain.CustomNotificationType) (*domain.ObjectDetails, error) {
+	if err := notificationType.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.customNotificationTypeWriteModel(ctx, notificationType.Type)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-ooX4a", "Errors.CustomNotificationType.AlreadyExists")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewCustomNotificationTypeAddedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), notificationType.Type, notificationType.Description))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeCustomNotificationType(ctx context.Context, notificationType *domain.CustomNotificationType) (*domain.ObjectDetails, error) {
+	if err := notificationType.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.customNotificationTypeWriteModel(ctx, notificationType.Type)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ieb3o", "Errors.CustomNotificationType.NotFound")
+	}
+	if writeModel.Description == notificationType.Description {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewCustomNotificationTypeChangedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), notificationType.Type, notificationType.Description))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveCustomNotificationType prevents further notifications of the type to be sent.
+// The texts and templates of the type are kept and will be used again if the type is added again.
+func (c *Commands) RemoveCustomNotificationType(ctx context.Context, notificationType string) (*domain.ObjectDetails, error) {
+	writeModel, err := c.customNotificationTypeWriteModel(ctx, notificationType)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Aen9u", "Errors.CustomNotificationType.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewCustomNotificationTypeRemovedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), notificationType))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetDefaultCustomNotificationText sets the texts of a custom notification type on the instance.
+func (c *Commands) SetDefaultCustomNotificationText(ctx context.Context, messageText *domain.CustomMessageText) (*domain.ObjectDetails, error) {
+	if err := c.checkCustomNotificationTypeExists(ctx, messageText.MessageTextType); err != nil {
+		return nil, err
+	}
+	return c.SetDefaultMessageText(ctx, authz.GetInstance(ctx).InstanceID(), messageText)
+}
+
+// SendCustomNotification requests a notification of a custom notification type to be sent to the user.
+// The notification is sent asynchronously by the notification handlers through the configured email or SMS provider.
+func (c *Commands) SendCustomNotification(ctx context.Context, notification *CustomNotification) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if notification.UserID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohgh4", "Errors.IDMissing")
+	}
+	if !domain.CustomNotificationChannelValid(notification.Channel) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieW2u", "Errors.CustomNotificationType.InvalidChannel")
+	}
+	if err = c.checkCustomNotificationTypeExists(ctx, notification.MessageType); err != nil {
+		return nil, err
+	}
+	existingUser, err := c.userWriteModelByID(ctx, notification.UserID, notification.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Uoh5e", "Errors.User.NotFound")
+	}
+	if existingUser.UserType != domain.UserTypeHuman {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xei8o", "Errors.User.NotHuman")
+	}
+	if err = c.pushAppendAndReduce(ctx, existingUser,
+		user.NewHumanCustomNotificationRequestedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel),
+			notification.MessageType, notification.Channel, notification.Args, notification.URL),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
+
+// CustomNotificationSent stores that the custom notification requested by the event with the triggerSequence was sent to the user.
+func (c *Commands) CustomNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ahV7i", "Errors.IDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ooc6r", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewHumanCustomNotificationSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), messageType, triggerSequence))
+	return err
+}
+
+func (c *Commands) checkCustomNotificationTypeExists(ctx context.Context, notificationType string) error {
+	if !domain.IsCustomMessageType(notificationType) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Eis4a", "Errors.CustomNotificationType.InvalidType")
+	}
+	writeModel, err := c.customNotificationTypeWriteModel(ctx, notificationType)
+	if err != nil {
+		return err
+	}
+	if !writeModel.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-thee7", "Errors.CustomNotificationType.NotFound")
+	}
+	return nil
+}
+
+func (c *Commands) customNotificationTypeWriteModel(ctx context.Context, notificationType string) (*InstanceCustomNotificationTypeWriteModel, error) {
+	writeModel := NewInstanceCustomNotificationTypeWriteModel(ctx, notificationType)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceCustomNotificationTypeWriteModel struct {
+	eventstore.WriteModel
+
+	Type        string
+	Description string
+	State       domain.PolicyState
+}
+
+func NewInstanceCustomNotificationTypeWriteModel(ctx context.Context, notificationType string) *InstanceCustomNotificationTypeWriteModel {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return &InstanceCustomNotificationTypeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Type: notificationType,
+	}
+}
+
+func (wm *InstanceCustomNotificationTypeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.CustomNotificationTypeAddedEvent:
+			wm.Description = e.Description
+			wm.State = domain.PolicyStateActive
+		case *instance.CustomNotificationTypeChangedEvent:
+			wm.Description = e.Description
+		case *instance.CustomNotificationTypeRemovedEvent:
+			wm.Description = ""
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomNotificationTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.CustomNotificationTypeAddedEventType,
+			instance.CustomNotificationTypeChangedEventType,
+			instance.CustomNotificationTypeRemovedEventType,
+		).
+		EventData(map[string]interface{}{
+			"notificationType": wm.Type,
+		}).
+		Builder()
+}
+
+func (wm *InstanceCustomNotificationTypeWriteModel) Exists() bool {
+	return wm.State == domain.PolicyStateActive
+}
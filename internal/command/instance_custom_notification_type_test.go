@@ -0,0 +1,483 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func customNotificationTypeAddedEvent(notificationType, description string) eventstore.Event {
+	return eventFromEventPusher(
+		instance.NewCustomNotificationTypeAddedEvent(context.Background(),
+			&instance.NewAggregate("instanceID").Aggregate, notificationType, description),
+	)
+}
+
+func TestCommands_AddCustomNotificationType(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		notificationType *domain.CustomNotificationType
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "built-in type, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: domain.InitCodeMessageType},
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oiZ3e", "Errors.CustomNotificationType.InvalidType"),
+			},
+		},
+		{
+			name: "already exists, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+					),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires"},
+			},
+			want: want{
+				err: zerrors.ThrowAlreadyExists(nil, "COMMAND-ooX4a", "Errors.CustomNotificationType.AlreadyExists"),
+			},
+		},
+		{
+			name: "add after removal, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+						eventFromEventPusher(
+							instance.NewCustomNotificationTypeRemovedEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate, "CustomSubscriptionExpires"),
+						),
+					),
+					expectPush(
+						instance.NewCustomNotificationTypeAddedEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "CustomSubscriptionExpires", "subscription expires soon"),
+					),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires", Description: "subscription expires soon"},
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewCustomNotificationTypeAddedEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "CustomSubscriptionExpires", "subscription expires soon"),
+					),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires", Description: "subscription expires soon"},
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddCustomNotificationType(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.notificationType)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
+
+func TestCommands_ChangeCustomNotificationType(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		notificationType *domain.CustomNotificationType
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires", Description: "description"},
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ieb3o", "Errors.CustomNotificationType.NotFound"),
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", "description"),
+					),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires", Description: "description"},
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", "description"),
+					),
+					expectPush(
+						instance.NewCustomNotificationTypeChangedEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "CustomSubscriptionExpires", "new description"),
+					),
+				),
+			},
+			args: args{
+				notificationType: &domain.CustomNotificationType{Type: "CustomSubscriptionExpires", Description: "new description"},
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeCustomNotificationType(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.notificationType)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
+
+func TestCommands_RemoveCustomNotificationType(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		notificationType string
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				notificationType: "CustomSubscriptionExpires",
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Aen9u", "Errors.CustomNotificationType.NotFound"),
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+					),
+					expectPush(
+						instance.NewCustomNotificationTypeRemovedEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "CustomSubscriptionExpires"),
+					),
+				),
+			},
+			args: args{
+				notificationType: "CustomSubscriptionExpires",
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveCustomNotificationType(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.notificationType)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
+
+func TestCommands_SendCustomNotification(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		notification *CustomNotification
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "user id missing, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				notification: &CustomNotification{
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+				},
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohgh4", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "invalid channel, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+					Channel:       domain.NotificationType(5),
+				},
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieW2u", "Errors.CustomNotificationType.InvalidChannel"),
+			},
+		},
+		{
+			name: "built-in type, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   domain.PasswordResetMessageType,
+				},
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Eis4a", "Errors.CustomNotificationType.InvalidType"),
+			},
+		},
+		{
+			name: "type not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+				},
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-thee7", "Errors.CustomNotificationType.NotFound"),
+			},
+		},
+		{
+			name: "user not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+				},
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Uoh5e", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "machine user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"name",
+								"description",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+				},
+			},
+			want: want{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xei8o", "Errors.User.NotHuman"),
+			},
+		},
+		{
+			name: "send, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						customNotificationTypeAddedEvent("CustomSubscriptionExpires", ""),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanCustomNotificationRequestedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"CustomSubscriptionExpires",
+							domain.NotificationTypeSms,
+							map[string]string{"ExpiryDate": "2026-12-31"},
+							"https://example.com/subscription",
+						),
+					),
+				),
+			},
+			args: args{
+				notification: &CustomNotification{
+					UserID:        "user1",
+					ResourceOwner: "org1",
+					MessageType:   "CustomSubscriptionExpires",
+					Channel:       domain.NotificationTypeSms,
+					Args:          map[string]string{"ExpiryDate": "2026-12-31"},
+					URL:           "https://example.com/subscription",
+				},
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SendCustomNotification(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.notification)
+			assert.ErrorIs(t, err, tt.want.err)
+			if tt.want.err == nil {
+				assertObjectDetails(t, tt.want.details, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetIDPDiscoveryDomain routes all users with a login name of the (email) domain to the identity provider.
+// The identity provider can either be one of the instance or of an organization.
+// An existing routing of the domain is replaced.
+func (c *Commands) SetIDPDiscoveryDomain(ctx context.Context, discoveryDomain, idpID string) (*domain.ObjectDetails, error) {
+	discoveryDomain, err := prepareIDPDiscoveryDomain(discoveryDomain)
+	if err != nil {
+		return nil, err
+	}
+	if idpID = strings.TrimSpace(idpID); idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INST-Hrd2i", "Errors.IDMissing")
+	}
+	exists, err := ExistsIDP(ctx, c.eventstore.Filter, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Hrd2n", "Errors.IDPConfig.NotExisting")
+	}
+	writeModel := NewInstanceIDPDiscoveryDomainWriteModel(ctx, discoveryDomain)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.IDPID == idpID {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	instanceAgg := instance.NewAggregate(writeModel.AggregateID)
+	if err := c.pushAppendAndReduce(ctx, writeModel, instance.NewIDPDiscoveryDomainSetEvent(ctx, &instanceAgg.Aggregate, discoveryDomain, idpID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveIDPDiscoveryDomain removes the routing of the (email) domain,
+// so users of the domain will be asked for their password (or other local authentication) again.
+func (c *Commands) RemoveIDPDiscoveryDomain(ctx context.Context, discoveryDomain string) (*domain.ObjectDetails, error) {
+	discoveryDomain, err := prepareIDPDiscoveryDomain(discoveryDomain)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewInstanceIDPDiscoveryDomainWriteModel(ctx, discoveryDomain)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.IDPID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "INST-Hrd3n", "Errors.IDPConfig.DiscoveryDomainNotFound")
+	}
+	instanceAgg := instance.NewAggregate(writeModel.AggregateID)
+	if err := c.pushAppendAndReduce(ctx, writeModel, instance.NewIDPDiscoveryDomainRemovedEvent(ctx, &instanceAgg.Aggregate, discoveryDomain)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// prepareIDPDiscoveryDomain normalizes the domain, which is matched case-insensitive against the part after the @ of the login name.
+func prepareIDPDiscoveryDomain(discoveryDomain string) (string, error) {
+	discoveryDomain = strings.ToLower(strings.TrimSpace(discoveryDomain))
+	if discoveryDomain == "" || strings.ContainsAny(discoveryDomain, "@ /") || !strings.Contains(discoveryDomain, ".") {
+		return "", zerrors.ThrowInvalidArgument(nil, "INST-Hrd1i", "Errors.IDPConfig.DiscoveryDomainInvalid")
+	}
+	return discoveryDomain, nil
+}
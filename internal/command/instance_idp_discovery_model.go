@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceIDPDiscoveryDomainWriteModel struct {
+	eventstore.WriteModel
+
+	Domain string
+	IDPID  string
+}
+
+func NewInstanceIDPDiscoveryDomainWriteModel(ctx context.Context, discoveryDomain string) *InstanceIDPDiscoveryDomainWriteModel {
+	return &InstanceIDPDiscoveryDomainWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+		Domain: discoveryDomain,
+	}
+}
+
+func (wm *InstanceIDPDiscoveryDomainWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.IDPDiscoveryDomainSetEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *instance.IDPDiscoveryDomainRemovedEvent:
+			if e.Domain != wm.Domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceIDPDiscoveryDomainWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.IDPDiscoveryDomainSetEvent:
+			wm.IDPID = e.IDPID
+		case *instance.IDPDiscoveryDomainRemovedEvent:
+			wm.IDPID = ""
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceIDPDiscoveryDomainWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.IDPDiscoveryDomainSetEventType,
+			instance.IDPDiscoveryDomainRemovedEventType,
+		).
+		EventData(map[string]interface{}{"domain": wm.Domain}).
+		Builder()
+}
@@ -0,0 +1,266 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetIDPDiscoveryDomain(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		domain string
+		idpID  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid domain, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "alice@customer.com",
+				idpID:  "idp1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"missing idp id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "customer.com",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"idp not existing, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "customer.com",
+				idpID:  "idp1",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"already set, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"",
+								"clientID",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPDiscoveryDomainSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"customer.com",
+								"idp1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "customer.com",
+				idpID:  "idp1",
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			"set (normalized), ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGoogleIDPAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								"idp1",
+								"",
+								"clientID",
+								nil,
+								nil,
+								idp.Options{},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPDiscoveryDomainSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"customer.com",
+								"idp2",
+							),
+						),
+					),
+					expectPush(
+						instance.NewIDPDiscoveryDomainSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+							"customer.com",
+							"idp1",
+						),
+					),
+				),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: " Customer.COM ",
+				idpID:  "idp1",
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetIDPDiscoveryDomain(tt.args.ctx, tt.args.domain, tt.args.idpID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveIDPDiscoveryDomain(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		domain string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not set, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPDiscoveryDomainSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"customer.com",
+								"idp1",
+							),
+						),
+						eventFromEventPusher(
+							instance.NewIDPDiscoveryDomainRemovedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"customer.com",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "customer.com",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewIDPDiscoveryDomainSetEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"customer.com",
+								"idp1",
+							),
+						),
+					),
+					expectPush(
+						instance.NewIDPDiscoveryDomainRemovedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+							"customer.com",
+						),
+					),
+				),
+			},
+			args{
+				ctx:    authz.WithInstanceID(context.Background(), "instance1"),
+				domain: "customer.com",
+			},
+			res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveIDPDiscoveryDomain(tt.args.ctx, tt.args.domain)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -770,6 +770,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 	timeout time.Duration,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
+	syncOptions idp.LDAPSyncOptions,
 	options idp.Options,
 ) (*instance.LDAPIDPChangedEvent, error) {
 
@@ -786,6 +787,7 @@ func (wm *InstanceLDAPIDPWriteModel) NewChangedEvent(
 		timeout,
 		secretCrypto,
 		attributes,
+		syncOptions,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -4194,6 +4194,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							idp.LDAPAttributes{},
+							idp.LDAPSyncOptions{},
 							idp.Options{},
 						),
 					),
@@ -4259,6 +4260,7 @@ func TestCommandSide_AddInstanceLDAPIDP(t *testing.T) {
 								AvatarURLAttribute:         "avatarURL",
 								ProfileAttribute:           "profile",
 							},
+							idp.LDAPSyncOptions{},
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -4560,6 +4562,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								idp.LDAPSyncOptions{},
 								idp.Options{},
 							)),
 					),
@@ -4608,6 +4611,7 @@ func TestCommandSide_UpdateInstanceLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								idp.LDAPSyncOptions{},
 								idp.Options{},
 							)),
 					),
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"bytes"
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const languageBundleContentType = "application/yaml"
+
+// SetLanguageBundle stores the language bundle of the namespace as asset of the instance.
+// The bundle must contain exactly the keys of the english texts of the namespace.
+// It is loaded at runtime, so additional languages can be provided without a rebuild.
+func (c *Commands) SetLanguageBundle(ctx context.Context, namespace i18n.Namespace, lang language.Tag, content []byte) (*domain.ObjectDetails, error) {
+	if err := validateLanguageBundleNamespace(namespace); err != nil {
+		return nil, err
+	}
+	if _, err := i18n.ParseBundle(namespace, lang, content); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.languageBundleWriteModel(ctx, namespace, lang)
+	if err != nil {
+		return nil, err
+	}
+	asset, err := c.uploadAsset(ctx, &AssetUpload{
+		ResourceOwner: writeModel.ResourceOwner,
+		ObjectName:    domain.GetLanguageBundleAssetPath(string(namespace), lang.String()),
+		ContentType:   languageBundleContentType,
+		ObjectType:    static.ObjectTypeLanguageBundle,
+		File:          bytes.NewReader(content),
+		Size:          int64(len(content)),
+	})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-ahR6e", "Errors.Assets.Object.PutFailed")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewLanguageBundleSetEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), string(namespace), lang, asset.Name))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveLanguageBundle removes the language bundle of the namespace from the instance.
+// Languages which are not shipped with ZITADEL are not available anymore afterwards.
+func (c *Commands) RemoveLanguageBundle(ctx context.Context, namespace i18n.Namespace, lang language.Tag) (*domain.ObjectDetails, error) {
+	if err := validateLanguageBundleNamespace(namespace); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.languageBundleWriteModel(ctx, namespace, lang)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Oop3e", "Errors.LanguageBundle.NotFound")
+	}
+	if err = c.removeAsset(ctx, writeModel.ResourceOwner, writeModel.AssetKey); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, instance.NewLanguageBundleRemovedEvent(ctx, InstanceAggregateFromWriteModel(&writeModel.WriteModel), string(namespace), lang))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// validateLanguageBundleNamespace only allows the namespaces, which are translated per request.
+// The ZITADEL (error) texts are loaded once on startup.
+func validateLanguageBundleNamespace(namespace i18n.Namespace) error {
+	if namespace != i18n.LOGIN && namespace != i18n.NOTIFICATION {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ke5Ee", "Errors.LanguageBundle.InvalidNamespace")
+	}
+	return nil
+}
+
+func (c *Commands) languageBundleWriteModel(ctx context.Context, namespace i18n.Namespace, lang language.Tag) (*InstanceLanguageBundleWriteModel, error) {
+	writeModel := NewInstanceLanguageBundleWriteModel(authz.GetInstance(ctx).InstanceID(), namespace, lang)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
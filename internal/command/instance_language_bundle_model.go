@@ -0,0 +1,62 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceLanguageBundleWriteModel struct {
+	eventstore.WriteModel
+
+	Namespace i18n.Namespace
+	Language  language.Tag
+	AssetKey  string
+}
+
+func NewInstanceLanguageBundleWriteModel(instanceID string, namespace i18n.Namespace, lang language.Tag) *InstanceLanguageBundleWriteModel {
+	return &InstanceLanguageBundleWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Namespace: namespace,
+		Language:  lang,
+	}
+}
+
+func (wm *InstanceLanguageBundleWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.LanguageBundleSetEvent:
+			wm.AssetKey = e.AssetKey
+		case *instance.LanguageBundleRemovedEvent:
+			wm.AssetKey = ""
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceLanguageBundleWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.LanguageBundleSetEventType,
+			instance.LanguageBundleRemovedEventType,
+		).
+		EventData(map[string]interface{}{
+			"namespace": wm.Namespace,
+			"language":  wm.Language.String(),
+		}).
+		Builder()
+}
+
+func (wm *InstanceLanguageBundleWriteModel) Exists() bool {
+	return wm.AssetKey != ""
+}
@@ -0,0 +1,225 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetLanguageBundle(t *testing.T) {
+	bundle, err := i18n.BundleTemplate(i18n.NOTIFICATION)
+	require.NoError(t, err)
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		storage    static.Storage
+	}
+	type args struct {
+		namespace i18n.Namespace
+		lang      language.Tag
+		content   []byte
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "zitadel namespace, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				namespace: i18n.ZITADEL,
+				lang:      language.Arabic,
+				content:   bundle,
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ke5Ee", "Errors.LanguageBundle.InvalidNamespace"),
+			},
+		},
+		{
+			name: "missing keys, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				namespace: i18n.NOTIFICATION,
+				lang:      language.Arabic,
+				content:   []byte("InitCode:\n  Title: title"),
+			},
+			want: want{
+				err: zerrors.ThrowInvalidArgument(nil, "I18N-Eiph7", "Errors.LanguageBundle.MissingKeys"),
+			},
+		},
+		{
+			name: "upload failed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				storage: mock.NewStorage(t).ExpectPutObjectError(),
+			},
+			args: args{
+				namespace: i18n.NOTIFICATION,
+				lang:      language.Arabic,
+				content:   bundle,
+			},
+			want: want{
+				err: zerrors.ThrowInternal(nil, "COMMAND-ahR6e", "Errors.Assets.Object.PutFailed"),
+			},
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewLanguageBundleSetEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "notification", language.Arabic, "i18n/notification/ar.yaml"),
+					),
+				),
+				storage: mock.NewStorage(t).ExpectPutObject(),
+			},
+			args: args{
+				namespace: i18n.NOTIFICATION,
+				lang:      language.Arabic,
+				content:   bundle,
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage,
+			}
+			got, err := c.SetLanguageBundle(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.namespace, tt.args.lang, tt.args.content)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
+
+func TestCommands_RemoveLanguageBundle(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		storage    static.Storage
+	}
+	type args struct {
+		namespace i18n.Namespace
+		lang      language.Tag
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				namespace: i18n.LOGIN,
+				lang:      language.Arabic,
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Oop3e", "Errors.LanguageBundle.NotFound"),
+			},
+		},
+		{
+			name: "already removed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewLanguageBundleSetEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate, "login", language.Arabic, "i18n/login/ar.yaml"),
+						),
+						eventFromEventPusher(
+							instance.NewLanguageBundleRemovedEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate, "login", language.Arabic),
+						),
+					),
+				),
+			},
+			args: args{
+				namespace: i18n.LOGIN,
+				lang:      language.Arabic,
+			},
+			want: want{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Oop3e", "Errors.LanguageBundle.NotFound"),
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewLanguageBundleSetEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate, "login", language.Arabic, "i18n/login/ar.yaml"),
+						),
+					),
+					expectPush(
+						instance.NewLanguageBundleRemovedEvent(context.Background(),
+							&instance.NewAggregate("instanceID").Aggregate, "login", language.Arabic),
+					),
+				),
+				storage: mock.NewStorage(t).ExpectRemoveObjectNoError(),
+			},
+			args: args{
+				namespace: i18n.LOGIN,
+				lang:      language.Arabic,
+			},
+			want: want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+					ID:            "instanceID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage,
+			}
+			got, err := c.RemoveLanguageBundle(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.namespace, tt.args.lang)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
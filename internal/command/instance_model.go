@@ -31,6 +31,16 @@ func NewInstanceWriteModel(instanceID string) *InstanceWriteModel {
 	}
 }
 
+// SnapshotKey implements [eventstore.SnapshotReducer]
+func (wm *InstanceWriteModel) SnapshotKey() string {
+	return "instance:" + wm.AggregateID
+}
+
+// SnapshotVersion implements [eventstore.SnapshotReducer]
+func (wm *InstanceWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func (wm *InstanceWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultLoginRiskPolicy adds the login risk policy of the instance or changes it, if it already exists.
+func (c *Commands) SetDefaultLoginRiskPolicy(ctx context.Context, policy *domain.LoginRiskPolicy) (*domain.ObjectDetails, error) {
+	if !policy.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Ohj9u", "Errors.IAM.LoginRiskPolicy.Invalid")
+	}
+	existingPolicy, err := defaultLoginRiskPolicyWriteModelByID(ctx, c.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := &instance.NewAggregate(authz.GetInstance(ctx).InstanceID()).Aggregate
+	var cmd eventstore.Command
+	if existingPolicy.State != domain.PolicyStateActive {
+		cmd = instance.NewLoginRiskPolicyAddedEvent(ctx, instanceAgg, policy.NewDevice, policy.NewNetwork, policy.ImpossibleTravel)
+	} else {
+		changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.NewDevice, policy.NewNetwork, policy.ImpossibleTravel)
+		if !hasChanged {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-ahN6o", "Errors.IAM.LoginRiskPolicy.NotChanged")
+		}
+		cmd = changedEvent
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.LoginRiskPolicyWriteModel.WriteModel), nil
+}
+
+func defaultLoginRiskPolicyWriteModelByID(ctx context.Context, reducer func(ctx context.Context, r eventstore.QueryReducer) error) (policy *InstanceLoginRiskPolicyWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewInstanceLoginRiskPolicyWriteModel(ctx)
+	err = reducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
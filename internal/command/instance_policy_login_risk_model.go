@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceLoginRiskPolicyWriteModel struct {
+	LoginRiskPolicyWriteModel
+}
+
+func NewInstanceLoginRiskPolicyWriteModel(ctx context.Context) *InstanceLoginRiskPolicyWriteModel {
+	return &InstanceLoginRiskPolicyWriteModel{
+		LoginRiskPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceLoginRiskPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.LoginRiskPolicyAddedEvent:
+			wm.LoginRiskPolicyWriteModel.AppendEvents(&e.LoginRiskPolicyAddedEvent)
+		case *instance.LoginRiskPolicyChangedEvent:
+			wm.LoginRiskPolicyWriteModel.AppendEvents(&e.LoginRiskPolicyChangedEvent)
+		}
+	}
+}
+
+func (wm *InstanceLoginRiskPolicyWriteModel) Reduce() error {
+	return wm.LoginRiskPolicyWriteModel.Reduce()
+}
+
+func (wm *InstanceLoginRiskPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.LoginRiskPolicyWriteModel.AggregateID).
+		EventTypes(
+			instance.LoginRiskPolicyAddedEventType,
+			instance.LoginRiskPolicyChangedEventType).
+		Builder()
+}
+
+func (wm *InstanceLoginRiskPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	newDevice,
+	newNetwork,
+	impossibleTravel domain.LoginRiskAction,
+) (*instance.LoginRiskPolicyChangedEvent, bool) {
+	changes := wm.changes(newDevice, newNetwork, impossibleTravel)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := instance.NewLoginRiskPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultMessageTemplate only validates if the language is supported, not if it is allowed.
+// This enables setting templates before allowing a language
+func (c *Commands) SetDefaultMessageTemplate(ctx context.Context, instanceID string, template *domain.MessageTemplate) (*domain.ObjectDetails, error) {
+	if err := template.IsValid(i18n.SupportedLanguages()); err != nil {
+		return nil, err
+	}
+	if domain.IsCustomMessageType(template.MessageType) {
+		if err := c.checkCustomNotificationTypeExists(ctx, template.MessageType); err != nil {
+			return nil, err
+		}
+	}
+	existing, err := c.defaultMessageTemplateWriteModelByID(ctx, instanceID, template.MessageType, template.Language)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.PolicyStateActive && existing.HTML == template.HTML && existing.PlainText == template.PlainText {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	instanceAgg := &instance.NewAggregate(instanceID).Aggregate
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateSetEvent(ctx, instanceAgg, template.MessageType, template.Language, template.HTML, template.PlainText))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveInstanceMessageTemplate(ctx context.Context, instanceID, messageType string, lang language.Tag) (*domain.ObjectDetails, error) {
+	if messageType == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-ahR2u", "Errors.MessageTemplate.Invalid")
+	}
+	existing, err := c.defaultMessageTemplateWriteModelByID(ctx, instanceID, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Ohz3e", "Errors.MessageTemplate.NotFound")
+	}
+	instanceAgg := &instance.NewAggregate(instanceID).Aggregate
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateRemovedEvent(ctx, instanceAgg, messageType, lang))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) defaultMessageTemplateWriteModelByID(ctx context.Context, instanceID, messageType string, lang language.Tag) (*InstanceMessageTemplateWriteModel, error) {
+	writeModel := NewInstanceMessageTemplateWriteModel(instanceID, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
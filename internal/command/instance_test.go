@@ -207,12 +207,12 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
-		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
+		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeU2F),
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
 		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
-		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
+		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true),
 		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
 		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
 		instance.NewLabelPolicyActivatedEvent(ctx, &instanceAgg.Aggregate),
@@ -248,6 +248,7 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			AllowDomainDiscovery       bool
 			DisableLoginWithEmail      bool
 			DisableLoginWithPhone      bool
+			AllowMagicLink             bool
 			PasswordlessType           domain.PasswordlessType
 			DefaultRedirectURI         string
 			PasswordCheckLifetime      time.Duration
@@ -255,10 +256,11 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MfaInitSkipLifetime        time.Duration
 			SecondFactorCheckLifetime  time.Duration
 			MultiFactorCheckLifetime   time.Duration
-		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
+		}{true, true, true, false, false, false, false, true, false, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
 		NotificationPolicy: struct {
-			PasswordChange bool
-		}{true},
+			PasswordChange       bool
+			SecurityNotification bool
+		}{true, true},
 		PrivacyPolicy: struct {
 			TOSLink        string
 			PrivacyLink    string
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationDelivered records the result of an attempt to deliver a notification to a user.
+// All attempts for the same triggering event are recorded on the same notification.
+func (c *Commands) NotificationDelivered(ctx context.Context, resourceOwner string, delivery *notification.Delivery, deliveryErr error) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" || delivery == nil || delivery.TriggeringAggregateID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooch7", "Errors.Notification.Invalid")
+	}
+	agg := &notification.NewAggregate(notification.ID(delivery.TriggeringAggregateID, delivery.TriggeringSequence), resourceOwner).Aggregate
+	var cmd eventstore.Command = notification.NewSentEvent(ctx, agg, *delivery)
+	if deliveryErr != nil {
+		cmd = notification.NewFailedEvent(ctx, agg, *delivery, deliveryErr.Error())
+	}
+	_, err = c.eventstore.Push(ctx, cmd)
+	return err
+}
+
+// ResendNotification requests the failed notification of the user to be sent again.
+// The notification handler handles the triggering event again, codes which expired in the meantime are not sent.
+func (c *Commands) ResendNotification(ctx context.Context, userID, notificationID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || notificationID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-aiT4o", "Errors.Notification.Invalid")
+	}
+	writeModel := NewNotificationWriteModel(notificationID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || writeModel.Delivery.UserID != userID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eix0u", "Errors.Notification.NotFound")
+	}
+	if writeModel.State != domain.NotificationStateFailed {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahL6e", "Errors.Notification.NotFailed")
+	}
+	agg := &notification.NewAggregate(writeModel.AggregateID, writeModel.ResourceOwner).Aggregate
+	if err = c.pushAppendAndReduce(ctx, writeModel, notification.NewResendRequestedEvent(ctx, agg, writeModel.Delivery)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationWriteModel struct {
+	eventstore.WriteModel
+
+	State    domain.NotificationState
+	Delivery notification.Delivery
+}
+
+func NewNotificationWriteModel(notificationID, resourceOwner string) *NotificationWriteModel {
+	return &NotificationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   notificationID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *NotificationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.SentEvent:
+			wm.Delivery = e.Delivery
+			wm.State = domain.NotificationStateSent
+		case *notification.FailedEvent:
+			wm.Delivery = e.Delivery
+			wm.State = domain.NotificationStateFailed
+		case *notification.ResendRequestedEvent:
+			wm.State = domain.NotificationStateResendRequested
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.SentType,
+			notification.FailedType,
+			notification.ResendRequestedType,
+		).
+		Builder()
+}
@@ -0,0 +1,236 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testDelivery() notification.Delivery {
+	return notification.Delivery{
+		UserID:                  "user1",
+		MessageType:             domain.InitCodeMessageType,
+		Channel:                 domain.NotificationTypeEmail,
+		ProviderID:              "smtp1",
+		TriggeringAggregateType: user.AggregateType,
+		TriggeringAggregateID:   "user1",
+		TriggeringEventType:     user.HumanInitialCodeAddedType,
+		TriggeringSequence:      5,
+	}
+}
+
+func TestCommands_NotificationDelivered(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+		delivery      *notification.Delivery
+		deliveryErr   error
+	}
+	delivery := testDelivery()
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing delivery, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "sent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewSentEvent(context.Background(),
+							&notification.NewAggregate("user1-5", "org1").Aggregate,
+							delivery,
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				delivery:      &delivery,
+			},
+		},
+		{
+			name: "failed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewFailedEvent(context.Background(),
+							&notification.NewAggregate("user1-5", "org1").Aggregate,
+							delivery,
+							"connection refused",
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				delivery:      &delivery,
+				deliveryErr:   errors.New("connection refused"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.NotificationDelivered(context.Background(), tt.args.resourceOwner, tt.args.delivery, tt.args.deliveryErr)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+		})
+	}
+}
+
+func TestCommands_ResendNotification(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		userID         string
+		notificationID string
+		resourceOwner  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	delivery := testDelivery()
+	agg := &notification.NewAggregate("user1-5", "org1").Aggregate
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:         "user1",
+				notificationID: "user1-5",
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "notification of other user, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewFailedEvent(context.Background(), agg, delivery, "error")),
+					),
+				),
+			},
+			args: args{
+				userID:         "user2",
+				notificationID: "user1-5",
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "notification sent, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewFailedEvent(context.Background(), agg, delivery, "error")),
+						eventFromEventPusher(notification.NewSentEvent(context.Background(), agg, delivery)),
+					),
+				),
+			},
+			args: args{
+				userID:         "user1",
+				notificationID: "user1-5",
+				resourceOwner:  "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "resend, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewFailedEvent(context.Background(), agg, delivery, "error")),
+					),
+					expectPush(
+						notification.NewResendRequestedEvent(context.Background(), agg, delivery),
+					),
+				),
+			},
+			args: args{
+				userID:         "user1",
+				notificationID: "user1-5",
+				resourceOwner:  "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ResendNotification(context.Background(), tt.args.userID, tt.args.notificationID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -81,6 +81,9 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReq
 	if err = sessionModel.CheckIsActive(); err != nil {
 		return nil, "", err
 	}
+	if err = sessionModel.CheckLoginRisk(); err != nil {
+		return nil, "", err
+	}
 
 	cmd, err := c.newOIDCSessionAddEvents(ctx, sessionModel.UserID, sessionModel.UserResourceOwner)
 	if err != nil {
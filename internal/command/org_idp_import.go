@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The ImportOrg*Provider commands add an identity provider with the id it had in an exported organization.
+
+func (c *Commands) ImportOrgGenericOAuthProvider(ctx context.Context, resourceOwner, id string, provider GenericOAuthProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgOAuthProvider(org.NewAggregate(resourceOwner), NewOAuthOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGenericOIDCProvider(ctx context.Context, resourceOwner, id string, provider GenericOIDCProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgOIDCProvider(org.NewAggregate(resourceOwner), NewOIDCOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgJWTProvider(ctx context.Context, resourceOwner, id string, provider JWTProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgJWTProvider(org.NewAggregate(resourceOwner), NewJWTOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgAzureADProvider(ctx context.Context, resourceOwner, id string, provider AzureADProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgAzureADProvider(org.NewAggregate(resourceOwner), NewAzureADOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGitHubProvider(ctx context.Context, resourceOwner, id string, provider GitHubProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgGitHubProvider(org.NewAggregate(resourceOwner), NewGitHubOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGitHubEnterpriseProvider(ctx context.Context, resourceOwner, id string, provider GitHubEnterpriseProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgGitHubEnterpriseProvider(org.NewAggregate(resourceOwner), NewGitHubEnterpriseOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGitLabProvider(ctx context.Context, resourceOwner, id string, provider GitLabProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgGitLabProvider(org.NewAggregate(resourceOwner), NewGitLabOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGitLabSelfHostedProvider(ctx context.Context, resourceOwner, id string, provider GitLabSelfHostedProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgGitLabSelfHostedProvider(org.NewAggregate(resourceOwner), NewGitLabSelfHostedOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgGoogleProvider(ctx context.Context, resourceOwner, id string, provider GoogleProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgGoogleProvider(org.NewAggregate(resourceOwner), NewGoogleOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgLDAPProvider(ctx context.Context, resourceOwner, id string, provider LDAPProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgLDAPProvider(org.NewAggregate(resourceOwner), NewLDAPOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) ImportOrgAppleProvider(ctx context.Context, resourceOwner, id string, provider AppleProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgAppleProvider(org.NewAggregate(resourceOwner), NewAppleOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+// ImportOrgSAMLProvider adds the SAML identity provider with a newly generated key and certificate,
+// the service provider metadata has to be registered at the identity provider again
+func (c *Commands) ImportOrgSAMLProvider(ctx context.Context, resourceOwner, id string, provider SAMLProvider) (*domain.ObjectDetails, error) {
+	return c.importOrgProvider(ctx, resourceOwner, id, c.prepareAddOrgSAMLProvider(org.NewAggregate(resourceOwner), NewSAMLOrgIDPWriteModel(resourceOwner, id), provider))
+}
+
+func (c *Commands) importOrgProvider(ctx context.Context, resourceOwner, id string, validation preparation.Validation) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ieG4a", "Errors.IDMissing")
+	}
+	exists, err := ExistsOrgIDP(ctx, c.eventstore.Filter, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, zerrors.ThrowAlreadyExists(nil, "ORG-Xoo0a", "Errors.Org.IDPConfig.AlreadyExists")
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
@@ -780,6 +780,7 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 	timeout time.Duration,
 	secretCrypto crypto.EncryptionAlgorithm,
 	attributes idp.LDAPAttributes,
+	syncOptions idp.LDAPSyncOptions,
 	options idp.Options,
 ) (*org.LDAPIDPChangedEvent, error) {
 
@@ -796,6 +797,7 @@ func (wm *OrgLDAPIDPWriteModel) NewChangedEvent(
 		timeout,
 		secretCrypto,
 		attributes,
+		syncOptions,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -4329,6 +4329,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 							[]string{"filter"},
 							time.Second*30,
 							idp.LDAPAttributes{},
+							idp.LDAPSyncOptions{},
 							idp.Options{},
 						),
 					),
@@ -4395,6 +4396,7 @@ func TestCommandSide_AddOrgLDAPIDP(t *testing.T) {
 								AvatarURLAttribute:         "avatarURL",
 								ProfileAttribute:           "profile",
 							},
+							idp.LDAPSyncOptions{},
 							idp.Options{
 								IsCreationAllowed: true,
 								IsLinkingAllowed:  true,
@@ -4707,6 +4709,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								idp.LDAPSyncOptions{},
 								idp.Options{},
 							)),
 					),
@@ -4755,6 +4758,7 @@ func TestCommandSide_UpdateOrgLDAPIDP(t *testing.T) {
 								[]string{"filter"},
 								time.Second*30,
 								idp.LDAPAttributes{},
+								idp.LDAPSyncOptions{},
 								idp.Options{},
 							)),
 					),
@@ -23,6 +23,16 @@ func NewOrgWriteModel(orgID string) *OrgWriteModel {
 	}
 }
 
+// SnapshotKey implements [eventstore.SnapshotReducer]
+func (wm *OrgWriteModel) SnapshotKey() string {
+	return "org:" + wm.AggregateID
+}
+
+// SnapshotVersion implements [eventstore.SnapshotReducer]
+func (wm *OrgWriteModel) SnapshotVersion() uint16 {
+	return 1
+}
+
 func (wm *OrgWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddLoginRiskPolicy(ctx context.Context, resourceOwner string, policy *domain.LoginRiskPolicy) (_ *domain.LoginRiskPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ahw3o", "Errors.ResourceOwnerMissing")
+	}
+	if !policy.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Quo4e", "Errors.Org.LoginRiskPolicy.Invalid")
+	}
+	addedPolicy, err := orgLoginRiskPolicyWriteModelByID(ctx, resourceOwner, c.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, zerrors.ThrowAlreadyExists(nil, "Org-ieP4u", "Errors.Org.LoginRiskPolicy.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&addedPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewLoginRiskPolicyAddedEvent(
+		ctx,
+		orgAgg,
+		policy.NewDevice,
+		policy.NewNetwork,
+		policy.ImpossibleTravel,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToLoginRiskPolicy(&addedPolicy.LoginRiskPolicyWriteModel), nil
+}
+
+func (c *Commands) ChangeLoginRiskPolicy(ctx context.Context, resourceOwner string, policy *domain.LoginRiskPolicy) (*domain.LoginRiskPolicy, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Tae0i", "Errors.ResourceOwnerMissing")
+	}
+	if !policy.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-ohB7a", "Errors.Org.LoginRiskPolicy.Invalid")
+	}
+	existingPolicy, err := orgLoginRiskPolicyWriteModelByID(ctx, resourceOwner, c.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "Org-Eeth5", "Errors.Org.LoginRiskPolicy.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.LoginRiskPolicyWriteModel.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.NewDevice, policy.NewNetwork, policy.ImpossibleTravel)
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-Ouy1o", "Errors.Org.LoginRiskPolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToLoginRiskPolicy(&existingPolicy.LoginRiskPolicyWriteModel), nil
+}
+
+func (c *Commands) RemoveLoginRiskPolicy(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-xei4A", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy, err := orgLoginRiskPolicyWriteModelByID(ctx, orgID, c.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "Org-iCh8e", "Errors.Org.LoginRiskPolicy.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewLoginRiskPolicyRemovedEvent(ctx, orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.LoginRiskPolicyWriteModel.WriteModel), nil
+}
+
+func orgLoginRiskPolicyWriteModelByID(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (_ *OrgLoginRiskPolicyWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	policy := NewOrgLoginRiskPolicyWriteModel(orgID)
+	err = queryReducer(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// getLoginRiskPolicy returns the policy of the organization or the default policy of the instance.
+// If neither is set, all actions are [domain.LoginRiskActionNone].
+func getLoginRiskPolicy(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (*domain.LoginRiskPolicy, error) {
+	orgWm, err := orgLoginRiskPolicyWriteModelByID(ctx, orgID, queryReducer)
+	if err != nil {
+		return nil, err
+	}
+	if orgWm.State == domain.PolicyStateActive {
+		return writeModelToLoginRiskPolicy(&orgWm.LoginRiskPolicyWriteModel), nil
+	}
+	instanceWm, err := defaultLoginRiskPolicyWriteModelByID(ctx, queryReducer)
+	if err != nil {
+		return nil, err
+	}
+	policy := writeModelToLoginRiskPolicy(&instanceWm.LoginRiskPolicyWriteModel)
+	policy.Default = true
+	return policy, nil
+}
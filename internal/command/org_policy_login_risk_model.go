@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgLoginRiskPolicyWriteModel struct {
+	LoginRiskPolicyWriteModel
+}
+
+func NewOrgLoginRiskPolicyWriteModel(orgID string) *OrgLoginRiskPolicyWriteModel {
+	return &OrgLoginRiskPolicyWriteModel{
+		LoginRiskPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgLoginRiskPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.LoginRiskPolicyAddedEvent:
+			wm.LoginRiskPolicyWriteModel.AppendEvents(&e.LoginRiskPolicyAddedEvent)
+		case *org.LoginRiskPolicyChangedEvent:
+			wm.LoginRiskPolicyWriteModel.AppendEvents(&e.LoginRiskPolicyChangedEvent)
+		case *org.LoginRiskPolicyRemovedEvent:
+			wm.LoginRiskPolicyWriteModel.AppendEvents(&e.LoginRiskPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgLoginRiskPolicyWriteModel) Reduce() error {
+	return wm.LoginRiskPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgLoginRiskPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateIDs(wm.LoginRiskPolicyWriteModel.AggregateID).
+		AggregateTypes(org.AggregateType).
+		EventTypes(org.LoginRiskPolicyAddedEventType,
+			org.LoginRiskPolicyChangedEventType,
+			org.LoginRiskPolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgLoginRiskPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	newDevice,
+	newNetwork,
+	impossibleTravel domain.LoginRiskAction,
+) (*org.LoginRiskPolicyChangedEvent, bool) {
+	changes := wm.changes(newDevice, newNetwork, impossibleTravel)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewLoginRiskPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
@@ -0,0 +1,487 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddLoginRiskPolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *domain.LoginRiskPolicy
+	}
+	type res struct {
+		want *domain.LoginRiskPolicy
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				policy: &domain.LoginRiskPolicy{
+					NewDevice: domain.LoginRiskActionNotify,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid action, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice: 10,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy already existing, already exists error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionBlock,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice: domain.LoginRiskActionNotify,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add policy, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						org.NewLoginRiskPolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.LoginRiskActionNotify,
+							domain.LoginRiskActionRequireMFA,
+							domain.LoginRiskActionBlock,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice:        domain.LoginRiskActionNotify,
+					NewNetwork:       domain.LoginRiskActionRequireMFA,
+					ImpossibleTravel: domain.LoginRiskActionBlock,
+				},
+			},
+			res: res{
+				want: &domain.LoginRiskPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					NewDevice:        domain.LoginRiskActionNotify,
+					NewNetwork:       domain.LoginRiskActionRequireMFA,
+					ImpossibleTravel: domain.LoginRiskActionBlock,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.AddLoginRiskPolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeLoginRiskPolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		policy *domain.LoginRiskPolicy
+	}
+	type res struct {
+		want *domain.LoginRiskPolicy
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				policy: &domain.LoginRiskPolicy{},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice: domain.LoginRiskActionNotify,
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionNone,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice: domain.LoginRiskActionNotify,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectPush(
+						newLoginRiskPolicyChangedEvent(context.Background(), "org1",
+							policy.ChangeLoginRiskNewNetwork(domain.LoginRiskActionRequireMFA),
+							policy.ChangeLoginRiskImpossibleTravel(domain.LoginRiskActionBlock),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LoginRiskPolicy{
+					NewDevice:        domain.LoginRiskActionNotify,
+					NewNetwork:       domain.LoginRiskActionRequireMFA,
+					ImpossibleTravel: domain.LoginRiskActionBlock,
+				},
+			},
+			res: res{
+				want: &domain.LoginRiskPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					NewDevice:        domain.LoginRiskActionNotify,
+					NewNetwork:       domain.LoginRiskActionRequireMFA,
+					ImpossibleTravel: domain.LoginRiskActionBlock,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeLoginRiskPolicy(tt.args.ctx, tt.args.orgID, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveLoginRiskPolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx   context.Context
+		orgID string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectPush(
+						org.NewLoginRiskPolicyRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			_, err := r.RemoveLoginRiskPolicy(tt.args.ctx, tt.args.orgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommandSide_getLoginRiskPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   *domain.LoginRiskPolicy
+	}{
+		{
+			name: "org policy",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionBlock,
+							),
+						),
+					),
+				),
+			},
+			want: &domain.LoginRiskPolicy{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "org1",
+					ResourceOwner: "org1",
+				},
+				NewDevice:        domain.LoginRiskActionNotify,
+				ImpossibleTravel: domain.LoginRiskActionBlock,
+			},
+		},
+		{
+			name: "default policy",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewLoginRiskPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								domain.LoginRiskActionRequireMFA,
+								domain.LoginRiskActionNone,
+								domain.LoginRiskActionNone,
+							),
+						),
+					),
+				),
+			},
+			want: &domain.LoginRiskPolicy{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "INSTANCE",
+					ResourceOwner: "INSTANCE",
+					InstanceID:    "INSTANCE",
+				},
+				Default:   true,
+				NewDevice: domain.LoginRiskActionRequireMFA,
+			},
+		},
+		{
+			name: "no policy, no actions",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			want: &domain.LoginRiskPolicy{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "INSTANCE",
+					ResourceOwner: "INSTANCE",
+				},
+				Default: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authz.WithInstanceID(context.Background(), "INSTANCE")
+			got, err := getLoginRiskPolicy(ctx, "org1", tt.fields.eventstore(t).FilterToQueryReducer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newLoginRiskPolicyChangedEvent(ctx context.Context, orgID string, changes ...policy.LoginRiskPolicyChanges) *org.LoginRiskPolicyChangedEvent {
+	event, _ := org.NewLoginRiskPolicyChangedEvent(ctx,
+		&org.NewAggregate(orgID).Aggregate,
+		changes,
+	)
+	return event
+}
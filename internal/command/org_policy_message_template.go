@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMessageTemplate only validates if the language is supported, not if it is allowed.
+// This enables setting templates before allowing a language
+func (c *Commands) SetOrgMessageTemplate(ctx context.Context, resourceOwner string, template *domain.MessageTemplate) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Aeng4", "Errors.ResourceOwnerMissing")
+	}
+	if err := template.IsValid(i18n.SupportedLanguages()); err != nil {
+		return nil, err
+	}
+	if domain.IsCustomMessageType(template.MessageType) {
+		if err := c.checkCustomNotificationTypeExists(ctx, template.MessageType); err != nil {
+			return nil, err
+		}
+	}
+	existing, err := c.orgMessageTemplateWriteModelByID(ctx, resourceOwner, template.MessageType, template.Language)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State == domain.PolicyStateActive && existing.HTML == template.HTML && existing.PlainText == template.PlainText {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateSetEvent(ctx, orgAgg, template.MessageType, template.Language, template.HTML, template.PlainText))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgMessageTemplate(ctx context.Context, resourceOwner, messageType string, lang language.Tag) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ieX7o", "Errors.ResourceOwnerMissing")
+	}
+	if messageType == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Vah4o", "Errors.MessageTemplate.Invalid")
+	}
+	existing, err := c.orgMessageTemplateWriteModelByID(ctx, resourceOwner, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ee4ai", "Errors.MessageTemplate.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateRemovedEvent(ctx, orgAgg, messageType, lang))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) orgMessageTemplateWriteModelByID(ctx context.Context, orgID, messageType string, lang language.Tag) (*OrgMessageTemplateWriteModel, error) {
+	writeModel := NewOrgMessageTemplateWriteModel(orgID, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
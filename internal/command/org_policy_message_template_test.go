@@ -0,0 +1,372 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgMessageTemplate(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		template      *domain.MessageTemplate
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no resource owner, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					HTML:        "<html></html>",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown message type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: "Unknown",
+					HTML:        "<html></html>",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "empty template, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unsupported language, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					Language:    UnsupportedLanguage,
+					HTML:        "<html></html>",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid html template, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					HTML:        "<html>{{.Text</html>",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "template unchanged, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMessageTemplateSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.InviteUserMessageType,
+								language.English,
+								"<html>{{.Text}}</html>",
+								"{{.Text}}",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Text}}</html>",
+					PlainText:   "{{.Text}}",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "template set, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						org.NewMessageTemplateSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InviteUserMessageType,
+							language.English,
+							"<html>{{.Text}}</html>",
+							"{{.Text}}",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Text}}</html>",
+					PlainText:   "{{.Text}}",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "template for all languages set, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						org.NewMessageTemplateSetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InviteUserMessageType,
+							language.Und,
+							"",
+							"{{.Text}}",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				template: &domain.MessageTemplate{
+					MessageType: domain.InviteUserMessageType,
+					PlainText:   "{{.Text}}",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetOrgMessageTemplate(tt.args.ctx, tt.args.resourceOwner, tt.args.template)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgMessageTemplate(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		messageType   string
+		lang          language.Tag
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "empty message type, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				lang:          language.English,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "template not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				messageType:   domain.InviteUserMessageType,
+				lang:          language.English,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "template of other language, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMessageTemplateSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.InviteUserMessageType,
+								language.German,
+								"<html>{{.Text}}</html>",
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				messageType:   domain.InviteUserMessageType,
+				lang:          language.English,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove template, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMessageTemplateSetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								domain.InviteUserMessageType,
+								language.English,
+								"<html>{{.Text}}</html>",
+								"",
+							),
+						),
+					),
+					expectPush(
+						org.NewMessageTemplateRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							domain.InviteUserMessageType,
+							language.English,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				messageType:   domain.InviteUserMessageType,
+				lang:          language.English,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveOrgMessageTemplate(tt.args.ctx, tt.args.resourceOwner, tt.args.messageType, tt.args.lang)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
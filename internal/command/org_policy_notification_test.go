@@ -18,9 +18,10 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		orgID          string
-		passwordChange bool
+		ctx                  context.Context
+		orgID                string
+		passwordChange       bool
+		securityNotification bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -58,6 +59,7 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -82,6 +84,7 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 						org.NewNotificationPolicyAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
 							true,
+							false,
 						),
 					),
 				),
@@ -107,6 +110,7 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 						org.NewNotificationPolicyAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
 							false,
+							false,
 						),
 					),
 				),
@@ -128,7 +132,7 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange)
+			got, err := r.AddNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange, tt.args.securityNotification)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -147,9 +151,10 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		orgID          string
-		passwordChange bool
+		ctx                  context.Context
+		orgID                string
+		passwordChange       bool
+		securityNotification bool
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -203,6 +208,7 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -227,6 +233,7 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -246,13 +253,52 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change security notification, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNotificationPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+							),
+						),
+					),
+					expectPush(
+						func() *org.NotificationPolicyChangedEvent {
+							event, _ := org.NewNotificationPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.NotificationPolicyChanges{
+									policy.ChangeSecurityNotification(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:                  context.Background(),
+				orgID:                "org1",
+				passwordChange:       true,
+				securityNotification: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange)
+			got, err := r.ChangeNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange, tt.args.securityNotification)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -324,6 +370,7 @@ func TestCommandSide_RemoveNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
 							),
 						),
 					),
@@ -0,0 +1,226 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMSConfigTwilio adds a Twilio configuration to the organization passed as resource owner.
+// Once activated, it's used for the SMS of the users of the organization instead of the instance configuration.
+// The verification of codes through Twilio Verify is only possible with the instance configuration.
+func (c *Commands) AddOrgSMSConfigTwilio(ctx context.Context, config *AddTwilioConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahth4", "Errors.ResourceOwnerMissing")
+	}
+	if config.VerifyServiceSID != "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-eiN2a", "Errors.SMSConfig.OrgExternalVerification")
+	}
+	if err = c.checkOrgExists(ctx, config.ResourceOwner); err != nil {
+		return err
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	var token *crypto.CryptoValue
+	if config.Token != "" {
+		token, err = crypto.Encrypt([]byte(config.Token), c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMSConfigTwilioAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&writeModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.SID,
+			config.SenderNumber,
+			token,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilio(ctx context.Context, config *ChangeTwilioConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ee9ph", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-eeL6u", "Errors.IDMissing")
+	}
+	if config.VerifyServiceSID != nil && *config.VerifyServiceSID != "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pah7e", "Errors.SMSConfig.OrgExternalVerification")
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !writeModel.State.Exists() || writeModel.Twilio == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Iej1u", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := writeModel.NewTwilioChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.SID,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilioToken(ctx context.Context, orgID, id, token string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cah4u", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rai9e", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || writeModel.Twilio == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ii4ai", "Errors.SMSConfig.NotFound")
+	}
+	newToken, err := crypto.Encrypt([]byte(token), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMSConfigTokenChangedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id, newToken),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ActivateOrgSMSConfig activates the SMS configuration of the organization,
+// any other active configuration of the organization is deactivated.
+func (c *Commands) ActivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ughe3", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Zoo5u", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Deo1x", "Errors.SMSConfig.NotFound")
+	}
+	if writeModel.State == domain.SMSConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Thae8", "Errors.SMSConfig.AlreadyActive")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMSConfigActivatedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DeactivateOrgSMSConfig deactivates the SMS configuration of the organization,
+// the SMS of its users are sent through the active configuration of the instance again.
+func (c *Commands) DeactivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohs4u", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Chah6", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eep5d", "Errors.SMSConfig.NotFound")
+	}
+	if writeModel.State == domain.SMSConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uph7k", "Errors.SMSConfig.AlreadyDeactivated")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMSConfigDeactivatedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jah6o", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aip3f", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ieb9o", "Errors.SMSConfig.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMSConfigRemovedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getOrgSMSConfig(ctx context.Context, orgID, id string) (*OrgSMSConfigWriteModel, error) {
+	writeModel := NewOrgSMSConfigWriteModel(orgID, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// getActiveOrgSMSConfig returns the last activated SMS configuration of the organization
+func (c *Commands) getActiveOrgSMSConfig(ctx context.Context, orgID string) (*OrgSMSConfigWriteModel, error) {
+	writeModel := NewOrgSMSLastActivatedConfigWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.activeID == "" {
+		return NewOrgSMSConfigWriteModel(orgID, ""), nil
+	}
+	return c.getOrgSMSConfig(ctx, orgID, writeModel.activeID)
+}
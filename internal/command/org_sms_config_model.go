@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgSMSConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	Description string
+	Twilio      *TwilioConfig
+	State       domain.SMSConfigState
+}
+
+func NewOrgSMSConfigWriteModel(orgID, id string) *OrgSMSConfigWriteModel {
+	return &OrgSMSConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		ID: id,
+	}
+}
+
+func (wm *OrgSMSConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMSConfigTwilioAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Twilio = &TwilioConfig{
+				SID:          e.SID,
+				Token:        e.Token,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *org.SMSConfigTwilioChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.SID != nil {
+				wm.Twilio.SID = *e.SID
+			}
+			if e.SenderNumber != nil {
+				wm.Twilio.SenderNumber = *e.SenderNumber
+			}
+		case *org.SMSConfigTwilioTokenChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			wm.Twilio.Token = e.Token
+		case *org.SMSConfigActivatedEvent:
+			if wm.ID != e.ID {
+				wm.State = domain.SMSConfigStateInactive
+				continue
+			}
+			wm.State = domain.SMSConfigStateActive
+		case *org.SMSConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *org.SMSConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = ""
+			wm.Twilio = nil
+			wm.State = domain.SMSConfigStateRemoved
+		case *org.OrgRemovedEvent:
+			wm.Description = ""
+			wm.Twilio = nil
+			wm.State = domain.SMSConfigStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMSConfigTwilioAddedEventType,
+			org.SMSConfigTwilioChangedEventType,
+			org.SMSConfigTwilioTokenChangedEventType,
+			org.SMSConfigActivatedEventType,
+			org.SMSConfigDeactivatedEventType,
+			org.SMSConfigRemovedEventType,
+			org.OrgRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMSConfigWriteModel) NewTwilioChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, sid, senderNumber *string) (*org.SMSConfigTwilioChangedEvent, bool, error) {
+	if wm.Twilio == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigTwilioChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigTwilioDescription(*description))
+	}
+	if sid != nil && wm.Twilio.SID != *sid {
+		changes = append(changes, instance.ChangeSMSConfigTwilioSID(*sid))
+	}
+	if senderNumber != nil && wm.Twilio.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigTwilioSenderNumber(*senderNumber))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+type OrgSMSLastActivatedConfigWriteModel struct {
+	eventstore.WriteModel
+
+	activeID string
+}
+
+func NewOrgSMSLastActivatedConfigWriteModel(orgID string) *OrgSMSLastActivatedConfigWriteModel {
+	return &OrgSMSLastActivatedConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgSMSLastActivatedConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*org.SMSConfigActivatedEvent); ok {
+			wm.activeID = e.ID
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMSLastActivatedConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		OrderDesc().
+		Limit(1).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(org.SMSConfigActivatedEventType).
+		Builder()
+}
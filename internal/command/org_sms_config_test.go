@@ -0,0 +1,429 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddOrgSMSConfigTwilio(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		sms *AddTwilioConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resourceowner missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				sms: &AddTwilioConfig{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahth4", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "verify service sid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				sms: &AddTwilioConfig{
+					ResourceOwner:    "org1",
+					VerifyServiceSID: "verify",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-eiN2a", "Errors.SMSConfig.OrgExternalVerification"))
+				},
+			},
+		},
+		{
+			name: "add sms config twilio, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org1",
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewSMSConfigTwilioAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"providerid",
+							"description",
+							"sid",
+							"senderName",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("token"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				sms: &AddTwilioConfig{
+					ResourceOwner: "org1",
+					Description:   "description",
+					SID:           "sid",
+					Token:         "token",
+					SenderNumber:  "senderName",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddOrgSMSConfigTwilio(context.Background(), tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeOrgSMSConfigTwilio(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		sms *ChangeTwilioConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "verify service sid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				sms: &ChangeTwilioConfig{
+					ResourceOwner:    "org1",
+					ID:               "providerid",
+					VerifyServiceSID: gu.Ptr("verify"),
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pah7e", "Errors.SMSConfig.OrgExternalVerification"))
+				},
+			},
+		},
+		{
+			name: "sms config not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				sms: &ChangeTwilioConfig{
+					ResourceOwner: "org1",
+					ID:            "providerid",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Iej1u", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMSConfigTwilioAddedEvent("providerid"),
+						),
+					),
+				),
+			},
+			args: args{
+				sms: &ChangeTwilioConfig{
+					ResourceOwner: "org1",
+					ID:            "providerid",
+					Description:   gu.Ptr("description"),
+					SID:           gu.Ptr("sid"),
+					SenderNumber:  gu.Ptr("senderName"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "sms config twilio change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMSConfigTwilioAddedEvent("providerid"),
+						),
+					),
+					expectPush(
+						newOrgSMSConfigTwilioChangedEvent(
+							"providerid",
+							"description2",
+							"sid2",
+							"senderName2",
+						),
+					),
+				),
+			},
+			args: args{
+				sms: &ChangeTwilioConfig{
+					ResourceOwner: "org1",
+					ID:            "providerid",
+					Description:   gu.Ptr("description2"),
+					SID:           gu.Ptr("sid2"),
+					SenderNumber:  gu.Ptr("senderName2"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeOrgSMSConfigTwilio(context.Background(), tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DeactivateOrgSMSConfig(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		id    string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms config not active, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMSConfigTwilioAddedEvent("providerid"),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "providerid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uph7k", "Errors.SMSConfig.AlreadyDeactivated"))
+				},
+			},
+		},
+		{
+			name: "sms config deactivated by activation of other config, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMSConfigTwilioAddedEvent("providerid"),
+						),
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"providerid",
+							),
+						),
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"providerid2",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "providerid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uph7k", "Errors.SMSConfig.AlreadyDeactivated"))
+				},
+			},
+		},
+		{
+			name: "deactivate sms config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMSConfigTwilioAddedEvent("providerid"),
+						),
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"providerid",
+							),
+						),
+					),
+					expectPush(
+						org.NewSMSConfigDeactivatedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"providerid",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.DeactivateOrgSMSConfig(context.Background(), tt.args.orgID, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newOrgSMSConfigTwilioAddedEvent(id string) *org.SMSConfigTwilioAddedEvent {
+	return org.NewSMSConfigTwilioAddedEvent(
+		context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		id,
+		"description",
+		"sid",
+		"senderName",
+		&crypto.CryptoValue{},
+	)
+}
+
+func newOrgSMSConfigTwilioChangedEvent(id, description, sid, senderNumber string) *org.SMSConfigTwilioChangedEvent {
+	event, _ := org.NewSMSConfigTwilioChangedEvent(
+		context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		id,
+		[]instance.SMSConfigTwilioChanges{
+			instance.ChangeSMSConfigTwilioDescription(description),
+			instance.ChangeSMSConfigTwilioSID(sid),
+			instance.ChangeSMSConfigTwilioSenderNumber(senderNumber),
+		},
+	)
+	return event
+}
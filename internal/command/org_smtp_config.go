@@ -0,0 +1,244 @@
+package command
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMTPConfig adds an SMTP configuration to the organization passed as resource owner.
+// Once activated, it's used for the notifications of the users of the organization instead of the instance configuration.
+func (c *Commands) AddOrgSMTPConfig(ctx context.Context, config *AddSMTPConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Eej4o", "Errors.ResourceOwnerMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ahB5u", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoo9a", "Errors.Invalid.Argument")
+	}
+	if err = c.checkOrgExists(ctx, config.ResourceOwner); err != nil {
+		return err
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	var smtpPassword *crypto.CryptoValue
+	if config.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.Password), c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMTPConfigAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&writeModel.WriteModel),
+			config.ID,
+			strings.TrimSpace(config.Description),
+			config.Tls,
+			from,
+			config.FromName,
+			strings.TrimSpace(config.ReplyToAddress),
+			hostAndPort,
+			config.User,
+			smtpPassword,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfig(ctx context.Context, config *ChangeSMTPConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Thoo2", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahk4i", "Errors.IDMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Uu5ae", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ohF8e", "Errors.Invalid.Argument")
+	}
+	var smtpPassword *crypto.CryptoValue
+	if config.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.Password), c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !writeModel.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Kie0u", "Errors.SMTPConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := writeModel.NewChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		config.ID,
+		strings.TrimSpace(config.Description),
+		config.Tls,
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		hostAndPort,
+		config.User,
+		smtpPassword,
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&writeModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfigPassword(ctx context.Context, orgID, id, password string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ieh7u", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Roo3e", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ga0ie", "Errors.SMTPConfig.NotFound")
+	}
+	var smtpPassword *crypto.CryptoValue
+	if password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMTPConfigPasswordChangedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id, smtpPassword),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ActivateOrgSMTPConfig activates the SMTP configuration of the organization,
+// any other active configuration of the organization is deactivated.
+func (c *Commands) ActivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vah0e", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohj2u", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Shu7o", "Errors.SMTPConfig.NotFound")
+	}
+	if writeModel.State == domain.SMTPConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiS4o", "Errors.SMTPConfig.AlreadyActive")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMTPConfigActivatedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DeactivateOrgSMTPConfig deactivates the SMTP configuration of the organization,
+// the notifications of its users are sent through the active configuration of the instance again.
+func (c *Commands) DeactivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohh1a", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-yo0Ei", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Eir0h", "Errors.SMTPConfig.NotFound")
+	}
+	if writeModel.State == domain.SMTPConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-iePh6", "Errors.SMTPConfig.AlreadyDeactivated")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMTPConfigDeactivatedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wae5k", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooh4s", "Errors.IDMissing")
+	}
+	writeModel, err := c.getOrgSMTPConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ath9e", "Errors.SMTPConfig.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		writeModel,
+		org.NewSMTPConfigRemovedEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getOrgSMTPConfig(ctx context.Context, orgID, id string) (*OrgSMTPConfigWriteModel, error) {
+	writeModel := NewOrgSMTPConfigWriteModel(orgID, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
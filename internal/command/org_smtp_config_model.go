@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgSMTPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	Description string
+	SMTPConfig  *SMTPConfig
+	State       domain.SMTPConfigState
+}
+
+func NewOrgSMTPConfigWriteModel(orgID, id string) *OrgSMTPConfigWriteModel {
+	return &OrgSMTPConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		ID: id,
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMTPConfigAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = e.Description
+			wm.SMTPConfig = &SMTPConfig{
+				TLS:            e.TLS,
+				Host:           e.Host,
+				User:           e.User,
+				Password:       e.Password,
+				SenderName:     e.SenderName,
+				SenderAddress:  e.SenderAddress,
+				ReplyToAddress: e.ReplyToAddress,
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.SMTPConfigChangedEvent:
+			if wm.ID != e.ID || wm.SMTPConfig == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.TLS != nil {
+				wm.SMTPConfig.TLS = *e.TLS
+			}
+			if e.Host != nil {
+				wm.SMTPConfig.Host = *e.Host
+			}
+			if e.User != nil {
+				wm.SMTPConfig.User = *e.User
+			}
+			if e.Password != nil {
+				wm.SMTPConfig.Password = e.Password
+			}
+			if e.FromAddress != nil {
+				wm.SMTPConfig.SenderAddress = *e.FromAddress
+			}
+			if e.FromName != nil {
+				wm.SMTPConfig.SenderName = *e.FromName
+			}
+			if e.ReplyToAddress != nil {
+				wm.SMTPConfig.ReplyToAddress = *e.ReplyToAddress
+			}
+		case *org.SMTPConfigPasswordChangedEvent:
+			if wm.ID != e.ID || wm.SMTPConfig == nil {
+				continue
+			}
+			wm.SMTPConfig.Password = e.Password
+		case *org.SMTPConfigActivatedEvent:
+			if wm.ID != e.ID {
+				wm.State = domain.SMTPConfigStateInactive
+				continue
+			}
+			wm.State = domain.SMTPConfigStateActive
+		case *org.SMTPConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.SMTPConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = ""
+			wm.SMTPConfig = nil
+			wm.State = domain.SMTPConfigStateRemoved
+		case *org.OrgRemovedEvent:
+			wm.Description = ""
+			wm.SMTPConfig = nil
+			wm.State = domain.SMTPConfigStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMTPConfigAddedEventType,
+			org.SMTPConfigChangedEventType,
+			org.SMTPConfigPasswordChangedEventType,
+			org.SMTPConfigActivatedEventType,
+			org.SMTPConfigDeactivatedEventType,
+			org.SMTPConfigRemovedEventType,
+			org.OrgRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) (*org.SMTPConfigChangedEvent, bool, error) {
+	if wm.SMTPConfig == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMTPConfigChanges, 0)
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigDescription(description))
+	}
+	if wm.SMTPConfig.TLS != tls {
+		changes = append(changes, instance.ChangeSMTPConfigTLS(tls))
+	}
+	if wm.SMTPConfig.SenderAddress != fromAddress {
+		changes = append(changes, instance.ChangeSMTPConfigFromAddress(fromAddress))
+	}
+	if wm.SMTPConfig.SenderName != fromName {
+		changes = append(changes, instance.ChangeSMTPConfigFromName(fromName))
+	}
+	if wm.SMTPConfig.ReplyToAddress != replyToAddress {
+		changes = append(changes, instance.ChangeSMTPConfigReplyToAddress(replyToAddress))
+	}
+	if wm.SMTPConfig.Host != smtpHost {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPHost(smtpHost))
+	}
+	if wm.SMTPConfig.User != smtpUser {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPUser(smtpUser))
+	}
+	if smtpPassword != nil {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPPassword(smtpPassword))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
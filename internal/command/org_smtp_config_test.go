@@ -0,0 +1,417 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		smtp *AddSMTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resourceowner missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				smtp: &AddSMTPConfig{
+					From: "from@domain.ch",
+					Host: "host:587",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eej4o", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "host without port, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				smtp: &AddSMTPConfig{
+					ResourceOwner: "org1",
+					From:          "from@domain.ch",
+					Host:          "host",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoo9a", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "org not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				smtp: &AddSMTPConfig{
+					ResourceOwner: "org1",
+					From:          "from@domain.ch",
+					Host:          "host:587",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add smtp config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org1",
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewSMTPConfigAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+							"test",
+							true,
+							"from@domain.ch",
+							"name",
+							"replyto@domain.ch",
+							"host:587",
+							"user",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("password"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				smtp: &AddSMTPConfig{
+					ResourceOwner:  "org1",
+					Description:    "test",
+					Tls:            true,
+					From:           "from@domain.ch",
+					FromName:       "name",
+					ReplyToAddress: "replyto@domain.ch",
+					Host:           "host:587",
+					User:           "user",
+					Password:       "password",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				idGenerator:    tt.fields.idGenerator,
+				smtpEncryption: tt.fields.alg,
+			}
+			err := r.AddOrgSMTPConfig(context.Background(), tt.args.smtp)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.smtp.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ActivateOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		id    string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				orgID: "org1",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohj2u", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Shu7o", "Errors.SMTPConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "smtp config already active, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMTPConfigAddedEvent("configid"),
+						),
+						eventFromEventPusher(
+							org.NewSMTPConfigActivatedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiS4o", "Errors.SMTPConfig.AlreadyActive"))
+				},
+			},
+		},
+		{
+			name: "activate smtp config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMTPConfigAddedEvent("configid"),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigActivatedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.ActivateOrgSMTPConfig(context.Background(), tt.args.orgID, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		orgID string
+		id    string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resourceowner empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				id: "configid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wae5k", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "smtp config removed, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMTPConfigAddedEvent("configid"),
+						),
+						eventFromEventPusher(
+							org.NewSMTPConfigRemovedEvent(
+								context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"configid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Ath9e", "Errors.SMTPConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "remove smtp config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgSMTPConfigAddedEvent("configid"),
+						),
+					),
+					expectPush(
+						org.NewSMTPConfigRemovedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"configid",
+						),
+					),
+				),
+			},
+			args: args{
+				orgID: "org1",
+				id:    "configid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgSMTPConfig(context.Background(), tt.args.orgID, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newOrgSMTPConfigAddedEvent(id string) *org.SMTPConfigAddedEvent {
+	return org.NewSMTPConfigAddedEvent(
+		context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		id,
+		"test",
+		true,
+		"from@domain.ch",
+		"name",
+		"",
+		"host:587",
+		"user",
+		&crypto.CryptoValue{},
+	)
+}
@@ -17,9 +17,9 @@ type Phone struct {
 }
 
 // newPhoneCode generates a new code to be sent out to via SMS or
-// returns the ID of the external code provider (e.g. when using Twilio verification API)
-func (c *Commands) newPhoneCode(ctx context.Context, filter preparation.FilterToQueryReducer, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
-	externalID, err := c.activeSMSProvider(ctx)
+// returns the ID of the external code provider (e.g. when using Twilio verification API) of the user's resource owner
+func (c *Commands) newPhoneCode(ctx context.Context, filter preparation.FilterToQueryReducer, resourceOwner string, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
+	externalID, err := c.activeSMSProvider(ctx, resourceOwner)
 	if err != nil {
 		return nil, "", err
 	}
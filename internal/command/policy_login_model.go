@@ -21,6 +21,7 @@ type LoginPolicyWriteModel struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 	PasswordlessType           domain.PasswordlessType
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      time.Duration
@@ -46,6 +47,7 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.AllowDomainDiscovery = e.AllowDomainDiscovery
 			wm.DisableLoginWithEmail = e.DisableLoginWithEmail
 			wm.DisableLoginWithPhone = e.DisableLoginWithPhone
+			wm.AllowMagicLink = e.AllowMagicLink
 			wm.DefaultRedirectURI = e.DefaultRedirectURI
 			wm.PasswordCheckLifetime = e.PasswordCheckLifetime
 			wm.ExternalLoginCheckLifetime = e.ExternalLoginCheckLifetime
@@ -105,6 +107,9 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.DisableLoginWithPhone != nil {
 				wm.DisableLoginWithPhone = *e.DisableLoginWithPhone
 			}
+			if e.AllowMagicLink != nil {
+				wm.AllowMagicLink = *e.AllowMagicLink
+			}
 		case *policy.LoginPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
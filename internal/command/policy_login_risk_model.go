@@ -0,0 +1,64 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type LoginRiskPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	NewDevice        domain.LoginRiskAction
+	NewNetwork       domain.LoginRiskAction
+	ImpossibleTravel domain.LoginRiskAction
+	State            domain.PolicyState
+}
+
+func (wm *LoginRiskPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.LoginRiskPolicyAddedEvent:
+			wm.NewDevice = e.NewDevice
+			wm.NewNetwork = e.NewNetwork
+			wm.ImpossibleTravel = e.ImpossibleTravel
+			wm.State = domain.PolicyStateActive
+		case *policy.LoginRiskPolicyChangedEvent:
+			if e.NewDevice != nil {
+				wm.NewDevice = *e.NewDevice
+			}
+			if e.NewNetwork != nil {
+				wm.NewNetwork = *e.NewNetwork
+			}
+			if e.ImpossibleTravel != nil {
+				wm.ImpossibleTravel = *e.ImpossibleTravel
+			}
+		case *policy.LoginRiskPolicyRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LoginRiskPolicyWriteModel) changes(newDevice, newNetwork, impossibleTravel domain.LoginRiskAction) []policy.LoginRiskPolicyChanges {
+	changes := make([]policy.LoginRiskPolicyChanges, 0, 3)
+	if wm.NewDevice != newDevice {
+		changes = append(changes, policy.ChangeLoginRiskNewDevice(newDevice))
+	}
+	if wm.NewNetwork != newNetwork {
+		changes = append(changes, policy.ChangeLoginRiskNewNetwork(newNetwork))
+	}
+	if wm.ImpossibleTravel != impossibleTravel {
+		changes = append(changes, policy.ChangeLoginRiskImpossibleTravel(impossibleTravel))
+	}
+	return changes
+}
+
+func writeModelToLoginRiskPolicy(wm *LoginRiskPolicyWriteModel) *domain.LoginRiskPolicy {
+	return &domain.LoginRiskPolicy{
+		ObjectRoot:       writeModelToObjectRoot(wm.WriteModel),
+		NewDevice:        wm.NewDevice,
+		NewNetwork:       wm.NewNetwork,
+		ImpossibleTravel: wm.ImpossibleTravel,
+	}
+}
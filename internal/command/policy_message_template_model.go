@@ -0,0 +1,42 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type MessageTemplateWriteModel struct {
+	eventstore.WriteModel
+
+	MessageType string
+	Language    language.Tag
+	HTML        string
+	PlainText   string
+
+	State domain.PolicyState
+}
+
+func (wm *MessageTemplateWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.MessageTemplateSetEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.HTML = e.HTML
+			wm.PlainText = e.PlainText
+			wm.State = domain.PolicyStateActive
+		case *policy.MessageTemplateRemovedEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.HTML = ""
+			wm.PlainText = ""
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
@@ -9,8 +9,9 @@ import (
 type NotificationPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	PasswordChange bool
-	State          domain.PolicyState
+	PasswordChange       bool
+	SecurityNotification bool
+	State                domain.PolicyState
 }
 
 func (wm *NotificationPolicyWriteModel) Reduce() error {
@@ -18,11 +19,15 @@ func (wm *NotificationPolicyWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *policy.NotificationPolicyAddedEvent:
 			wm.PasswordChange = e.PasswordChange
+			wm.SecurityNotification = e.SecurityNotification
 			wm.State = domain.PolicyStateActive
 		case *policy.NotificationPolicyChangedEvent:
 			if e.PasswordChange != nil {
 				wm.PasswordChange = *e.PasswordChange
 			}
+			if e.SecurityNotification != nil {
+				wm.SecurityNotification = *e.SecurityNotification
+			}
 		case *policy.NotificationPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
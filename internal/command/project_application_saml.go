@@ -45,6 +45,21 @@ func (c *Commands) AddSAMLApplication(ctx context.Context, application *domain.S
 	return result, nil
 }
 
+func (c *Commands) AddSAMLApplicationWithID(ctx context.Context, application *domain.SAMLApp, resourceOwner, appID string) (_ *domain.SAMLApp, err error) {
+	if application == nil || application.AggregateID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Veeh3", "Errors.Project.App.Invalid")
+	}
+	existingApp, err := c.getSAMLAppWriteModel(ctx, application.AggregateID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingApp.State != domain.AppStateUnspecified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-Aeb5o", "Errors.Project.App.AlreadyExisting")
+	}
+	application.AppID = appID
+	return c.AddSAMLApplication(ctx, application, resourceOwner)
+}
+
 func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstore.Aggregate, samlApp *domain.SAMLApp) (events []eventstore.Command, err error) {
 
 	if samlApp.AppName == "" || !samlApp.IsValid() {
@@ -68,9 +83,11 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-bquso", "Errors.Project.App.SAMLMetadataFormat")
 	}
 
-	samlApp.AppID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, err
+	if samlApp.AppID == "" {
+		samlApp.AppID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return []eventstore.Command{
@@ -32,31 +32,33 @@ type SessionCommands struct {
 	eventstore        *eventstore.Eventstore
 	eventCommands     []eventstore.Command
 
-	hasher          *crypto.Hasher
-	intentAlg       crypto.EncryptionAlgorithm
-	totpAlg         crypto.EncryptionAlgorithm
-	otpAlg          crypto.EncryptionAlgorithm
-	createCode      encryptedCodeWithDefaultFunc
-	createPhoneCode encryptedCodeGeneratorWithDefaultFunc
-	createToken     func(sessionID string) (id string, token string, err error)
-	getCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error)
-	now             func() time.Time
+	hasher              *crypto.Hasher
+	intentAlg           crypto.EncryptionAlgorithm
+	totpAlg             crypto.EncryptionAlgorithm
+	otpAlg              crypto.EncryptionAlgorithm
+	createCode          encryptedCodeWithDefaultFunc
+	createPhoneCode     encryptedCodeGeneratorWithDefaultFunc
+	createToken         func(sessionID string) (id string, token string, err error)
+	createPushChallenge func() (string, error)
+	getCodeVerifier     func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
+	now                 func() time.Time
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
 	return &SessionCommands{
-		sessionCommands:   cmds,
-		sessionWriteModel: session,
-		eventstore:        c.eventstore,
-		hasher:            c.userPasswordHasher,
-		intentAlg:         c.idpConfigEncryption,
-		totpAlg:           c.multifactors.OTP.CryptoMFA,
-		otpAlg:            c.userEncryption,
-		createCode:        c.newEncryptedCodeWithDefault,
-		createPhoneCode:   c.newPhoneCode,
-		createToken:       c.sessionTokenCreator,
-		getCodeVerifier:   c.phoneCodeVerifierFromConfig,
-		now:               time.Now,
+		sessionCommands:     cmds,
+		sessionWriteModel:   session,
+		eventstore:          c.eventstore,
+		hasher:              c.userPasswordHasher,
+		intentAlg:           c.idpConfigEncryption,
+		totpAlg:             c.multifactors.OTP.CryptoMFA,
+		otpAlg:              c.userEncryption,
+		createCode:          c.newEncryptedCodeWithDefault,
+		createPhoneCode:     c.newPhoneCode,
+		createToken:         c.sessionTokenCreator,
+		createPushChallenge: newPushChallenge,
+		getCodeVerifier:     c.phoneCodeVerifierFromConfig,
+		now:                 time.Now,
 	}
 }
 
@@ -152,6 +154,8 @@ func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error
 
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so the login risk evaluation can use it
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -209,6 +213,22 @@ func (s *SessionCommands) OTPEmailChecked(ctx context.Context, checkedAt time.Ti
 	s.eventCommands = append(s.eventCommands, session.NewOTPEmailCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) PushChallenged(ctx context.Context, challenge string, expiry time.Duration, devices []session.PushDevice) {
+	s.eventCommands = append(s.eventCommands, session.NewPushChallengedEvent(ctx, s.sessionWriteModel.aggregate, challenge, expiry, devices))
+}
+
+func (s *SessionCommands) PushChecked(ctx context.Context, deviceID string, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewPushCheckedEvent(ctx, s.sessionWriteModel.aggregate, deviceID, checkedAt))
+}
+
+func (s *SessionCommands) MagicLinkChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, urlTmpl string) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, urlTmpl))
+}
+
+func (s *SessionCommands) MagicLinkChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
 func (s *SessionCommands) SetToken(ctx context.Context, tokenID string) {
 	// trigger activity log for session for user
 	activity.Trigger(ctx, s.sessionWriteModel.UserResourceOwner, s.sessionWriteModel.UserID, activity.SessionAPI, s.eventstore.FilterToQueryReducer)
@@ -348,6 +368,16 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 		}
 		return nil, err
 	}
+	riskAction, err := c.evaluateLoginRisk(ctx, checks)
+	if err != nil {
+		return nil, err
+	}
+	if riskAction == domain.LoginRiskActionBlock {
+		if _, err = c.eventstore.Push(ctx, checks.eventCommands...); err != nil {
+			return nil, err
+		}
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ooqu4", "Errors.Session.Risk.Blocked")
+	}
 	checks.ChangeMetadata(ctx, metadata)
 	err = checks.SetLifetime(ctx, lifetime)
 	if err != nil {
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CreateMagicLinkChallengeURLTemplate sends a login link to the verified email address of the session user.
+// The link is rendered from the urlTmpl, which has to point to the login UI checking the code.
+func (c *Commands) CreateMagicLinkChallengeURLTemplate(urlTmpl string) (SessionCommand, error) {
+	if urlTmpl == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ahTh4", "Errors.User.MagicLink.URLTemplateMissing")
+	}
+	if err := domain.RenderMagicLinkURLTemplate(io.Discard, urlTmpl, "code", "userID", "loginName", "displayName", "sessionID", language.English); err != nil {
+		return nil, err
+	}
+	return c.createMagicLinkChallenge(false, urlTmpl, nil), nil
+}
+
+// CreateMagicLinkChallengeReturnCode creates the code of the login link and returns it instead of sending it,
+// so the login UI can deliver the link itself.
+func (c *Commands) CreateMagicLinkChallengeReturnCode(dst *string) SessionCommand {
+	return c.createMagicLinkChallenge(true, "", dst)
+}
+
+func (c *Commands) createMagicLinkChallenge(returnCode bool, urlTmpl string, dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eij7u", "Errors.User.UserIDMissing")
+		}
+		writeModel, err := c.magicLinkWriteModelByID(ctx, cmd.sessionWriteModel.UserID, "")
+		if err != nil {
+			return nil, err
+		}
+		if err = c.checkMagicLinkAllowed(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		if c.magicLink.RateLimited(writeModel.Requests, cmd.now()) {
+			return nil, zerrors.ThrowResourceExhausted(nil, "COMMAND-Ve4ai", "Errors.User.MagicLink.RateLimited")
+		}
+		code, err := cmd.createCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeMagicLink, cmd.otpAlg, c.defaultSecretGenerators.MagicLink) //nolint:staticcheck
+		if err != nil {
+			return nil, err
+		}
+		if returnCode {
+			*dst = code.Plain
+		}
+		cmd.MagicLinkChallenged(ctx, code.Crypted, code.Expiry, returnCode, urlTmpl)
+		return []eventstore.Command{
+			user.NewHumanMagicLinkRequestedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)),
+		}, nil
+	}
+}
+
+func (c *Commands) MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.MagicLinkChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oog5a", "Errors.User.Code.NotFound")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewMagicLinkSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckSessionMagicLink defines a check of the code of the login link to be executed for a session update.
+// The login link must still be allowed for the user, a failed check counts towards the OTP attempts of the lockout policy.
+func (c *Commands) CheckSessionMagicLink(code string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ree4u", "Errors.User.UserIDMissing")
+		}
+		if code == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieT5a", "Errors.User.Code.Empty")
+		}
+		challenge := cmd.sessionWriteModel.MagicLinkChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xei0u", "Errors.User.Code.NotFound")
+		}
+		writeModel, err := c.magicLinkWriteModelByID(ctx, cmd.sessionWriteModel.UserID, "")
+		if err != nil {
+			return nil, err
+		}
+		if err = c.checkMagicLinkAllowed(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+		verifyErr := crypto.VerifyCode(challenge.CreationDate, challenge.Expiry, challenge.Code, code, cmd.otpAlg)
+		if verifyErr == nil {
+			cmd.eventCommands = append(cmd.eventCommands, user.NewHumanMagicLinkCheckSucceededEvent(ctx, userAgg, nil))
+			cmd.MagicLinkChecked(ctx, cmd.now())
+			return nil, nil
+		}
+		commands := make([]eventstore.Command, 0, 2)
+		commands = append(commands, user.NewHumanMagicLinkCheckFailedEvent(ctx, userAgg, nil))
+		lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, writeModel.ResourceOwner, cmd.eventstore.FilterToQueryReducer)
+		logging.OnError(lockoutErr).Error("unable to get lockout policy")
+		if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && writeModel.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
+			commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+		}
+		return commands, verifyErr
+	}
+}
@@ -0,0 +1,444 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func sessionMagicLinkUserEvents(emailVerified bool) []eventstore.Event {
+	events := []eventstore.Event{
+		eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("userID", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		),
+	}
+	if emailVerified {
+		events = append(events, eventFromEventPusher(
+			user.NewHumanEmailVerifiedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+		))
+	}
+	return events
+}
+
+func TestCommands_CreateMagicLinkChallengeURLTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		urlTmpl string
+		wantErr error
+	}{
+		{
+			name:    "missing template",
+			urlTmpl: "",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ahTh4", "Errors.User.MagicLink.URLTemplateMissing"),
+		},
+		{
+			name:    "invalid template",
+			urlTmpl: "https://example.com/magic?code={{.Code",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+		},
+		{
+			name:    "valid template",
+			urlTmpl: "https://example.com/magic?sessionID={{.SessionID}}&code={{.Code}}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := new(Commands)
+			got, err := c.CreateMagicLinkChallengeURLTemplate(tt.urlTmpl)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestCommands_CreateMagicLinkChallengeReturnCode(t *testing.T) {
+	type fields struct {
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		createCode encryptedCodeWithDefaultFunc
+		magicLink  domain.MagicLinkConfig
+	}
+	type res struct {
+		err          error
+		returnCode   string
+		commands     []eventstore.Command
+		userCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "userID missing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eij7u", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "not allowed by login policy, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(true)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), false),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohz0e", "Errors.User.MagicLink.NotAllowed"),
+			},
+		},
+		{
+			name: "email not verified, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(false)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-iW9ei", "Errors.User.MagicLink.EmailNotVerified"),
+			},
+		},
+		{
+			name: "rate limited, resource exhausted error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(append(sessionMagicLinkUserEvents(true),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanMagicLinkRequestedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						),
+					)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+				),
+				magicLink: domain.MagicLinkConfig{
+					MaxRequests:     1,
+					RateLimitWindow: time.Hour,
+				},
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-Ve4ai", "Errors.User.MagicLink.RateLimited"),
+			},
+		},
+		{
+			name: "generate code",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(true)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+				),
+				createCode: mockEncryptedCodeWithDefault("1234567", 5*time.Minute),
+				magicLink: domain.MagicLinkConfig{
+					MaxRequests:     1,
+					RateLimitWindow: time.Hour,
+				},
+			},
+			res: res{
+				returnCode: "1234567",
+				userCommands: []eventstore.Command{
+					user.NewHumanMagicLinkRequestedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+				},
+				commands: []eventstore.Command{
+					session.NewMagicLinkChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("1234567"),
+						},
+						5*time.Minute,
+						true,
+						"",
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := tt.fields.eventstore(t)
+			c := &Commands{
+				eventstore: es,
+				// config will not be actively used for the test (is only for default),
+				// but not providing it would result in a nil pointer
+				defaultSecretGenerators: &SecretGenerators{
+					MagicLink: emptyConfig,
+				},
+				magicLink: tt.fields.magicLink,
+			}
+			var dst string
+			cmd := c.CreateMagicLinkChallengeReturnCode(&dst)
+
+			sessionModel := &SessionWriteModel{
+				UserID:        tt.fields.userID,
+				UserCheckedAt: testNow,
+				State:         domain.SessionStateActive,
+				aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        es,
+				createCode:        tt.fields.createCode,
+				now:               time.Now,
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.userCommands, gotCmds)
+			assert.Equal(t, tt.res.returnCode, dst)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_CheckSessionMagicLink(t *testing.T) {
+	challenge := func(creationDate time.Time) *OTPCode {
+		return &OTPCode{
+			Code: &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("code"),
+			},
+			Expiry:       5 * time.Minute,
+			CreationDate: creationDate,
+		}
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		userID     string
+		challenge  *OTPCode
+	}
+	type args struct {
+		code string
+	}
+	type res struct {
+		err           error
+		commands      []eventstore.Command
+		errorCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ree4u", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing code",
+			fields: fields{
+				eventstore: expectEventstore(),
+				userID:     "userID",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieT5a", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			name: "missing challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+				userID:     "userID",
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xei0u", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			name: "not allowed by login policy",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(true)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), false),
+						),
+					),
+				),
+				userID:    "userID",
+				challenge: challenge(testNow),
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohz0e", "Errors.User.MagicLink.NotAllowed"),
+			},
+		},
+		{
+			name: "email not verified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(false)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+				),
+				userID:    "userID",
+				challenge: challenge(testNow),
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-iW9ei", "Errors.User.MagicLink.EmailNotVerified"),
+			},
+		},
+		{
+			name: "expired code, locked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(true)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								0, 1, false,
+							),
+						),
+					),
+				),
+				userID:    "userID",
+				challenge: challenge(testNow.Add(-10 * time.Minute)),
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+				},
+			},
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(sessionMagicLinkUserEvents(true)...),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(context.Background(), true),
+						),
+					),
+				),
+				userID:    "userID",
+				challenge: challenge(time.Now()),
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckSucceededEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					session.NewMagicLinkCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						testNow,
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := tt.fields.eventstore(t)
+			c := &Commands{
+				eventstore: es,
+			}
+			cmd := c.CheckSessionMagicLink(tt.args.code)
+
+			sessionModel := &SessionWriteModel{
+				UserID:             tt.fields.userID,
+				UserCheckedAt:      testNow,
+				State:              domain.SessionStateActive,
+				MagicLinkChallenge: tt.fields.challenge,
+				aggregate:          &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        es,
+				otpAlg:            crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.errorCommands, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
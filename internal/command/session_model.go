@@ -52,15 +52,21 @@ type SessionWriteModel struct {
 	TOTPCheckedAt        time.Time
 	OTPSMSCheckedAt      time.Time
 	OTPEmailCheckedAt    time.Time
+	PushCheckedAt        time.Time
+	MagicLinkCheckedAt   time.Time
 	WebAuthNUserVerified bool
 	Metadata             map[string][]byte
 	State                domain.SessionState
 	UserAgent            *domain.UserAgent
 	Expiration           time.Time
+	RiskEvaluated        bool
+	RiskAction           domain.LoginRiskAction
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
 	OTPEmailCodeChallenge *OTPCode
+	PushChallenge         *PushChallengeModel
+	MagicLinkChallenge    *OTPCode
 
 	aggregate *eventstore.Aggregate
 }
@@ -102,10 +108,20 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceOTPEmailChallenged(e)
 		case *session.OTPEmailCheckedEvent:
 			wm.reduceOTPEmailChecked(e)
+		case *session.PushChallengedEvent:
+			wm.reducePushChallenged(e)
+		case *session.PushCheckedEvent:
+			wm.reducePushChecked(e)
+		case *session.MagicLinkChallengedEvent:
+			wm.reduceMagicLinkChallenged(e)
+		case *session.MagicLinkCheckedEvent:
+			wm.reduceMagicLinkChecked(e)
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.LifetimeSetEvent:
 			wm.reduceLifetimeSet(e)
+		case *session.RiskEvaluatedEvent:
+			wm.reduceRiskEvaluated(e)
 		case *session.TerminateEvent:
 			wm.reduceTerminate()
 		}
@@ -131,9 +147,14 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPSMSCheckedType,
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
+			session.PushChallengedType,
+			session.PushCheckedType,
+			session.MagicLinkChallengedType,
+			session.MagicLinkCheckedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
+			session.RiskEvaluatedType,
 			session.TerminateType,
 		).
 		Builder()
@@ -215,6 +236,37 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reducePushChallenged(e *session.PushChallengedEvent) {
+	deviceIDs := make([]string, len(e.Devices))
+	for i, device := range e.Devices {
+		deviceIDs[i] = device.ID
+	}
+	wm.PushChallenge = &PushChallengeModel{
+		Challenge:    e.Challenge,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+		DeviceIDs:    deviceIDs,
+	}
+}
+
+func (wm *SessionWriteModel) reducePushChecked(e *session.PushCheckedEvent) {
+	wm.PushChallenge = nil
+	wm.PushCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceMagicLinkChallenged(e *session.MagicLinkChallengedEvent) {
+	wm.MagicLinkChallenge = &OTPCode{
+		Code:         e.Code,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+	}
+}
+
+func (wm *SessionWriteModel) reduceMagicLinkChecked(e *session.MagicLinkCheckedEvent) {
+	wm.MagicLinkChallenge = nil
+	wm.MagicLinkCheckedAt = e.CheckedAt
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -223,6 +275,11 @@ func (wm *SessionWriteModel) reduceLifetimeSet(e *session.LifetimeSetEvent) {
 	wm.Expiration = e.CreationDate().Add(e.Lifetime)
 }
 
+func (wm *SessionWriteModel) reduceRiskEvaluated(e *session.RiskEvaluatedEvent) {
+	wm.RiskEvaluated = true
+	wm.RiskAction = e.Action
+}
+
 func (wm *SessionWriteModel) reduceTerminate() {
 	wm.State = domain.SessionStateTerminated
 }
@@ -237,6 +294,8 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.PushCheckedAt,
+		wm.MagicLinkCheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -270,6 +329,12 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.OTPEmailCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !wm.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
+	if !wm.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
 	return types
 }
 
@@ -285,6 +350,16 @@ func (wm *SessionWriteModel) CheckNotInvalidated() error {
 	return nil
 }
 
+// CheckLoginRisk checks that a session with a login risk requiring MFA, was authenticated by multiple factors.
+// The login UI is expected to check the missing factors on the session and retry.
+func (wm *SessionWriteModel) CheckLoginRisk() error {
+	if wm.RiskAction == domain.LoginRiskActionRequireMFA &&
+		domain.LevelOfAssuranceFromAuthMethods(wm.AuthMethodTypes()) < domain.LevelOfAssuranceMultiFactor {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Je3ai", "Errors.Session.Risk.MFARequired")
+	}
+	return nil
+}
+
 // CheckIsActive checks that the session was not invalidated ([CheckNotInvalidated]) and actually already exists.
 func (wm *SessionWriteModel) CheckIsActive() error {
 	if wm.State == domain.SessionStateUnspecified {
@@ -764,7 +764,7 @@ func TestCheckOTPSMS(t *testing.T) {
 		userID           string
 		otpCodeChallenge *OTPCode
 		otpAlg           crypto.EncryptionAlgorithm
-		getCodeVerifier  func(ctx context.Context, id string) (senders.CodeGenerator, error)
+		getCodeVerifier  func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
 	}
 	type args struct {
 		code string
@@ -967,7 +967,7 @@ func TestCheckOTPSMS(t *testing.T) {
 					VerificationID: "verificationID",
 					CreationDate:   testNow,
 				},
-				getCodeVerifier: func(ctx context.Context, id string) (senders.CodeGenerator, error) {
+				getCodeVerifier: func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error) {
 					sender := mock.NewMockCodeGenerator(gomock.NewController(t))
 					sender.EXPECT().VerifyCode("verificationID", "code").Return(nil)
 					return sender, nil
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type PushChallengeModel struct {
+	Challenge    string
+	Expiry       time.Duration
+	CreationDate time.Time
+	DeviceIDs    []string
+}
+
+func (p *PushChallengeModel) expired(now time.Time) bool {
+	return p.CreationDate.Add(p.Expiry).Before(now)
+}
+
+func newPushChallenge() (string, error) {
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", zerrors.ThrowInternal(err, "COMMAND-Uu3ie", "Errors.Internal")
+	}
+	return base64.RawURLEncoding.EncodeToString(challenge), nil
+}
+
+// CreatePushChallenge sends a challenge to all enrolled push devices of the session user,
+// which has to be signed by one of the devices to approve the login.
+func (c *Commands) CreatePushChallenge() SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xoo9a", "Errors.User.UserIDMissing")
+		}
+		writeModel := NewHumanPushDevicesWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		if len(writeModel.Devices) == 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieR0o", "Errors.User.MFA.Push.NotReady")
+		}
+		challenge, err := cmd.createPushChallenge()
+		if err != nil {
+			return nil, err
+		}
+		devices := make([]session.PushDevice, len(writeModel.Devices))
+		for i, device := range writeModel.Devices {
+			devices[i] = session.PushDevice{
+				ID:    device.ID,
+				Token: device.DeviceToken,
+			}
+		}
+		cmd.PushChallenged(ctx, challenge, c.multifactors.Push.ChallengeLifetime, devices)
+		return nil, nil
+	}
+}
+
+// PushChallengeSent marks the push challenge of the session as sent to the devices
+func (c *Commands) PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.PushChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vai4o", "Errors.Session.Push.NoChallenge")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewPushSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckPush defines a check of the push challenge signed by the device to be executed for a session update
+func CheckPush(deviceID string, signature []byte) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieb2o", "Errors.User.UserIDMissing")
+		}
+		challenge := cmd.sessionWriteModel.PushChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aeph3", "Errors.Session.Push.NoChallenge")
+		}
+		if challenge.expired(cmd.now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ou6ee", "Errors.Session.Push.ChallengeExpired")
+		}
+		writeModel := NewHumanPushDevicesWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		device := writeModel.Device(deviceID)
+		if device == nil || !slices.Contains(challenge.DeviceIDs, deviceID) {
+			return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ohl2e", "Errors.User.MFA.Push.NotExisting")
+		}
+		userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+		if err := domain.VerifyPushSignature(device.PublicKey, challenge.Challenge, signature); err != nil {
+			return []eventstore.Command{user.NewHumanPushCheckFailedEvent(ctx, userAgg, deviceID, nil)}, err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, user.NewHumanPushCheckSucceededEvent(ctx, userAgg, deviceID, nil))
+		cmd.PushChecked(ctx, deviceID, cmd.now())
+		return nil, nil
+	}
+}
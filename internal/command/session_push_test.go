@@ -0,0 +1,378 @@
+package command
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreatePushChallenge(t *testing.T) {
+	_, publicKey := testPushDeviceKey(t)
+	type fields struct {
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err      error
+		commands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "userID missing, precondition error",
+			fields: fields{
+				userID:     "",
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xoo9a", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "filter error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			res: res{
+				err: io.ErrClosedPipe,
+			},
+		},
+		{
+			name: "no devices, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieR0o", "Errors.User.MFA.Push.NotReady"),
+			},
+		},
+		{
+			name: "challenge devices",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "device1", "phone", "token1", publicKey),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), &user.NewAggregate("userID", "org").Aggregate, "device2", "tablet", "token2", publicKey),
+						),
+					),
+				),
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						"challenge",
+						5*time.Minute,
+						[]session.PushDevice{
+							{ID: "device1", Token: "token1"},
+							{ID: "device2", Token: "token2"},
+						},
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				multifactors: domain.MultifactorConfigs{
+					Push: domain.PushConfig{
+						ChallengeLifetime: 5 * time.Minute,
+					},
+				},
+			}
+			cmd := c.CreatePushChallenge()
+
+			sessionModel := &SessionWriteModel{
+				UserID:        tt.fields.userID,
+				UserCheckedAt: testNow,
+				State:         domain.SessionStateActive,
+				aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:     []SessionCommand{cmd},
+				sessionWriteModel:   sessionModel,
+				eventstore:          tt.fields.eventstore(t),
+				createPushChallenge: func() (string, error) { return "challenge", nil },
+				now:                 time.Now,
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_PushChallengeSent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		sessionID     string
+		resourceOwner string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "not challenged, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vai4o", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "challenged and sent",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+								"challenge",
+								5*time.Minute,
+								[]session.PushDevice{{ID: "device1", Token: "token1"}},
+							),
+						),
+					),
+					expectPush(
+						session.NewPushSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.PushChallengeSent(tt.args.ctx, tt.args.sessionID, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckPush(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	privateKey, publicKey := testPushDeviceKey(t)
+	signature := ed25519.Sign(privateKey, []byte("challenge"))
+
+	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	challenge := func(creationDate time.Time) *PushChallengeModel {
+		return &PushChallengeModel{
+			Challenge:    "challenge",
+			Expiry:       5 * time.Minute,
+			CreationDate: creationDate,
+			DeviceIDs:    []string{"device1"},
+		}
+	}
+
+	type fields struct {
+		sessionWriteModel *SessionWriteModel
+		eventstore        func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name              string
+		deviceID          string
+		signature         []byte
+		fields            fields
+		wantEventCommands []eventstore.Command
+		wantErrorCommands []eventstore.Command
+		wantErr           error
+	}{
+		{
+			name:      "missing userID",
+			deviceID:  "device1",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					aggregate: sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieb2o", "Errors.User.UserIDMissing"),
+		},
+		{
+			name:      "no challenge",
+			deviceID:  "device1",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aeph3", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name:      "challenge expired",
+			deviceID:  "device1",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: challenge(testNow.Add(-10 * time.Minute)),
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ou6ee", "Errors.Session.Push.ChallengeExpired"),
+		},
+		{
+			name:      "device not challenged",
+			deviceID:  "device2",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: challenge(testNow),
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token1", publicKey),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device2", "tablet", "token2", publicKey),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohl2e", "Errors.User.MFA.Push.NotExisting"),
+		},
+		{
+			name:      "device removed",
+			deviceID:  "device1",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: challenge(testNow),
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token1", publicKey),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, "device1"),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohl2e", "Errors.User.MFA.Push.NotExisting"),
+		},
+		{
+			name:      "invalid signature",
+			deviceID:  "device1",
+			signature: []byte("invalid"),
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: challenge(testNow),
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token1", publicKey),
+						),
+					),
+				),
+			},
+			wantErrorCommands: []eventstore.Command{
+				user.NewHumanPushCheckFailedEvent(ctx, userAgg, "device1", nil),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiS2a", "Errors.User.MFA.Push.InvalidSignature"),
+		},
+		{
+			name:      "ok",
+			deviceID:  "device1",
+			signature: signature,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: challenge(testNow),
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token1", publicKey),
+						),
+					),
+				),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewHumanPushCheckSucceededEvent(ctx, userAgg, "device1", nil),
+				session.NewPushCheckedEvent(ctx, sessAgg, "device1", testNow),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: tt.fields.sessionWriteModel,
+				eventstore:        tt.fields.eventstore(t),
+				now:               func() time.Time { return testNow },
+			}
+			gotCmds, err := CheckPush(tt.deviceID, tt.signature)(ctx, cmd)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantErrorCommands, gotCmds)
+			assert.Equal(t, tt.wantEventCommands, cmd.eventCommands)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// evaluateLoginRisk compares the authentication of the session with the previous logins of the user
+// and returns the action of the login risk policy.
+// The evaluation is done once per session, as soon as the user was authenticated by any factor.
+func (c *Commands) evaluateLoginRisk(ctx context.Context, s *SessionCommands) (_ domain.LoginRiskAction, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := s.sessionWriteModel
+	if c.loginRisk == nil || wm.UserID == "" || wm.RiskEvaluated || !factorChecked(s.eventCommands) {
+		return domain.LoginRiskActionNone, nil
+	}
+	policy, err := getLoginRiskPolicy(ctx, wm.UserResourceOwner, c.eventstore.FilterToQueryReducer)
+	if err != nil {
+		return domain.LoginRiskActionNone, err
+	}
+	if !policy.HasActions() {
+		return domain.LoginRiskActionNone, nil
+	}
+	history := newLoginRiskHistoryReadModel(authz.GetInstance(ctx).InstanceID(), wm.UserID, c.loginRisk.HistoryLimit())
+	if err = c.eventstore.FilterToQueryReducer(ctx, history); err != nil {
+		return domain.LoginRiskActionNone, err
+	}
+	login := &risk.Login{Time: s.now()}
+	if wm.UserAgent != nil {
+		login.IP = wm.UserAgent.IP
+		if wm.UserAgent.FingerprintID != nil {
+			login.FingerprintID = *wm.UserAgent.FingerprintID
+		}
+	}
+	result := c.loginRisk.Evaluate(login, history.Logins)
+	action := policy.Action(result.Signals)
+	s.eventCommands = append(s.eventCommands, session.NewRiskEvaluatedEvent(ctx, wm.aggregate,
+		wm.UserID,
+		wm.UserResourceOwner,
+		login.FingerprintID,
+		login.IP,
+		result.Country,
+		result.Signals,
+		action,
+	))
+	wm.RiskAction = action
+	if action == domain.LoginRiskActionBlock {
+		s.eventCommands = append(s.eventCommands, session.NewTerminateEvent(ctx, wm.aggregate))
+	}
+	return action, nil
+}
+
+// LoginRiskNotified stores that the user was informed about the login risk of the session.
+func (c *Commands) LoginRiskNotified(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if !sessionWriteModel.RiskEvaluated {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieL1a", "Errors.Session.Risk.NotEvaluated")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewRiskNotifiedEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// factorChecked returns true if any of the commands is a successful check of an authentication factor
+func factorChecked(cmds []eventstore.Command) bool {
+	for _, cmd := range cmds {
+		switch cmd.(type) {
+		case *session.PasswordCheckedEvent,
+			*session.IntentCheckedEvent,
+			*session.WebAuthNCheckedEvent,
+			*session.TOTPCheckedEvent,
+			*session.OTPSMSCheckedEvent,
+			*session.OTPEmailCheckedEvent,
+			*session.PushCheckedEvent,
+			*session.MagicLinkCheckedEvent:
+			return true
+		}
+	}
+	return false
+}
+
+// loginRiskHistoryReadModel collects the latest (not blocked) logins of a user over all sessions
+// and the succeeded identity provider intents of the user.
+// Intents don't provide any user agent information, but ensure that a user, who has already been authenticated
+// by an identity provider, is not evaluated as on the first login.
+type loginRiskHistoryReadModel struct {
+	eventstore.WriteModel
+
+	instanceID string
+	userID     string
+	limit      uint64
+
+	Logins []*risk.Login
+}
+
+func newLoginRiskHistoryReadModel(instanceID, userID string, limit uint64) *loginRiskHistoryReadModel {
+	return &loginRiskHistoryReadModel{
+		instanceID: instanceID,
+		userID:     userID,
+		limit:      limit,
+	}
+}
+
+func (rm *loginRiskHistoryReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *session.RiskEvaluatedEvent:
+			if e.Action == domain.LoginRiskActionBlock {
+				continue
+			}
+			rm.Logins = append(rm.Logins, &risk.Login{
+				FingerprintID: e.FingerprintID,
+				IP:            e.IP,
+				Time:          e.CreationDate(),
+			})
+		case *idpintent.SucceededEvent,
+			*idpintent.SAMLSucceededEvent,
+			*idpintent.LDAPSucceededEvent:
+			rm.Logins = append(rm.Logins, &risk.Login{
+				Time: e.CreatedAt(),
+			})
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *loginRiskHistoryReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(rm.instanceID).
+		OrderDesc().
+		Limit(rm.limit).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.RiskEvaluatedType).
+		EventData(map[string]interface{}{"userID": rm.userID}).
+		Or().
+		AggregateTypes(idpintent.AggregateType).
+		EventTypes(
+			idpintent.SucceededEventType,
+			idpintent.SAMLSucceededEventType,
+			idpintent.LDAPSucceededEventType,
+		).
+		EventData(map[string]interface{}{"userId": rm.userID}).
+		Builder()
+}
@@ -0,0 +1,367 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_evaluateLoginRisk(t *testing.T) {
+	testNow := time.Now()
+	fingerprint := "fingerprint2"
+	evaluator, err := risk.NewEvaluator(risk.Config{HistoryLimit: 10})
+	require.NoError(t, err)
+
+	sessionWriteModel := func() *SessionWriteModel {
+		wm := NewSessionWriteModel("sessionID", "instance1")
+		wm.UserID = "userID"
+		wm.UserResourceOwner = "org1"
+		wm.UserAgent = &domain.UserAgent{
+			FingerprintID: &fingerprint,
+			IP:            net.ParseIP("10.0.0.1"),
+		}
+		return wm
+	}
+	passwordChecked := session.NewPasswordCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate, testNow)
+	previousLogin := func(action domain.LoginRiskAction) eventstore.Event {
+		return eventFromEventPusher(
+			session.NewRiskEvaluatedEvent(context.Background(), &session.NewAggregate("sessionID1", "instance1").Aggregate,
+				"userID", "org1", "fingerprint1", net.ParseIP("10.0.0.2"), "", nil, action,
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		loginRisk  *risk.Evaluator
+	}
+	type args struct {
+		checks *SessionCommands
+	}
+	type res struct {
+		action  domain.LoginRiskAction
+		signals []domain.LoginRiskSignal
+		// evaluated defines if a risk evaluated event is expected
+		evaluated bool
+		err       error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no evaluator, skipped",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+				},
+			},
+			res: res{
+				action: domain.LoginRiskActionNone,
+			},
+		},
+		{
+			name: "no factor checked, skipped",
+			fields: fields{
+				eventstore: expectEventstore(),
+				loginRisk:  evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+				},
+			},
+			res: res{
+				action: domain.LoginRiskActionNone,
+			},
+		},
+		{
+			name: "no policy actions, skipped",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+				loginRisk: evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+				},
+			},
+			res: res{
+				action: domain.LoginRiskActionNone,
+			},
+		},
+		{
+			name: "first login, evaluated without signals",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionBlock, domain.LoginRiskActionNone, domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectFilter(),
+				),
+				loginRisk: evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+					now:               func() time.Time { return testNow },
+				},
+			},
+			res: res{
+				action:    domain.LoginRiskActionNone,
+				evaluated: true,
+			},
+		},
+		{
+			name: "new device, notify",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify, domain.LoginRiskActionNone, domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectFilter(
+						previousLogin(domain.LoginRiskActionNone),
+					),
+				),
+				loginRisk: evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+					now:               func() time.Time { return testNow },
+				},
+			},
+			res: res{
+				action:    domain.LoginRiskActionNotify,
+				signals:   []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+				evaluated: true,
+			},
+		},
+		{
+			name: "previous idp intent, new device and network, notify",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify, domain.LoginRiskActionNotify, domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							idpintent.NewSucceededEvent(context.Background(), &idpintent.NewAggregate("intentID", "instance1").Aggregate,
+								nil, "idpUserID", "idpUserName", "userID", nil, "",
+							),
+						),
+					),
+				),
+				loginRisk: evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+					now:               func() time.Time { return testNow },
+				},
+			},
+			res: res{
+				action:    domain.LoginRiskActionNotify,
+				signals:   []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice, domain.LoginRiskSignalNewNetwork},
+				evaluated: true,
+			},
+		},
+		{
+			name: "blocked logins are not part of the history",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginRiskPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								domain.LoginRiskActionNotify, domain.LoginRiskActionRequireMFA, domain.LoginRiskActionNone,
+							),
+						),
+					),
+					expectFilter(
+						previousLogin(domain.LoginRiskActionBlock),
+					),
+				),
+				loginRisk: evaluator,
+			},
+			args: args{
+				checks: &SessionCommands{
+					sessionWriteModel: sessionWriteModel(),
+					eventCommands:     []eventstore.Command{passwordChecked},
+					now:               func() time.Time { return testNow },
+				},
+			},
+			res: res{
+				action:    domain.LoginRiskActionNone,
+				evaluated: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				loginRisk:  tt.fields.loginRisk,
+			}
+			got, err := c.evaluateLoginRisk(authz.NewMockContext("instance1", "", ""), tt.args.checks)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.action, got)
+			evaluated, ok := lastCommand[*session.RiskEvaluatedEvent](tt.args.checks.eventCommands)
+			if !tt.res.evaluated {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.res.action, evaluated.Action)
+			assert.Equal(t, tt.res.signals, evaluated.Signals)
+			assert.Equal(t, "fingerprint2", evaluated.FingerprintID)
+		})
+	}
+}
+
+func Test_factorChecked(t *testing.T) {
+	ctx := context.Background()
+	agg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	checkedAt := time.Now()
+	tests := []struct {
+		name string
+		cmds []eventstore.Command
+		want bool
+	}{
+		{
+			name: "no commands",
+			want: false,
+		},
+		{
+			name: "user checked",
+			cmds: []eventstore.Command{
+				session.NewUserCheckedEvent(ctx, agg, "userID", "org1", checkedAt, nil),
+			},
+			want: false,
+		},
+		{
+			name: "password checked",
+			cmds: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, agg, checkedAt),
+			},
+			want: true,
+		},
+		{
+			name: "push checked",
+			cmds: []eventstore.Command{
+				session.NewPushCheckedEvent(ctx, agg, "deviceID", checkedAt),
+			},
+			want: true,
+		},
+		{
+			name: "magic link checked",
+			cmds: []eventstore.Command{
+				session.NewUserCheckedEvent(ctx, agg, "userID", "org1", checkedAt, nil),
+				session.NewMagicLinkCheckedEvent(ctx, agg, checkedAt),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, factorChecked(tt.cmds))
+		})
+	}
+}
+
+func TestCommands_updateSession_loginRiskBlocked(t *testing.T) {
+	testNow := time.Now()
+	fingerprint := "fingerprint2"
+	evaluator, err := risk.NewEvaluator(risk.Config{HistoryLimit: 10})
+	require.NoError(t, err)
+	wm := NewSessionWriteModel("sessionID", "instance1")
+	wm.UserID = "userID"
+	wm.UserResourceOwner = "org1"
+	wm.UserAgent = &domain.UserAgent{FingerprintID: &fingerprint}
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					org.NewLoginRiskPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						domain.LoginRiskActionBlock, domain.LoginRiskActionNone, domain.LoginRiskActionNone,
+					),
+				),
+			),
+			expectFilter(
+				eventFromEventPusher(
+					session.NewRiskEvaluatedEvent(context.Background(), &session.NewAggregate("sessionID1", "instance1").Aggregate,
+						"userID", "org1", "fingerprint1", nil, "", nil, domain.LoginRiskActionNone,
+					),
+				),
+			),
+			expectPush(
+				session.NewPasswordCheckedEvent(context.Background(), sessionAgg, testNow),
+				session.NewRiskEvaluatedEvent(context.Background(), sessionAgg,
+					"userID", "org1", "fingerprint2", nil, "",
+					[]domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+					domain.LoginRiskActionBlock,
+				),
+				session.NewTerminateEvent(context.Background(), sessionAgg),
+			),
+		)(t),
+		loginRisk: evaluator,
+	}
+	checks := &SessionCommands{
+		sessionWriteModel: wm,
+		eventstore:        c.eventstore,
+		eventCommands: []eventstore.Command{
+			session.NewPasswordCheckedEvent(context.Background(), sessionAgg, testNow),
+		},
+		now: func() time.Time { return testNow },
+	}
+	got, err := c.updateSession(authz.NewMockContext("instance1", "", ""), checks, nil, 0)
+	require.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ooqu4", "Errors.Session.Risk.Blocked"))
+	assert.Nil(t, got)
+}
+
+func lastCommand[T eventstore.Command](cmds []eventstore.Command) (T, bool) {
+	var zero T
+	if len(cmds) == 0 {
+		return zero, false
+	}
+	cmd, ok := cmds[len(cmds)-1].(T)
+	return cmd, ok
+}
@@ -327,6 +327,25 @@ func (c *Commands) UserDomainClaimedSent(ctx context.Context, orgID, userID stri
 	return err
 }
 
+// SecurityNotificationSent stores that the user was informed about the security relevant change of the account
+// caused by the event with the triggerSequence.
+func (c *Commands) SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sec1n", "Errors.IDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Sec2n", "Errors.User.NotFound")
+	}
+
+	_, err = c.eventstore.Push(ctx,
+		user.NewHumanSecurityNotificationSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), messageType, triggerSequence))
+	return err
+}
+
 func (c *Commands) checkUserExists(ctx context.Context, userID, resourceOwner string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
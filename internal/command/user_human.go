@@ -317,7 +317,7 @@ func (c *Commands) addHumanCommandPhone(ctx context.Context, filter preparation.
 	if human.Phone.Verified {
 		return append(cmds, user.NewHumanPhoneVerifiedEvent(ctx, &a.Aggregate)), nil
 	}
-	phoneCode, generatorID, err := c.newPhoneCode(ctx, filter, domain.SecretGeneratorTypeVerifyPhoneCode, codeAlg, c.defaultSecretGenerators.PhoneVerificationCode)
+	phoneCode, generatorID, err := c.newPhoneCode(ctx, filter, a.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, codeAlg, c.defaultSecretGenerators.PhoneVerificationCode)
 	if err != nil {
 		return nil, err
 	}
@@ -563,7 +563,7 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 	}
 
 	if human.Phone != nil && human.PhoneNumber != "" && !human.IsPhoneVerified {
-		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, userAgg.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		if err != nil {
 			return nil, nil, err
 		}
@@ -745,6 +745,7 @@ func AddHumanFromDomain(user *domain.Human, metadataList []*domain.Metadata, aut
 
 func verifyCode(
 	ctx context.Context,
+	resourceOwner string,
 	codeCreationDate time.Time,
 	codeExpiry time.Duration,
 	encryptedCode *crypto.CryptoValue,
@@ -752,7 +753,7 @@ func verifyCode(
 	codeVerificationID string,
 	code string,
 	codeAlg crypto.EncryptionAlgorithm,
-	getCodeVerifier func(ctx context.Context, id string) (_ senders.CodeGenerator, err error),
+	getCodeVerifier func(ctx context.Context, resourceOwner, id string) (_ senders.CodeGenerator, err error),
 ) (err error) {
 	if codeProviderID == "" {
 		if encryptedCode == nil {
@@ -767,7 +768,7 @@ func verifyCode(
 	if getCodeVerifier == nil {
 		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-M0g95", "Errors.User.Code.NotConfigured")
 	}
-	verifier, err := getCodeVerifier(ctx, codeProviderID)
+	verifier, err := getCodeVerifier(ctx, resourceOwner, codeProviderID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,131 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestMagicLink creates a single use code, which is sent as a login link to the verified email address of the user.
+// The link can only be requested if the login policy allows it and the user did not reach the rate limit.
+func (c *Commands) RequestMagicLink(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Aek5o", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.magicLinkWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if err = c.checkMagicLinkAllowed(ctx, writeModel); err != nil {
+		return err
+	}
+	if c.magicLink.RateLimited(writeModel.Requests, time.Now()) {
+		return zerrors.ThrowResourceExhausted(nil, "COMMAND-oF3ae", "Errors.User.MagicLink.RateLimited")
+	}
+	code, err := c.newEncryptedCodeWithDefault(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeMagicLink, c.userEncryption, c.defaultSecretGenerators.MagicLink) //nolint:staticcheck
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCodeAddedEvent(ctx, userAgg, code.Crypted, code.Expiry, authRequestDomainToAuthRequestInfo(authRequest)))
+	return err
+}
+
+func (c *Commands) HumanMagicLinkCodeSent(ctx context.Context, userID, resourceOwner string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-eeX3u", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.magicLinkWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.Code == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Jee0a", "Errors.User.Code.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCodeSentEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// CheckMagicLink verifies the code of a login link for the auth request it was requested for.
+// A successful check invalidates the code, so every link can only be used once.
+func (c *Commands) CheckMagicLink(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ooQu9", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahth3", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.magicLinkWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if err = c.checkMagicLinkAllowed(ctx, writeModel); err != nil {
+		return err
+	}
+	if writeModel.Code == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uu1oh", "Errors.User.Code.NotFound")
+	}
+	info := authRequestDomainToAuthRequestInfo(authRequest)
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	verifyErr := crypto.VerifyCode(writeModel.CodeCreationDate, writeModel.CodeExpiry, writeModel.Code, code, c.userEncryption)
+	if verifyErr == nil && writeModel.AuthRequestID != "" && (info == nil || info.ID != writeModel.AuthRequestID) {
+		verifyErr = zerrors.ThrowInvalidArgument(nil, "COMMAND-thai5", "Errors.User.Code.Invalid")
+	}
+	if verifyErr == nil {
+		_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCheckSucceededEvent(ctx, userAgg, info))
+		return err
+	}
+	commands := make([]eventstore.Command, 0, 2)
+	commands = append(commands, user.NewHumanMagicLinkCheckFailedEvent(ctx, userAgg, info))
+	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, writeModel.ResourceOwner, c.eventstore.FilterToQueryReducer)
+	logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && writeModel.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	}
+	_, pushErr := c.eventstore.Push(ctx, commands...)
+	logging.WithFields("userID", userID).OnError(pushErr).Error("magic link failure check push failed")
+	return verifyErr
+}
+
+func (c *Commands) checkMagicLinkAllowed(ctx context.Context, writeModel *HumanMagicLinkWriteModel) error {
+	if !writeModel.UserState.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Sie4u", "Errors.User.NotFound")
+	}
+	if writeModel.UserState != domain.UserStateActive {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ieN4a", "Errors.User.NotActive")
+	}
+	policy, err := c.getOrgLoginPolicy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	if !policy.AllowMagicLink {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohz0e", "Errors.User.MagicLink.NotAllowed")
+	}
+	if !writeModel.IsEmailVerified {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-iW9ei", "Errors.User.MagicLink.EmailNotVerified")
+	}
+	return nil
+}
+
+func (c *Commands) magicLinkWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanMagicLinkWriteModel, err error) {
+	writeModel = NewHumanMagicLinkWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,611 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RequestMagicLink(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	emailVerified := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanEmailVerifiedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+			),
+		)
+	}
+	codeAdded := func() eventstore.Event {
+		return eventFromEventPusherWithCreationDateNow(
+			user.NewHumanMagicLinkCodeAddedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("code"),
+				},
+				time.Hour,
+				nil,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore                  func(t *testing.T) *eventstore.Eventstore
+		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
+		magicLink                   domain.MagicLinkConfig
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+		authRequest   *domain.AuthRequest
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aek5o", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Sie4u", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "not allowed by login policy",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, false),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohz0e", "Errors.User.MagicLink.NotAllowed"),
+			},
+		},
+		{
+			name: "email not verified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-iW9ei", "Errors.User.MagicLink.EmailNotVerified"),
+			},
+		},
+		{
+			name: "rate limited",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded(),
+						codeAdded(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+				),
+				magicLink: domain.MagicLinkConfig{
+					MaxRequests:     2,
+					RateLimitWindow: time.Hour,
+				},
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.ThrowResourceExhausted(nil, "COMMAND-oF3ae", "Errors.User.MagicLink.RateLimited"),
+			},
+		},
+		{
+			name: "code added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+					expectPush(
+						user.NewHumanMagicLinkCodeAddedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("code"),
+							},
+							time.Hour,
+							&user.AuthRequestInfo{
+								ID:          "authRequestID",
+								UserAgentID: "userAgentID",
+								BrowserInfo: &user.BrowserInfo{
+									UserAgent:      "user-agent",
+									AcceptLanguage: "en",
+									RemoteIP:       net.IP{192, 0, 2, 1},
+								},
+							},
+						),
+					),
+				),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("code", time.Hour),
+				magicLink: domain.MagicLinkConfig{
+					MaxRequests:     2,
+					RateLimitWindow: time.Hour,
+				},
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				authRequest: &domain.AuthRequest{
+					ID:      "authRequestID",
+					AgentID: "userAgentID",
+					BrowserInfo: &domain.BrowserInfo{
+						UserAgent:      "user-agent",
+						AcceptLanguage: "en",
+						RemoteIP:       net.IP{192, 0, 2, 1},
+					},
+				},
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                  tt.fields.eventstore(t),
+				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
+				defaultSecretGenerators:     &SecretGenerators{},
+				magicLink:                   tt.fields.magicLink,
+			}
+			err := c.RequestMagicLink(ctx, tt.args.userID, tt.args.resourceOwner, tt.args.authRequest)
+			require.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
+func TestCommands_CheckMagicLink(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+
+	authRequestInfo := &user.AuthRequestInfo{
+		ID:          "authRequestID",
+		UserAgentID: "userAgentID",
+		BrowserInfo: &user.BrowserInfo{
+			UserAgent:      "user-agent",
+			AcceptLanguage: "en",
+			RemoteIP:       net.IP{192, 0, 2, 1},
+		},
+	}
+	authRequest := &domain.AuthRequest{
+		ID:      "authRequestID",
+		AgentID: "userAgentID",
+		BrowserInfo: &domain.BrowserInfo{
+			UserAgent:      "user-agent",
+			AcceptLanguage: "en",
+			RemoteIP:       net.IP{192, 0, 2, 1},
+		},
+	}
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	emailVerified := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanEmailVerifiedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+			),
+		)
+	}
+	codeAdded := func(code string) eventstore.Event {
+		return eventFromEventPusherWithCreationDateNow(
+			user.NewHumanMagicLinkCodeAddedEvent(ctx,
+				&user.NewAggregate("user1", "org1").Aggregate,
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte(code),
+				},
+				time.Hour,
+				authRequestInfo,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore     func(t *testing.T) *eventstore.Eventstore
+		userEncryption crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		userID        string
+		code          string
+		resourceOwner string
+		authRequest   *domain.AuthRequest
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooQu9", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing code",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				code:   "",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahth3", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			name: "code not requested",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uu1oh", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			name: "code already used",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded("code"),
+						eventFromEventPusher(
+							user.NewHumanMagicLinkCheckSucceededEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								authRequestInfo,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uu1oh", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			name: "invalid code",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded("other-code"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+					expectFilter(), // org lockout policy
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("org1").Aggregate,
+								3, 3, true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanMagicLinkCheckFailedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							authRequestInfo,
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "CODE-woT0xc", "Errors.User.Code.Invalid"),
+			},
+		},
+		{
+			name: "invalid code, max attempts reached",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded("other-code"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+					expectFilter(), // org lockout policy
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("org1").Aggregate,
+								1, 1, true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanMagicLinkCheckFailedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							authRequestInfo,
+						),
+						user.NewUserLockedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "CODE-woT0xc", "Errors.User.Code.Invalid"),
+			},
+		},
+		{
+			name: "other auth request",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded("code"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+					expectFilter(), // org lockout policy
+					expectFilter(),
+					expectPush(
+						user.NewHumanMagicLinkCheckFailedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:          "otherAuthRequestID",
+								UserAgentID: "userAgentID",
+							},
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest: &domain.AuthRequest{
+					ID:      "otherAuthRequestID",
+					AgentID: "userAgentID",
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-thai5", "Errors.User.Code.Invalid"),
+			},
+		},
+		{
+			name: "code ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+						emailVerified(),
+						codeAdded("code"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							magicLinkLoginPolicyAddedEvent(ctx, true),
+						),
+					),
+					expectPush(
+						user.NewHumanMagicLinkCheckSucceededEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							authRequestInfo,
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest:   authRequest,
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				userEncryption: tt.fields.userEncryption,
+			}
+			err := c.CheckMagicLink(ctx, tt.args.userID, tt.args.code, tt.args.resourceOwner, tt.args.authRequest)
+			require.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
+func magicLinkLoginPolicyAddedEvent(ctx context.Context, allowMagicLink bool) *org.LoginPolicyAddedEvent {
+	return org.NewLoginPolicyAddedEvent(ctx,
+		&org.NewAggregate("org1").Aggregate,
+		true,
+		true,
+		true,
+		false,
+		false,
+		false,
+		false,
+		false,
+		false,
+		false,
+		allowMagicLink,
+		domain.PasswordlessTypeAllowed,
+		"",
+		time.Hour*1,
+		time.Hour*2,
+		time.Hour*3,
+		time.Hour*4,
+		time.Hour*5,
+	)
+}
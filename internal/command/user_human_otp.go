@@ -474,7 +474,7 @@ func (c *Commands) HumanSendOTPEmail(ctx context.Context, userID, resourceOwner
 	codeAddedEvent := func(ctx context.Context, aggregate *eventstore.Aggregate, code *crypto.CryptoValue, expiry time.Duration, info *user.AuthRequestInfo, _ string) eventstore.Command {
 		return user.NewHumanOTPEmailCodeAddedEvent(ctx, aggregate, code, expiry, info)
 	}
-	generateCode := func(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
+	generateCode := func(ctx context.Context, filter preparation.FilterToQueryReducer, _ string, typ domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
 		code, err := c.newEncryptedCodeWithDefault(ctx, filter, typ, alg, defaultConfig)
 		return code, "", err
 	}
@@ -540,7 +540,7 @@ func (c *Commands) sendHumanOTP(
 	secretGeneratorType domain.SecretGeneratorType,
 	defaultSecretGenerator *crypto.GeneratorConfig,
 	codeAddedEvent func(ctx context.Context, aggregate *eventstore.Aggregate, code *crypto.CryptoValue, expiry time.Duration, info *user.AuthRequestInfo, generatorID string) eventstore.Command,
-	generateCode func(ctx context.Context, filter preparation.FilterToQueryReducer, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error),
+	generateCode func(ctx context.Context, filter preparation.FilterToQueryReducer, resourceOwner string, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error),
 ) (err error) {
 	if userID == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-S3SF1", "Errors.User.UserIDMissing")
@@ -552,7 +552,7 @@ func (c *Commands) sendHumanOTP(
 	if !existingOTP.OTPAdded() {
 		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-SFD52", "Errors.User.MFA.OTP.NotReady")
 	}
-	code, generatorID, err := generateCode(ctx, c.eventstore.Filter, secretGeneratorType, c.userEncryption, defaultSecretGenerator) //nolint:staticcheck
+	code, generatorID, err := generateCode(ctx, c.eventstore.Filter, existingOTP.ResourceOwner(), secretGeneratorType, c.userEncryption, defaultSecretGenerator) //nolint:staticcheck
 	if err != nil {
 		return err
 	}
@@ -589,7 +589,7 @@ func checkOTP(
 	writeModelByID func(ctx context.Context, userID string, resourceOwner string) (OTPCodeWriteModel, error),
 	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
 	alg crypto.EncryptionAlgorithm,
-	getCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error),
+	getCodeVerifier func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error),
 	checkSucceededEvent, checkFailedEvent func(ctx context.Context, aggregate *eventstore.Aggregate, info *user.AuthRequestInfo) eventstore.Command,
 ) ([]eventstore.Command, error) {
 	if userID == "" {
@@ -611,6 +611,7 @@ func checkOTP(
 	userAgg := &user.NewAggregate(userID, existingOTP.ResourceOwner()).Aggregate
 	verifyErr := verifyCode(
 		ctx,
+		existingOTP.ResourceOwner(),
 		existingOTP.CodeCreationDate(),
 		existingOTP.CodeExpiry(),
 		existingOTP.Code(),
@@ -1536,6 +1536,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSCodeAddedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1671,6 +1672,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSCodeAddedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1741,6 +1743,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSCodeAddedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1939,7 +1942,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 	type fields struct {
 		eventstore        func(*testing.T) *eventstore.Eventstore
 		userEncryption    crypto.EncryptionAlgorithm
-		phoneCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error)
+		phoneCodeVerifier func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
 	}
 	type (
 		args struct {
@@ -2375,7 +2378,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 					),
 				),
 				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
-				phoneCodeVerifier: func(ctx context.Context, id string) (senders.CodeGenerator, error) {
+				phoneCodeVerifier: func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error) {
 					sender := mock.NewMockCodeGenerator(gomock.NewController(t))
 					sender.EXPECT().VerifyCode("verificationID", "code").Return(nil)
 					return sender, nil
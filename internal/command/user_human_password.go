@@ -69,6 +69,7 @@ func (c *Commands) SetPasswordWithVerifyCode(ctx context.Context, orgID, userID,
 		userAgentID,
 		changeRequired,
 		c.setPasswordWithVerifyCode(
+			wm.ResourceOwner,
 			wm.CodeCreationDate,
 			wm.CodeExpiry,
 			wm.Code,
@@ -116,6 +117,7 @@ func (c *Commands) setPasswordWithPermission(userID, orgID string) setPasswordVe
 
 // setPasswordWithVerifyCode returns a password code check as [setPasswordVerification] implementation
 func (c *Commands) setPasswordWithVerifyCode(
+	resourceOwner string,
 	passwordCodeCreationDate time.Time,
 	passwordCodeExpiry time.Duration,
 	passwordCode *crypto.CryptoValue,
@@ -126,6 +128,7 @@ func (c *Commands) setPasswordWithVerifyCode(
 	return func(ctx context.Context) (_ string, err error) {
 		return "", verifyCode(
 			ctx,
+			resourceOwner,
 			passwordCodeCreationDate,
 			passwordCodeExpiry,
 			passwordCode,
@@ -269,7 +272,7 @@ func (c *Commands) RequestSetPassword(ctx context.Context, userID, resourceOwner
 	var passwordCode *EncryptedCode
 	var generatorID string
 	if notifyType == domain.NotificationTypeSms {
-		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
+		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, userAgg.ResourceOwner, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
 	} else {
 		passwordCode, err = c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
 	}
@@ -267,7 +267,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 		eventstore         func(*testing.T) *eventstore.Eventstore
 		userEncryption     crypto.EncryptionAlgorithm
 		userPasswordHasher *crypto.Hasher
-		phoneCodeVerifier  func(ctx context.Context, id string) (senders.CodeGenerator, error)
+		phoneCodeVerifier  func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
 	}
 	type args struct {
 		ctx            context.Context
@@ -715,7 +715,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 				),
 				userPasswordHasher: mockPasswordHasher("x"),
 				userEncryption:     crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
-				phoneCodeVerifier: func(ctx context.Context, id string) (senders.CodeGenerator, error) {
+				phoneCodeVerifier: func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error) {
 					sender := mock.NewMockCodeGenerator(gomock.NewController(t))
 					sender.EXPECT().VerifyCode("verificationID", "a").Return(nil)
 					return sender, nil
@@ -1678,6 +1678,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -1718,6 +1719,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -1759,6 +1761,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -1820,6 +1823,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -1877,6 +1881,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -1966,6 +1971,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -2059,6 +2065,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -2139,6 +2146,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -2224,6 +2232,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -2303,6 +2312,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 								domain.PasswordlessTypeNotAllowed,
 								"",
 								time.Hour*1,
@@ -43,7 +43,7 @@ func (c *Commands) ChangeHumanPhone(ctx context.Context, phone *domain.Phone, re
 	if phone.IsPhoneVerified {
 		events = append(events, user.NewHumanPhoneVerifiedEvent(ctx, userAgg))
 	} else {
-		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, existingPhone.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		if err != nil {
 			return nil, err
 		}
@@ -84,6 +84,7 @@ func (c *Commands) VerifyHumanPhone(ctx context.Context, userID, code, resourceo
 	userAgg := UserAggregateFromWriteModel(&existingCode.WriteModel)
 	err = verifyCode(
 		ctx,
+		existingCode.ResourceOwner,
 		existingCode.CodeCreationDate,
 		existingCode.CodeExpiry,
 		existingCode.Code,
@@ -109,41 +110,67 @@ func (c *Commands) VerifyHumanPhone(ctx context.Context, userID, code, resourceo
 	return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-sM0cs", "Errors.User.Code.Invalid")
 }
 
-func (c *Commands) phoneCodeVerifierFromConfig(ctx context.Context, id string) (senders.CodeGenerator, error) {
-	config, err := c.getSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), id)
+// phoneCodeVerifierFromConfig returns the external verifier of the SMS provider which created the code.
+// The provider is either one of the instance or an override of the organization of the user.
+func (c *Commands) phoneCodeVerifierFromConfig(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	config, err := c.getSMSConfig(ctx, instanceID, id)
 	if err != nil {
 		return nil, err
 	}
-	if config.State != domain.SMSConfigStateActive {
+	state, twilioConfig := config.State, config.Twilio
+	if !state.Exists() && resourceOwner != "" && resourceOwner != instanceID {
+		orgConfig, err := c.getOrgSMSConfig(ctx, resourceOwner, id)
+		if err != nil {
+			return nil, err
+		}
+		state, twilioConfig = orgConfig.State, orgConfig.Twilio
+	}
+	if state != domain.SMSConfigStateActive {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-M0odsf", "Errors.SMSConfig.NotFound")
 	}
-	if config.Twilio != nil {
-		if config.Twilio.VerifyServiceSID == "" {
+	if twilioConfig != nil {
+		if twilioConfig.VerifyServiceSID == "" {
 			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sgb4h", "Errors.SMSConfig.NotExternalVerification")
 		}
-		token, err := crypto.DecryptString(config.Twilio.Token, c.smsEncryption)
+		token, err := crypto.DecryptString(twilioConfig.Token, c.smsEncryption)
 		if err != nil {
 			return nil, err
 		}
 		return &twilio.Config{
-			SID:              config.Twilio.SID,
+			SID:              twilioConfig.SID,
 			Token:            token,
-			SenderNumber:     config.Twilio.SenderNumber,
-			VerifyServiceSID: config.Twilio.VerifyServiceSID,
+			SenderNumber:     twilioConfig.SenderNumber,
+			VerifyServiceSID: twilioConfig.VerifyServiceSID,
 		}, nil
 	}
 	return nil, nil
 }
 
-func (c *Commands) activeSMSProvider(ctx context.Context) (string, error) {
-	config, err := c.getActiveSMSConfig(ctx, authz.GetInstance(ctx).InstanceID())
+// activeSMSProvider returns the ID of the SMS provider used for the users of the resource owner,
+// if the provider generates and verifies the codes itself (Twilio Verify).
+// The provider is resolved the same way as the notification handlers do.
+func (c *Commands) activeSMSProvider(ctx context.Context, resourceOwner string) (string, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	config, err := c.getActiveSMSConfig(ctx, instanceID)
 	if err != nil {
 		return "", err
 	}
+	// codes of an instance using Twilio Verify are always sent through the verify service of the instance
 	if config.State == domain.SMSConfigStateActive && config.Twilio != nil && config.Twilio.VerifyServiceSID != "" {
 		return config.ID, nil
 	}
-	return "", err
+	if resourceOwner == "" || resourceOwner == instanceID {
+		return "", nil
+	}
+	orgConfig, err := c.getActiveOrgSMSConfig(ctx, resourceOwner)
+	if err != nil {
+		return "", err
+	}
+	if orgConfig.State == domain.SMSConfigStateActive && orgConfig.Twilio != nil && orgConfig.Twilio.VerifyServiceSID != "" {
+		return orgConfig.ID, nil
+	}
+	return "", nil
 }
 
 func (c *Commands) CreateHumanPhoneVerificationCode(ctx context.Context, userID, resourceowner string) (*domain.ObjectDetails, error) {
@@ -165,7 +192,7 @@ func (c *Commands) CreateHumanPhoneVerificationCode(ctx context.Context, userID,
 	if existingPhone.IsPhoneVerified {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-2M9sf", "Errors.User.Phone.AlreadyVerified")
 	}
-	phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+	phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, existingPhone.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 	if err != nil {
 		return nil, err
 	}
@@ -9,13 +9,16 @@ import (
 	"go.uber.org/mock/gomock"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/senders/mock"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -353,6 +356,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneChangedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -509,7 +513,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 func TestCommandSide_VerifyHumanPhone(t *testing.T) {
 	type fields struct {
 		eventstore        func(*testing.T) *eventstore.Eventstore
-		phoneCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error)
+		phoneCodeVerifier func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
 	}
 	type args struct {
 		ctx             context.Context
@@ -770,7 +774,7 @@ func TestCommandSide_VerifyHumanPhone(t *testing.T) {
 						),
 					),
 				),
-				phoneCodeVerifier: func(ctx context.Context, id string) (senders.CodeGenerator, error) {
+				phoneCodeVerifier: func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error) {
 					sender := mock.NewMockCodeGenerator(gomock.NewController(t))
 					sender.EXPECT().VerifyCode("verificationID", "a")
 					return sender, nil
@@ -969,6 +973,7 @@ func TestCommandSide_CreateVerificationCodeHumanPhone(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneCodeAddedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1397,3 +1402,218 @@ func TestCommandSide_RemoveHumanPhone(t *testing.T) {
 		})
 	}
 }
+
+func TestCommands_activeSMSProvider(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+	}
+	type res struct {
+		want string
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "instance Twilio Verify + org override, instance provider",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, "instanceConfig"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigTwilioAddedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate,
+								"instanceConfig", "", "sid", "senderNumber",
+								&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("crypted")},
+								"verifyServiceSID",
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, "instanceConfig"),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: "instanceConfig",
+			},
+		},
+		{
+			name: "instance Twilio + org override, no external provider",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, "instanceConfig"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigTwilioAddedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate,
+								"instanceConfig", "", "sid", "senderNumber",
+								&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("crypted")},
+								"",
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, "instanceConfig"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(ctx, &org.NewAggregate("org1").Aggregate, "orgConfig"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMSConfigTwilioAddedEvent(ctx, &org.NewAggregate("org1").Aggregate,
+								"orgConfig", "", "sid", "senderNumber",
+								&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("crypted")},
+							),
+						),
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(ctx, &org.NewAggregate("org1").Aggregate, "orgConfig"),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.activeSMSProvider(ctx, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_phoneCodeVerifierFromConfig(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+	type fields struct {
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		smsEncryption crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		resourceOwner string
+		id            string
+	}
+	type res struct {
+		want senders.CodeGenerator
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "instance Twilio Verify + org override, instance verifier",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigTwilioAddedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate,
+								"instanceConfig", "", "sid", "senderNumber",
+								&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("crypted")},
+								"verifyServiceSID",
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, "instanceConfig"),
+						),
+					),
+				),
+				smsEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				resourceOwner: "org1",
+				id:            "instanceConfig",
+			},
+			res: res{
+				want: &twilio.Config{
+					SID:              "sid",
+					Token:            "crypted",
+					SenderNumber:     "senderNumber",
+					VerifyServiceSID: "verifyServiceSID",
+				},
+			},
+		},
+		{
+			name: "org config, not external verification error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewSMSConfigTwilioAddedEvent(ctx, &org.NewAggregate("org1").Aggregate,
+								"orgConfig", "", "sid", "senderNumber",
+								&crypto.CryptoValue{CryptoType: crypto.TypeEncryption, Algorithm: "enc", KeyID: "id", Crypted: []byte("crypted")},
+							),
+						),
+						eventFromEventPusher(
+							org.NewSMSConfigActivatedEvent(ctx, &org.NewAggregate("org1").Aggregate, "orgConfig"),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				id:            "orgConfig",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sgb4h", "Errors.SMSConfig.NotExternalVerification"),
+			},
+		},
+		{
+			name: "not found in instance and org, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				id:            "unknown",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-M0odsf", "Errors.SMSConfig.NotFound"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				smsEncryption: tt.fields.smsEncryption,
+			}
+			got, err := c.phoneCodeVerifierFromConfig(ctx, tt.args.resourceOwner, tt.args.id)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type PushDevice struct {
+	ID          string
+	Name        string
+	DeviceToken string
+	PublicKey   []byte
+}
+
+type HumanPushDevicesWriteModel struct {
+	eventstore.WriteModel
+
+	humanExists bool
+	Devices     []*PushDevice
+}
+
+func NewHumanPushDevicesWriteModel(userID, resourceOwner string) *HumanPushDevicesWriteModel {
+	return &HumanPushDevicesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanPushDevicesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.humanExists = true
+		case *user.HumanPushDeviceAddedEvent:
+			wm.Devices = append(wm.Devices, &PushDevice{
+				ID:          e.DeviceID,
+				Name:        e.Name,
+				DeviceToken: e.DeviceToken,
+				PublicKey:   e.PublicKey,
+			})
+		case *user.HumanPushDeviceRemovedEvent:
+			wm.removeDevice(e.DeviceID)
+		case *user.UserRemovedEvent:
+			wm.humanExists = false
+			wm.Devices = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPushDevicesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *HumanPushDevicesWriteModel) Device(deviceID string) *PushDevice {
+	for _, device := range wm.Devices {
+		if device.ID == deviceID {
+			return device
+		}
+	}
+	return nil
+}
+
+func (wm *HumanPushDevicesWriteModel) removeDevice(deviceID string) {
+	for i, device := range wm.Devices {
+		if device.ID == deviceID {
+			wm.Devices = append(wm.Devices[:i], wm.Devices[i+1:]...)
+			return
+		}
+	}
+}
@@ -1084,6 +1084,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(
@@ -1257,6 +1258,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 						user.NewHumanEmailVerifiedEvent(context.Background(),
@@ -2009,6 +2011,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									),
 								),
 							),
+							expectFilter(),
 							expectPush(
 								newAddHumanEvent("$plain$x$password", false, true, "+41711234567", AllowedLanguage),
 								user.NewHumanInitialCodeAddedEvent(context.Background(),
@@ -14,6 +14,8 @@ import (
 type CreateUserSchema struct {
 	Details *domain.ObjectDetails
 
+	// ID is optional, it is generated if empty
+	ID                     string
 	ResourceOwner          string
 	Type                   string
 	Schema                 json.RawMessage
@@ -70,14 +72,20 @@ func (c *Commands) CreateUserSchema(ctx context.Context, userSchema *CreateUserS
 	if userSchema.ResourceOwner == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMA-J3hhj", "Errors.ResourceOwnerMissing")
 	}
-	id, err := c.idGenerator.Next()
-	if err != nil {
-		return err
+	id := userSchema.ID
+	if id == "" {
+		var err error
+		if id, err = c.idGenerator.Next(); err != nil {
+			return err
+		}
 	}
 	writeModel, err := c.getSchemaWriteModelByID(ctx, userSchema.ResourceOwner, id)
 	if err != nil {
 		return err
 	}
+	if writeModel.State != domain.UserSchemaStateUnspecified {
+		return zerrors.ThrowAlreadyExists(nil, "COMMA-Oor7e", "Errors.UserSchema.AlreadyExists")
+	}
 	if err := c.pushAppendAndReduce(ctx, writeModel,
 		schema.NewCreatedEvent(ctx,
 			UserSchemaAggregateFromWriteModel(&writeModel.WriteModel),
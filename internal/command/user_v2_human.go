@@ -352,7 +352,7 @@ func (c *Commands) changeUserPhone(ctx context.Context, cmds []eventstore.Comman
 		if phone.Verified {
 			return append(cmds, user.NewHumanPhoneVerifiedEvent(ctx, &wm.Aggregate().Aggregate)), code, nil
 		} else {
-			cryptoCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, alg, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+			cryptoCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, wm.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, alg, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 			if err != nil {
 				return cmds, code, err
 			}
@@ -390,6 +390,7 @@ func (c *Commands) changeUserPassword(ctx context.Context, cmds []eventstore.Com
 	// otherwise check the password code...
 	if password.PasswordCode != "" {
 		verification = c.setPasswordWithVerifyCode(
+			wm.ResourceOwner,
 			wm.PasswordCodeCreationDate,
 			wm.PasswordCodeExpiry,
 			wm.PasswordCode,
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetUserNotificationPreferences replaces the notification preferences of the user.
+// Passing empty preferences resets the user to the default behaviour.
+func (c *Commands) SetUserNotificationPreferences(ctx context.Context, userID, resourceOwner string, preferences *domain.NotificationPreferences) (_ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieW6o", "Errors.User.UserIDMissing")
+	}
+	if preferences == nil {
+		preferences = new(domain.NotificationPreferences)
+	}
+	if err = preferences.Validate(); err != nil {
+		return nil, err
+	}
+	wm, err := c.notificationPreferencesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.humanExists {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mae3x", "Errors.User.NotFound")
+	}
+	if err = c.checkPermissionUpdateUser(ctx, wm.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		user.NewHumanNotificationPreferencesSetEvent(ctx, UserAggregateFromWriteModel(&wm.WriteModel), preferences),
+	)
+}
+
+func (c *Commands) notificationPreferencesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanNotificationPreferencesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanNotificationPreferencesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanNotificationPreferencesWriteModel struct {
+	eventstore.WriteModel
+
+	humanExists bool
+	Preferences *domain.NotificationPreferences
+}
+
+func NewHumanNotificationPreferencesWriteModel(userID, resourceOwner string) *HumanNotificationPreferencesWriteModel {
+	return &HumanNotificationPreferencesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanNotificationPreferencesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.humanExists = true
+		case *user.HumanNotificationPreferencesSetEvent:
+			wm.Preferences = &domain.NotificationPreferences{
+				CodeChannels:       e.CodeChannels,
+				OptOutMessageTypes: e.OptOutMessageTypes,
+				SMSQuietHours:      e.SMSQuietHours,
+			}
+		case *user.UserRemovedEvent:
+			wm.humanExists = false
+			wm.Preferences = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanNotificationPreferencesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanNotificationPreferencesSetType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,222 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetUserNotificationPreferences(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+
+	humanAdded := func(userID string) eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				&user.NewAggregate(userID, "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	preferences := &domain.NotificationPreferences{
+		CodeChannels:       []domain.NotificationType{domain.NotificationTypeSms, domain.NotificationTypeEmail},
+		OptOutMessageTypes: []string{"CustomNewsletter"},
+		SMSQuietHours: &domain.QuietHours{
+			Start:    "22:00",
+			End:      "07:00",
+			TimeZone: "Europe/Zurich",
+		},
+	}
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+		preferences   *domain.NotificationPreferences
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieW6o", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "duplicate channel",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				preferences: &domain.NotificationPreferences{
+					CodeChannels: []domain.NotificationType{domain.NotificationTypeSms, domain.NotificationTypeSms},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ohb5i", "Errors.User.NotificationPreferences.InvalidChannel"),
+			},
+		},
+		{
+			name: "opt out of built-in message type",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				preferences: &domain.NotificationPreferences{
+					OptOutMessageTypes: []string{domain.PasswordResetMessageType},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-aiT7u", "Errors.User.NotificationPreferences.InvalidOptOut"),
+			},
+		},
+		{
+			name: "invalid quiet hours",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				preferences: &domain.NotificationPreferences{
+					SMSQuietHours: &domain.QuietHours{Start: "25:00", End: "07:00"},
+				},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Eiph3", "Errors.User.NotificationPreferences.InvalidQuietHours"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				preferences:   preferences,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Mae3x", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "other user, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded("user2"),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+				preferences:   preferences,
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "preferences set",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded("user1"),
+					),
+					expectPush(
+						user.NewHumanNotificationPreferencesSetEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							preferences,
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				preferences:   preferences,
+			},
+			res: res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "other user, preferences reset",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded("user2"),
+					),
+					expectPush(
+						user.NewHumanNotificationPreferencesSetEvent(ctx,
+							&user.NewAggregate("user2", "org1").Aggregate,
+							&domain.NotificationPreferences{},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+			},
+			res: res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.SetUserNotificationPreferences(ctx, tt.args.userID, tt.args.resourceOwner, tt.args.preferences)
+			require.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, got)
+			}
+		})
+	}
+}
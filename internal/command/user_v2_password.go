@@ -58,7 +58,7 @@ func (c *Commands) requestPasswordReset(ctx context.Context, userID string, retu
 	var passwordCode *EncryptedCode
 	var generatorID string
 	if notificationType == domain.NotificationTypeSms {
-		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
+		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, model.ResourceOwner, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
 	} else {
 		passwordCode, err = c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
 	}
@@ -563,6 +563,7 @@ func TestCommands_requestPasswordReset(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPasswordCodeAddedEventV2(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
 							&crypto.CryptoValue{
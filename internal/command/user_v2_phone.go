@@ -173,7 +173,7 @@ type UserPhoneEvents struct {
 	events          []eventstore.Command
 	model           *HumanPhoneWriteModel
 	generateCode    func(ctx context.Context, filter preparation.FilterToQueryReducer) (*EncryptedCode, string, error)
-	getCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	getCodeVerifier func(ctx context.Context, resourceOwner, id string) (senders.CodeGenerator, error)
 
 	plainCode *string
 }
@@ -201,7 +201,7 @@ func (c *Commands) NewUserPhoneEvents(ctx context.Context, userID string) (*User
 		aggregate:  UserAggregateFromWriteModel(&model.WriteModel),
 		model:      model,
 		generateCode: func(ctx context.Context, filter preparation.FilterToQueryReducer) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode)
+			return c.newPhoneCode(ctx, filter, model.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode)
 		},
 		getCodeVerifier: c.phoneCodeVerifier,
 	}, nil
@@ -256,6 +256,7 @@ func (c *UserPhoneEvents) VerifyCode(ctx context.Context, code string, gen crypt
 
 	err := verifyCode(
 		ctx,
+		c.model.ResourceOwner,
 		c.model.CodeCreationDate,
 		c.model.CodeExpiry,
 		c.model.Code,
@@ -863,6 +863,7 @@ func TestCommands_changeUserPhoneWithGenerator(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneChangedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -954,6 +955,7 @@ func TestCommands_changeUserPhoneWithGenerator(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneChangedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1182,6 +1184,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneCodeAddedEventV2(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -1372,6 +1375,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneCodeAddedEventV2(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddUserPushDevice enrolls a device of the user for push approvals.
+// The challenges sent to the device will have to be signed with the private key of the provided public key.
+func (c *Commands) AddUserPushDevice(ctx context.Context, userID, resourceOwner, name, deviceToken string, publicKey []byte) (deviceID string, _ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiw4h", "Errors.User.UserIDMissing")
+	}
+	if deviceToken == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ahY3u", "Errors.User.MFA.Push.DeviceTokenMissing")
+	}
+	if _, err = domain.ParsePushPublicKey(publicKey); err != nil {
+		return "", nil, err
+	}
+	wm, err := c.pushDevicesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if !wm.humanExists {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-uu8Ah", "Errors.User.NotFound")
+	}
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err = c.checkPermission(ctx, domain.PermissionUserCredentialWrite, wm.ResourceOwner, userID); err != nil {
+			return "", nil, err
+		}
+	}
+	deviceID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		user.NewHumanPushDeviceAddedEvent(ctx, UserAggregateFromWriteModel(&wm.WriteModel), deviceID, name, deviceToken, publicKey),
+	); err != nil {
+		return "", nil, err
+	}
+	return deviceID, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveUserPushDevice removes an enrolled device of the user, which will no longer receive push challenges.
+func (c *Commands) RemoveUserPushDevice(ctx context.Context, userID, resourceOwner, deviceID string) (_ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooK1a", "Errors.User.UserIDMissing")
+	}
+	if deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Oow5i", "Errors.User.MFA.Push.DeviceIDMissing")
+	}
+	wm, err := c.pushDevicesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.Device(deviceID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ahz0e", "Errors.User.MFA.Push.NotExisting")
+	}
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err = c.checkPermission(ctx, domain.PermissionUserCredentialWrite, wm.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.pushAppendAndReduce(ctx, wm,
+		user.NewHumanPushDeviceRemovedEvent(ctx, UserAggregateFromWriteModel(&wm.WriteModel), deviceID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) pushDevicesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPushDevicesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanPushDevicesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
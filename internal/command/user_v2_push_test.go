@@ -0,0 +1,338 @@
+package command
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testPushDeviceKey(t *testing.T) (ed25519.PrivateKey, []byte) {
+	privateKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	publicKey, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	return privateKey, publicKey
+}
+
+func TestCommands_AddUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	_, publicKey := testPushDeviceKey(t)
+
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(ctx,
+				userAgg,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+		name          string
+		deviceToken   string
+		publicKey     []byte
+	}
+	type res struct {
+		deviceID string
+		details  *domain.ObjectDetails
+		err      error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiw4h", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing device token",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:    "user1",
+				publicKey: publicKey,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ahY3u", "Errors.User.MFA.Push.DeviceTokenMissing"),
+			},
+		},
+		{
+			name: "invalid public key",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:      "user1",
+				deviceToken: "token",
+				publicKey:   []byte("invalid"),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Aiy8u", "Errors.User.MFA.Push.InvalidPublicKey"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				deviceToken:   "token",
+				publicKey:     publicKey,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-uu8Ah", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "other user, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(ctx,
+								&user.NewAggregate("user2", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+				deviceToken:   "token",
+				publicKey:     publicKey,
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "device added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+					),
+					expectPush(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token", publicKey),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "device1"),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				name:          "phone",
+				deviceToken:   "token",
+				publicKey:     publicKey,
+			},
+			res: res{
+				deviceID: "device1",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			deviceID, got, err := c.AddUserPushDevice(ctx, tt.args.userID, tt.args.resourceOwner, tt.args.name, tt.args.deviceToken, tt.args.publicKey)
+			require.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.deviceID, deviceID)
+				assertObjectDetails(t, tt.res.details, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("inst1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	_, publicKey := testPushDeviceKey(t)
+
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+		deviceID      string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				deviceID: "device1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ooK1a", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing deviceID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Oow5i", "Errors.User.MFA.Push.DeviceIDMissing"),
+			},
+		},
+		{
+			name: "device not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token", publicKey),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, "device1"),
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				deviceID:      "device1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ahz0e", "Errors.User.MFA.Push.NotExisting"),
+			},
+		},
+		{
+			name: "other user, permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, &user.NewAggregate("user2", "org1").Aggregate, "device1", "phone", "token", publicKey),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:        "user2",
+				resourceOwner: "org1",
+				deviceID:      "device1",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "device removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", "phone", "token", publicKey),
+						),
+					),
+					expectPush(
+						user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, "device1"),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				deviceID:      "device1",
+			},
+			res: res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveUserPushDevice(ctx, tt.args.userID, tt.args.resourceOwner, tt.args.deviceID)
+			require.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, got)
+			}
+		})
+	}
+}
@@ -218,6 +218,7 @@ func TestCommands_ChangeSchemaUserPhone(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
@@ -301,6 +302,7 @@ func TestCommands_ChangeSchemaUserPhone(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
@@ -1093,6 +1095,7 @@ func TestCommands_ResendSchemaUserPhoneCode(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						eventFromEventPusher(
 							schemauser.NewPhoneCodeAddedEvent(
@@ -1286,6 +1289,7 @@ func TestCommands_ResendSchemaUserPhoneCode(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						eventFromEventPusher(
 							schemauser.NewPhoneCodeAddedEvent(
@@ -706,6 +706,7 @@ func TestCommands_CreateSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewCreatedEvent(
 							context.Background(),
@@ -816,6 +817,7 @@ func TestCommands_CreateSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewCreatedEvent(
 							context.Background(),
@@ -3240,6 +3242,7 @@ func TestCommands_ChangeSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
@@ -3325,6 +3328,7 @@ func TestCommands_ChangeSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
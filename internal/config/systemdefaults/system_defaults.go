@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
+	"github.com/zitadel/zitadel/internal/risk"
 )
 
 type SystemDefaults struct {
@@ -16,6 +18,8 @@ type SystemDefaults struct {
 	KeyConfig          KeyConfig
 	DefaultQueryLimit  uint64
 	MaxQueryLimit      uint64
+	LoginRisk          risk.Config
+	MagicLink          MagicLinkConfig
 }
 
 type SecretGenerators struct {
@@ -24,19 +28,32 @@ type SecretGenerators struct {
 }
 
 type MultifactorConfig struct {
-	OTP OTPConfig
+	OTP  OTPConfig
+	Push PushConfig
 }
 
 type OTPConfig struct {
 	Issuer string
 }
 
+type PushConfig struct {
+	ChallengeLifetime time.Duration
+}
+
+type MagicLinkConfig struct {
+	// MaxRequests is the number of login links a user can request inside the RateLimitWindow
+	MaxRequests     int
+	RateLimitWindow time.Duration
+}
+
 type DomainVerification struct {
 	VerificationGenerator crypto.GeneratorConfig
 }
 
 type Notifications struct {
 	FileSystemPath string
+	// Push configures the provider delivering the push challenges to the enrolled devices of the users
+	Push push.Config
 }
 
 type KeyConfig struct {
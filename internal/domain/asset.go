@@ -19,6 +19,8 @@ const (
 	LabelPolicyLogoPath = labelPolicyLogoPrefix
 	LabelPolicyIconPath = labelPolicyIconPrefix
 	LabelPolicyFontPath = labelPolicyFontPrefix
+
+	LanguageBundlePrefix = "i18n"
 )
 
 type AssetInfo struct {
@@ -38,6 +40,11 @@ func GetHumanAvatarAssetPath(userID string) string {
 	return UsersAssetPath + "/" + userID + AvatarAssetPath
 }
 
+// GetLanguageBundleAssetPath returns the path of a runtime language bundle, e.g. i18n/login/ar.yaml
+func GetLanguageBundleAssetPath(namespace, language string) string {
+	return LanguageBundlePrefix + "/" + namespace + "/" + language + ".yaml"
+}
+
 func AssetURL(prefix, resourceOwner, key string) string {
 	if prefix == "" || resourceOwner == "" || key == "" {
 		return ""
@@ -47,6 +47,7 @@ type AuthRequest struct {
 	PossibleSteps            []NextStep `json:"-"`
 	PasswordVerified         bool
 	IDPLoginChecked          bool
+	MagicLinkVerified        bool
 	MFAsVerified             []MFAType
 	Audience                 []string
 	AuthTime                 time.Time
@@ -82,6 +83,9 @@ func (a *AuthRequest) AuthMethods() []UserAuthMethodType {
 	if a.IDPLoginChecked {
 		list = append(list, UserAuthMethodTypeIDP)
 	}
+	if a.MagicLinkVerified {
+		list = append(list, UserAuthMethodTypeMagicLink)
+	}
 	for _, mfa := range a.MFAsVerified {
 		list = append(list, mfa.UserAuthMethodType())
 	}
@@ -128,8 +132,70 @@ type LevelOfAssurance int
 
 const (
 	LevelOfAssuranceNone LevelOfAssurance = iota
+	// LevelOfAssuranceSingleFactor requires at least one verified factor
+	LevelOfAssuranceSingleFactor
+	// LevelOfAssuranceMultiFactor requires at least two verified factors
+	LevelOfAssuranceMultiFactor
+	// LevelOfAssurancePhishingResistant requires a verified (origin bound) webauthn credential,
+	// either as passkey or as second factor (u2f)
+	LevelOfAssurancePhishingResistant
 )
 
+// LevelOfAssuranceFromAuthMethods returns the level of assurance achieved
+// by the provided (verified) auth methods.
+func LevelOfAssuranceFromAuthMethods(methods []UserAuthMethodType) LevelOfAssurance {
+	var factors int
+	var webAuthN, magicLink bool
+	for _, method := range methods {
+		switch method {
+		case UserAuthMethodTypePasswordless:
+			factors += 2
+			webAuthN = true
+		case UserAuthMethodTypeU2F:
+			factors++
+			webAuthN = true
+		case UserAuthMethodTypePassword,
+			UserAuthMethodTypeIDP,
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypeTOTP,
+			UserAuthMethodTypeOTPSMS,
+			UserAuthMethodTypeOTPEmail,
+			UserAuthMethodTypePush:
+			factors++
+		case UserAuthMethodTypeMagicLink:
+			// a magic link authenticates the user, but doesn't count as an additional factor (see [HasMFA])
+			magicLink = true
+		case UserAuthMethodTypeUnspecified:
+			// ignore
+		}
+	}
+	switch {
+	case factors >= 2 && webAuthN:
+		return LevelOfAssurancePhishingResistant
+	case factors >= 2:
+		return LevelOfAssuranceMultiFactor
+	case factors == 1, magicLink:
+		return LevelOfAssuranceSingleFactor
+	default:
+		return LevelOfAssuranceNone
+	}
+}
+
+// RequiredLevelOfAssurance returns the lowest of the requested levels,
+// since any of them satisfies the request.
+func RequiredLevelOfAssurance(possibleLOAs []LevelOfAssurance) LevelOfAssurance {
+	required := LevelOfAssuranceNone
+	for _, loa := range possibleLOAs {
+		if loa == LevelOfAssuranceNone {
+			continue
+		}
+		if required == LevelOfAssuranceNone || loa < required {
+			required = loa
+		}
+	}
+	return required
+}
+
 type MFAType int
 
 const (
@@ -210,9 +276,21 @@ func (a *AuthRequest) SetOrgInformation(id, name, primaryDomain string, requeste
 	a.RequestedOrgDomain = requestedByDomain
 }
 
+// RequiredLevelOfAssurance returns the level of assurance requested by the client (acr_values).
+func (a *AuthRequest) RequiredLevelOfAssurance() LevelOfAssurance {
+	return RequiredLevelOfAssurance(a.PossibleLOAs)
+}
+
+// MFALevel returns the MFA level required by the requested level of assurance.
+// If no (multi factor) level was requested, -1 is returned and the login policy decides.
 func (a *AuthRequest) MFALevel() MFALevel {
-	return -1
-	//PLANNED: check a.PossibleLOAs (and Prompt Login?)
+	switch a.RequiredLevelOfAssurance() {
+	case LevelOfAssuranceMultiFactor,
+		LevelOfAssurancePhishingResistant:
+		return MFALevelSecondFactor
+	default:
+		return -1
+	}
 }
 
 func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
@@ -272,10 +350,13 @@ func (a *AuthRequest) PrivateLabelingOrgID(defaultID string) string {
 }
 
 func (a *AuthRequest) UserAuthMethodTypes() []UserAuthMethodType {
-	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+1)
+	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+2)
 	if a.PasswordVerified {
 		list = append(list, UserAuthMethodTypePassword)
 	}
+	if a.MagicLinkVerified {
+		list = append(list, UserAuthMethodTypeMagicLink)
+	}
 	for _, mfa := range a.MFAsVerified {
 		list = append(list, mfa.UserAuthMethodType())
 	}
@@ -53,8 +53,9 @@ func TestMFAType_UserAuthMethodType(t *testing.T) {
 
 func TestAuthRequest_UserAuthMethodTypes(t *testing.T) {
 	type fields struct {
-		PasswordVerified bool
-		MFAsVerified     []MFAType
+		PasswordVerified  bool
+		MagicLinkVerified bool
+		MFAsVerified      []MFAType
 	}
 	tests := []struct {
 		name   string
@@ -94,15 +95,133 @@ func TestAuthRequest_UserAuthMethodTypes(t *testing.T) {
 				UserAuthMethodTypeU2F,
 			},
 		},
+		{
+			name: "magic link, with mfa",
+			fields: fields{
+				MagicLinkVerified: true,
+				MFAsVerified: []MFAType{
+					MFATypeOTPEmail,
+				},
+			},
+			want: []UserAuthMethodType{
+				UserAuthMethodTypeMagicLink,
+				UserAuthMethodTypeOTPEmail,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := &AuthRequest{
-				PasswordVerified: tt.fields.PasswordVerified,
-				MFAsVerified:     tt.fields.MFAsVerified,
+				PasswordVerified:  tt.fields.PasswordVerified,
+				MagicLinkVerified: tt.fields.MagicLinkVerified,
+				MFAsVerified:      tt.fields.MFAsVerified,
 			}
 			got := a.UserAuthMethodTypes()
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestAuthRequest_MFALevel(t *testing.T) {
+	tests := []struct {
+		name         string
+		possibleLOAs []LevelOfAssurance
+		want         MFALevel
+	}{
+		{
+			name:         "nothing requested, policy decides",
+			possibleLOAs: nil,
+			want:         -1,
+		},
+		{
+			name:         "single factor requested, policy decides",
+			possibleLOAs: []LevelOfAssurance{LevelOfAssuranceSingleFactor},
+			want:         -1,
+		},
+		{
+			name:         "multi factor requested, second factor",
+			possibleLOAs: []LevelOfAssurance{LevelOfAssuranceMultiFactor},
+			want:         MFALevelSecondFactor,
+		},
+		{
+			name:         "phishing resistant requested, second factor",
+			possibleLOAs: []LevelOfAssurance{LevelOfAssurancePhishingResistant},
+			want:         MFALevelSecondFactor,
+		},
+		{
+			name:         "multiple requested, lowest wins",
+			possibleLOAs: []LevelOfAssurance{LevelOfAssurancePhishingResistant, LevelOfAssuranceSingleFactor},
+			want:         -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AuthRequest{
+				PossibleLOAs: tt.possibleLOAs,
+			}
+			assert.Equal(t, tt.want, a.MFALevel())
+		})
+	}
+}
+
+func TestLevelOfAssuranceFromAuthMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []UserAuthMethodType
+		want    LevelOfAssurance
+	}{
+		{
+			name: "none",
+			want: LevelOfAssuranceNone,
+		},
+		{
+			name:    "magic link",
+			methods: []UserAuthMethodType{UserAuthMethodTypeMagicLink},
+			want:    LevelOfAssuranceSingleFactor,
+		},
+		{
+			name:    "magic link and otp email",
+			methods: []UserAuthMethodType{UserAuthMethodTypeMagicLink, UserAuthMethodTypeOTPEmail},
+			want:    LevelOfAssuranceSingleFactor,
+		},
+		{
+			name:    "password and totp",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			want:    LevelOfAssuranceMultiFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LevelOfAssuranceFromAuthMethods(tt.methods))
+		})
+	}
+}
+
+func TestHasMFA(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []UserAuthMethodType
+		want    bool
+	}{
+		{
+			name:    "password and totp",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			want:    true,
+		},
+		{
+			name:    "magic link and otp email",
+			methods: []UserAuthMethodType{UserAuthMethodTypeMagicLink, UserAuthMethodTypeOTPEmail},
+			want:    false,
+		},
+		{
+			name:    "magic link and password",
+			methods: []UserAuthMethodType{UserAuthMethodTypeMagicLink, UserAuthMethodTypePassword},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, HasMFA(tt.methods))
+		})
+	}
+}
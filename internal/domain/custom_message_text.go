@@ -18,6 +18,16 @@ const (
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
 	InviteUserMessageType               = "InviteUser"
+	LoginRiskMessageType                = "LoginRisk"
+	MFAAddedMessageType                 = "MFAAdded"
+	MFARemovedMessageType               = "MFARemoved"
+	PasskeyAddedMessageType             = "PasskeyAdded"
+	EmailChangedMessageType             = "EmailChanged"
+	PhoneChangedMessageType             = "PhoneChanged"
+	AccountLockedMessageType            = "AccountLocked"
+	NewDeviceLoginMessageType           = "NewDeviceLogin"
+	PushChallengeMessageType            = "PushChallenge"
+	MagicLinkMessageType                = "MagicLink"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -63,5 +73,20 @@ func IsMessageTextType(textType string) bool {
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
 		textType == PasswordChangeMessageType ||
-		textType == InviteUserMessageType
+		textType == InviteUserMessageType ||
+		textType == LoginRiskMessageType ||
+		textType == MagicLinkMessageType ||
+		IsSecurityNotificationMessageType(textType)
+}
+
+// IsSecurityNotificationMessageType returns true for the messages informing a user about security relevant changes of the account.
+// They are sent if enabled in the notification policy.
+func IsSecurityNotificationMessageType(textType string) bool {
+	return textType == MFAAddedMessageType ||
+		textType == MFARemovedMessageType ||
+		textType == PasskeyAddedMessageType ||
+		textType == EmailChangedMessageType ||
+		textType == PhoneChangedMessageType ||
+		textType == AccountLockedMessageType ||
+		textType == NewDeviceLoginMessageType
 }
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"regexp"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CustomMessageTypePrefix = "Custom"
+
+	customNotificationTypeMaxLength        = 200
+	customNotificationDescriptionMaxLength = 500
+)
+
+var customMessageTypeRegex = regexp.MustCompile(`^` + CustomMessageTypePrefix + `[A-Z][A-Za-z0-9]*$`)
+
+// CustomNotificationType is a message type defined by the administrators of an instance.
+// Its texts and templates are managed like the ones of the built-in message types,
+// the notification itself is triggered through the API.
+type CustomNotificationType struct {
+	models.ObjectRoot
+
+	Type        string
+	Description string
+}
+
+func (t *CustomNotificationType) IsValid() error {
+	if !IsCustomMessageType(t.Type) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-oiZ3e", "Errors.CustomNotificationType.InvalidType")
+	}
+	if len(t.Description) > customNotificationDescriptionMaxLength {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Aes7a", "Errors.CustomNotificationType.InvalidDescription")
+	}
+	return nil
+}
+
+// IsCustomMessageType checks if the type has the form of a custom notification type, e.g. CustomSubscriptionExpires.
+// The prefix prevents collisions with built-in message types added in the future.
+func IsCustomMessageType(textType string) bool {
+	return len(textType) <= customNotificationTypeMaxLength && customMessageTypeRegex.MatchString(textType)
+}
+
+// CustomNotificationChannelValid checks if a custom notification can be sent on the channel.
+func CustomNotificationChannelValid(channel NotificationType) bool {
+	return channel == NotificationTypeEmail || channel == NotificationTypeSms
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCustomMessageType(t *testing.T) {
+	tests := []struct {
+		name     string
+		textType string
+		want     bool
+	}{
+		{
+			name:     "empty",
+			textType: "",
+			want:     false,
+		},
+		{
+			name:     "built-in type",
+			textType: InitCodeMessageType,
+			want:     false,
+		},
+		{
+			name:     "prefix only",
+			textType: CustomMessageTypePrefix,
+			want:     false,
+		},
+		{
+			name:     "lower case after prefix",
+			textType: "Customsubscription",
+			want:     false,
+		},
+		{
+			name:     "invalid characters",
+			textType: "CustomSubscription.Expires",
+			want:     false,
+		},
+		{
+			name:     "too long",
+			textType: "CustomA" + strings.Repeat("a", 200),
+			want:     false,
+		},
+		{
+			name:     "valid",
+			textType: "CustomSubscriptionExpires2",
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsCustomMessageType(tt.textType))
+		})
+	}
+}
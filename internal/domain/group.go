@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"strings"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+type Group struct {
+	es_models.ObjectRoot
+
+	State       GroupState
+	Name        string
+	Description string
+}
+
+func (g *Group) IsValid() bool {
+	return strings.TrimSpace(g.Name) != ""
+}
+
+type GroupState int32
+
+const (
+	GroupStateUnspecified GroupState = iota
+	GroupStateActive
+	GroupStateRemoved
+
+	groupStateCount
+)
+
+func (s GroupState) Valid() bool {
+	return s >= 0 && s < groupStateCount
+}
+
+func (s GroupState) Exists() bool {
+	return s != GroupStateUnspecified && s != GroupStateRemoved
+}
+
+// GroupMemberType defines if the member of a group is a user or a (nested) group
+type GroupMemberType int32
+
+const (
+	GroupMemberTypeUnspecified GroupMemberType = iota
+	GroupMemberTypeUser
+	GroupMemberTypeGroup
+)
+
+// GroupGrant grants roles of a project to all (indirect) members of the group,
+// the AggregateID is the id of the group
+type GroupGrant struct {
+	es_models.ObjectRoot
+
+	GrantID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (g *GroupGrant) IsValid() bool {
+	return g.AggregateID != "" && g.ProjectID != ""
+}
+
+func (g *GroupGrant) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range g.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupMember is a group which is member of an organization or project,
+// the AggregateID is the id of the organization or project
+type GroupMember struct {
+	es_models.ObjectRoot
+
+	GroupID string
+	Roles   []string
+}
+
+func NewGroupMember(aggregateID, groupID string, roles ...string) *GroupMember {
+	return &GroupMember{
+		ObjectRoot: es_models.ObjectRoot{
+			AggregateID: aggregateID,
+		},
+		GroupID: groupID,
+		Roles:   roles,
+	}
+}
+
+func (m *GroupMember) IsValid() bool {
+	return m.AggregateID != "" && m.GroupID != "" && len(m.Roles) != 0
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ParsePushPublicKey parses the PKIX, ASN.1 DER encoded public key of a push device.
+// Only ECDSA and Ed25519 keys are supported.
+func ParsePushPublicKey(publicKey []byte) (any, error) {
+	key, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Aiy8u", "Errors.User.MFA.Push.InvalidPublicKey")
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooL7e", "Errors.User.MFA.Push.InvalidPublicKey")
+	}
+}
+
+// VerifyPushSignature verifies the signature the device created over the challenge.
+// ECDSA signatures are expected to be ASN.1 encoded and created over the SHA-256 hash of the challenge,
+// Ed25519 signatures over the challenge itself.
+func VerifyPushSignature(publicKey []byte, challenge string, signature []byte) error {
+	key, err := ParsePushPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	var valid bool
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		hash := sha256.Sum256([]byte(challenge))
+		valid = ecdsa.VerifyASN1(k, hash[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, []byte(challenge), signature)
+	}
+	if !valid {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiS2a", "Errors.User.MFA.Push.InvalidSignature")
+	}
+	return nil
+}
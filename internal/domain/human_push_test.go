@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestVerifyPushSignature(t *testing.T) {
+	challenge := "challenge"
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecPublicKey, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte(challenge))
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecKey, hash[:])
+	require.NoError(t, err)
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edPublicKey, err := x509.MarshalPKIXPublicKey(edPublic)
+	require.NoError(t, err)
+	edSignature := ed25519.Sign(edPrivate, []byte(challenge))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPublicKey, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+
+	type args struct {
+		publicKey []byte
+		challenge string
+		signature []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "invalid public key",
+			args: args{
+				publicKey: []byte("invalid"),
+				challenge: challenge,
+				signature: ecSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Aiy8u", "Errors.User.MFA.Push.InvalidPublicKey"),
+		},
+		{
+			name: "unsupported public key",
+			args: args{
+				publicKey: rsaPublicKey,
+				challenge: challenge,
+				signature: ecSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooL7e", "Errors.User.MFA.Push.InvalidPublicKey"),
+		},
+		{
+			name: "ecdsa, wrong challenge",
+			args: args{
+				publicKey: ecPublicKey,
+				challenge: "other",
+				signature: ecSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiS2a", "Errors.User.MFA.Push.InvalidSignature"),
+		},
+		{
+			name: "ecdsa, ok",
+			args: args{
+				publicKey: ecPublicKey,
+				challenge: challenge,
+				signature: ecSignature,
+			},
+		},
+		{
+			name: "ed25519, wrong challenge",
+			args: args{
+				publicKey: edPublicKey,
+				challenge: "other",
+				signature: edSignature,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiS2a", "Errors.User.MFA.Push.InvalidSignature"),
+		},
+		{
+			name: "ed25519, ok",
+			args: args{
+				publicKey: edPublicKey,
+				challenge: challenge,
+				signature: edSignature,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPushSignature(tt.args.publicKey, tt.args.challenge, tt.args.signature)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"strings"
+)
+
+// IDPAttributeMapping maps an attribute (SAML) or claim (OIDC / OAuth) of the identity provider
+// to a field of the user or a metadata key
+type IDPAttributeMapping struct {
+	// Source is the name of the attribute or claim, nested claims can be addressed by a dot separated path
+	Source string                    `json:"source,omitempty"`
+	Target IDPAttributeMappingTarget `json:"target,omitempty"`
+	// Key is the metadata key, if the Target requires it
+	Key       string                `json:"key,omitempty"`
+	Transform IDPAttributeTransform `json:"transform,omitempty"`
+}
+
+func (m IDPAttributeMapping) IsValid() bool {
+	if strings.TrimSpace(m.Source) == "" || !m.Target.Valid() || !m.Transform.Valid() {
+		return false
+	}
+	if m.Target.RequiresKey() {
+		return strings.TrimSpace(m.Key) != ""
+	}
+	return m.Key == ""
+}
+
+type IDPAttributeMappingTarget int32
+
+const (
+	IDPAttributeMappingTargetUnspecified IDPAttributeMappingTarget = iota
+	IDPAttributeMappingTargetFirstName
+	IDPAttributeMappingTargetLastName
+	IDPAttributeMappingTargetDisplayName
+	IDPAttributeMappingTargetNickName
+	IDPAttributeMappingTargetPreferredUsername
+	IDPAttributeMappingTargetEmail
+	IDPAttributeMappingTargetEmailVerified
+	IDPAttributeMappingTargetPhone
+	IDPAttributeMappingTargetPhoneVerified
+	IDPAttributeMappingTargetPreferredLanguage
+	IDPAttributeMappingTargetMetadata
+	// IDPAttributeMappingTargetSchemaProperty is reserved for the user schema properties
+	// and not supported yet, since federated users are always created as human users
+	IDPAttributeMappingTargetSchemaProperty
+
+	idpAttributeMappingTargetCount
+)
+
+func (t IDPAttributeMappingTarget) Valid() bool {
+	return t > IDPAttributeMappingTargetUnspecified && t < idpAttributeMappingTargetCount &&
+		t != IDPAttributeMappingTargetSchemaProperty
+}
+
+// RequiresKey returns if the mapping needs a metadata key
+func (t IDPAttributeMappingTarget) RequiresKey() bool {
+	return t == IDPAttributeMappingTargetMetadata
+}
+
+type IDPAttributeTransform int32
+
+const (
+	IDPAttributeTransformNone IDPAttributeTransform = iota
+	IDPAttributeTransformLowercase
+	IDPAttributeTransformUppercase
+	IDPAttributeTransformTrim
+	// IDPAttributeTransformRemoveDomain removes everything after the last `@` (e.g. the domain of an email or UPN)
+	IDPAttributeTransformRemoveDomain
+
+	idpAttributeTransformCount
+)
+
+func (t IDPAttributeTransform) Valid() bool {
+	return t >= IDPAttributeTransformNone && t < idpAttributeTransformCount
+}
+
+func (t IDPAttributeTransform) Apply(value string) string {
+	switch t {
+	case IDPAttributeTransformLowercase:
+		return strings.ToLower(value)
+	case IDPAttributeTransformUppercase:
+		return strings.ToUpper(value)
+	case IDPAttributeTransformTrim:
+		return strings.TrimSpace(value)
+	case IDPAttributeTransformRemoveDomain:
+		if index := strings.LastIndex(value, "@"); index > 0 {
+			return value[:index]
+		}
+		return value
+	case IDPAttributeTransformNone,
+		idpAttributeTransformCount:
+		fallthrough
+	default:
+		return value
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+type LDAPSyncAction int32
+
+const (
+	LDAPSyncActionUnspecified LDAPSyncAction = iota
+	LDAPSyncActionUnchanged
+	LDAPSyncActionCreated
+	LDAPSyncActionUpdated
+	LDAPSyncActionDeactivated
+	LDAPSyncActionReactivated
+	LDAPSyncActionFailed
+)
+
+// LDAPSyncReport contains the result of a directory synchronization of an LDAP identity provider.
+// In case of a dry run, the report contains the actions which would have been executed.
+type LDAPSyncReport struct {
+	IDPID  string
+	DryRun bool
+	Users  []*LDAPSyncUserResult
+}
+
+type LDAPSyncUserResult struct {
+	// UserID is empty if the user was not yet created in ZITADEL
+	UserID         string
+	ExternalUserID string
+	Username       string
+	Action         LDAPSyncAction
+	Error          error
+}
+
+func (r *LDAPSyncReport) Add(result *LDAPSyncUserResult) {
+	r.Users = append(r.Users, result)
+}
+
+// Count returns the number of users the action was (or would have been) applied to.
+func (r *LDAPSyncReport) Count(action LDAPSyncAction) (count int) {
+	for _, user := range r.Users {
+		if user.Action == action {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"io"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// MagicLinkConfig limits how many login links a user can request in a period.
+type MagicLinkConfig struct {
+	MaxRequests     int
+	RateLimitWindow time.Duration
+}
+
+// RateLimited checks if the previous requests of a user already reached the limit.
+// A config without MaxRequests never limits the requests.
+func (c MagicLinkConfig) RateLimited(requests []time.Time, now time.Time) bool {
+	if c.MaxRequests <= 0 {
+		return false
+	}
+	var count int
+	for _, requested := range requests {
+		if now.Sub(requested) < c.RateLimitWindow {
+			count++
+		}
+	}
+	return count >= c.MaxRequests
+}
+
+type MagicLinkURLData struct {
+	Code              string
+	UserID            string
+	LoginName         string
+	DisplayName       string
+	PreferredLanguage language.Tag
+	SessionID         string
+}
+
+// RenderMagicLinkURLTemplate parses and renders tmpl.
+// code, userID, (preferred) loginName, displayName, sessionID and preferredLanguage are passed into the [MagicLinkURLData].
+func RenderMagicLinkURLTemplate(w io.Writer, tmpl, code, userID, loginName, displayName, sessionID string, preferredLanguage language.Tag) error {
+	return renderURLTemplate(w, tmpl, &MagicLinkURLData{
+		Code:              code,
+		UserID:            userID,
+		LoginName:         loginName,
+		DisplayName:       displayName,
+		PreferredLanguage: preferredLanguage,
+		SessionID:         sessionID,
+	})
+}
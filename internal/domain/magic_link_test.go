@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMagicLinkConfig_RateLimited(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		config   MagicLinkConfig
+		requests []time.Time
+		want     bool
+	}{
+		{
+			name:     "no limit",
+			config:   MagicLinkConfig{},
+			requests: []time.Time{now, now, now},
+			want:     false,
+		},
+		{
+			name:     "below limit",
+			config:   MagicLinkConfig{MaxRequests: 3, RateLimitWindow: time.Hour},
+			requests: []time.Time{now.Add(-time.Minute), now.Add(-2 * time.Minute)},
+			want:     false,
+		},
+		{
+			name:     "limit reached",
+			config:   MagicLinkConfig{MaxRequests: 3, RateLimitWindow: time.Hour},
+			requests: []time.Time{now.Add(-time.Minute), now.Add(-2 * time.Minute), now.Add(-3 * time.Minute)},
+			want:     true,
+		},
+		{
+			name:     "requests outside window",
+			config:   MagicLinkConfig{MaxRequests: 3, RateLimitWindow: time.Hour},
+			requests: []time.Time{now.Add(-time.Minute), now.Add(-2 * time.Hour), now.Add(-3 * time.Hour)},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.RateLimited(tt.requests, now))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	html_template "html/template"
+	text_template "text/template"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MessageTemplate replaces the mail template for a single message type.
+// If no language is set, the template is used for all languages without a template of their own.
+type MessageTemplate struct {
+	models.ObjectRoot
+
+	State       PolicyState
+	Default     bool
+	MessageType string
+	Language    language.Tag
+	HTML        string
+	PlainText   string
+}
+
+func (m *MessageTemplate) IsValid(supportedLanguages []language.Tag) error {
+	if !IsMessageTextType(m.MessageType) && !IsCustomMessageType(m.MessageType) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Oow4i", "Errors.MessageTemplate.Invalid")
+	}
+	if m.HTML == "" && m.PlainText == "" {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-ahY6e", "Errors.MessageTemplate.Empty")
+	}
+	if err := LanguagesAreSupported(supportedLanguages, m.Language); err != nil {
+		return err
+	}
+	if _, err := html_template.New(m.MessageType).Parse(m.HTML); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Eeph3", "Errors.MessageTemplate.InvalidHTML")
+	}
+	if _, err := text_template.New(m.MessageType).Parse(m.PlainText); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Uu1ae", "Errors.MessageTemplate.InvalidPlainText")
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/zitadel/zitadel/internal/crypto"
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+)
 
 type MFAState int32
 
@@ -14,10 +18,15 @@ const (
 )
 
 type MultifactorConfigs struct {
-	OTP OTPConfig
+	OTP  OTPConfig
+	Push PushConfig
 }
 
 type OTPConfig struct {
 	Issuer    string
 	CryptoMFA crypto.EncryptionAlgorithm
 }
+
+type PushConfig struct {
+	ChallengeLifetime time.Duration
+}
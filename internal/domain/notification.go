@@ -31,3 +31,17 @@ const (
 
 	notificationProviderTypeCount
 )
+
+// NotificationState is the delivery state of a notification sent to a user.
+type NotificationState int32
+
+const (
+	NotificationStateUnspecified NotificationState = iota
+	NotificationStateSent
+	NotificationStateFailed
+	NotificationStateResendRequested
+)
+
+func (s NotificationState) Exists() bool {
+	return s != NotificationStateUnspecified
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const quietHoursLayout = "15:04"
+
+// NotificationPreferences are the choices of a user on how they want to be notified.
+// Security relevant messages can't be opted out, only custom message types.
+type NotificationPreferences struct {
+	// CodeChannels is the priority of the channels used to send codes, e.g. for a password reset.
+	CodeChannels []NotificationType
+	// OptOutMessageTypes are the custom message types the user doesn't want to receive.
+	OptOutMessageTypes []string
+	// SMSQuietHours is the daily period in which no informational SMS is sent to the user.
+	SMSQuietHours *QuietHours
+}
+
+// QuietHours is a daily period in the time zone of the user.
+// If the end is before the start, the period spans midnight.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"timeZone"`
+}
+
+func (p *NotificationPreferences) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for i, channel := range p.CodeChannels {
+		if !CustomNotificationChannelValid(channel) || slices.Contains(p.CodeChannels[:i], channel) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ohb5i", "Errors.User.NotificationPreferences.InvalidChannel")
+		}
+	}
+	for _, messageType := range p.OptOutMessageTypes {
+		if !IsCustomMessageType(messageType) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-aiT7u", "Errors.User.NotificationPreferences.InvalidOptOut")
+		}
+	}
+	if p.SMSQuietHours != nil {
+		return p.SMSQuietHours.Validate()
+	}
+	return nil
+}
+
+// CodeChannel returns the first channel of the preferred order which is available for the user.
+// If no preference is set or none of the preferred channels is available, the requested channel is returned.
+// SMS is skipped during the quiet hours if another channel is available.
+func (p *NotificationPreferences) CodeChannel(requested NotificationType, available func(NotificationType) bool, now time.Time) NotificationType {
+	if p == nil || len(p.CodeChannels) == 0 {
+		return requested
+	}
+	var quiet []NotificationType
+	for _, channel := range p.CodeChannels {
+		if !available(channel) {
+			continue
+		}
+		if channel == NotificationTypeSms && p.SMSQuietHours.Contains(now) {
+			quiet = append(quiet, channel)
+			continue
+		}
+		return channel
+	}
+	if len(quiet) > 0 {
+		return quiet[0]
+	}
+	return requested
+}
+
+// OptedOut checks if the user doesn't want to receive messages of the type.
+func (p *NotificationPreferences) OptedOut(messageType string) bool {
+	if p == nil {
+		return false
+	}
+	return slices.Contains(p.OptOutMessageTypes, messageType)
+}
+
+// SMSQuiet checks if no informational SMS should be sent at the time.
+func (p *NotificationPreferences) SMSQuiet(now time.Time) bool {
+	if p == nil {
+		return false
+	}
+	return p.SMSQuietHours.Contains(now)
+}
+
+func (q *QuietHours) Validate() error {
+	if _, err := time.Parse(quietHoursLayout, q.Start); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Eiph3", "Errors.User.NotificationPreferences.InvalidQuietHours")
+	}
+	if _, err := time.Parse(quietHoursLayout, q.End); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-ooX4e", "Errors.User.NotificationPreferences.InvalidQuietHours")
+	}
+	if q.Start == q.End {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Zee6a", "Errors.User.NotificationPreferences.InvalidQuietHours")
+	}
+	if _, err := time.LoadLocation(q.TimeZone); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-uK7ae", "Errors.User.NotificationPreferences.InvalidQuietHours")
+	}
+	return nil
+}
+
+// Contains checks if the time is inside the quiet hours.
+// Invalid quiet hours never contain a time.
+func (q *QuietHours) Contains(t time.Time) bool {
+	if q == nil {
+		return false
+	}
+	start, err := time.Parse(quietHoursLayout, q.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(quietHoursLayout, q.End)
+	if err != nil {
+		return false
+	}
+	location, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return false
+	}
+	local := t.In(location)
+	minutes := local.Hour()*60 + local.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+	if startMinutes < endMinutes {
+		return minutes >= startMinutes && minutes < endMinutes
+	}
+	return minutes >= startMinutes || minutes < endMinutes
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuietHours_Contains(t *testing.T) {
+	tests := []struct {
+		name       string
+		quietHours *QuietHours
+		time       time.Time
+		want       bool
+	}{
+		{
+			name:       "nil",
+			quietHours: nil,
+			time:       time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "same day, inside",
+			quietHours: &QuietHours{Start: "12:00", End: "14:00", TimeZone: "UTC"},
+			time:       time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "same day, end excluded",
+			quietHours: &QuietHours{Start: "12:00", End: "14:00", TimeZone: "UTC"},
+			time:       time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "over midnight, before midnight",
+			quietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+			time:       time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "over midnight, after midnight",
+			quietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+			time:       time.Date(2024, 1, 1, 6, 59, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "over midnight, outside",
+			quietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+			time:       time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+		{
+			name:       "time zone of user",
+			quietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "Europe/Zurich"},
+			time:       time.Date(2024, 1, 1, 21, 30, 0, 0, time.UTC),
+			want:       true,
+		},
+		{
+			name:       "invalid time zone",
+			quietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "Mars/Olympus"},
+			time:       time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quietHours.Contains(tt.time))
+		})
+	}
+}
+
+func TestNotificationPreferences_CodeChannel(t *testing.T) {
+	night := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	day := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	all := func(NotificationType) bool { return true }
+	emailOnly := func(channel NotificationType) bool { return channel == NotificationTypeEmail }
+	tests := []struct {
+		name        string
+		preferences *NotificationPreferences
+		requested   NotificationType
+		available   func(NotificationType) bool
+		now         time.Time
+		want        NotificationType
+	}{
+		{
+			name:        "no preferences",
+			preferences: nil,
+			requested:   NotificationTypeEmail,
+			available:   all,
+			now:         day,
+			want:        NotificationTypeEmail,
+		},
+		{
+			name:        "preferred channel",
+			preferences: &NotificationPreferences{CodeChannels: []NotificationType{NotificationTypeSms, NotificationTypeEmail}},
+			requested:   NotificationTypeEmail,
+			available:   all,
+			now:         day,
+			want:        NotificationTypeSms,
+		},
+		{
+			name:        "preferred channel not available",
+			preferences: &NotificationPreferences{CodeChannels: []NotificationType{NotificationTypeSms, NotificationTypeEmail}},
+			requested:   NotificationTypeSms,
+			available:   emailOnly,
+			now:         day,
+			want:        NotificationTypeEmail,
+		},
+		{
+			name: "sms during quiet hours",
+			preferences: &NotificationPreferences{
+				CodeChannels:  []NotificationType{NotificationTypeSms, NotificationTypeEmail},
+				SMSQuietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+			},
+			requested: NotificationTypeSms,
+			available: all,
+			now:       night,
+			want:      NotificationTypeEmail,
+		},
+		{
+			name: "sms during quiet hours, only channel",
+			preferences: &NotificationPreferences{
+				CodeChannels:  []NotificationType{NotificationTypeSms},
+				SMSQuietHours: &QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+			},
+			requested: NotificationTypeEmail,
+			available: all,
+			now:       night,
+			want:      NotificationTypeSms,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.preferences.CodeChannel(tt.requested, tt.available, tt.now))
+		})
+	}
+}
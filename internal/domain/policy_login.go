@@ -31,6 +31,7 @@ type LoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 }
 
 func ValidateDefaultRedirectURI(rawURL string) bool {
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// LoginRiskAction is the reaction on a detected [LoginRiskSignal].
+// The actions are ordered by their severity.
+type LoginRiskAction int32
+
+const (
+	LoginRiskActionNone LoginRiskAction = iota
+	LoginRiskActionNotify
+	LoginRiskActionRequireMFA
+	LoginRiskActionBlock
+
+	loginRiskActionCount
+)
+
+func (a LoginRiskAction) Valid() bool {
+	return a >= LoginRiskActionNone && a < loginRiskActionCount
+}
+
+type LoginRiskSignal int32
+
+const (
+	LoginRiskSignalUnspecified LoginRiskSignal = iota
+	// LoginRiskSignalNewDevice is raised if the fingerprint of the user agent was never used by the user before
+	LoginRiskSignalNewDevice
+	// LoginRiskSignalNewNetwork is raised if the ip range (/24 for IPv4, /48 for IPv6) was never used by the user before
+	LoginRiskSignalNewNetwork
+	// LoginRiskSignalImpossibleTravel is raised if the distance to the location of the previous login
+	// could not have been travelled in the elapsed time
+	LoginRiskSignalImpossibleTravel
+)
+
+type LoginRiskPolicy struct {
+	models.ObjectRoot
+
+	Default          bool
+	NewDevice        LoginRiskAction
+	NewNetwork       LoginRiskAction
+	ImpossibleTravel LoginRiskAction
+}
+
+func (p *LoginRiskPolicy) IsValid() bool {
+	return p.NewDevice.Valid() && p.NewNetwork.Valid() && p.ImpossibleTravel.Valid()
+}
+
+// Action returns the most severe action of the detected signals.
+func (p *LoginRiskPolicy) Action(signals []LoginRiskSignal) LoginRiskAction {
+	action := LoginRiskActionNone
+	for _, signal := range signals {
+		var signalAction LoginRiskAction
+		switch signal {
+		case LoginRiskSignalNewDevice:
+			signalAction = p.NewDevice
+		case LoginRiskSignalNewNetwork:
+			signalAction = p.NewNetwork
+		case LoginRiskSignalImpossibleTravel:
+			signalAction = p.ImpossibleTravel
+		case LoginRiskSignalUnspecified:
+			continue
+		}
+		if signalAction > action {
+			action = signalAction
+		}
+	}
+	return action
+}
+
+// HasActions returns false if none of the signals leads to an action, so the evaluation can be skipped.
+func (p *LoginRiskPolicy) HasActions() bool {
+	return p.NewDevice != LoginRiskActionNone || p.NewNetwork != LoginRiskActionNone || p.ImpossibleTravel != LoginRiskActionNone
+}
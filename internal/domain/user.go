@@ -44,6 +44,8 @@ const (
 	UserAuthMethodTypeOTPEmail
 	UserAuthMethodTypeOTP // generic OTP when parsing AMR from OIDC
 	UserAuthMethodTypePrivateKey
+	UserAuthMethodTypePush
+	UserAuthMethodTypeMagicLink
 	userAuthMethodTypeCount
 )
 
@@ -63,8 +65,12 @@ func HasMFA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeOTPEmail,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypeOTP,
-			UserAuthMethodTypePrivateKey:
+			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypePush:
 			factors++
+		case UserAuthMethodTypeMagicLink:
+			// the magic link only proves the access to the email address,
+			// which can also be used to reset any other factor, so it's not counted
 		case UserAuthMethodTypeUnspecified,
 			userAuthMethodTypeCount:
 			// ignore
@@ -83,13 +89,15 @@ func Has2FA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeTOTP,
 			UserAuthMethodTypeOTPSMS,
 			UserAuthMethodTypeOTPEmail,
-			UserAuthMethodTypeOTP:
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypePush:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			UserAuthMethodTypePassword,
 			UserAuthMethodTypePasswordless,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeMagicLink,
 			userAuthMethodTypeCount:
 			// ignore
 		}
@@ -0,0 +1,33 @@
+package eventstore
+
+import (
+	"time"
+)
+
+// ArchiveConfig configures the archival of events no write model needs anymore
+type ArchiveConfig struct {
+	// Enabled periodically moves the events of finished aggregates
+	// from eventstore.events2 to eventstore.events2_archive
+	Enabled bool
+	// Interval defines how often the archival runs
+	Interval time.Duration
+	// OlderThan defines the minimum age of the latest event of an aggregate before its events are archived
+	OlderThan time.Duration
+	// BulkLimit is the maximum amount of aggregates archived per transaction
+	BulkLimit uint16
+}
+
+var archivableAggregates = map[AggregateType][]EventType{}
+
+// RegisterArchivable registers an aggregate type whose events can be archived
+// as soon as the aggregate is not used anymore.
+// If finalEventTypes are defined, only aggregates whose latest event is one of them are archived (e.g. removed),
+// otherwise every aggregate of the type is archived as soon as its latest event is old enough.
+func RegisterArchivable(aggregateType AggregateType, finalEventTypes ...EventType) {
+	archivableAggregates[aggregateType] = append(archivableAggregates[aggregateType], finalEventTypes...)
+}
+
+// ArchivableAggregates returns the registered aggregate types and their final event types
+func ArchivableAggregates() map[AggregateType][]EventType {
+	return archivableAggregates
+}
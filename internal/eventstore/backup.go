@@ -0,0 +1,92 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InstanceBackup exports and imports the data of an instance stored in the eventstore,
+// which are the events (including the archived ones), the unique constraints and the personal data keys.
+type InstanceBackup interface {
+	// ExportInstance writes the data of the instance at a consistent point in time
+	// and returns the position of the latest exported event
+	ExportInstance(ctx context.Context, instanceID string, w InstanceExportWriter) (position float64, err error)
+	// ImportInstance replaces the data of the instance with the data of r in a single transaction.
+	// The global unique constraints in release are removed before the import.
+	// The events get new positions but keep their order, so projections and subscriptions pick them up.
+	ImportInstance(ctx context.Context, instanceID string, release []*BackupUniqueConstraint, r InstanceImportReader) error
+	// ImportUniqueConstraints adds the unique constraints to the instance, already existing constraints are ignored
+	ImportUniqueConstraints(ctx context.Context, instanceID string, constraints []*BackupUniqueConstraint) error
+}
+
+// InstanceExportWriter receives the data of an instance in the order of the methods
+type InstanceExportWriter interface {
+	WriteEvent(event *BackupEvent) error
+	WriteUniqueConstraint(constraint *BackupUniqueConstraint) error
+	WritePersonalDataKey(key *BackupPersonalDataKey) error
+}
+
+// InstanceImportReader provides the data of an instance in the order of the methods,
+// each method returns [io.EOF] as soon as all its data are read
+type InstanceImportReader interface {
+	NextEvent() (*BackupEvent, error)
+	NextUniqueConstraint() (*BackupUniqueConstraint, error)
+	NextPersonalDataKey() (*BackupPersonalDataKey, error)
+}
+
+// BackupEvent is a stored event without the instance it belongs to
+type BackupEvent struct {
+	AggregateType AggregateType   `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	EventType     EventType       `json:"eventType"`
+	Sequence      uint64          `json:"sequence"`
+	Revision      uint16          `json:"revision"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	Creator       string          `json:"creator"`
+	Owner         string          `json:"owner"`
+	Position      float64         `json:"position"`
+}
+
+// BackupUniqueConstraint is a stored unique constraint,
+// global constraints are unique across all instances
+type BackupUniqueConstraint struct {
+	UniqueType  string `json:"uniqueType"`
+	UniqueField string `json:"uniqueField"`
+	IsGlobal    bool   `json:"global,omitempty"`
+}
+
+// BackupPersonalDataKey is the encrypted key of a subject used to protect its personal data
+type BackupPersonalDataKey struct {
+	SubjectID    string    `json:"subjectId"`
+	KeyID        string    `json:"keyId"`
+	Key          []byte    `json:"key"`
+	CreationDate time.Time `json:"creationDate"`
+}
+
+// ExportInstance see [InstanceBackup]
+func (es *Eventstore) ExportInstance(ctx context.Context, instanceID string, w InstanceExportWriter) (float64, error) {
+	if es.backup == nil {
+		return 0, zerrors.ThrowUnimplemented(nil, "EVENT-Ohr3e", "Errors.Instance.Backup.NotSupported")
+	}
+	return es.backup.ExportInstance(ctx, instanceID, w)
+}
+
+// ImportInstance see [InstanceBackup]
+func (es *Eventstore) ImportInstance(ctx context.Context, instanceID string, release []*BackupUniqueConstraint, r InstanceImportReader) error {
+	if es.backup == nil {
+		return zerrors.ThrowUnimplemented(nil, "EVENT-ahJ3u", "Errors.Instance.Backup.NotSupported")
+	}
+	return es.backup.ImportInstance(ctx, instanceID, release, r)
+}
+
+// ImportUniqueConstraints see [InstanceBackup]
+func (es *Eventstore) ImportUniqueConstraints(ctx context.Context, instanceID string, constraints []*BackupUniqueConstraint) error {
+	if es.backup == nil {
+		return zerrors.ThrowUnimplemented(nil, "EVENT-Eeg6o", "Errors.Instance.Backup.NotSupported")
+	}
+	return es.backup.ImportUniqueConstraints(ctx, instanceID, constraints)
+}
@@ -8,7 +8,19 @@ type Config struct {
 	PushTimeout time.Duration
 	MaxRetries  uint32
 
+	Notification NotificationConfig
+	Archive      ArchiveConfig
+	Snapshot     SnapshotConfig
+
 	Pusher   Pusher
 	Querier  Querier
 	Searcher Searcher
+	// Notifier is optional and distributes the pushed events to the other nodes of the cluster
+	Notifier Notifier
+	// PersonalData is optional and encrypts the registered personal data of the events
+	PersonalData PersonalDataProtector
+	// Snapshots is optional and stores the snapshots of write models
+	Snapshots SnapshotStore
+	// Backup is optional and exports and imports the data of instances
+	Backup InstanceBackup
 }
@@ -24,6 +24,12 @@ type Eventstore struct {
 	pusher   Pusher
 	querier  Querier
 	searcher Searcher
+	notifier Notifier
+
+	personalData PersonalDataProtector
+	snapshot     SnapshotConfig
+	snapshots    SnapshotStore
+	backup       InstanceBackup
 
 	instances         []string
 	lastInstanceQuery time.Time
@@ -68,6 +74,12 @@ func NewEventstore(config *Config) *Eventstore {
 		pusher:   config.Pusher,
 		querier:  config.Querier,
 		searcher: config.Searcher,
+		notifier: config.Notifier,
+
+		personalData: config.PersonalData,
+		snapshot:     config.Snapshot,
+		snapshots:    config.Snapshots,
+		backup:       config.Backup,
 
 		instancesMu: sync.Mutex{},
 	}
@@ -90,10 +102,11 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 		ctx, cancel = context.WithTimeout(ctx, es.PushTimeout)
 		defer cancel()
 	}
-	var (
-		events []Event
-		err    error
-	)
+	cmds, err := es.protectPersonalData(ctx, cmds)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
 
 	// Retry when there is a collision of the sequence as part of the primary key.
 	// "duplicate key value violates unique constraint \"events2_pkey\" (SQLSTATE 23505)"
@@ -111,7 +124,7 @@ retry:
 		return nil, err
 	}
 
-	mappedEvents, err := es.mapEvents(events)
+	mappedEvents, err := es.mapEvents(ctx, events)
 	if err != nil {
 		return mappedEvents, err
 	}
@@ -153,7 +166,7 @@ func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilde
 	events := make([]Event, 0, searchQuery.GetLimit())
 	searchQuery.ensureInstanceID(ctx)
 	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
-		event, err := es.mapEvent(event)
+		event, err := es.mapEvent(ctx, event)
 		if err != nil {
 			return err
 		}
@@ -166,10 +179,10 @@ func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilde
 	return events, nil
 }
 
-func (es *Eventstore) mapEvents(events []Event) (mappedEvents []Event, err error) {
+func (es *Eventstore) mapEvents(ctx context.Context, events []Event) (mappedEvents []Event, err error) {
 	mappedEvents = make([]Event, len(events))
 	for i, event := range events {
-		mappedEvents[i], err = es.mapEventLocked(event)
+		mappedEvents[i], err = es.mapEventLocked(ctx, event)
 		if err != nil {
 			return nil, err
 		}
@@ -177,11 +190,15 @@ func (es *Eventstore) mapEvents(events []Event) (mappedEvents []Event, err error
 	return mappedEvents, nil
 }
 
-func (es *Eventstore) mapEvent(event Event) (Event, error) {
-	return es.mapEventLocked(event)
+func (es *Eventstore) mapEvent(ctx context.Context, event Event) (Event, error) {
+	return es.mapEventLocked(ctx, event)
 }
 
-func (es *Eventstore) mapEventLocked(event Event) (Event, error) {
+func (es *Eventstore) mapEventLocked(ctx context.Context, event Event) (Event, error) {
+	event, err := es.revealPersonalData(ctx, event)
+	if err != nil {
+		return nil, err
+	}
 	interceptors, ok := eventInterceptors[event.Type()]
 	if !ok || interceptors.eventMapper == nil {
 		return BaseEventFromRepo(event), nil
@@ -209,7 +226,7 @@ type reducer interface {
 func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
 	searchQuery.ensureInstanceID(ctx)
 	return es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
-		event, err := es.mapEvent(event)
+		event, err := es.mapEvent(ctx, event)
 		if err != nil {
 			return err
 		}
@@ -259,8 +276,12 @@ type QueryReducer interface {
 }
 
 // FilterToQueryReducer filters the events based on the search query of the query function,
-// appends all events to the reducer and calls it's reduce function
+// appends all events to the reducer and calls it's reduce function.
+// If snapshots are enabled and r implements [SnapshotReducer] only the events after the snapshot are reduced
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
+	if snapshotReducer, ok := r.(SnapshotReducer); ok && es.snapshotsEnabled() {
+		return es.filterToSnapshotReducer(ctx, snapshotReducer)
+	}
 	return es.FilterToReducer(ctx, r.Query(), r)
 }
 
@@ -63,6 +63,8 @@ type Handler struct {
 
 	triggerWithoutEvents Reduce
 	cacheInvalidations   []func(ctx context.Context, aggregates []*eventstore.Aggregate)
+
+	pause pauseState
 }
 
 var _ migration.Migration = (*Handler)(nil)
@@ -119,7 +121,7 @@ func (h *Handler) Execute(ctx context.Context, startedEvent eventstore.Event) er
 
 func (h *Handler) executeInstances(ctx context.Context, instances <-chan string, startedEvent eventstore.Event, wg *sync.WaitGroup) {
 	for instance := range instances {
-		h.triggerInstances(ctx, []string{instance}, WithMaxPosition(startedEvent.Position()))
+		h.triggerInstances(ctx, []string{instance}, WithMaxPosition(startedEvent.Position()), ignorePause())
 	}
 	wg.Done()
 }
@@ -248,20 +250,39 @@ func (h *Handler) schedule(ctx context.Context) {
 	}
 }
 
-func (h *Handler) triggerInstances(ctx context.Context, instances []string, triggerOpts ...TriggerOpt) {
+// triggerInstances triggers the instances one after another and retries failed triggers.
+// It stops and returns the error of ctx as soon as ctx is done.
+func (h *Handler) triggerInstances(ctx context.Context, instances []string, triggerOpts ...TriggerOpt) error {
 	for _, instance := range instances {
 		instanceCtx := authz.WithInstanceID(ctx, instance)
 
 		// simple implementation of do while
 		_, err := h.Trigger(instanceCtx, triggerOpts...)
 		h.log().WithField("instance", instance).OnError(err).Debug("trigger failed")
-		time.Sleep(h.retryFailedAfter)
+		if sleepErr := h.waitRetry(ctx); sleepErr != nil {
+			return sleepErr
+		}
 		// retry if trigger failed
 		for ; err != nil; _, err = h.Trigger(instanceCtx, triggerOpts...) {
-			time.Sleep(h.retryFailedAfter)
+			if sleepErr := h.waitRetry(ctx); sleepErr != nil {
+				return sleepErr
+			}
 			h.log().WithField("instance", instance).OnError(err).Debug("trigger failed")
 		}
 	}
+	return nil
+}
+
+// waitRetry waits for retryFailedAfter or until ctx is done
+func (h *Handler) waitRetry(ctx context.Context) error {
+	t := time.NewTimer(h.retryFailedAfter)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
 }
 
 func randomizeStart(min, maxSeconds float64) time.Duration {
@@ -381,6 +402,7 @@ func (h *Handler) existingInstances(ctx context.Context) ([]string, error) {
 type triggerConfig struct {
 	awaitRunning bool
 	maxPosition  float64
+	ignorePause  bool
 }
 
 type TriggerOpt func(conf *triggerConfig)
@@ -397,12 +419,23 @@ func WithMaxPosition(position float64) TriggerOpt {
 	}
 }
 
+// ignorePause processes the events even if the handler is paused (e.g. during setup)
+func ignorePause() TriggerOpt {
+	return func(conf *triggerConfig) {
+		conf.ignorePause = true
+	}
+}
+
 func (h *Handler) Trigger(ctx context.Context, opts ...TriggerOpt) (_ context.Context, err error) {
 	config := new(triggerConfig)
 	for _, opt := range opts {
 		opt(config)
 	}
 
+	if !config.ignorePause && h.isPaused(ctx) {
+		return call.ResetTimestamp(ctx), nil
+	}
+
 	cancel := h.lockInstance(ctx, config)
 	if cancel == nil {
 		return call.ResetTimestamp(ctx), nil
@@ -652,7 +685,9 @@ func (h *Handler) eventQuery(currentState *state) *eventstore.SearchQueryBuilder
 		Limit(uint64(h.bulkLimit)).
 		AllowTimeTravel().
 		OrderAsc().
-		InstanceID(currentState.instanceID)
+		InstanceID(currentState.instanceID).
+		// projections must be rebuildable from all events, including the archived ones
+		IncludeArchived()
 
 	if currentState.position > 0 {
 		// decrease position by 10 because builder.PositionAfter filters for position > and we need position >=
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+)
+
+func TestHandler_triggerInstances_contextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h := &Handler{
+		projection:       &projection{name: "projection"},
+		client:           &database.DB{DB: mock.NewSQLMock(t).DB},
+		retryFailedAfter: time.Hour,
+		now:              time.Now,
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- h.triggerInstances(ctx, []string{"instance1", "instance2"}, ignorePause())
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("triggerInstances() error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("triggerInstances() did not stop after the context was done")
+	}
+}
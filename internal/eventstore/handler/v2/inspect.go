@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed state_list.sql
+var listStatesStmt string
+
+// ProjectionState is the state of a projection for an instance
+type ProjectionState struct {
+	ProjectionName string
+	InstanceID     string
+	// Position of the latest event reduced by the projection
+	Position float64
+	// EventDate is the creation date of the latest event reduced by the projection
+	EventDate time.Time
+	// LastUpdated is the last time the projection processed events of the instance
+	LastUpdated time.Time
+	// Lag is the age of the oldest event the projection did not reduce yet,
+	// it is 0 if the projection is up to date
+	Lag    time.Duration
+	Paused bool
+}
+
+// States returns the current state of the projection for the instances,
+// if no instance is passed the states of all instances are returned.
+func (h *Handler) States(ctx context.Context, instanceIDs ...string) ([]*ProjectionState, error) {
+	paused, err := h.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateTypes, eventTypes := h.lagEventTypes()
+	stmt := listStatesStmt
+	args := []any{h.ProjectionName(), aggregateTypes, eventTypes}
+	if len(instanceIDs) > 0 {
+		stmt += " AND s.instance_id = ANY($4)"
+		args = append(args, database.TextArray[string](instanceIDs))
+	}
+
+	var states []*ProjectionState
+	err = h.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var (
+				state        = &ProjectionState{ProjectionName: h.ProjectionName(), Paused: paused}
+				position     sql.NullFloat64
+				eventDate    sql.NullTime
+				lastUpdated  sql.NullTime
+				pendingSince sql.NullTime
+			)
+			if err := rows.Scan(&state.InstanceID, &position, &eventDate, &lastUpdated, &pendingSince); err != nil {
+				return err
+			}
+			state.Position = position.Float64
+			state.EventDate = eventDate.Time
+			state.LastUpdated = lastUpdated.Time
+			if pendingSince.Valid {
+				state.Lag = h.now().Sub(pendingSince.Time)
+			}
+			states = append(states, state)
+		}
+		return nil
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Quae5", "Errors.Internal")
+	}
+	return states, nil
+}
+
+// lagEventTypes returns the aggregate and event types the projection reduces,
+// they are used to compute the lag in the same query as the states.
+// Projections triggered without events have no lag.
+func (h *Handler) lagEventTypes() (database.TextArray[string], database.TextArray[string]) {
+	aggregateTypes := make(database.TextArray[string], 0, len(h.eventTypes))
+	eventTypes := make(database.TextArray[string], 0, len(h.eventTypes))
+	if h.triggerWithoutEvents != nil {
+		return aggregateTypes, eventTypes
+	}
+	for aggregateType, types := range h.eventTypes {
+		aggregateTypes = append(aggregateTypes, string(aggregateType))
+		for _, eventType := range types {
+			eventTypes = append(eventTypes, string(eventType))
+		}
+	}
+	return aggregateTypes, eventTypes
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// pauseCheckInterval defines how long the paused state of a handler is cached,
+// nodes which did not pause the handler stop processing at the latest after the interval
+const pauseCheckInterval = 10 * time.Second
+
+var (
+	//go:embed pause_get.sql
+	pausedStmt string
+	//go:embed pause_set.sql
+	pauseStmt string
+	//go:embed pause_remove.sql
+	resumeStmt string
+)
+
+type pauseState struct {
+	mu        sync.Mutex
+	paused    bool
+	checkedAt time.Time
+}
+
+// Pause stops the processing of events on all nodes until [Handler.Resume] is called.
+// Queries still return the state of the projection at the time it was paused.
+func (h *Handler) Pause(ctx context.Context) error {
+	if _, err := h.client.ExecContext(ctx, pauseStmt, h.ProjectionName()); err != nil {
+		return zerrors.ThrowInternal(err, "V2-eiW5a", "Errors.Internal")
+	}
+	h.setPaused(true)
+	return nil
+}
+
+// Resume continues the processing of events of a paused handler
+func (h *Handler) Resume(ctx context.Context) error {
+	if _, err := h.client.ExecContext(ctx, resumeStmt, h.ProjectionName()); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ahth3", "Errors.Internal")
+	}
+	h.setPaused(false)
+	return nil
+}
+
+// IsPaused queries if the handler is paused
+func (h *Handler) IsPaused(ctx context.Context) (paused bool, err error) {
+	err = h.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&paused)
+	}, pausedStmt, h.ProjectionName())
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "V2-ooJ7i", "Errors.Internal")
+	}
+	return paused, nil
+}
+
+func (h *Handler) setPaused(paused bool) {
+	h.pause.mu.Lock()
+	defer h.pause.mu.Unlock()
+	h.pause.paused = paused
+	h.pause.checkedAt = h.now()
+}
+
+// isPaused returns the cached paused state of the handler.
+// If the state cannot be queried the previous state is kept.
+func (h *Handler) isPaused(ctx context.Context) bool {
+	h.pause.mu.Lock()
+	defer h.pause.mu.Unlock()
+	if h.now().Sub(h.pause.checkedAt) < pauseCheckInterval {
+		return h.pause.paused
+	}
+	paused, err := h.IsPaused(ctx)
+	if err != nil {
+		h.log().WithError(err).Debug("unable to check if projection is paused")
+		return h.pause.paused
+	}
+	h.pause.paused = paused
+	h.pause.checkedAt = h.now()
+	return paused
+}
@@ -0,0 +1,3 @@
+SELECT EXISTS (
+    SELECT 1 FROM projections.paused_projections WHERE projection_name = $1
+);
@@ -0,0 +1 @@
+DELETE FROM projections.paused_projections WHERE projection_name = $1;
@@ -0,0 +1,7 @@
+INSERT INTO projections.paused_projections (
+    projection_name
+    , paused_at
+) VALUES (
+    $1
+    , now()
+) ON CONFLICT (projection_name) DO NOTHING;
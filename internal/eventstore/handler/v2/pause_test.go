@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+)
+
+func TestHandler_isPaused(t *testing.T) {
+	now := time.Now()
+	type fields struct {
+		pause *pauseState
+		mock  *mock.SQLMock
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		want          bool
+		wantCheckedAt time.Time
+	}{
+		{
+			name: "cached",
+			fields: fields{
+				pause: &pauseState{paused: true, checkedAt: now.Add(-time.Second)},
+				mock:  mock.NewSQLMock(t),
+			},
+			want:          true,
+			wantCheckedAt: now.Add(-time.Second),
+		},
+		{
+			name: "cache expired",
+			fields: fields{
+				pause: &pauseState{paused: false, checkedAt: now.Add(-pauseCheckInterval)},
+				mock: mock.NewSQLMock(t,
+					mock.ExpectQuery(
+						pausedStmt,
+						mock.WithQueryArgs("projection"),
+						mock.WithQueryResult([]string{"exists"}, [][]driver.Value{{true}}),
+					),
+				),
+			},
+			want:          true,
+			wantCheckedAt: now,
+		},
+		{
+			name: "query fails",
+			fields: fields{
+				pause: &pauseState{paused: true, checkedAt: now.Add(-pauseCheckInterval)},
+				mock: mock.NewSQLMock(t,
+					mock.ExpectQuery(
+						pausedStmt,
+						mock.WithQueryArgs("projection"),
+						mock.WithQueryErr(errors.New("failed")),
+					),
+				),
+			},
+			want:          true,
+			wantCheckedAt: now.Add(-pauseCheckInterval),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				projection: &projection{name: "projection"},
+				client:     &database.DB{DB: tt.fields.mock.DB},
+				now:        func() time.Time { return now },
+			}
+			h.pause.paused = tt.fields.pause.paused
+			h.pause.checkedAt = tt.fields.pause.checkedAt
+
+			if got := h.isPaused(context.Background()); got != tt.want {
+				t.Errorf("isPaused() = %v, want %v", got, tt.want)
+			}
+			if !h.pause.checkedAt.Equal(tt.wantCheckedAt) {
+				t.Errorf("checkedAt = %v, want %v", h.pause.checkedAt, tt.wantCheckedAt)
+			}
+
+			tt.fields.mock.Assert(t)
+		})
+	}
+}
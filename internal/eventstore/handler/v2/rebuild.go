@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// shadowSchema contains the tables of projections which are currently rebuilt.
+// The tables keep the names of the projection, so the names of the indexes and constraints
+// do not change if they are moved to the schema of the projection.
+const shadowSchema = "projections_shadow"
+
+const (
+	rebuildLocksTable = "projections.locks"
+	rebuildLockPrefix = "rebuild_"
+	// rebuildLockInstanceID is used as instance of the lock, because a rebuild affects all instances
+	rebuildLockInstanceID = ""
+	// rebuildLockDuration is the duration of the lock, it is renewed as long as the rebuild is running
+	rebuildLockDuration = 30 * time.Second
+)
+
+const (
+	listTablesStmt = `SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = $1 AND (table_name = $2 OR table_name LIKE $3)`
+	lockStatesStmt = `SELECT instance_id FROM projections.current_states WHERE projection_name = $1 FOR UPDATE`
+
+	removeStatesStmt       = `DELETE FROM projections.current_states WHERE projection_name = $1`
+	renameStatesStmt       = `UPDATE projections.current_states SET projection_name = $1 WHERE projection_name = $2`
+	removeFailedEventsStmt = `DELETE FROM projections.failed_events2 WHERE projection_name = $1`
+	renameFailedEventsStmt = `UPDATE projections.failed_events2 SET projection_name = $1 WHERE projection_name = $2`
+)
+
+// shadowProjection builds the tables of a projection in the shadow schema
+type shadowProjection struct {
+	Projection
+	name string
+}
+
+// Name implements [Projection]
+func (p *shadowProjection) Name() string {
+	return p.name
+}
+
+// Init implements [initializer]
+func (p *shadowProjection) Init() *handler.Check {
+	check, ok := p.Projection.(initializer)
+	if !ok {
+		return new(handler.Check)
+	}
+	return check.Init()
+}
+
+// Rebuild reduces all events of the projection into new tables in the shadow schema
+// and replaces the tables of the projection with them in a single transaction.
+// The projection is served from the current tables until the tables are swapped.
+// Projections consisting of views cannot be rebuilt.
+// The rebuild is locked over all nodes, so a projection is only rebuilt once at a time.
+func (h *Handler) Rebuild(ctx context.Context) error {
+	ctx, unlock, err := h.lockRebuild(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return h.rebuild(ctx)
+}
+
+// StartRebuild locks the rebuild and executes it in the background, see [Handler.Rebuild].
+// Errors of the rebuild are only logged.
+func (h *Handler) StartRebuild(ctx context.Context) error {
+	ctx, unlock, err := h.lockRebuild(context.WithoutCancel(ctx))
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer unlock()
+		err := h.rebuild(ctx)
+		h.log().OnError(err).Error("rebuild of projection failed")
+	}()
+	return nil
+}
+
+// lockRebuild acquires the lock of the rebuild, which is renewed until unlock is called.
+// The returned context is canceled if the lock is lost.
+func (h *Handler) lockRebuild(ctx context.Context) (_ context.Context, unlock func(), err error) {
+	if h.triggerWithoutEvents != nil {
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "V2-ohd4A", "Errors.Projection.RebuildNotSupported")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	locker := crdb.NewLocker(h.client.DB, rebuildLocksTable, rebuildLockPrefix+h.ProjectionName())
+	errs := locker.Lock(ctx, rebuildLockDuration, rebuildLockInstanceID)
+	err, ok := <-errs
+	if !ok {
+		cancel()
+		return nil, nil, ctx.Err()
+	}
+	if err != nil {
+		cancel()
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil, nil, zerrors.ThrowPreconditionFailed(err, "V2-Vae1a", "Errors.Projection.RebuildRunning")
+		}
+		return nil, nil, err
+	}
+	go func() {
+		for err := range errs {
+			if zerrors.IsErrorAlreadyExists(err) {
+				h.log().Warn("lock of projection rebuild lost, rebuild is canceled")
+				cancel()
+				continue
+			}
+			h.log().OnError(err).Debug("unable to renew lock of projection rebuild")
+		}
+	}()
+	return ctx, func() {
+		cancel()
+		err := locker.Unlock(rebuildLockInstanceID)
+		h.log().OnError(err).Debug("unable to unlock projection rebuild")
+	}, nil
+}
+
+func (h *Handler) rebuild(ctx context.Context) (err error) {
+	schema, table, ok := strings.Cut(h.ProjectionName(), ".")
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "V2-Eim3o", "Errors.Projection.RebuildNotSupported")
+	}
+	if _, err = h.projectionTables(ctx, h.client.DB, schema, table); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	shadow := h.shadow(shadowSchema + "." + table)
+	if err = shadow.removeShadow(ctx, table); err != nil {
+		return err
+	}
+	if err = shadow.Init(ctx); err != nil {
+		return err
+	}
+	h.log().Info("rebuild of projection started")
+
+	instanceIDs, err := h.existingInstances(ctx)
+	if err != nil {
+		return err
+	}
+	// the projection is only swapped if all instances are rebuilt
+	if err = shadow.triggerInstances(ctx, instanceIDs, ignorePause()); err != nil {
+		return err
+	}
+
+	if err = h.swap(ctx, schema, table); err != nil {
+		return err
+	}
+	h.log().WithField("took", time.Since(start)).Info("projection rebuilt")
+	return nil
+}
+
+// shadow returns a handler with the same configuration which reduces the events into the shadow tables
+func (h *Handler) shadow(name string) *Handler {
+	return &Handler{
+		client:                h.client,
+		projection:            &shadowProjection{Projection: h.projection, name: name},
+		es:                    h.es,
+		bulkLimit:             h.bulkLimit,
+		eventTypes:            h.eventTypes,
+		maxFailureCount:       h.maxFailureCount,
+		retryFailedAfter:      h.retryFailedAfter,
+		requeueEvery:          h.requeueEvery,
+		handleActiveInstances: h.handleActiveInstances,
+		txDuration:            h.txDuration,
+		now:                   h.now,
+	}
+}
+
+// removeShadow removes the tables and states of a previous rebuild
+func (h *Handler) removeShadow(ctx context.Context, table string) (err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ubo4i", "Errors.Internal")
+	}
+	defer func() {
+		err = finishTx(tx, err)
+	}()
+	if _, err = tx.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+shadowSchema); err != nil {
+		return zerrors.ThrowInternal(err, "V2-aeC4e", "Errors.Internal")
+	}
+	tables, err := h.projectionTables(ctx, tx, shadowSchema, table)
+	if err != nil {
+		return err
+	}
+	if err = dropTables(ctx, tx, tables); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, removeStatesStmt, h.ProjectionName()); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Oow5e", "Errors.Internal")
+	}
+	if _, err = tx.ExecContext(ctx, removeFailedEventsStmt, h.ProjectionName()); err != nil {
+		return zerrors.ThrowInternal(err, "V2-cah8E", "Errors.Internal")
+	}
+	return nil
+}
+
+// swap replaces the tables and states of the projection with the ones of the shadow.
+// The states of the projection are locked so no events are processed during the swap.
+// Events pushed after the shadow was built are processed by the projection afterwards
+// because the states of the shadow are taken over.
+func (h *Handler) swap(ctx context.Context, schema, table string) (err error) {
+	shadowName := shadowSchema + "." + table
+
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-aiz5U", "Errors.Internal")
+	}
+	defer func() {
+		err = finishTx(tx, err)
+	}()
+
+	rows, err := tx.QueryContext(ctx, lockStatesStmt, h.ProjectionName())
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-uPh6o", "Errors.Internal")
+	}
+	if err = rows.Close(); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Oph2e", "Errors.Internal")
+	}
+
+	tables, err := h.projectionTables(ctx, tx, schema, table)
+	if err != nil {
+		return err
+	}
+	if err = dropTables(ctx, tx, tables); err != nil {
+		return err
+	}
+	shadowTables, err := h.projectionTables(ctx, tx, shadowSchema, table)
+	if err != nil {
+		return err
+	}
+	for _, shadowTable := range shadowTables {
+		if _, err = tx.ExecContext(ctx, "ALTER TABLE "+shadowTable+" SET SCHEMA "+schema); err != nil {
+			return zerrors.ThrowInternal(err, "V2-ieN3u", "Errors.Internal")
+		}
+	}
+
+	for _, stmt := range []string{removeStatesStmt, removeFailedEventsStmt} {
+		if _, err = tx.ExecContext(ctx, stmt, h.ProjectionName()); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Aegh9", "Errors.Internal")
+		}
+	}
+	for _, stmt := range []string{renameStatesStmt, renameFailedEventsStmt} {
+		if _, err = tx.ExecContext(ctx, stmt, h.ProjectionName(), shadowName); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Dai7o", "Errors.Internal")
+		}
+	}
+	return nil
+}
+
+type queryExecuter interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// projectionTables returns the qualified names of the table and the suffixed tables of the projection in the schema
+func (h *Handler) projectionTables(ctx context.Context, db queryExecuter, schema, table string) (tables []string, err error) {
+	rows, err := db.QueryContext(ctx, listTablesStmt, schema, table, escapeLike(table)+`\_%`)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Oa2ch", "Errors.Internal")
+	}
+	defer func() {
+		closeErr := rows.Close()
+		logging.OnError(closeErr).Debug("unable to close rows")
+	}()
+	for rows.Next() {
+		var name, tableType string
+		if err = rows.Scan(&name, &tableType); err != nil {
+			return nil, zerrors.ThrowInternal(err, "V2-Iez7a", "Errors.Internal")
+		}
+		if tableType == "VIEW" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "V2-jah0E", "Errors.Projection.RebuildNotSupported")
+		}
+		tables = append(tables, schema+"."+name)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Eeth9", "Errors.Internal")
+	}
+	return tables, nil
+}
+
+func dropTables(ctx context.Context, tx *sql.Tx, tables []string) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS "+strings.Join(tables, ", ")+" CASCADE"); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ceiw4", "Errors.Internal")
+	}
+	return nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func finishTx(tx *sql.Tx, err error) error {
+	if err != nil {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("unable to rollback")
+		return err
+	}
+	if commitErr := tx.Commit(); commitErr != nil {
+		return zerrors.ThrowInternal(commitErr, "V2-Gie5o", "Errors.Internal")
+	}
+	return nil
+}
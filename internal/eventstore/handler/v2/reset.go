@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	lockInstanceStateStmt          = `SELECT instance_id FROM projections.current_states WHERE projection_name = $1 AND instance_id = $2 FOR UPDATE`
+	removeInstanceStateStmt        = `DELETE FROM projections.current_states WHERE projection_name = $1 AND instance_id = $2`
+	removeInstanceFailedEventsStmt = `DELETE FROM projections.failed_events2 WHERE projection_name = $1 AND instance_id = $2`
+	latestInstanceEventStmt        = `SELECT "position", aggregate_type, aggregate_id, "sequence", created_at FROM eventstore.events2 WHERE instance_id = $1 ORDER BY "position" DESC, in_tx_order DESC LIMIT 1`
+)
+
+// ResetInstance removes the data of the instance from the projection
+// so the events of the instance are reduced again on the next trigger.
+// It is required if the events of the instance were replaced, e.g. on a restore.
+// The data are removed using the reducer of the instance removed event,
+// projections without such a reducer keep their data.
+func (h *Handler) ResetInstance(ctx context.Context, instanceID string) (err error) {
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ru6ae", "Errors.Internal")
+	}
+	defer func() {
+		err = finishTx(tx, err)
+	}()
+
+	rows, err := tx.QueryContext(ctx, lockInstanceStateStmt, h.ProjectionName(), instanceID)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-ooC8e", "Errors.Internal")
+	}
+	if err = rows.Close(); err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ohk2a", "Errors.Internal")
+	}
+
+	statement, err := h.reduce(instance.NewInstanceRemovedEvent(ctx, &instance.NewAggregate(instanceID).Aggregate, "", nil))
+	if err != nil {
+		return err
+	}
+	if statement.Execute != nil {
+		if err = statement.Execute(tx, h.projection.Name()); err != nil {
+			return zerrors.ThrowInternal(err, "V2-eeY0u", "Errors.Internal")
+		}
+	}
+
+	for _, stmt := range []string{removeInstanceStateStmt, removeInstanceFailedEventsStmt} {
+		if _, err = tx.ExecContext(ctx, stmt, h.ProjectionName(), instanceID); err != nil {
+			return zerrors.ThrowInternal(err, "V2-Sai2e", "Errors.Internal")
+		}
+	}
+	return nil
+}
+
+// SkipInstance sets the state of the instance to its latest event,
+// so the events stored until now are not processed by the handler.
+// It is used for handlers with side effects, like sending notifications, after the events of the instance were replaced.
+func (h *Handler) SkipInstance(ctx context.Context, instanceID string) (err error) {
+	latest := &state{instanceID: instanceID}
+	err = h.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&latest.position, &latest.aggregateType, &latest.aggregateID, &latest.sequence, &latest.eventTimestamp)
+	}, latestInstanceEventStmt, instanceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-ahD4e", "Errors.Internal")
+	}
+
+	// events with the same position are skipped using the offset
+	events, err := h.es.Filter(ctx, h.eventQuery(latest).Limit(0))
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if event.Position() == latest.position {
+			latest.offset++
+		}
+	}
+
+	tx, err := h.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V2-Ie2ch", "Errors.Internal")
+	}
+	defer func() {
+		err = finishTx(tx, err)
+	}()
+	return h.setState(tx, latest)
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestHandler_ResetInstance(t *testing.T) {
+	reducers := []AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []EventReducer{
+				{
+					Event: instance.InstanceRemovedEventType,
+					Reduce: func(event eventstore.Event) (*Statement, error) {
+						return NewDeleteStatement(event, []Condition{NewCond("instance_id", event.Aggregate().ID)}), nil
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		reducers []AggregateReducer
+		mock     *mock.SQLMock
+		wantErr  bool
+	}{
+		{
+			name:     "reset",
+			reducers: reducers,
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(lockInstanceStateStmt,
+					mock.WithQueryArgs("projection", "instance"),
+					mock.WithQueryResult([]string{"instance_id"}, nil),
+				),
+				mock.ExcpectExec("DELETE FROM projection WHERE (instance_id = $1)",
+					mock.WithExecArgs("instance"),
+					mock.WithExecRowsAffected(2),
+				),
+				mock.ExcpectExec(removeInstanceStateStmt,
+					mock.WithExecArgs("projection", "instance"),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExcpectExec(removeInstanceFailedEventsStmt,
+					mock.WithExecArgs("projection", "instance"),
+					mock.WithExecNoRowsAffected(),
+				),
+				mock.ExpectCommit(nil),
+			),
+		},
+		{
+			name: "no reducer",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(lockInstanceStateStmt,
+					mock.WithQueryArgs("projection", "instance"),
+					mock.WithQueryResult([]string{"instance_id"}, nil),
+				),
+				mock.ExcpectExec(removeInstanceStateStmt,
+					mock.WithExecArgs("projection", "instance"),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExcpectExec(removeInstanceFailedEventsStmt,
+					mock.WithExecArgs("projection", "instance"),
+					mock.WithExecNoRowsAffected(),
+				),
+				mock.ExpectCommit(nil),
+			),
+		},
+		{
+			name:     "reduce fails",
+			reducers: reducers,
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(lockInstanceStateStmt,
+					mock.WithQueryArgs("projection", "instance"),
+					mock.WithQueryResult([]string{"instance_id"}, nil),
+				),
+				mock.ExcpectExec("DELETE FROM projection WHERE (instance_id = $1)",
+					mock.WithExecArgs("instance"),
+					mock.WithExecErr(errors.New("failed")),
+				),
+				mock.ExpectRollback(nil),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				projection: &projection{name: "projection", reducers: tt.reducers},
+				client:     &database.DB{DB: tt.mock.DB},
+			}
+			err := h.ResetInstance(context.Background(), "instance")
+			tt.mock.Assert(t)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type filterEventStore struct {
+	EventStore
+	events []eventstore.Event
+}
+
+func (es *filterEventStore) Filter(context.Context, *eventstore.SearchQueryBuilder) ([]eventstore.Event, error) {
+	return es.events, nil
+}
+
+func TestHandler_SkipInstance(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		events  []eventstore.Event
+		mock    *mock.SQLMock
+		wantErr bool
+	}{
+		{
+			name: "no events",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(latestInstanceEventStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult([]string{"position", "aggregate_type", "aggregate_id", "sequence", "created_at"}, nil),
+				),
+			),
+		},
+		{
+			name: "skipped",
+			events: []eventstore.Event{
+				&eventstore.BaseEvent{Pos: 41},
+				&eventstore.BaseEvent{Pos: 42},
+				&eventstore.BaseEvent{Pos: 42},
+			},
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(latestInstanceEventStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult([]string{"position", "aggregate_type", "aggregate_id", "sequence", "created_at"}, [][]driver.Value{
+						{float64(42), "user", "user1", uint64(3), now},
+					}),
+				),
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(updateStateStmt,
+					mock.WithExecArgs("projection", "instance", "user1", eventstore.AggregateType("user"), uint64(3), now, float64(42), uint32(2)),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExpectCommit(nil),
+			),
+		},
+		{
+			name: "query fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(latestInstanceEventStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryErr(errors.New("failed")),
+				),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{
+				projection: &projection{name: "projection"},
+				client:     &database.DB{DB: tt.mock.DB},
+				es:         &filterEventStore{events: tt.events},
+			}
+			err := h.SkipInstance(context.Background(), "instance")
+			tt.mock.Assert(t)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
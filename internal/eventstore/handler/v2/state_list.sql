@@ -0,0 +1,24 @@
+SELECT
+    s.instance_id
+    , s."position"
+    , s.event_date
+    , s.last_updated
+    , (
+        -- the oldest event not reduced yet, its age is the lag of the projection
+        SELECT
+            e.created_at
+        FROM
+            eventstore.events2 e
+        WHERE
+            e.instance_id = s.instance_id
+            AND e.aggregate_type = ANY($2)
+            AND e.event_type = ANY($3)
+            AND e."position" > s."position"
+        ORDER BY
+            e."position"
+        LIMIT 1
+    ) AS pending_since
+FROM
+    projections.current_states s
+WHERE
+    s.projection_name = $1
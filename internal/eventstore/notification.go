@@ -0,0 +1,34 @@
+package eventstore
+
+import (
+	"context"
+	"time"
+)
+
+// NotificationConfig configures the distribution of pushed events to the other nodes of the cluster
+type NotificationConfig struct {
+	// Enabled sends a notification for each push to all nodes,
+	// which wakes up the subscriptions (e.g. projections) immediately instead of waiting for the next requeue.
+	Enabled bool
+	// PollInterval defines how often new events are queried
+	// if the database does not support LISTEN / NOTIFY (e.g. CockroachDB)
+	// or the listening connection was lost.
+	PollInterval time.Duration
+}
+
+// Notifier distributes the events pushed on one node to all nodes of the cluster
+type Notifier interface {
+	// Listen blocks until ctx is done.
+	// notify is called with the events pushed by other nodes.
+	// The events only contain the aggregate, the event type and the position but no payload.
+	Listen(ctx context.Context, notify func(events []Event)) error
+}
+
+// Listen passes the events pushed by other nodes to the subscriptions of this node.
+// It blocks until ctx is done and returns immediately if no [Notifier] is configured.
+func (es *Eventstore) Listen(ctx context.Context) error {
+	if es.notifier == nil {
+		return nil
+	}
+	return es.notifier.Listen(ctx, es.notify)
+}
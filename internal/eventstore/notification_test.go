@@ -0,0 +1,48 @@
+package eventstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testNotifier struct {
+	events []Event
+}
+
+func (n *testNotifier) Listen(_ context.Context, notify func(events []Event)) error {
+	notify(n.events)
+	return nil
+}
+
+func TestEventstore_Listen(t *testing.T) {
+	remote := &BaseEvent{
+		Agg:       &Aggregate{InstanceID: "instance", Type: "test.aggregate.notified"},
+		EventType: "test.event.notified",
+		Pos:       42,
+	}
+	queue := make(chan Event, 1)
+	SubscribeEventTypes(queue, map[AggregateType][]EventType{
+		"test.aggregate.notified": {"test.event.notified"},
+	})
+
+	es := NewEventstore(&Config{
+		Notifier: &testNotifier{events: []Event{remote}},
+	})
+	require.NoError(t, es.Listen(context.Background()))
+
+	select {
+	case event := <-queue:
+		assert.Equal(t, remote, event)
+	case <-time.After(time.Second):
+		t.Fatal("event of other node not received")
+	}
+}
+
+func TestEventstore_Listen_withoutNotifier(t *testing.T) {
+	es := NewEventstore(&Config{})
+	assert.NoError(t, es.Listen(context.Background()))
+}
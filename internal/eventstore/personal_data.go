@@ -0,0 +1,222 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// personalDataPrefix marks an encrypted value in the payload of an event
+const personalDataPrefix = "pd:v1:"
+
+var (
+	personalDataFields   = map[EventType][]string{}
+	personalDataErasures = map[EventType]PersonalDataErasure{}
+)
+
+// PersonalDataErasure defines the subjects whose personal data is erased by an event
+type PersonalDataErasure uint8
+
+const (
+	PersonalDataErasureNone PersonalDataErasure = iota
+	// PersonalDataErasureAggregate erases the personal data of the aggregate of the event
+	PersonalDataErasureAggregate
+	// PersonalDataErasureOwner erases the personal data of all aggregates owned by the aggregate of the event (e.g. the users of an organization)
+	PersonalDataErasureOwner
+	// PersonalDataErasureInstance erases the personal data of all aggregates of the instance
+	PersonalDataErasureInstance
+)
+
+// PersonalDataProtector encrypts the personal data of event payloads with a key per subject.
+// The subject of an event is its aggregate.
+// As soon as the key of a subject is destroyed the personal data cannot be decrypted anymore (crypto-shredding).
+type PersonalDataProtector interface {
+	// Encrypt encrypts the value with the key of the subject, the key is created if it does not exist
+	Encrypt(ctx context.Context, instanceID, subjectID string, value []byte) ([]byte, error)
+	// Decrypt decrypts the value with the key of the subject.
+	// If the key was destroyed a [zerrors.NotFoundError] is returned
+	Decrypt(ctx context.Context, instanceID, subjectID string, value []byte) ([]byte, error)
+	// Destroy irreversibly removes the keys of the subjects
+	Destroy(ctx context.Context, instanceID string, subjectIDs ...string) error
+}
+
+// RegisterPersonalData registers the top level fields of the payload of the event type containing personal data.
+// The values of the fields are encrypted before the event is pushed.
+func RegisterPersonalData(eventType EventType, fields ...string) {
+	personalDataFields[eventType] = append(personalDataFields[eventType], fields...)
+}
+
+// RegisterPersonalDataErasure registers an event type which erases the personal data of its aggregate.
+// The key of the aggregate is destroyed as soon as the event is pushed.
+func RegisterPersonalDataErasure(eventType EventType) {
+	personalDataErasures[eventType] = PersonalDataErasureAggregate
+}
+
+// RegisterPersonalDataOwnerErasure registers an event type which erases the personal data of all aggregates
+// owned by its aggregate. The keys of the aggregates are destroyed as soon as the event is pushed.
+func RegisterPersonalDataOwnerErasure(eventType EventType) {
+	personalDataErasures[eventType] = PersonalDataErasureOwner
+}
+
+// RegisterPersonalDataInstanceErasure registers an event type which erases the personal data of all aggregates
+// of its instance. The keys of the instance are destroyed as soon as the event is pushed.
+func RegisterPersonalDataInstanceErasure(eventType EventType) {
+	personalDataErasures[eventType] = PersonalDataErasureInstance
+}
+
+// PersonalDataErasureOf returns the subjects whose personal data is erased by the event type
+func PersonalDataErasureOf(eventType EventType) PersonalDataErasure {
+	return personalDataErasures[eventType]
+}
+
+type personalDataCommand struct {
+	Command
+	payload []byte
+}
+
+// Payload implements [Command]
+func (c *personalDataCommand) Payload() any {
+	return json.RawMessage(c.payload)
+}
+
+type personalDataEvent struct {
+	Event
+	payload []byte
+}
+
+// Unmarshal implements [Event]
+func (e *personalDataEvent) Unmarshal(ptr any) error {
+	return json.Unmarshal(e.payload, ptr)
+}
+
+// DataAsBytes implements [Event]
+func (e *personalDataEvent) DataAsBytes() []byte {
+	return e.payload
+}
+
+// protectPersonalData encrypts the registered fields of the commands
+func (es *Eventstore) protectPersonalData(ctx context.Context, cmds []Command) ([]Command, error) {
+	if es.personalData == nil {
+		return cmds, nil
+	}
+	protected := make([]Command, len(cmds))
+	for i, cmd := range cmds {
+		protected[i] = cmd
+		fields, ok := personalDataFields[cmd.Type()]
+		if !ok {
+			continue
+		}
+		if cmd.Payload() == nil {
+			continue
+		}
+		payload, err := json.Marshal(cmd.Payload())
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EVENT-eiC4a", "Errors.Internal")
+		}
+		payload, err = es.encryptPersonalData(ctx, cmd.Aggregate(), fields, payload)
+		if err != nil {
+			return nil, err
+		}
+		protected[i] = &personalDataCommand{Command: cmd, payload: payload}
+	}
+	return protected, nil
+}
+
+func (es *Eventstore) encryptPersonalData(ctx context.Context, aggregate *Aggregate, fields []string, payload []byte) ([]byte, error) {
+	data := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EVENT-Ha9ie", "Errors.Internal")
+	}
+	for _, field := range fields {
+		value, ok := data[field]
+		if !ok || string(value) == "null" {
+			continue
+		}
+		encrypted, err := es.personalData.Encrypt(ctx, aggregate.InstanceID, aggregate.ID, value)
+		if err != nil {
+			return nil, err
+		}
+		data[field], err = json.Marshal(personalDataPrefix + base64.RawStdEncoding.EncodeToString(encrypted))
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EVENT-ooP0e", "Errors.Internal")
+		}
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EVENT-aiL7u", "Errors.Internal")
+	}
+	return payload, nil
+}
+
+// revealPersonalData decrypts the registered fields of the event.
+// Fields which cannot be decrypted anymore because the key of the subject was destroyed are removed from the payload,
+// values stored before the protection was enabled are returned as is.
+func (es *Eventstore) revealPersonalData(ctx context.Context, event Event) (Event, error) {
+	fields, ok := personalDataFields[event.Type()]
+	if !ok {
+		return event, nil
+	}
+	payload := event.DataAsBytes()
+	data := make(map[string]json.RawMessage)
+	if len(payload) == 0 || json.Unmarshal(payload, &data) != nil {
+		return event, nil
+	}
+	var revealed bool
+	for _, field := range fields {
+		encrypted, ok := personalDataValue(data[field])
+		if !ok {
+			continue
+		}
+		revealed = true
+		value, err := es.decryptPersonalData(ctx, event.Aggregate(), encrypted)
+		if zerrors.IsNotFound(err) {
+			delete(data, field)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		data[field] = value
+	}
+	if !revealed {
+		return event, nil
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EVENT-xu5Ie", "Errors.Internal")
+	}
+	return &personalDataEvent{Event: event, payload: payload}, nil
+}
+
+func (es *Eventstore) decryptPersonalData(ctx context.Context, aggregate *Aggregate, encrypted string) ([]byte, error) {
+	if es.personalData == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-Iequ4", "Errors.Internal")
+	}
+	value, err := base64.RawStdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EVENT-Oox3h", "Errors.Internal")
+	}
+	value, err = es.personalData.Decrypt(ctx, aggregate.InstanceID, aggregate.ID, value)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(value) {
+		return nil, zerrors.ThrowInternal(nil, "EVENT-ieH6a", "Errors.Internal")
+	}
+	return value, nil
+}
+
+// personalDataValue returns the encrypted value without prefix if the raw value is encrypted
+func personalDataValue(raw json.RawMessage) (string, bool) {
+	if len(raw) == 0 || raw[0] != '"' {
+		return "", false
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", false
+	}
+	return strings.CutPrefix(value, personalDataPrefix)
+}
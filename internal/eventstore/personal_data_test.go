@@ -0,0 +1,182 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const testPersonalDataType EventType = "test.personal.data"
+
+func init() {
+	RegisterPersonalData(testPersonalDataType, "name", "address")
+}
+
+// testProtector reverses the values and forgets the destroyed subjects
+type testProtector struct {
+	destroyed map[string]bool
+}
+
+func (p *testProtector) Encrypt(_ context.Context, _, _ string, value []byte) ([]byte, error) {
+	return reverse(value), nil
+}
+
+func (p *testProtector) Decrypt(_ context.Context, _, subjectID string, value []byte) ([]byte, error) {
+	if p.destroyed[subjectID] {
+		return nil, zerrors.ThrowNotFound(nil, "TEST-Gah5u", "key destroyed")
+	}
+	return reverse(value), nil
+}
+
+func (p *testProtector) Destroy(_ context.Context, _ string, subjectIDs ...string) error {
+	for _, subjectID := range subjectIDs {
+		p.destroyed[subjectID] = true
+	}
+	return nil
+}
+
+func reverse(value []byte) []byte {
+	reversed := make([]byte, len(value))
+	for i, b := range value {
+		reversed[len(value)-1-i] = b
+	}
+	return reversed
+}
+
+type testPersonalDataPayload struct {
+	Name    string            `json:"name,omitempty"`
+	Address map[string]string `json:"address,omitempty"`
+	Other   string            `json:"other,omitempty"`
+}
+
+func TestEventstore_personalData(t *testing.T) {
+	ctx := context.Background()
+	protector := &testProtector{destroyed: map[string]bool{}}
+	es := &Eventstore{personalData: protector}
+	payload := &testPersonalDataPayload{
+		Name:    "Gigi Giraffe",
+		Address: map[string]string{"street": "Savanna 1"},
+		Other:   "not personal",
+	}
+	cmd := newTestEvent("user1", "", func() interface{} { return payload }, false)
+	cmd.BaseEvent.EventType = testPersonalDataType
+
+	protected, err := es.protectPersonalData(ctx, []Command{cmd})
+	require.NoError(t, err)
+	data, err := json.Marshal(protected[0].Payload())
+	require.NoError(t, err)
+	stored := make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &stored))
+	assert.Contains(t, stored["name"], personalDataPrefix)
+	assert.Contains(t, stored["address"], personalDataPrefix)
+	assert.Equal(t, "not personal", stored["other"])
+
+	event := &BaseEvent{EventType: testPersonalDataType, Agg: cmd.Aggregate(), Data: data}
+
+	revealed, err := es.revealPersonalData(ctx, event)
+	require.NoError(t, err)
+	got := new(testPersonalDataPayload)
+	require.NoError(t, revealed.Unmarshal(got))
+	assert.Equal(t, payload, got)
+
+	require.NoError(t, protector.Destroy(ctx, "", cmd.Aggregate().ID))
+	shredded, err := es.revealPersonalData(ctx, event)
+	require.NoError(t, err)
+	got = new(testPersonalDataPayload)
+	require.NoError(t, shredded.Unmarshal(got))
+	assert.Equal(t, &testPersonalDataPayload{Other: "not personal"}, got)
+	assert.JSONEq(t, `{"other": "not personal"}`, string(shredded.DataAsBytes()))
+}
+
+func TestEventstore_revealPersonalData(t *testing.T) {
+	type fields struct {
+		personalData PersonalDataProtector
+	}
+	type args struct {
+		event Event
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name: "not registered",
+			fields: fields{
+				personalData: &testProtector{},
+			},
+			args: args{
+				event: &BaseEvent{EventType: "test.other", Agg: &Aggregate{ID: "user1"}, Data: []byte(`{"name":"pd:v1:eman"}`)},
+			},
+			want: `{"name":"pd:v1:eman"}`,
+		},
+		{
+			name: "plaintext",
+			fields: fields{
+				personalData: &testProtector{},
+			},
+			args: args{
+				event: &BaseEvent{EventType: testPersonalDataType, Agg: &Aggregate{ID: "user1"}, Data: []byte(`{"name":"Gigi"}`)},
+			},
+			want: `{"name":"Gigi"}`,
+		},
+		{
+			name: "no payload",
+			fields: fields{
+				personalData: &testProtector{},
+			},
+			args: args{
+				event: &BaseEvent{EventType: testPersonalDataType, Agg: &Aggregate{ID: "user1"}},
+			},
+			want: ``,
+		},
+		{
+			name: "encrypted without protector",
+			args: args{
+				event: &BaseEvent{EventType: testPersonalDataType, Agg: &Aggregate{ID: "user1"}, Data: []byte(`{"name":"pd:v1:ImlnaUci"}`)},
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "encrypted",
+			fields: fields{
+				personalData: &testProtector{},
+			},
+			args: args{
+				event: &BaseEvent{EventType: testPersonalDataType, Agg: &Aggregate{ID: "user1"}, Data: []byte(`{"name":"pd:v1:ImlnaUci"}`)},
+			},
+			want: `{"name":"Gigi"}`,
+		},
+		{
+			name: "shredded",
+			fields: fields{
+				personalData: &testProtector{destroyed: map[string]bool{"user1": true}},
+			},
+			args: args{
+				event: &BaseEvent{EventType: testPersonalDataType, Agg: &Aggregate{ID: "user1"}, Data: []byte(`{"name":"pd:v1:ImlnaUci"}`)},
+			},
+			want: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				personalData: tt.fields.personalData,
+			}
+			got, err := es.revealPersonalData(context.Background(), tt.args.event)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got.DataAsBytes()))
+		})
+	}
+}
@@ -16,6 +16,7 @@ type SearchQuery struct {
 	Tx                    *sql.Tx
 	AllowTimeTravel       bool
 	AwaitOpenTransactions bool
+	IncludeArchived       bool
 	Limit                 uint64
 	Offset                uint32
 	Desc                  bool
@@ -128,6 +129,7 @@ func QueryFromBuilder(builder *eventstore.SearchQueryBuilder) (*SearchQuery, err
 		Tx:                    builder.GetTx(),
 		AllowTimeTravel:       builder.GetAllowTimeTravel(),
 		AwaitOpenTransactions: builder.GetAwaitOpenTransactions(),
+		IncludeArchived:       builder.GetIncludeArchived(),
 		SubQueries:            make([][]*Filter, len(builder.GetQueries())),
 	}
 
@@ -105,6 +105,12 @@ const (
 					WHERE instance_id = $1`
 )
 
+const (
+	eventsTable = "eventstore.events2"
+	// eventsWithArchiveView combines the events and the archived events
+	eventsWithArchiveView = "eventstore.events2_with_archive"
+)
+
 // awaitOpenTransactions ensures event ordering, so we don't events younger that open transactions
 var (
 	awaitOpenTransactionsV1 string
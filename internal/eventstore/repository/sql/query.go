@@ -61,6 +61,9 @@ func query(ctx context.Context, criteria querier, searchQuery *eventstore.Search
 	}
 
 	query, rowScanner := prepareColumns(criteria, q.Columns, useV1)
+	if q.IncludeArchived && !useV1 {
+		query = strings.Replace(query, " FROM "+eventsTable, " FROM "+eventsWithArchiveView, 1)
+	}
 	where, values := prepareConditions(criteria, q, useV1)
 	if where == "" || query == "" {
 		return zerrors.ThrowInvalidArgument(nil, "SQL-rWeBw", "invalid query factory")
@@ -28,6 +28,7 @@ type SearchQueryBuilder struct {
 	creationDateAfter     time.Time
 	creationDateBefore    time.Time
 	eventSequenceGreater  uint64
+	includeArchived       bool
 }
 
 func (b *SearchQueryBuilder) GetColumns() Columns {
@@ -82,6 +83,10 @@ func (b SearchQueryBuilder) GetAwaitOpenTransactions() bool {
 	return b.awaitOpenTransactions
 }
 
+func (b SearchQueryBuilder) GetIncludeArchived() bool {
+	return b.includeArchived
+}
+
 func (q SearchQueryBuilder) GetEventSequenceGreater() uint64 {
 	return q.eventSequenceGreater
 }
@@ -283,6 +288,12 @@ func (builder *SearchQueryBuilder) AwaitOpenTransactions() *SearchQueryBuilder {
 	return builder
 }
 
+// IncludeArchived also searches the archived events (see [RegisterArchivable])
+func (builder *SearchQueryBuilder) IncludeArchived() *SearchQueryBuilder {
+	builder.includeArchived = true
+	return builder
+}
+
 // SequenceGreater filters for events with sequence greater the requested sequence
 func (builder *SearchQueryBuilder) SequenceGreater(sequence uint64) *SearchQueryBuilder {
 	builder.eventSequenceGreater = sequence
@@ -0,0 +1,233 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// defaultSnapshotMargin is used if no push timeout is configured.
+// Events created within the margin are not part of a snapshot
+// because transactions with a lower position could still be open.
+const defaultSnapshotMargin = 15 * time.Second
+
+// SnapshotConfig configures the snapshots of write models
+type SnapshotConfig struct {
+	// Enabled stores the reduced state of write models implementing [SnapshotReducer]
+	// and only reduces the events after the snapshot on subsequent filters
+	Enabled bool
+	// MinEvents is the minimum amount of reduced events before a snapshot is stored
+	MinEvents uint32
+}
+
+// SnapshotReducer is a [QueryReducer] whose state can be stored as snapshot.
+// The state is the json representation of the reducer,
+// the fields of the embedded [WriteModel] are stored separately.
+type SnapshotReducer interface {
+	QueryReducer
+	// SnapshotKey identifies the snapshot of the reducer inside the instance
+	SnapshotKey() string
+	// SnapshotVersion must be increased as soon as the reduce logic or the state changes,
+	// snapshots of other versions are ignored
+	SnapshotVersion() uint16
+	// GetWriteModel returns the embedded [WriteModel]
+	GetWriteModel() *WriteModel
+}
+
+// Snapshot is the state of a reducer at a position
+type Snapshot struct {
+	InstanceID string
+	Key        string
+	Version    uint16
+	Position   float64
+	Payload    []byte
+}
+
+// SnapshotStore persists the snapshots
+type SnapshotStore interface {
+	// LoadSnapshot returns the snapshot of the key and version, nil is returned if it does not exist
+	LoadSnapshot(ctx context.Context, instanceID, key string, version uint16) (*Snapshot, error)
+	// SaveSnapshot stores the snapshot if it is newer than the stored one
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
+
+type snapshotPayload struct {
+	WriteModel snapshotWriteModel `json:"writeModel"`
+	State      json.RawMessage    `json:"state"`
+}
+
+type snapshotWriteModel struct {
+	AggregateID       string    `json:"aggregateId,omitempty"`
+	ResourceOwner     string    `json:"resourceOwner,omitempty"`
+	InstanceID        string    `json:"instanceId,omitempty"`
+	ProcessedSequence uint64    `json:"processedSequence,omitempty"`
+	ChangeDate        time.Time `json:"changeDate,omitempty"`
+}
+
+func (es *Eventstore) snapshotsEnabled() bool {
+	return es.snapshot.Enabled && es.snapshots != nil
+}
+
+// canSnapshot returns if the query reduces all events of the reducer in ascending order
+func canSnapshot(query *SearchQueryBuilder) bool {
+	if query.GetPositionAfter() != 0 ||
+		query.GetDesc() ||
+		query.GetLimit() > 0 ||
+		query.GetOffset() > 0 ||
+		query.GetTx() != nil ||
+		query.GetEventSequenceGreater() > 0 ||
+		!query.GetCreationDateAfter().IsZero() ||
+		!query.GetCreationDateBefore().IsZero() ||
+		query.GetInstanceID() == nil {
+		return false
+	}
+	for _, q := range query.GetQueries() {
+		if q.GetPositionAfter() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// filterToSnapshotReducer restores the reducer from its snapshot and only reduces the events after it.
+// A new snapshot is stored if enough events were reduced.
+func (es *Eventstore) filterToSnapshotReducer(ctx context.Context, r SnapshotReducer) error {
+	query := r.Query()
+	query.ensureInstanceID(ctx)
+	if !canSnapshot(query) {
+		return es.FilterToReducer(ctx, query, r)
+	}
+	instanceID := *query.GetInstanceID()
+
+	if position := es.restoreSnapshot(ctx, instanceID, r); position > 0 {
+		query.PositionAfter(position)
+	}
+
+	var (
+		reduced          uint32
+		previousPosition float64
+		captured         *Snapshot
+		margin           = time.Now().Add(-es.snapshotMargin())
+	)
+	err := es.querier.FilterToReducer(ctx, query, func(event Event) error {
+		event, err := es.mapEvent(ctx, event)
+		if err != nil {
+			return err
+		}
+		// events of the same transaction must be part of the same snapshot
+		if captured == nil && reduced >= es.snapshot.MinEvents && event.CreatedAt().After(margin) && event.Position() != previousPosition {
+			captured = takeSnapshot(instanceID, r, previousPosition)
+		}
+		r.AppendEvents(event)
+		if err = r.Reduce(); err != nil {
+			return err
+		}
+		reduced++
+		previousPosition = event.Position()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if captured == nil && reduced >= es.snapshot.MinEvents && reduced > 0 && previousPosition > 0 {
+		captured = takeSnapshot(instanceID, r, previousPosition)
+	}
+	if captured != nil {
+		err = es.snapshots.SaveSnapshot(ctx, captured)
+		logging.WithFields("key", captured.Key).OnError(err).Warn("unable to save snapshot")
+	}
+	return nil
+}
+
+func (es *Eventstore) snapshotMargin() time.Duration {
+	if es.PushTimeout > 0 {
+		return es.PushTimeout
+	}
+	return defaultSnapshotMargin
+}
+
+// restoreSnapshot sets the state of the reducer to the stored snapshot and returns its position.
+// If no valid snapshot exists the reducer is untouched and 0 is returned.
+func (es *Eventstore) restoreSnapshot(ctx context.Context, instanceID string, r SnapshotReducer) float64 {
+	snapshot, err := es.snapshots.LoadSnapshot(ctx, instanceID, r.SnapshotKey(), r.SnapshotVersion())
+	if err != nil {
+		logging.WithFields("key", r.SnapshotKey()).WithError(err).Warn("unable to load snapshot")
+		return 0
+	}
+	if snapshot == nil || snapshot.Position <= 0 {
+		return 0
+	}
+	if err = restoreReducer(snapshot.Payload, r); err != nil {
+		logging.WithFields("key", r.SnapshotKey()).WithError(err).Warn("invalid snapshot ignored")
+		return 0
+	}
+	return snapshot.Position
+}
+
+func restoreReducer(data []byte, r SnapshotReducer) error {
+	payload := new(snapshotPayload)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return zerrors.ThrowInternal(err, "EVENT-Lai6o", "Errors.Internal")
+	}
+	// the state is validated on a copy so the reducer is not changed partially
+	probe := reflect.New(reflect.TypeOf(r).Elem()).Interface()
+	if err := json.Unmarshal(payload.State, probe); err != nil {
+		return zerrors.ThrowInternal(err, "EVENT-aeD3o", "Errors.Internal")
+	}
+	if err := json.Unmarshal(payload.State, r); err != nil {
+		return zerrors.ThrowInternal(err, "EVENT-ohX4e", "Errors.Internal")
+	}
+
+	wm := r.GetWriteModel()
+	if wm.AggregateID == "" {
+		wm.AggregateID = payload.WriteModel.AggregateID
+	}
+	if wm.ResourceOwner == "" {
+		wm.ResourceOwner = payload.WriteModel.ResourceOwner
+	}
+	if wm.InstanceID == "" {
+		wm.InstanceID = payload.WriteModel.InstanceID
+	}
+	wm.ProcessedSequence = payload.WriteModel.ProcessedSequence
+	wm.ChangeDate = payload.WriteModel.ChangeDate
+	return nil
+}
+
+// takeSnapshot captures the current state of the reducer, nil is returned if it cannot be captured
+func takeSnapshot(instanceID string, r SnapshotReducer, position float64) *Snapshot {
+	if position <= 0 {
+		return nil
+	}
+	state, err := json.Marshal(r)
+	if err != nil {
+		logging.WithFields("key", r.SnapshotKey()).WithError(err).Warn("unable to marshal snapshot")
+		return nil
+	}
+	wm := r.GetWriteModel()
+	payload, err := json.Marshal(&snapshotPayload{
+		WriteModel: snapshotWriteModel{
+			AggregateID:       wm.AggregateID,
+			ResourceOwner:     wm.ResourceOwner,
+			InstanceID:        wm.InstanceID,
+			ProcessedSequence: wm.ProcessedSequence,
+			ChangeDate:        wm.ChangeDate,
+		},
+		State: state,
+	})
+	if err != nil {
+		logging.WithFields("key", r.SnapshotKey()).WithError(err).Warn("unable to marshal snapshot")
+		return nil
+	}
+	return &Snapshot{
+		InstanceID: instanceID,
+		Key:        r.SnapshotKey(),
+		Version:    r.SnapshotVersion(),
+		Position:   position,
+		Payload:    payload,
+	}
+}
@@ -0,0 +1,253 @@
+package eventstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSnapshotReducer struct {
+	WriteModel
+	Count int `json:"count"`
+}
+
+func (r *testSnapshotReducer) Reduce() error {
+	r.Count += len(r.Events)
+	return r.WriteModel.Reduce()
+}
+
+func (r *testSnapshotReducer) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		InstanceID("instance").
+		AddQuery().
+		AggregateIDs("id").
+		Builder()
+}
+
+func (r *testSnapshotReducer) SnapshotKey() string {
+	return "test:id"
+}
+
+func (r *testSnapshotReducer) SnapshotVersion() uint16 {
+	return 1
+}
+
+// testSnapshotQuerier only returns the events after the position of the query
+type testSnapshotQuerier struct {
+	testQuerier
+}
+
+func (repo *testSnapshotQuerier) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	for _, event := range repo.events {
+		if event.Position() <= searchQuery.GetPositionAfter() {
+			continue
+		}
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testSnapshotStore struct {
+	snapshots map[string]*Snapshot
+}
+
+func (s *testSnapshotStore) LoadSnapshot(_ context.Context, instanceID, key string, version uint16) (*Snapshot, error) {
+	snapshot, ok := s.snapshots[instanceID+key]
+	if !ok || snapshot.Version != version {
+		return nil, nil
+	}
+	return snapshot, nil
+}
+
+func (s *testSnapshotStore) SaveSnapshot(_ context.Context, snapshot *Snapshot) error {
+	s.snapshots[snapshot.InstanceID+snapshot.Key] = snapshot
+	return nil
+}
+
+func testSnapshotEvents(createdAt ...time.Time) []Event {
+	events := make([]Event, len(createdAt))
+	for i, creation := range createdAt {
+		events[i] = &BaseEvent{
+			EventType: "test.snapshot",
+			Agg:       &Aggregate{ID: "id", ResourceOwner: "ro", InstanceID: "instance"},
+			Seq:       uint64(i + 1),
+			Pos:       float64(i + 1),
+			Creation:  creation,
+		}
+	}
+	return events
+}
+
+func TestEventstore_FilterToQueryReducer_snapshot(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	type fields struct {
+		events    []Event
+		snapshot  SnapshotConfig
+		snapshots map[string]*Snapshot
+	}
+	type want struct {
+		count             int
+		processedSequence uint64
+		snapshot          *Snapshot
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "disabled",
+			fields: fields{
+				events:    testSnapshotEvents(old, old, old),
+				snapshot:  SnapshotConfig{Enabled: false, MinEvents: 1},
+				snapshots: map[string]*Snapshot{},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+			},
+		},
+		{
+			name: "not enough events",
+			fields: fields{
+				events:    testSnapshotEvents(old, old, old),
+				snapshot:  SnapshotConfig{Enabled: true, MinEvents: 5},
+				snapshots: map[string]*Snapshot{},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+			},
+		},
+		{
+			name: "snapshot stored",
+			fields: fields{
+				events:    testSnapshotEvents(old, old, old),
+				snapshot:  SnapshotConfig{Enabled: true, MinEvents: 2},
+				snapshots: map[string]*Snapshot{},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+				snapshot: &Snapshot{
+					InstanceID: "instance",
+					Key:        "test:id",
+					Version:    1,
+					Position:   3,
+				},
+			},
+		},
+		{
+			name: "recent events not in snapshot",
+			fields: fields{
+				events:    testSnapshotEvents(old, old, time.Now()),
+				snapshot:  SnapshotConfig{Enabled: true, MinEvents: 2},
+				snapshots: map[string]*Snapshot{},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+				snapshot: &Snapshot{
+					InstanceID: "instance",
+					Key:        "test:id",
+					Version:    1,
+					Position:   2,
+				},
+			},
+		},
+		{
+			name: "restored from snapshot",
+			fields: fields{
+				events:   testSnapshotEvents(old, old, old),
+				snapshot: SnapshotConfig{Enabled: true, MinEvents: 2},
+				snapshots: map[string]*Snapshot{
+					"instancetest:id": {
+						InstanceID: "instance",
+						Key:        "test:id",
+						Version:    1,
+						Position:   2,
+						Payload:    []byte(`{"writeModel":{"aggregateId":"id","processedSequence":2},"state":{"count":10}}`),
+					},
+				},
+			},
+			want: want{
+				count:             11,
+				processedSequence: 3,
+			},
+		},
+		{
+			name: "other version ignored",
+			fields: fields{
+				events:   testSnapshotEvents(old, old, old),
+				snapshot: SnapshotConfig{Enabled: true, MinEvents: 5},
+				snapshots: map[string]*Snapshot{
+					"instancetest:id": {
+						InstanceID: "instance",
+						Key:        "test:id",
+						Version:    0,
+						Position:   2,
+						Payload:    []byte(`{"writeModel":{"aggregateId":"id","processedSequence":2},"state":{"count":10}}`),
+					},
+				},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+			},
+		},
+		{
+			name: "invalid snapshot ignored",
+			fields: fields{
+				events:   testSnapshotEvents(old, old, old),
+				snapshot: SnapshotConfig{Enabled: true, MinEvents: 5},
+				snapshots: map[string]*Snapshot{
+					"instancetest:id": {
+						InstanceID: "instance",
+						Key:        "test:id",
+						Version:    1,
+						Position:   2,
+						Payload:    []byte(`{"writeModel":{},"state":{"count":"ten"}}`),
+					},
+				},
+			},
+			want: want{
+				count:             3,
+				processedSequence: 3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &testSnapshotStore{snapshots: tt.fields.snapshots}
+			es := &Eventstore{
+				querier:   &testSnapshotQuerier{testQuerier: testQuerier{events: tt.fields.events}},
+				snapshot:  tt.fields.snapshot,
+				snapshots: store,
+			}
+			r := &testSnapshotReducer{WriteModel: WriteModel{AggregateID: "id"}}
+			require.NoError(t, es.FilterToQueryReducer(context.Background(), r))
+			assert.Equal(t, tt.want.count, r.Count)
+			assert.Equal(t, tt.want.processedSequence, r.ProcessedSequence)
+
+			if tt.want.snapshot == nil {
+				assert.Equal(t, tt.fields.snapshots, store.snapshots)
+				return
+			}
+			got := store.snapshots["instancetest:id"]
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want.snapshot.Position, got.Position)
+			assert.Equal(t, tt.want.snapshot.Version, got.Version)
+
+			restored := &testSnapshotReducer{}
+			require.NoError(t, restoreReducer(got.Payload, restored))
+			assert.Equal(t, int(got.Position), restored.Count)
+			assert.Equal(t, "id", restored.AggregateID)
+			assert.Equal(t, uint64(got.Position), restored.ProcessedSequence)
+		})
+	}
+}
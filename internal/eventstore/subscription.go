@@ -11,6 +11,9 @@ var (
 	subsMutext    sync.Mutex
 )
 
+// Subscription receives the events pushed on this node.
+// If a [Notifier] is configured it also receives the events pushed by other nodes,
+// these events only contain the aggregate, the event type and the position.
 type Subscription struct {
 	Events chan Event
 	types  map[AggregateType][]EventType
@@ -67,17 +70,13 @@ func (es *Eventstore) notify(events []Event) {
 			eventTypes := sub.types[event.Aggregate().Type]
 			//subscription for all events
 			if len(eventTypes) == 0 {
-				sub.Events <- event
+				sub.push(event)
 				continue
 			}
 			//subscription for certain events
 			for _, eventType := range eventTypes {
 				if event.Type() == eventType {
-					select {
-					case sub.Events <- event:
-					default:
-						logging.Debug("unable to push event")
-					}
+					sub.push(event)
 					break
 				}
 			}
@@ -85,6 +84,16 @@ func (es *Eventstore) notify(events []Event) {
 	}
 }
 
+// push does not block if the queue of the subscription is full,
+// so that slow subscribers do not block the pushes.
+func (s *Subscription) push(event Event) {
+	select {
+	case s.Events <- event:
+	default:
+		logging.Debug("unable to push event")
+	}
+}
+
 func (s *Subscription) Unsubscribe() {
 	subsMutext.Lock()
 	defer subsMutext.Unlock()
@@ -100,9 +109,7 @@ func (s *Subscription) Unsubscribe() {
 				subs = subs[:len(subs)-1]
 			}
 		}
+		subscriptions[aggregate] = subs
 	}
-	_, ok := <-s.Events
-	if ok {
-		close(s.Events)
-	}
+	close(s.Events)
 }
@@ -0,0 +1,27 @@
+package eventstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscription_Unsubscribe(t *testing.T) {
+	aggregateType := AggregateType("test.aggregate.unsubscribe")
+	first := SubscribeAggregates(make(chan Event, 1), aggregateType)
+	second := SubscribeAggregates(make(chan Event, 1), aggregateType)
+
+	first.Unsubscribe()
+
+	_, ok := <-first.Events
+	assert.False(t, ok, "events of unsubscribed subscription must be closed")
+	assert.Equal(t, []*Subscription{second}, subscriptions[aggregateType])
+
+	es := NewEventstore(&Config{})
+	event := &BaseEvent{Agg: &Aggregate{Type: aggregateType}, EventType: "test.event"}
+	es.notify([]Event{event, event})
+
+	assert.Len(t, second.Events, 1, "full queue must not block")
+	second.Unsubscribe()
+	assert.Empty(t, subscriptions[aggregateType])
+}
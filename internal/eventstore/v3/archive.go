@@ -0,0 +1,168 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// archiveCandidatesStmt selects the latest event of each aggregate,
+	// the scan is bounded by the es_archive_candidates index and the successor lookup uses the primary key
+	archiveCandidatesStmt = `SELECT e.instance_id, e.aggregate_id FROM eventstore.events2 e` +
+		` WHERE e.aggregate_type = $1 AND e.created_at < $2`
+	archiveFinalEventCondition  = ` AND e.event_type = ANY($4)`
+	archiveLatestEventCondition = ` AND NOT EXISTS (SELECT 1 FROM eventstore.events2 n` +
+		` WHERE n.instance_id = e.instance_id AND n.aggregate_type = e.aggregate_type AND n.aggregate_id = e.aggregate_id AND n."sequence" > e."sequence")`
+	archiveCandidatesLimit = ` LIMIT $3`
+
+	// lockArchiveCandidatesStmt locks the events of the candidates,
+	// pushes to the aggregates wait until the archival is finished (see sequences_query.sql)
+	lockArchiveCandidatesStmt = `SELECT "sequence" FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3) FOR UPDATE`
+
+	// archiveEventsStmt moves the events of the aggregates and adds the manifest in a single statement.
+	// The candidates are checked again, as events might have been pushed since they were selected.
+	archiveEventsStmt = `WITH moved AS (` +
+		`DELETE FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id IN (` +
+		`SELECT aggregate_id FROM eventstore.events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = ANY($3)` +
+		` GROUP BY aggregate_id HAVING MAX(created_at) < $4`
+	archiveEventsFinalEventCondition = ` AND (ARRAY_AGG(event_type ORDER BY "sequence" DESC))[1] = ANY($5)`
+	archiveEventsManifestStmt        = `)` +
+		` RETURNING instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order` +
+		`), archived AS (` +
+		`INSERT INTO eventstore.events2_archive (instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order)` +
+		` SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM moved` +
+		`) INSERT INTO eventstore.event_archives (instance_id, aggregate_type, aggregate_count, event_count, min_position, max_position, oldest_created_at, newest_created_at)` +
+		` SELECT instance_id, aggregate_type, COUNT(DISTINCT aggregate_id), COUNT(*), MIN("position"), MAX("position"), MIN(created_at), MAX(created_at)` +
+		` FROM moved GROUP BY instance_id, aggregate_type` +
+		` RETURNING aggregate_count`
+)
+
+// StartArchive periodically archives the events of the registered aggregates (see [eventstore.RegisterArchivable])
+// until ctx is done.
+func (es *Eventstore) StartArchive(ctx context.Context, config eventstore.ArchiveConfig) {
+	if !config.Enabled {
+		return
+	}
+	ticker := time.NewTicker(config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for aggregateType, finalEventTypes := range eventstore.ArchivableAggregates() {
+				es.archiveAggregateType(ctx, config, aggregateType, finalEventTypes)
+			}
+		}
+	}
+}
+
+func (es *Eventstore) archiveAggregateType(ctx context.Context, config eventstore.ArchiveConfig, aggregateType eventstore.AggregateType, finalEventTypes []eventstore.EventType) {
+	start := time.Now()
+	var archived int
+	for ctx.Err() == nil {
+		count, err := es.Archive(ctx, time.Now().Add(-config.OlderThan), config.BulkLimit, aggregateType, finalEventTypes...)
+		if err != nil {
+			logging.WithFields("aggregate_type", aggregateType).WithError(err).Warn("unable to archive events")
+			return
+		}
+		archived += count
+		if count < int(config.BulkLimit) {
+			break
+		}
+	}
+	if archived > 0 {
+		logging.WithFields("aggregate_type", aggregateType, "aggregates", archived, "took", time.Since(start)).Info("events archived")
+	}
+}
+
+// Archive moves the events of at most limit aggregates of the type whose latest event was created before olderThan
+// to eventstore.events2_archive and adds an entry to the manifest (eventstore.event_archives).
+// If finalEventTypes are defined only aggregates whose latest event is one of them are archived.
+// The candidates are locked and checked again inside the transaction moving the events.
+// It returns the count of archived aggregates.
+func (es *Eventstore) Archive(ctx context.Context, olderThan time.Time, limit uint16, aggregateType eventstore.AggregateType, finalEventTypes ...eventstore.EventType) (int, error) {
+	candidates, err := es.archiveCandidates(ctx, olderThan, limit, aggregateType, finalEventTypes)
+	if err != nil || len(candidates) == 0 {
+		return 0, err
+	}
+
+	tx, err := es.client.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "V3-Lae4a", "Errors.Internal")
+	}
+	var count int
+	for instanceID, aggregateIDs := range candidates {
+		archived, err := archiveAggregates(ctx, tx, instanceID, olderThan, aggregateType, aggregateIDs, finalEventTypes)
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			logging.OnError(rollbackErr).Debug("unable to rollback archival")
+			return 0, err
+		}
+		count += archived
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, zerrors.ThrowInternal(err, "V3-eeB5a", "Errors.Internal")
+	}
+	return count, nil
+}
+
+// archiveCandidates returns the ids of the aggregates to archive grouped by instance
+func (es *Eventstore) archiveCandidates(ctx context.Context, olderThan time.Time, limit uint16, aggregateType eventstore.AggregateType, finalEventTypes []eventstore.EventType) (map[string][]string, error) {
+	stmt := archiveCandidatesStmt
+	args := []any{aggregateType, olderThan, limit}
+	if len(finalEventTypes) > 0 {
+		stmt += archiveFinalEventCondition
+		args = append(args, database.TextArray[eventstore.EventType](finalEventTypes))
+	}
+	stmt += archiveLatestEventCondition + archiveCandidatesLimit
+
+	candidates := make(map[string][]string)
+	err := es.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var instanceID, aggregateID string
+			if err := rows.Scan(&instanceID, &aggregateID); err != nil {
+				return err
+			}
+			candidates[instanceID] = append(candidates[instanceID], aggregateID)
+		}
+		return nil
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Oonu4", "Errors.Internal")
+	}
+	return candidates, nil
+}
+
+// archiveAggregates moves the events of the aggregates of an instance, which are still candidates, and returns their count
+func archiveAggregates(ctx context.Context, tx *sql.Tx, instanceID string, olderThan time.Time, aggregateType eventstore.AggregateType, aggregateIDs []string, finalEventTypes []eventstore.EventType) (int, error) {
+	ids := database.TextArray[string](aggregateIDs)
+	if _, err := tx.ExecContext(ctx, lockArchiveCandidatesStmt, instanceID, aggregateType, ids); err != nil {
+		return 0, zerrors.ThrowInternal(err, "V3-Shoo4", "Errors.Internal")
+	}
+	stmt := archiveEventsStmt
+	args := []any{instanceID, aggregateType, ids, olderThan}
+	if len(finalEventTypes) > 0 {
+		stmt += archiveEventsFinalEventCondition
+		args = append(args, database.TextArray[eventstore.EventType](finalEventTypes))
+	}
+	stmt += archiveEventsManifestStmt
+
+	var count int
+	err := tx.QueryRowContext(ctx, stmt, args...).Scan(&count)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "V3-aiG3u", "Errors.Internal")
+	}
+	return count, nil
+}
@@ -0,0 +1,167 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func TestEventstore_Archive(t *testing.T) {
+	olderThan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		aggregateType   eventstore.AggregateType
+		finalEventTypes []eventstore.EventType
+	}
+	tests := []struct {
+		name    string
+		mock    *mock.SQLMock
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "no candidates",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("auth_request"), olderThan, uint16(10)),
+					mock.WithQueryResult([]string{"instance_id", "aggregate_id"}, nil),
+				),
+			),
+			args: args{
+				aggregateType: "auth_request",
+			},
+			want: 0,
+		},
+		{
+			name: "query fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("auth_request"), olderThan, uint16(10)),
+					mock.WithQueryErr(assert.AnError),
+				),
+			),
+			args: args{
+				aggregateType: "auth_request",
+			},
+			wantErr: true,
+		},
+		{
+			name: "final events archived",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveFinalEventCondition+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("user"), olderThan, uint16(10), sqlmock.AnyArg()),
+					mock.WithQueryResult([]string{"instance_id", "aggregate_id"}, [][]driver.Value{
+						{"instance", "user1"},
+						{"instance", "user2"},
+					}),
+				),
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(lockArchiveCandidatesStmt,
+					mock.WithExecArgs("instance", eventstore.AggregateType("user"), database.TextArray[string]{"user1", "user2"}),
+					mock.WithExecRowsAffected(5),
+				),
+				mock.ExpectQuery(archiveEventsStmt+archiveEventsFinalEventCondition+archiveEventsManifestStmt,
+					mock.WithQueryArgs("instance", eventstore.AggregateType("user"), database.TextArray[string]{"user1", "user2"}, olderThan, sqlmock.AnyArg()),
+					mock.WithQueryResult([]string{"aggregate_count"}, [][]driver.Value{{2}}),
+				),
+				mock.ExpectCommit(nil),
+			),
+			args: args{
+				aggregateType:   "user",
+				finalEventTypes: []eventstore.EventType{"user.removed"},
+			},
+			want: 2,
+		},
+		{
+			name: "events pushed since selection, not archived",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("auth_request"), olderThan, uint16(10)),
+					mock.WithQueryResult([]string{"instance_id", "aggregate_id"}, [][]driver.Value{
+						{"instance", "request1"},
+					}),
+				),
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(lockArchiveCandidatesStmt,
+					mock.WithExecArgs("instance", eventstore.AggregateType("auth_request"), database.TextArray[string]{"request1"}),
+					mock.WithExecRowsAffected(3),
+				),
+				mock.ExpectQuery(archiveEventsStmt+archiveEventsManifestStmt,
+					mock.WithQueryArgs("instance", eventstore.AggregateType("auth_request"), database.TextArray[string]{"request1"}, olderThan),
+					mock.WithQueryResult([]string{"aggregate_count"}, nil),
+				),
+				mock.ExpectCommit(nil),
+			),
+			args: args{
+				aggregateType: "auth_request",
+			},
+			want: 0,
+		},
+		{
+			name: "lock fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("auth_request"), olderThan, uint16(10)),
+					mock.WithQueryResult([]string{"instance_id", "aggregate_id"}, [][]driver.Value{
+						{"instance", "request1"},
+					}),
+				),
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(lockArchiveCandidatesStmt,
+					mock.WithExecErr(assert.AnError),
+				),
+			),
+			args: args{
+				aggregateType: "auth_request",
+			},
+			wantErr: true,
+		},
+		{
+			name: "move fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(archiveCandidatesStmt+archiveLatestEventCondition+archiveCandidatesLimit,
+					mock.WithQueryArgs(eventstore.AggregateType("auth_request"), olderThan, uint16(10)),
+					mock.WithQueryResult([]string{"instance_id", "aggregate_id"}, [][]driver.Value{
+						{"instance", "request1"},
+					}),
+				),
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(lockArchiveCandidatesStmt,
+					mock.WithExecArgs("instance", eventstore.AggregateType("auth_request"), database.TextArray[string]{"request1"}),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExpectQuery(archiveEventsStmt+archiveEventsManifestStmt,
+					mock.WithQueryErr(assert.AnError),
+				),
+			),
+			args: args{
+				aggregateType: "auth_request",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			got, err := es.Archive(context.Background(), olderThan, 10, tt.args.aggregateType, tt.args.finalEventTypes...)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			tt.mock.Assert(t)
+		})
+	}
+}
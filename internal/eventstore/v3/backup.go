@@ -0,0 +1,229 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	exportEventsStmt = `SELECT aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position"` +
+		` FROM eventstore.events2_with_archive WHERE instance_id = $1 ORDER BY "position", in_tx_order`
+	exportUniqueConstraintsStmt = `SELECT unique_type, unique_field FROM eventstore.unique_constraints WHERE instance_id = $1 ORDER BY unique_type, unique_field`
+	exportPersonalDataKeysStmt  = `SELECT subject_id, key_id, "key", creation_date FROM eventstore.personal_data_keys WHERE instance_id = $1 ORDER BY subject_id`
+
+	releaseUniqueConstraintStmt = `DELETE FROM eventstore.unique_constraints WHERE instance_id = '' AND unique_type = $1 AND unique_field = $2`
+	importEventsStmt            = `INSERT INTO eventstore.events2 (instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order) VALUES `
+	importUniqueConstraintsStmt = `INSERT INTO eventstore.unique_constraints (instance_id, unique_type, unique_field) VALUES `
+	ignoreConflictStmt          = ` ON CONFLICT DO NOTHING`
+	importPersonalDataKeysStmt  = `INSERT INTO eventstore.personal_data_keys (instance_id, subject_id, key_id, "key", creation_date) VALUES `
+
+	// importBatchSize is the maximum amount of rows inserted per statement
+	importBatchSize = 500
+)
+
+// removeInstanceDataStmts remove all data of an instance before it is imported
+var removeInstanceDataStmts = []string{
+	`DELETE FROM eventstore.events2 WHERE instance_id = $1`,
+	`DELETE FROM eventstore.events2_archive WHERE instance_id = $1`,
+	`DELETE FROM eventstore.event_archives WHERE instance_id = $1`,
+	`DELETE FROM eventstore.unique_constraints WHERE instance_id = $1`,
+	`DELETE FROM eventstore.fields WHERE instance_id = $1`,
+	`DELETE FROM eventstore.snapshots WHERE instance_id = $1`,
+	`DELETE FROM eventstore.personal_data_keys WHERE instance_id = $1`,
+}
+
+// importPositionExpr calculates the position of imported events
+var importPositionExpr = "EXTRACT(EPOCH FROM clock_timestamp())"
+
+var _ eventstore.InstanceBackup = (*Eventstore)(nil)
+
+// ExportInstance implements [eventstore.InstanceBackup]
+// The data are read in a single read only transaction, so they represent a consistent state.
+func (es *Eventstore) ExportInstance(ctx context.Context, instanceID string, w eventstore.InstanceExportWriter) (position float64, err error) {
+	tx, err := es.client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "V3-ooP6a", "Errors.Internal")
+	}
+	defer func() {
+		rollbackErr := tx.Rollback()
+		logging.OnError(rollbackErr).Debug("unable to rollback export")
+	}()
+
+	err = exportRows(ctx, tx, exportEventsStmt, instanceID, func(rows *sql.Rows) error {
+		event := new(eventstore.BackupEvent)
+		// scanning into a byte slice copies the payload, the buffer of the driver is reused for the next row
+		var payload []byte
+		if err := rows.Scan(&event.AggregateType, &event.AggregateID, &event.EventType, &event.Sequence, &event.Revision, &event.CreatedAt, &payload, &event.Creator, &event.Owner, &event.Position); err != nil {
+			return err
+		}
+		event.Payload = payload
+		position = event.Position
+		return w.WriteEvent(event)
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = exportRows(ctx, tx, exportUniqueConstraintsStmt, instanceID, func(rows *sql.Rows) error {
+		constraint := new(eventstore.BackupUniqueConstraint)
+		if err := rows.Scan(&constraint.UniqueType, &constraint.UniqueField); err != nil {
+			return err
+		}
+		return w.WriteUniqueConstraint(constraint)
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = exportRows(ctx, tx, exportPersonalDataKeysStmt, instanceID, func(rows *sql.Rows) error {
+		key := new(eventstore.BackupPersonalDataKey)
+		if err := rows.Scan(&key.SubjectID, &key.KeyID, &key.Key, &key.CreationDate); err != nil {
+			return err
+		}
+		return w.WritePersonalDataKey(key)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return position, nil
+}
+
+func exportRows(ctx context.Context, tx *sql.Tx, stmt, instanceID string, write func(rows *sql.Rows) error) (err error) {
+	rows, err := tx.QueryContext(ctx, stmt, instanceID)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-Eir4a", "Errors.Internal")
+	}
+	defer func() {
+		closeErr := rows.Close()
+		logging.OnError(closeErr).Debug("unable to close rows")
+	}()
+	for rows.Next() {
+		if err = write(rows); err != nil {
+			return zerrors.ThrowInternal(err, "V3-xoh1E", "Errors.Internal")
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return zerrors.ThrowInternal(err, "V3-Ieph4", "Errors.Internal")
+	}
+	return nil
+}
+
+// ImportInstance implements [eventstore.InstanceBackup]
+func (es *Eventstore) ImportInstance(ctx context.Context, instanceID string, release []*eventstore.BackupUniqueConstraint, r eventstore.InstanceImportReader) (err error) {
+	tx, err := es.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-ieW3u", "Errors.Internal")
+	}
+	defer func() {
+		if err != nil {
+			rollbackErr := tx.Rollback()
+			logging.OnError(rollbackErr).Debug("unable to rollback import")
+			return
+		}
+		if err = tx.Commit(); err != nil {
+			err = zerrors.ThrowInternal(err, "V3-Wae8i", "Errors.Internal")
+		}
+	}()
+
+	for _, stmt := range removeInstanceDataStmts {
+		if _, err = tx.ExecContext(ctx, stmt, instanceID); err != nil {
+			return zerrors.ThrowInternal(err, "V3-aeX2o", "Errors.Internal")
+		}
+	}
+	for _, constraint := range release {
+		if _, err = tx.ExecContext(ctx, releaseUniqueConstraintStmt, constraint.UniqueType, strings.ToLower(constraint.UniqueField)); err != nil {
+			return zerrors.ThrowInternal(err, "V3-Ohn5e", "Errors.Internal")
+		}
+	}
+
+	err = importRows(ctx, tx, importEventsStmt, r.NextEvent, func(event *eventstore.BackupEvent, index int, args []any) (string, []any) {
+		n := len(args)
+		placeholder := fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, %s, $%d)", n+1, n+2, n+3, n+4, n+5, n+6, n+7, n+8, n+9, n+10, importPositionExpr, n+11)
+		return placeholder, append(args, instanceID, string(event.AggregateType), event.AggregateID, string(event.EventType), event.Sequence, event.Revision, event.CreatedAt, Payload(event.Payload), event.Creator, event.Owner, index)
+	})
+	if err != nil {
+		return err
+	}
+	err = importRows(ctx, tx, importUniqueConstraintsStmt, r.NextUniqueConstraint, func(constraint *eventstore.BackupUniqueConstraint, _ int, args []any) (string, []any) {
+		n := len(args)
+		constraintInstanceID := instanceID
+		if constraint.IsGlobal {
+			constraintInstanceID = ""
+		}
+		return fmt.Sprintf("($%d, $%d, $%d)", n+1, n+2, n+3), append(args, constraintInstanceID, constraint.UniqueType, strings.ToLower(constraint.UniqueField))
+	})
+	if err != nil {
+		return err
+	}
+	return importRows(ctx, tx, importPersonalDataKeysStmt, r.NextPersonalDataKey, func(key *eventstore.BackupPersonalDataKey, _ int, args []any) (string, []any) {
+		n := len(args)
+		return fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5), append(args, instanceID, key.SubjectID, key.KeyID, key.Key, key.CreationDate)
+	})
+}
+
+// ImportUniqueConstraints implements [eventstore.InstanceBackup]
+func (es *Eventstore) ImportUniqueConstraints(ctx context.Context, instanceID string, constraints []*eventstore.BackupUniqueConstraint) error {
+	for len(constraints) > 0 {
+		batch := constraints[:min(len(constraints), importBatchSize)]
+		constraints = constraints[len(batch):]
+
+		placeholders := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*3)
+		for i, constraint := range batch {
+			constraintInstanceID := instanceID
+			if constraint.IsGlobal {
+				constraintInstanceID = ""
+			}
+			placeholders[i] = fmt.Sprintf("($%d, $%d, $%d)", len(args)+1, len(args)+2, len(args)+3)
+			args = append(args, constraintInstanceID, constraint.UniqueType, strings.ToLower(constraint.UniqueField))
+		}
+		if _, err := es.client.ExecContext(ctx, importUniqueConstraintsStmt+strings.Join(placeholders, ", ")+ignoreConflictStmt, args...); err != nil {
+			return zerrors.ThrowInternal(err, "V3-Aiph7", "Errors.Internal")
+		}
+	}
+	return nil
+}
+
+// importRows inserts the values returned by next in batches until next returns [io.EOF].
+// row appends the arguments of the value and returns its placeholder, index is the position of the value.
+func importRows[T any](ctx context.Context, tx *sql.Tx, stmt string, next func() (T, error), row func(value T, index int, args []any) (string, []any)) error {
+	placeholders := make([]string, 0, importBatchSize)
+	args := make([]any, 0)
+	flush := func() error {
+		if len(placeholders) == 0 {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, stmt+strings.Join(placeholders, ", "), args...); err != nil {
+			return zerrors.ThrowInternal(err, "V3-uu6Ee", "Errors.Internal")
+		}
+		placeholders, args = placeholders[:0], args[:0]
+		return nil
+	}
+
+	for index := 0; ; index++ {
+		value, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var placeholder string
+		placeholder, args = row(value, index, args)
+		placeholders = append(placeholders, placeholder)
+		if len(placeholders) < importBatchSize {
+			continue
+		}
+		if err = flush(); err != nil {
+			return err
+		}
+	}
+	return flush()
+}
@@ -0,0 +1,292 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+type testBackup struct {
+	events      []*eventstore.BackupEvent
+	constraints []*eventstore.BackupUniqueConstraint
+	keys        []*eventstore.BackupPersonalDataKey
+}
+
+func (b *testBackup) WriteEvent(event *eventstore.BackupEvent) error {
+	b.events = append(b.events, event)
+	return nil
+}
+
+func (b *testBackup) WriteUniqueConstraint(constraint *eventstore.BackupUniqueConstraint) error {
+	b.constraints = append(b.constraints, constraint)
+	return nil
+}
+
+func (b *testBackup) WritePersonalDataKey(key *eventstore.BackupPersonalDataKey) error {
+	b.keys = append(b.keys, key)
+	return nil
+}
+
+func (b *testBackup) NextEvent() (*eventstore.BackupEvent, error) {
+	return next(&b.events)
+}
+
+func (b *testBackup) NextUniqueConstraint() (*eventstore.BackupUniqueConstraint, error) {
+	return next(&b.constraints)
+}
+
+func (b *testBackup) NextPersonalDataKey() (*eventstore.BackupPersonalDataKey, error) {
+	return next(&b.keys)
+}
+
+func next[T any](values *[]*T) (*T, error) {
+	if len(*values) == 0 {
+		return nil, io.EOF
+	}
+	value := (*values)[0]
+	*values = (*values)[1:]
+	return value, nil
+}
+
+func TestEventstore_ExportInstance(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		mock         *mock.SQLMock
+		want         *testBackup
+		wantPosition float64
+		wantErr      bool
+	}{
+		{
+			name: "query fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(exportEventsStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryErr(assert.AnError),
+				),
+				mock.ExpectRollback(nil),
+			),
+			wantErr: true,
+		},
+		{
+			name: "exported",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(exportEventsStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult(
+						[]string{"aggregate_type", "aggregate_id", "event_type", "sequence", "revision", "created_at", "payload", "creator", "owner", "position"},
+						[][]driver.Value{
+							{"user", "user1", "user.added", uint64(1), uint16(1), createdAt, []byte(`{"userName":"gigi"}`), "creator", "org", float64(1.5)},
+							{"user", "user1", "user.locked", uint64(2), uint16(1), createdAt, nil, "creator", "org", float64(2.5)},
+						},
+					),
+				),
+				mock.ExpectQuery(exportUniqueConstraintsStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult([]string{"unique_type", "unique_field"}, [][]driver.Value{
+						{"usernames", "gigi"},
+					}),
+				),
+				mock.ExpectQuery(exportPersonalDataKeysStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult([]string{"subject_id", "key_id", "key", "creation_date"}, [][]driver.Value{
+						{"user1", "key1", []byte("key"), createdAt},
+					}),
+				),
+				mock.ExpectRollback(nil),
+			),
+			want: &testBackup{
+				events: []*eventstore.BackupEvent{
+					{AggregateType: "user", AggregateID: "user1", EventType: "user.added", Sequence: 1, Revision: 1, CreatedAt: createdAt, Payload: []byte(`{"userName":"gigi"}`), Creator: "creator", Owner: "org", Position: 1.5},
+					{AggregateType: "user", AggregateID: "user1", EventType: "user.locked", Sequence: 2, Revision: 1, CreatedAt: createdAt, Creator: "creator", Owner: "org", Position: 2.5},
+				},
+				constraints: []*eventstore.BackupUniqueConstraint{
+					{UniqueType: "usernames", UniqueField: "gigi"},
+				},
+				keys: []*eventstore.BackupPersonalDataKey{
+					{SubjectID: "user1", KeyID: "key1", Key: []byte("key"), CreationDate: createdAt},
+				},
+			},
+			wantPosition: 2.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			got := new(testBackup)
+			position, err := es.ExportInstance(context.Background(), "instance", got)
+			tt.mock.Assert(t)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPosition, position)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEventstore_ImportInstance(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		mock    *mock.SQLMock
+		backup  *testBackup
+		release []*eventstore.BackupUniqueConstraint
+		wantErr bool
+	}{
+		{
+			name: "remove fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(removeInstanceDataStmts[0],
+					mock.WithExecArgs("instance"),
+					mock.WithExecErr(assert.AnError),
+				),
+				mock.ExpectRollback(nil),
+			),
+			backup:  new(testBackup),
+			wantErr: true,
+		},
+		{
+			name: "imported",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExcpectExec(removeInstanceDataStmts[0], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[1], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[2], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[3], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[4], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[5], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(removeInstanceDataStmts[6], mock.WithExecArgs("instance"), mock.WithExecNoRowsAffected()),
+				mock.ExcpectExec(releaseUniqueConstraintStmt,
+					mock.WithExecArgs("instance_domain", "old.zitadel.cloud"),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExcpectExec(importEventsStmt+
+					"($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, "+importPositionExpr+", $11), "+
+					"($12, $13, $14, $15, $16, $17, $18, $19, $20, $21, "+importPositionExpr+", $22)",
+					mock.WithExecArgs(
+						"instance", "user", "user1", "user.added", uint64(1), uint16(1), createdAt, Payload(`{"userName":"gigi"}`), "creator", "org", 0,
+						"instance", "user", "user1", "user.locked", uint64(2), uint16(1), createdAt, Payload(nil), "creator", "org", 1,
+					),
+					mock.WithExecRowsAffected(2),
+				),
+				mock.ExcpectExec(importUniqueConstraintsStmt+"($1, $2, $3), ($4, $5, $6)",
+					mock.WithExecArgs(
+						"instance", "usernames", "gigi",
+						"", "instance_domain", "zitadel.cloud",
+					),
+					mock.WithExecRowsAffected(2),
+				),
+				mock.ExcpectExec(importPersonalDataKeysStmt+"($1, $2, $3, $4, $5)",
+					mock.WithExecArgs("instance", "user1", "key1", []byte("key"), createdAt),
+					mock.WithExecRowsAffected(1),
+				),
+				mock.ExpectCommit(nil),
+			),
+			release: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: "instance_domain", UniqueField: "Old.zitadel.cloud", IsGlobal: true},
+			},
+			backup: &testBackup{
+				events: []*eventstore.BackupEvent{
+					{AggregateType: "user", AggregateID: "user1", EventType: "user.added", Sequence: 1, Revision: 1, CreatedAt: createdAt, Payload: []byte(`{"userName":"gigi"}`), Creator: "creator", Owner: "org", Position: 1.5},
+					{AggregateType: "user", AggregateID: "user1", EventType: "user.locked", Sequence: 2, Revision: 1, CreatedAt: createdAt, Creator: "creator", Owner: "org", Position: 2.5},
+				},
+				constraints: []*eventstore.BackupUniqueConstraint{
+					{UniqueType: "usernames", UniqueField: "Gigi"},
+					{UniqueType: "instance_domain", UniqueField: "zitadel.cloud", IsGlobal: true},
+				},
+				keys: []*eventstore.BackupPersonalDataKey{
+					{SubjectID: "user1", KeyID: "key1", Key: []byte("key"), CreationDate: createdAt},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			err := es.ImportInstance(context.Background(), "instance", tt.release, tt.backup)
+			tt.mock.Assert(t)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEventstore_ImportUniqueConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		mock        *mock.SQLMock
+		constraints []*eventstore.BackupUniqueConstraint
+		wantErr     bool
+	}{
+		{
+			name:        "no constraints",
+			mock:        mock.NewSQLMock(t),
+			constraints: nil,
+		},
+		{
+			name: "import fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExcpectExec(importUniqueConstraintsStmt+"($1, $2, $3)"+ignoreConflictStmt,
+					mock.WithExecArgs("instance", "usernames", "gigi"),
+					mock.WithExecErr(assert.AnError),
+				),
+			),
+			constraints: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: "usernames", UniqueField: "gigi"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "imported",
+			mock: mock.NewSQLMock(t,
+				mock.ExcpectExec(importUniqueConstraintsStmt+"($1, $2, $3), ($4, $5, $6)"+ignoreConflictStmt,
+					mock.WithExecArgs(
+						"instance", "usernames", "gigi",
+						"", "instance_domain", "zitadel.cloud",
+					),
+					mock.WithExecRowsAffected(1),
+				),
+			),
+			constraints: []*eventstore.BackupUniqueConstraint{
+				{UniqueType: "usernames", UniqueField: "Gigi"},
+				{UniqueType: "instance_domain", UniqueField: "zitadel.cloud", IsGlobal: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			err := es.ImportUniqueConstraints(context.Background(), "instance", tt.constraints)
+			tt.mock.Assert(t)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
 var (
@@ -15,19 +16,44 @@ var (
 
 type Eventstore struct {
 	client *database.DB
+
+	// node identifies the notifications sent by this instance of the eventstore
+	node         string
+	notification eventstore.NotificationConfig
+
+	personalData *personalData
+}
+
+type Option func(*Eventstore)
+
+// WithNotification enables the notification of other nodes on push
+// and lets the eventstore act as [eventstore.Notifier]
+func WithNotification(config eventstore.NotificationConfig) Option {
+	return func(es *Eventstore) {
+		es.notification = config
+	}
 }
 
-func NewEventstore(client *database.DB) *Eventstore {
+func NewEventstore(client *database.DB, opts ...Option) *Eventstore {
 	switch client.Type() {
 	case "cockroach":
 		pushPlaceholderFmt = "($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, hlc_to_timestamp(cluster_logical_timestamp()), cluster_logical_timestamp(), $%d)"
 		uniqueConstraintPlaceholderFmt = "('%s', '%s', '%s')"
+		importPositionExpr = "cluster_logical_timestamp()"
 	case "postgres":
 		pushPlaceholderFmt = "($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, statement_timestamp(), EXTRACT(EPOCH FROM clock_timestamp()), $%d)"
 		uniqueConstraintPlaceholderFmt = "(%s, %s, %s)"
+		importPositionExpr = "EXTRACT(EPOCH FROM clock_timestamp())"
 	}
 
-	return &Eventstore{client: client}
+	es := &Eventstore{
+		client: client,
+		node:   newNodeID(),
+	}
+	for _, opt := range opts {
+		opt(es)
+	}
+	return es
 }
 
 func (es *Eventstore) Health(ctx context.Context) error {
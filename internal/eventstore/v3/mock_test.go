@@ -8,6 +8,7 @@ var _ eventstore.Command = (*mockCommand)(nil)
 
 type mockCommand struct {
 	aggregate   *eventstore.Aggregate
+	typ         eventstore.EventType
 	payload     any
 	constraints []*eventstore.UniqueConstraint
 }
@@ -29,6 +30,9 @@ func (m *mockCommand) Revision() uint16 {
 
 // Type implements [eventstore.Command]
 func (m *mockCommand) Type() eventstore.EventType {
+	if m.typ != "" {
+		return m.typ
+	}
 	return "event.type"
 }
 
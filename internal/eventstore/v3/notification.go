@@ -0,0 +1,231 @@
+package eventstore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	notificationChannel = "zitadel_events"
+	// maxNotificationPayload is the maximum payload size of NOTIFY on PostgreSQL (8000 bytes)
+	maxNotificationPayload = 7999
+	defaultPollInterval    = time.Second
+
+	notifyStmt           = "SELECT pg_notify($1, $2)"
+	latestPositionStmt   = `SELECT COALESCE(MAX("position"), 0) FROM eventstore.events2`
+	pollNotificationStmt = `SELECT instance_id, aggregate_type, event_type, MAX("position") FROM eventstore.events2 WHERE "position" > $1 GROUP BY instance_id, aggregate_type, event_type`
+)
+
+var _ eventstore.Notifier = (*Eventstore)(nil)
+
+type notification struct {
+	Node   string               `json:"node"`
+	Events []*notificationEvent `json:"events"`
+}
+
+type notificationEvent struct {
+	InstanceID    string                   `json:"instanceID"`
+	AggregateType eventstore.AggregateType `json:"aggregateType"`
+	EventType     eventstore.EventType     `json:"eventType"`
+	Position      float64                  `json:"position"`
+}
+
+func newNodeID() string {
+	id := make([]byte, 12)
+	_, err := rand.Read(id)
+	logging.OnError(err).Warn("unable to generate node id of eventstore")
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// sendNotification notifies the other nodes about the pushed events.
+// The notification is only delivered if the transaction commits.
+func (es *Eventstore) sendNotification(ctx context.Context, tx *sql.Tx, events []eventstore.Event) error {
+	if !es.notification.Enabled || es.client.Type() != "postgres" || len(events) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(&notification{
+		Node:   es.node,
+		Events: notificationEvents(events),
+	})
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-ieT4o", "Errors.Internal")
+	}
+	if len(payload) > maxNotificationPayload {
+		// the other nodes catch up on their next requeue
+		logging.WithFields("size", len(payload)).Info("notification payload exceeds limit, skip notification")
+		return nil
+	}
+	_, err = tx.ExecContext(ctx, notifyStmt, notificationChannel, string(payload))
+	return err
+}
+
+// notificationEvents reduces the events to the latest position per instance, aggregate type and event type,
+// which is enough to wake up the subscriptions.
+func notificationEvents(events []eventstore.Event) []*notificationEvent {
+	notified := make([]*notificationEvent, 0, len(events))
+	for _, event := range events {
+		if existing := findNotificationEvent(notified, event); existing != nil {
+			existing.Position = max(existing.Position, event.Position())
+			continue
+		}
+		notified = append(notified, &notificationEvent{
+			InstanceID:    event.Aggregate().InstanceID,
+			AggregateType: event.Aggregate().Type,
+			EventType:     event.Type(),
+			Position:      event.Position(),
+		})
+	}
+	return notified
+}
+
+func findNotificationEvent(notified []*notificationEvent, event eventstore.Event) *notificationEvent {
+	for _, n := range notified {
+		if n.InstanceID == event.Aggregate().InstanceID &&
+			n.AggregateType == event.Aggregate().Type &&
+			n.EventType == event.Type() {
+			return n
+		}
+	}
+	return nil
+}
+
+// Listen implements [eventstore.Notifier].
+// On PostgreSQL it uses LISTEN on a dedicated connection, otherwise the events table is polled.
+func (es *Eventstore) Listen(ctx context.Context, notify func(events []eventstore.Event)) error {
+	if !es.notification.Enabled {
+		return nil
+	}
+	if es.notification.PollInterval <= 0 {
+		es.notification.PollInterval = defaultPollInterval
+	}
+	if es.client.Type() != "postgres" || es.client.Pool == nil {
+		return es.poll(ctx, notify)
+	}
+	for {
+		err := es.listen(ctx, notify)
+		if ctx.Err() != nil {
+			return nil
+		}
+		logging.WithError(err).Warn("listening for event notifications failed, retry")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(es.notification.PollInterval):
+		}
+	}
+}
+
+func (es *Eventstore) listen(ctx context.Context, notify func(events []eventstore.Event)) error {
+	poolConn, err := es.client.Pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// the connection is removed from the pool because it stays in listening state
+	conn := poolConn.Hijack()
+	defer func() {
+		closeErr := conn.Close(context.Background())
+		logging.OnError(closeErr).Debug("unable to close listening connection")
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+notificationChannel); err != nil {
+		return err
+	}
+	for {
+		received, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		events, err := es.parseNotification(received.Payload)
+		if err != nil {
+			logging.WithError(err).Warn("unable to parse event notification")
+			continue
+		}
+		if len(events) > 0 {
+			notify(events)
+		}
+	}
+}
+
+// parseNotification maps the payload to events and ignores the notifications sent by this node
+func (es *Eventstore) parseNotification(payload string) ([]eventstore.Event, error) {
+	var n notification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		return nil, err
+	}
+	if n.Node == es.node {
+		return nil, nil
+	}
+	events := make([]eventstore.Event, len(n.Events))
+	for i, event := range n.Events {
+		events[i] = event.toEvent()
+	}
+	return events, nil
+}
+
+// poll queries the events pushed since the last poll.
+// On CockroachDB events committed later can have a lower position than already polled ones,
+// these events are handled on the next requeue of the projections.
+func (es *Eventstore) poll(ctx context.Context, notify func(events []eventstore.Event)) error {
+	var position float64
+	err := es.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&position)
+	}, latestPositionStmt)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(es.notification.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			events, err := es.pollEvents(ctx, position)
+			if err != nil {
+				logging.WithError(err).Warn("unable to poll events")
+				continue
+			}
+			for _, event := range events {
+				position = max(position, event.Position())
+			}
+			if len(events) > 0 {
+				notify(events)
+			}
+		}
+	}
+}
+
+func (es *Eventstore) pollEvents(ctx context.Context, position float64) (events []eventstore.Event, err error) {
+	err = es.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			event := new(notificationEvent)
+			if err := rows.Scan(&event.InstanceID, &event.AggregateType, &event.EventType, &event.Position); err != nil {
+				return err
+			}
+			events = append(events, event.toEvent())
+		}
+		return nil
+	}, pollNotificationStmt, position)
+	return events, err
+}
+
+func (n *notificationEvent) toEvent() eventstore.Event {
+	return &event{
+		aggregate: &eventstore.Aggregate{
+			Type:       n.AggregateType,
+			InstanceID: n.InstanceID,
+		},
+		typ:      n.EventType,
+		position: n.Position,
+	}
+}
@@ -0,0 +1,95 @@
+package eventstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_notificationEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   []*notificationEvent
+	}{
+		{
+			name:   "no events",
+			events: []eventstore.Event{},
+			want:   []*notificationEvent{},
+		},
+		{
+			name: "same type reduced to latest position",
+			events: []eventstore.Event{
+				&event{aggregate: &eventstore.Aggregate{InstanceID: "instance", Type: "user"}, typ: "user.added", position: 1.5},
+				&event{aggregate: &eventstore.Aggregate{InstanceID: "instance", Type: "user"}, typ: "user.added", position: 2.5},
+			},
+			want: []*notificationEvent{
+				{InstanceID: "instance", AggregateType: "user", EventType: "user.added", Position: 2.5},
+			},
+		},
+		{
+			name: "different instances and types",
+			events: []eventstore.Event{
+				&event{aggregate: &eventstore.Aggregate{InstanceID: "instance", Type: "user"}, typ: "user.added", position: 1},
+				&event{aggregate: &eventstore.Aggregate{InstanceID: "instance", Type: "user"}, typ: "user.changed", position: 2},
+				&event{aggregate: &eventstore.Aggregate{InstanceID: "instance2", Type: "user"}, typ: "user.added", position: 3},
+			},
+			want: []*notificationEvent{
+				{InstanceID: "instance", AggregateType: "user", EventType: "user.added", Position: 1},
+				{InstanceID: "instance", AggregateType: "user", EventType: "user.changed", Position: 2},
+				{InstanceID: "instance2", AggregateType: "user", EventType: "user.added", Position: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, notificationEvents(tt.events))
+		})
+	}
+}
+
+func TestEventstore_parseNotification(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    []eventstore.Event
+		wantErr bool
+	}{
+		{
+			name:    "invalid payload",
+			payload: "{",
+			wantErr: true,
+		},
+		{
+			name:    "own node ignored",
+			payload: `{"node":"node","events":[{"instanceID":"instance","aggregateType":"user","eventType":"user.added","position":1.5}]}`,
+			want:    nil,
+		},
+		{
+			name:    "other node",
+			payload: `{"node":"other","events":[{"instanceID":"instance","aggregateType":"user","eventType":"user.added","position":1.5}]}`,
+			want: []eventstore.Event{
+				&event{
+					aggregate: &eventstore.Aggregate{InstanceID: "instance", Type: "user"},
+					typ:       "user.added",
+					position:  1.5,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{node: "node"}
+			got, err := es.parseNotification(tt.payload)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package eventstore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// dataKeyCacheTTL is the maximum time a destroyed key can still be used by other nodes
+	dataKeyCacheTTL     = time.Minute
+	dataKeyCacheMaxSize = 10000
+	dataKeyLength       = 32
+
+	getDataKeyStmt     = "SELECT key_id, key FROM eventstore.personal_data_keys WHERE instance_id = $1 AND subject_id = $2"
+	addDataKeyStmt     = "INSERT INTO eventstore.personal_data_keys (instance_id, subject_id, key_id, key) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING"
+	destroyDataKeyStmt = "DELETE FROM eventstore.personal_data_keys WHERE instance_id = $1 AND subject_id = ANY($2) RETURNING subject_id"
+	// destroyOwnerDataKeysStmt destroys the keys of all aggregates owned by the resource owner
+	destroyOwnerDataKeysStmt = "DELETE FROM eventstore.personal_data_keys WHERE instance_id = $1" +
+		` AND subject_id IN (SELECT aggregate_id FROM eventstore.events2 WHERE instance_id = $1 AND "owner" = $2)` +
+		" RETURNING subject_id"
+	destroyInstanceDataKeysStmt = "DELETE FROM eventstore.personal_data_keys WHERE instance_id = $1 RETURNING subject_id"
+)
+
+var _ eventstore.PersonalDataProtector = (*Eventstore)(nil)
+
+// personalData encrypts the personal data using a data key per subject,
+// the data keys are encrypted using the key encryption key
+type personalData struct {
+	keyEncryption crypto.EncryptionAlgorithm
+
+	mu   sync.Mutex
+	keys map[dataKeyID]*cachedDataKey
+}
+
+type dataKeyID struct {
+	instanceID string
+	subjectID  string
+}
+
+type cachedDataKey struct {
+	key       []byte
+	expiresAt time.Time
+}
+
+// WithPersonalData enables the encryption of personal data
+// and lets the eventstore act as [eventstore.PersonalDataProtector]
+func WithPersonalData(keyEncryption crypto.EncryptionAlgorithm) Option {
+	return func(es *Eventstore) {
+		es.personalData = &personalData{
+			keyEncryption: keyEncryption,
+			keys:          make(map[dataKeyID]*cachedDataKey),
+		}
+	}
+}
+
+// Encrypt implements [eventstore.PersonalDataProtector]
+func (es *Eventstore) Encrypt(ctx context.Context, instanceID, subjectID string, value []byte) ([]byte, error) {
+	if es.personalData == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "V3-Ohgh9", "Errors.Internal")
+	}
+	key, err := es.dataKey(ctx, instanceID, subjectID)
+	if zerrors.IsNotFound(err) {
+		key, err = es.addDataKey(ctx, instanceID, subjectID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return encryptPersonalData(key, value)
+}
+
+// Decrypt implements [eventstore.PersonalDataProtector]
+func (es *Eventstore) Decrypt(ctx context.Context, instanceID, subjectID string, value []byte) ([]byte, error) {
+	if es.personalData == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "V3-eeF3a", "Errors.Internal")
+	}
+	key, err := es.dataKey(ctx, instanceID, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	return decryptPersonalData(key, value)
+}
+
+// Destroy implements [eventstore.PersonalDataProtector]
+func (es *Eventstore) Destroy(ctx context.Context, instanceID string, subjectIDs ...string) error {
+	if es.personalData == nil || len(subjectIDs) == 0 {
+		return nil
+	}
+	_, err := es.client.ExecContext(ctx, destroyDataKeyStmt, instanceID, database.TextArray[string](subjectIDs))
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-Ya4ai", "Errors.Internal")
+	}
+	es.personalData.uncache(instanceID, subjectIDs...)
+	return nil
+}
+
+// destroyPersonalData destroys the keys of the subjects erased by the events in the push transaction (see [eventstore.PersonalDataErasure])
+func (es *Eventstore) destroyPersonalData(ctx context.Context, tx *sql.Tx, commands []eventstore.Command) (func(), error) {
+	if es.personalData == nil {
+		return func() {}, nil
+	}
+	aggregates := make(map[string][]string)
+	destroyed := make(map[string][]string)
+	for _, command := range commands {
+		instanceID := command.Aggregate().InstanceID
+		var (
+			subjectIDs []string
+			err        error
+		)
+		switch eventstore.PersonalDataErasureOf(command.Type()) {
+		case eventstore.PersonalDataErasureAggregate:
+			aggregates[instanceID] = append(aggregates[instanceID], command.Aggregate().ID)
+			continue
+		case eventstore.PersonalDataErasureOwner:
+			subjectIDs, err = destroyDataKeys(ctx, tx, destroyOwnerDataKeysStmt, instanceID, command.Aggregate().ID)
+		case eventstore.PersonalDataErasureInstance:
+			subjectIDs, err = destroyDataKeys(ctx, tx, destroyInstanceDataKeysStmt, instanceID)
+		case eventstore.PersonalDataErasureNone:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		destroyed[instanceID] = append(destroyed[instanceID], subjectIDs...)
+	}
+	for instanceID, aggregateIDs := range aggregates {
+		subjectIDs, err := destroyDataKeys(ctx, tx, destroyDataKeyStmt, instanceID, database.TextArray[string](aggregateIDs))
+		if err != nil {
+			return nil, err
+		}
+		destroyed[instanceID] = append(destroyed[instanceID], subjectIDs...)
+	}
+	// the cache is cleared after the commit of the transaction
+	return func() {
+		for instanceID, subjectIDs := range destroyed {
+			es.personalData.uncache(instanceID, subjectIDs...)
+		}
+	}, nil
+}
+
+// destroyDataKeys executes the destroy statement and returns the subjects of the destroyed keys
+func destroyDataKeys(ctx context.Context, tx *sql.Tx, stmt string, args ...any) (subjectIDs []string, err error) {
+	rows, err := tx.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-ahM4u", "Errors.Internal")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var subjectID string
+		if err = rows.Scan(&subjectID); err != nil {
+			return nil, zerrors.ThrowInternal(err, "V3-ieL5a", "Errors.Internal")
+		}
+		subjectIDs = append(subjectIDs, subjectID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Oob7e", "Errors.Internal")
+	}
+	return subjectIDs, nil
+}
+
+func (es *Eventstore) dataKey(ctx context.Context, instanceID, subjectID string) ([]byte, error) {
+	if key := es.personalData.cached(instanceID, subjectID); key != nil {
+		return key, nil
+	}
+	var (
+		keyID     string
+		encrypted []byte
+	)
+	err := es.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&keyID, &encrypted)
+	}, getDataKeyStmt, instanceID, subjectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, zerrors.ThrowNotFound(err, "V3-Kie7h", "Errors.Internal")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-oo0Ae", "Errors.Internal")
+	}
+	key, err := es.personalData.keyEncryption.Decrypt(encrypted, keyID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Chai6", "Errors.Internal")
+	}
+	es.personalData.cache(instanceID, subjectID, key)
+	return key, nil
+}
+
+// addDataKey creates the key of the subject,
+// if another transaction created the key in the meantime its key is returned
+func (es *Eventstore) addDataKey(ctx context.Context, instanceID, subjectID string) ([]byte, error) {
+	key := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Eiph8", "Errors.Internal")
+	}
+	encrypted, err := es.personalData.keyEncryption.Encrypt(key)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-uu3Ie", "Errors.Internal")
+	}
+	_, err = es.client.ExecContext(ctx, addDataKeyStmt, instanceID, subjectID, es.personalData.keyEncryption.EncryptionKeyID(), encrypted)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-ThaX4", "Errors.Internal")
+	}
+	return es.dataKey(ctx, instanceID, subjectID)
+}
+
+func (p *personalData) cached(instanceID, subjectID string) []byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cached, ok := p.keys[dataKeyID{instanceID: instanceID, subjectID: subjectID}]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.key
+}
+
+func (p *personalData) cache(instanceID, subjectID string, key []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) >= dataKeyCacheMaxSize {
+		p.purge()
+	}
+	p.keys[dataKeyID{instanceID: instanceID, subjectID: subjectID}] = &cachedDataKey{
+		key:       key,
+		expiresAt: time.Now().Add(dataKeyCacheTTL),
+	}
+}
+
+func (p *personalData) uncache(instanceID string, subjectIDs ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, subjectID := range subjectIDs {
+		delete(p.keys, dataKeyID{instanceID: instanceID, subjectID: subjectID})
+	}
+}
+
+// purge removes the expired keys, if the cache is still full all keys are removed
+func (p *personalData) purge() {
+	now := time.Now()
+	for id, cached := range p.keys {
+		if now.After(cached.expiresAt) {
+			delete(p.keys, id)
+		}
+	}
+	if len(p.keys) >= dataKeyCacheMaxSize {
+		clear(p.keys)
+	}
+}
+
+func encryptPersonalData(key, value []byte) ([]byte, error) {
+	gcm, err := newPersonalDataCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-pah9A", "Errors.Internal")
+	}
+	return gcm.Seal(nonce, nonce, value, nil), nil
+}
+
+func decryptPersonalData(key, value []byte) ([]byte, error) {
+	gcm, err := newPersonalDataCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) < gcm.NonceSize() {
+		return nil, zerrors.ThrowInternal(nil, "V3-Iy7ae", "Errors.Internal")
+	}
+	plain, err := gcm.Open(nil, value[:gcm.NonceSize()], value[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Thoo4", "Errors.Internal")
+	}
+	return plain, nil
+}
+
+func newPersonalDataCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-ae3Gu", "Errors.Internal")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-Vei0o", "Errors.Internal")
+	}
+	return gcm, nil
+}
@@ -0,0 +1,155 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql/driver"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_personalDataEncryption(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	encrypted, err := encryptPersonalData(key, []byte(`"gigi@zitadel.com"`))
+	require.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "gigi@zitadel.com")
+
+	decrypted, err := decryptPersonalData(key, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, `"gigi@zitadel.com"`, string(decrypted))
+
+	_, err = decryptPersonalData([]byte("fedcba9876543210fedcba9876543210"), encrypted)
+	assert.Error(t, err)
+	_, err = decryptPersonalData(key, encrypted[:4])
+	assert.Error(t, err)
+}
+
+func Test_personalData_cache(t *testing.T) {
+	p := &personalData{keys: make(map[dataKeyID]*cachedDataKey)}
+
+	p.cache("instance", "user1", []byte("key1"))
+	p.cache("instance", "user2", []byte("key2"))
+	assert.Equal(t, []byte("key1"), p.cached("instance", "user1"))
+	assert.Nil(t, p.cached("other", "user1"))
+
+	p.uncache("instance", "user1")
+	assert.Nil(t, p.cached("instance", "user1"))
+	assert.Equal(t, []byte("key2"), p.cached("instance", "user2"))
+
+	p.keys[dataKeyID{instanceID: "instance", subjectID: "user2"}].expiresAt = time.Now().Add(-time.Second)
+	assert.Nil(t, p.cached("instance", "user2"))
+	p.purge()
+	assert.Empty(t, p.keys)
+}
+
+func TestEventstore_destroyPersonalData(t *testing.T) {
+	eventstore.RegisterPersonalDataErasure("test.user.removed")
+	eventstore.RegisterPersonalDataOwnerErasure("test.org.removed")
+	eventstore.RegisterPersonalDataInstanceErasure("test.instance.removed")
+
+	command := func(typ eventstore.EventType, id string) eventstore.Command {
+		return &mockCommand{aggregate: mockAggregate(id), typ: typ}
+	}
+	tests := []struct {
+		name      string
+		mock      *mock.SQLMock
+		commands  []eventstore.Command
+		destroyed []string
+		wantErr   bool
+	}{
+		{
+			name: "no erasure",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+			),
+			commands: []eventstore.Command{command("event.type", "user1")},
+		},
+		{
+			name: "user removed",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(destroyDataKeyStmt,
+					mock.WithQueryArgs("instance", database.TextArray[string]{"user1", "user2"}),
+					mock.WithQueryResult([]string{"subject_id"}, [][]driver.Value{{"user1"}, {"user2"}}),
+				),
+			),
+			commands: []eventstore.Command{
+				command("test.user.removed", "user1"),
+				command("test.user.removed", "user2"),
+			},
+			destroyed: []string{"user1", "user2"},
+		},
+		{
+			name: "org removed",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(destroyOwnerDataKeysStmt,
+					mock.WithQueryArgs("instance", "org1"),
+					mock.WithQueryResult([]string{"subject_id"}, [][]driver.Value{{"user1"}, {"user2"}}),
+				),
+			),
+			commands:  []eventstore.Command{command("test.org.removed", "org1")},
+			destroyed: []string{"user1", "user2"},
+		},
+		{
+			name: "instance removed",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(destroyInstanceDataKeysStmt,
+					mock.WithQueryArgs("instance"),
+					mock.WithQueryResult([]string{"subject_id"}, [][]driver.Value{{"user1"}, {"user3"}}),
+				),
+			),
+			commands:  []eventstore.Command{command("test.instance.removed", "instance")},
+			destroyed: []string{"user1", "user3"},
+		},
+		{
+			name: "destroy fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectBegin(nil),
+				mock.ExpectQuery(destroyOwnerDataKeysStmt,
+					mock.WithQueryErr(assert.AnError),
+				),
+			),
+			commands: []eventstore.Command{command("test.org.removed", "org1")},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				personalData: &personalData{keys: make(map[dataKeyID]*cachedDataKey)},
+			}
+			for _, subjectID := range []string{"user1", "user2", "user3"} {
+				es.personalData.cache("instance", subjectID, []byte("key"))
+			}
+			tx, err := tt.mock.DB.Begin()
+			require.NoError(t, err)
+
+			uncache, err := es.destroyPersonalData(context.Background(), tx, tt.commands)
+			if tt.wantErr {
+				require.Error(t, err)
+				tt.mock.Assert(t)
+				return
+			}
+			require.NoError(t, err)
+			uncache()
+			for _, subjectID := range []string{"user1", "user2", "user3"} {
+				if slices.Contains(tt.destroyed, subjectID) {
+					assert.Nil(t, es.personalData.cached("instance", subjectID), subjectID)
+					continue
+				}
+				assert.NotNil(t, es.personalData.cached("instance", subjectID), subjectID)
+			}
+			tt.mock.Assert(t)
+		})
+	}
+}
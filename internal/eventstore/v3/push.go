@@ -28,7 +28,8 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 	}
 	// tx is not closed because [crdb.ExecuteInTx] takes care of that
 	var (
-		sequences []*latestSequence
+		sequences   []*latestSequence
+		uncacheKeys func()
 	)
 
 	err = crdb.ExecuteInTx(ctx, &transaction{tx}, func() (err error) {
@@ -49,6 +50,14 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 			return err
 		}
 
+		if uncacheKeys, err = es.destroyPersonalData(inTxCtx, tx, commands); err != nil {
+			return err
+		}
+
+		if err = es.sendNotification(inTxCtx, tx, events); err != nil {
+			return err
+		}
+
 		// CockroachDB by default does not allow multiple modifications of the same table using ON CONFLICT
 		// Thats why we enable it manually
 		if es.client.Type() == "cockroach" {
@@ -64,6 +73,7 @@ func (es *Eventstore) Push(ctx context.Context, commands ...eventstore.Command)
 	if err != nil {
 		return nil, err
 	}
+	uncacheKeys()
 
 	return events, nil
 }
@@ -0,0 +1,48 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	loadSnapshotStmt = `SELECT "position", payload FROM eventstore.snapshots WHERE instance_id = $1 AND key = $2 AND version = $3`
+	// saveSnapshotStmt only overwrites older snapshots or snapshots of other versions
+	saveSnapshotStmt = `INSERT INTO eventstore.snapshots (instance_id, key, version, "position", payload, updated_at) VALUES ($1, $2, $3, $4, $5, now())` +
+		` ON CONFLICT (instance_id, key) DO UPDATE SET version = EXCLUDED.version, "position" = EXCLUDED."position", payload = EXCLUDED.payload, updated_at = EXCLUDED.updated_at` +
+		` WHERE snapshots.version <> EXCLUDED.version OR snapshots."position" < EXCLUDED."position"`
+)
+
+var _ eventstore.SnapshotStore = (*Eventstore)(nil)
+
+// LoadSnapshot implements [eventstore.SnapshotStore]
+func (es *Eventstore) LoadSnapshot(ctx context.Context, instanceID, key string, version uint16) (*eventstore.Snapshot, error) {
+	snapshot := &eventstore.Snapshot{
+		InstanceID: instanceID,
+		Key:        key,
+		Version:    version,
+	}
+	err := es.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&snapshot.Position, &snapshot.Payload)
+	}, loadSnapshotStmt, instanceID, key, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V3-ieS7o", "Errors.Internal")
+	}
+	return snapshot, nil
+}
+
+// SaveSnapshot implements [eventstore.SnapshotStore]
+func (es *Eventstore) SaveSnapshot(ctx context.Context, snapshot *eventstore.Snapshot) error {
+	_, err := es.client.ExecContext(ctx, saveSnapshotStmt, snapshot.InstanceID, snapshot.Key, snapshot.Version, snapshot.Position, snapshot.Payload)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "V3-Ahz2u", "Errors.Internal")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func TestEventstore_LoadSnapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    *mock.SQLMock
+		want    *eventstore.Snapshot
+		wantErr bool
+	}{
+		{
+			name: "not found",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(loadSnapshotStmt,
+					mock.WithQueryArgs("instance", "org:id", uint16(1)),
+					mock.WithQueryResult([]string{"position", "payload"}, nil),
+				),
+			),
+			want: nil,
+		},
+		{
+			name: "query fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(loadSnapshotStmt,
+					mock.WithQueryArgs("instance", "org:id", uint16(1)),
+					mock.WithQueryErr(assert.AnError),
+				),
+			),
+			wantErr: true,
+		},
+		{
+			name: "found",
+			mock: mock.NewSQLMock(t,
+				mock.ExpectQuery(loadSnapshotStmt,
+					mock.WithQueryArgs("instance", "org:id", uint16(1)),
+					mock.WithQueryResult([]string{"position", "payload"}, [][]driver.Value{
+						{float64(42.5), []byte(`{"state":{}}`)},
+					}),
+				),
+			),
+			want: &eventstore.Snapshot{
+				InstanceID: "instance",
+				Key:        "org:id",
+				Version:    1,
+				Position:   42.5,
+				Payload:    []byte(`{"state":{}}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			got, err := es.LoadSnapshot(context.Background(), "instance", "org:id", 1)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			tt.mock.Assert(t)
+		})
+	}
+}
+
+func TestEventstore_SaveSnapshot(t *testing.T) {
+	snapshot := &eventstore.Snapshot{
+		InstanceID: "instance",
+		Key:        "org:id",
+		Version:    1,
+		Position:   42.5,
+		Payload:    []byte(`{"state":{}}`),
+	}
+	tests := []struct {
+		name    string
+		mock    *mock.SQLMock
+		wantErr bool
+	}{
+		{
+			name: "saved",
+			mock: mock.NewSQLMock(t,
+				mock.ExcpectExec(saveSnapshotStmt,
+					mock.WithExecArgs("instance", "org:id", uint16(1), float64(42.5), []byte(`{"state":{}}`)),
+					mock.WithExecRowsAffected(1),
+				),
+			),
+		},
+		{
+			name: "exec fails",
+			mock: mock.NewSQLMock(t,
+				mock.ExcpectExec(saveSnapshotStmt,
+					mock.WithExecErr(assert.AnError),
+				),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &Eventstore{
+				client: &database.DB{DB: tt.mock.DB},
+			}
+			err := es.SaveSnapshot(context.Background(), snapshot)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			tt.mock.Assert(t)
+		})
+	}
+}
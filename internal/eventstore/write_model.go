@@ -20,6 +20,11 @@ func (rm *WriteModel) AppendEvents(events ...Event) {
 	rm.Events = append(rm.Events, events...)
 }
 
+// GetWriteModel returns the write model, it implements [SnapshotReducer]
+func (wm *WriteModel) GetWriteModel() *WriteModel {
+	return wm
+}
+
 // Reduce is the basic implementation of reducer
 // If this function is extended the extending function should be the last step
 func (wm *WriteModel) Reduce() error {
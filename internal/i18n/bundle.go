@@ -2,10 +2,12 @@ package i18n
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/fs"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/BurntSushi/toml"
@@ -15,6 +17,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const i18nPath = "/i18n"
@@ -64,17 +67,118 @@ func loadTranslationsFromFile(ns Namespace, fileInfo fs.FileInfo, dir http.FileS
 	content, err := io.ReadAll(file)
 	logging.WithFields("namespace", ns, "file", fileInfo.Name()).OnError(err).Panic("unable to read translation file")
 
+	messageFile, err := parseMessageFile(content, fileInfo.Name())
+	logging.WithFields("namespace", ns, "file", fileInfo.Name()).OnError(err).Panic("unable to parse translation file")
+
+	fileLang, _ := strings.CutSuffix(fileInfo.Name(), filepath.Ext(fileInfo.Name()))
+	lang := language.Make(fileLang)
+
+	translationMessages[ns][lang] = messageFile
+}
+
+func parseMessageFile(content []byte, name string) (*i18n.MessageFile, error) {
 	unmarshaler := map[string]i18n.UnmarshalFunc{
 		"yaml": func(data []byte, v interface{}) error { return yaml.Unmarshal(data, v) },
 		"json": json.Unmarshal,
 		"toml": toml.Unmarshal,
 	}
+	return i18n.ParseMessageFileBytes(content, name, unmarshaler)
+}
 
-	messageFile, err := i18n.ParseMessageFileBytes(content, fileInfo.Name(), unmarshaler)
-	logging.WithFields("namespace", ns, "file", fileInfo.Name()).OnError(err).Panic("unable to parse translation file")
+// ParseBundle parses a yaml language bundle and validates it against the english texts of the namespace.
+// The bundle must contain exactly the keys of the english texts.
+// Plural messages must at least provide the `other` form.
+// It is used to validate a bundle on upload, see [LoadBundle] for loading a stored bundle.
+func ParseBundle(ns Namespace, lang language.Tag, content []byte) ([]*i18n.Message, error) {
+	reference, ok := translationMessages[ns][language.English]
+	if !ok || lang == language.Und {
+		return nil, zerrors.ThrowInvalidArgument(nil, "I18N-ea2Ai", "Errors.LanguageBundle.Invalid")
+	}
+	file, err := parseMessageFile(content, lang.String()+".yaml")
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "I18N-Wai3o", "Errors.LanguageBundle.Invalid")
+	}
+	expected := make(map[string]struct{}, len(reference.Messages))
+	for _, message := range reference.Messages {
+		expected[message.ID] = struct{}{}
+	}
+	var unknown, invalid []string
+	for _, message := range file.Messages {
+		if _, ok := expected[message.ID]; !ok {
+			unknown = append(unknown, message.ID)
+			continue
+		}
+		delete(expected, message.ID)
+		if isPlural(message) && message.Other == "" {
+			invalid = append(invalid, message.ID)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return nil, zerrors.ThrowInvalidArgument(fmt.Errorf("unknown keys: %s", strings.Join(unknown, ", ")), "I18N-ahB4e", "Errors.LanguageBundle.UnknownKeys")
+	}
+	if len(expected) > 0 {
+		missing := make([]string, 0, len(expected))
+		for id := range expected {
+			missing = append(missing, id)
+		}
+		slices.Sort(missing)
+		return nil, zerrors.ThrowInvalidArgument(fmt.Errorf("missing keys: %s", strings.Join(missing, ", ")), "I18N-Eiph7", "Errors.LanguageBundle.MissingKeys")
+	}
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		return nil, zerrors.ThrowInvalidArgument(fmt.Errorf("plural form other missing: %s", strings.Join(invalid, ", ")), "I18N-ooT0a", "Errors.LanguageBundle.PluralOtherMissing")
+	}
+	return file.Messages, nil
+}
 
-	fileLang, _ := strings.CutSuffix(fileInfo.Name(), filepath.Ext(fileInfo.Name()))
-	lang := language.Make(fileLang)
+// Bundle is a parsed language bundle, which can be added to multiple translators of the namespace
+type Bundle struct {
+	Language language.Tag
+	messages []*i18n.Message
+}
 
-	translationMessages[ns][lang] = messageFile
+// LoadBundle parses a stored language bundle.
+// The keys of the english texts might have changed since the bundle was uploaded,
+// therefore unknown keys are ignored and missing keys fall back to the english texts.
+// Plural messages without the `other` form fall back to the english texts as well.
+func LoadBundle(ns Namespace, lang language.Tag, content []byte) (*Bundle, error) {
+	reference, ok := translationMessages[ns][language.English]
+	if !ok || lang == language.Und {
+		return nil, zerrors.ThrowInvalidArgument(nil, "I18N-Ohz4e", "Errors.LanguageBundle.Invalid")
+	}
+	file, err := parseMessageFile(content, lang.String()+".yaml")
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "I18N-Ahw5i", "Errors.LanguageBundle.Invalid")
+	}
+	translated := make(map[string]*i18n.Message, len(file.Messages))
+	for _, message := range file.Messages {
+		if isPlural(message) && message.Other == "" {
+			continue
+		}
+		translated[message.ID] = message
+	}
+	messages := make([]*i18n.Message, len(reference.Messages))
+	for i, message := range reference.Messages {
+		if translation, ok := translated[message.ID]; ok {
+			message = translation
+		}
+		messages[i] = message
+	}
+	return &Bundle{Language: lang, messages: messages}, nil
+}
+
+func isPlural(message *i18n.Message) bool {
+	return message.Zero != "" || message.One != "" || message.Two != "" || message.Few != "" || message.Many != ""
+}
+
+// BundleTemplate returns the english texts of the namespace,
+// which can be used as template to translate a language bundle.
+func BundleTemplate(ns Namespace) ([]byte, error) {
+	file, err := LoadFilesystem(ns).Open(i18nPath + "/" + language.English.String() + ".yaml")
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "I18N-ohT8i", "Errors.Internal")
+	}
+	defer file.Close()
+	return io.ReadAll(file)
 }
@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// BundleCache caches the parsed language bundles of a namespace per instance,
+// so the bundles are not loaded from the storage on every request.
+// A bundle is reloaded as soon as its sequence changes.
+type BundleCache struct {
+	namespace Namespace
+	mutex     sync.RWMutex
+	bundles   map[bundleCacheKey]*cachedBundle
+}
+
+type bundleCacheKey struct {
+	instanceID string
+	language   language.Tag
+}
+
+type cachedBundle struct {
+	sequence uint64
+	bundle   *Bundle
+}
+
+func NewBundleCache(namespace Namespace) *BundleCache {
+	return &BundleCache{
+		namespace: namespace,
+		bundles:   make(map[bundleCacheKey]*cachedBundle),
+	}
+}
+
+// Get returns the cached bundle of the language of the instance,
+// if it is not cached with the sequence, the content is loaded and parsed.
+func (c *BundleCache) Get(instanceID string, lang language.Tag, sequence uint64, content func() ([]byte, error)) (*Bundle, error) {
+	key := bundleCacheKey{instanceID: instanceID, language: lang}
+	c.mutex.RLock()
+	cached, ok := c.bundles[key]
+	c.mutex.RUnlock()
+	if ok && cached.sequence == sequence {
+		return cached.bundle, nil
+	}
+	data, err := content()
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := LoadBundle(c.namespace, lang, data)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if cached, ok = c.bundles[key]; !ok || cached.sequence < sequence {
+		c.bundles[key] = &cachedBundle{sequence: sequence, bundle: bundle}
+	}
+	return bundle, nil
+}
@@ -49,3 +49,29 @@ func MustLoadSupportedLanguagesFromDir() {
 		}
 	}
 }
+
+const (
+	DirectionLTR = "ltr"
+	DirectionRTL = "rtl"
+)
+
+var rtlScripts = []language.Script{
+	language.MustParseScript("Arab"),
+	language.MustParseScript("Hebr"),
+	language.MustParseScript("Thaa"),
+	language.MustParseScript("Syrc"),
+	language.MustParseScript("Nkoo"),
+	language.MustParseScript("Adlm"),
+	language.MustParseScript("Rohg"),
+}
+
+// Direction returns the text direction (`ltr` or `rtl`) of the language based on its (likely) script.
+func Direction(tag language.Tag) string {
+	script, _ := tag.Script()
+	for _, rtl := range rtlScripts {
+		if script == rtl {
+			return DirectionRTL
+		}
+	}
+	return DirectionLTR
+}
@@ -2,7 +2,9 @@ package i18n
 
 import (
 	"context"
+	"maps"
 	"net/http"
+	"slices"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
@@ -14,11 +16,14 @@ import (
 )
 
 type Translator struct {
+	namespace          Namespace
 	bundle             *i18n.Bundle
 	cookieName         string
 	cookieHandler      *http_util.CookieHandler
 	preferredLanguages []string
 	allowedLanguages   []language.Tag
+	// restricted is set if the allowed languages are restricted (e.g. by the restrictions of the instance)
+	restricted bool
 }
 
 type TranslatorConfig struct {
@@ -33,7 +38,7 @@ type Message struct {
 
 // NewZitadelTranslator translates to all supported languages, as the ZITADEL texts are not customizable.
 func NewZitadelTranslator(defaultLanguage language.Tag) (*Translator, error) {
-	return newTranslator(ZITADEL, defaultLanguage, SupportedLanguages(), "")
+	return newTranslator(ZITADEL, defaultLanguage, nil, "")
 }
 
 func NewNotificationTranslator(defaultLanguage language.Tag, allowedLanguages []language.Tag) (*Translator, error) {
@@ -47,7 +52,9 @@ func NewLoginTranslator(defaultLanguage language.Tag, allowedLanguages []languag
 func newTranslator(ns Namespace, defaultLanguage language.Tag, allowedLanguages []language.Tag, cookieName string) (*Translator, error) {
 	t := new(Translator)
 	var err error
+	t.namespace = ns
 	t.allowedLanguages = allowedLanguages
+	t.restricted = len(allowedLanguages) > 0
 	if len(t.allowedLanguages) == 0 {
 		t.allowedLanguages = SupportedLanguages()
 	}
@@ -78,6 +85,22 @@ func (t *Translator) AddMessages(tag language.Tag, messages ...Message) error {
 	return t.bundle.AddMessages(tag, i18nMessages...)
 }
 
+// AddBundle adds the messages of a language bundle uploaded at runtime, see [LoadBundle].
+// Languages which are not shipped with ZITADEL are allowed by adding their bundle,
+// unless the allowed languages are restricted. Bundles of languages which are not allowed are ignored.
+func (t *Translator) AddBundle(bundle *Bundle) error {
+	if t.restricted && !slices.Contains(t.allowedLanguages, bundle.Language) {
+		return nil
+	}
+	if err := t.bundle.AddMessages(bundle.Language, bundle.messages...); err != nil {
+		return err
+	}
+	if !slices.Contains(t.allowedLanguages, bundle.Language) {
+		t.allowedLanguages = append(slices.Clip(t.allowedLanguages), bundle.Language)
+	}
+	return nil
+}
+
 func (t *Translator) LocalizeFromRequest(r *http.Request, id string, args map[string]interface{}) string {
 	return localize(t.localizerFromRequest(r), id, args)
 }
@@ -94,6 +117,16 @@ func (t *Translator) LocalizeWithoutArgs(id string, langs ...string) string {
 	return localize(t.localizer(langs...), id, map[string]interface{}{})
 }
 
+// LocalizePlural selects the plural form of the message by the CLDR plural rules of the language.
+// The count is also passed to the template as `Count`, unless args already contain it.
+func (t *Translator) LocalizePlural(id string, count interface{}, args map[string]interface{}, langs ...string) string {
+	return localizePlural(t.localizer(langs...), id, count, args)
+}
+
+func (t *Translator) LocalizePluralFromRequest(r *http.Request, id string, count interface{}, args map[string]interface{}) string {
+	return localizePlural(t.localizerFromRequest(r), id, count, args)
+}
+
 func (t *Translator) Lang(r *http.Request) language.Tag {
 	matcher := language.NewMatcher(t.allowedLanguages)
 	tag, _ := language.MatchStrings(matcher, t.langsFromRequest(r)...)
@@ -163,3 +196,19 @@ func localize(localizer *i18n.Localizer, id string, args map[string]interface{})
 	}
 	return s
 }
+
+func localizePlural(localizer *i18n.Localizer, id string, count interface{}, args map[string]interface{}) string {
+	data := make(map[string]interface{}, len(args)+1)
+	data["Count"] = count
+	maps.Copy(data, args)
+	s, err := localizer.Localize(&i18n.LocalizeConfig{
+		MessageID:    id,
+		TemplateData: data,
+		PluralCount:  count,
+	})
+	if err != nil {
+		// messages without the required plural form fall back to their default text
+		return localize(localizer, id, data)
+	}
+	return s
+}
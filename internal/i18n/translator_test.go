@@ -0,0 +1,258 @@
+package i18n
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestDirection(t *testing.T) {
+	tests := []struct {
+		tag  language.Tag
+		want string
+	}{
+		{language.English, DirectionLTR},
+		{language.German, DirectionLTR},
+		{language.Korean, DirectionLTR},
+		{language.Ukrainian, DirectionLTR},
+		{language.Arabic, DirectionRTL},
+		{language.Hebrew, DirectionRTL},
+		{language.Persian, DirectionRTL},
+		{language.Make("ur"), DirectionRTL},
+		{language.Make("az-Arab"), DirectionRTL},
+		{language.Make("az-Latn"), DirectionLTR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, Direction(tt.tag))
+		})
+	}
+}
+
+func TestParseBundle(t *testing.T) {
+	english := string(bundleFromMessages(t, NOTIFICATION, nil))
+	template, err := BundleTemplate(LOGIN)
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		ns      Namespace
+		lang    language.Tag
+		content []byte
+		wantErr error
+	}{
+		{
+			name:    "invalid yaml",
+			ns:      NOTIFICATION,
+			lang:    language.Arabic,
+			content: []byte("InitCode: ["),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "I18N-Wai3o", "Errors.LanguageBundle.Invalid"),
+		},
+		{
+			name:    "undefined language",
+			ns:      NOTIFICATION,
+			lang:    language.Und,
+			content: []byte(english),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "I18N-ea2Ai", "Errors.LanguageBundle.Invalid"),
+		},
+		{
+			name:    "missing keys",
+			ns:      NOTIFICATION,
+			lang:    language.Arabic,
+			content: []byte("InitCode:\n  Title: title"),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "I18N-Eiph7", "Errors.LanguageBundle.MissingKeys"),
+		},
+		{
+			name:    "unknown keys",
+			ns:      NOTIFICATION,
+			lang:    language.Arabic,
+			content: []byte(english + "\nUnknown:\n  Title: title"),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "I18N-ahB4e", "Errors.LanguageBundle.UnknownKeys"),
+		},
+		{
+			name: "plural without other",
+			ns:   NOTIFICATION,
+			lang: language.Arabic,
+			content: bundleFromMessages(t, NOTIFICATION, map[string]string{
+				"InitCode.Title": "\n    one: one\n    few: few",
+			}),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "I18N-ooT0a", "Errors.LanguageBundle.PluralOtherMissing"),
+		},
+		{
+			name:    "ok",
+			ns:      NOTIFICATION,
+			lang:    language.Arabic,
+			content: []byte(english),
+		},
+		{
+			name:    "template ok",
+			ns:      LOGIN,
+			lang:    language.Hebrew,
+			content: template,
+		},
+		{
+			name: "ok with plural",
+			ns:   NOTIFICATION,
+			lang: language.Arabic,
+			content: bundleFromMessages(t, NOTIFICATION, map[string]string{
+				"InitCode.Title": "\n    one: one\n    few: few\n    other: other",
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBundle(tt.ns, tt.lang, tt.content)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTranslator_AddBundle(t *testing.T) {
+	SupportLanguages(language.English, language.German)
+	translator, err := NewNotificationTranslator(language.English, nil)
+	require.NoError(t, err)
+
+	bundle, err := LoadBundle(NOTIFICATION, language.Arabic, bundleFromMessages(t, NOTIFICATION, map[string]string{
+		"InitCode.Title": "\"مرحبا\"",
+	}))
+	require.NoError(t, err)
+	err = translator.AddBundle(bundle)
+	require.NoError(t, err)
+
+	assert.Equal(t, []language.Tag{language.English, language.German, language.Arabic}, translator.SupportedLanguages())
+	assert.Equal(t, "مرحبا", translator.LocalizeWithoutArgs("InitCode.Title", "ar"))
+	assert.NotEqual(t, "مرحبا", translator.LocalizeWithoutArgs("InitCode.Title", "en"))
+}
+
+func TestTranslator_AddBundle_restricted(t *testing.T) {
+	SupportLanguages(language.English, language.German)
+	translator, err := NewNotificationTranslator(language.English, []language.Tag{language.English})
+	require.NoError(t, err)
+
+	bundle, err := LoadBundle(NOTIFICATION, language.Arabic, bundleFromMessages(t, NOTIFICATION, map[string]string{
+		"InitCode.Title": "\"مرحبا\"",
+	}))
+	require.NoError(t, err)
+	err = translator.AddBundle(bundle)
+	require.NoError(t, err)
+
+	assert.Equal(t, []language.Tag{language.English}, translator.SupportedLanguages())
+	assert.NotEqual(t, "مرحبا", translator.LocalizeWithoutArgs("InitCode.Title", "ar"))
+}
+
+func TestLoadBundle(t *testing.T) {
+	SupportLanguages(language.English, language.German)
+	translator, err := NewNotificationTranslator(language.English, nil)
+	require.NoError(t, err)
+
+	// the bundle was uploaded before keys were added or removed
+	content := []byte("InitCode:\n  Title: \"مرحبا\"\n  Removed: text\nVerifyEmail:\n  Title:\n    one: one\n")
+	_, err = ParseBundle(NOTIFICATION, language.Arabic, content)
+	require.Error(t, err)
+
+	bundle, err := LoadBundle(NOTIFICATION, language.Arabic, content)
+	require.NoError(t, err)
+	require.NoError(t, translator.AddBundle(bundle))
+
+	assert.Equal(t, "مرحبا", translator.LocalizeWithoutArgs("InitCode.Title", "ar"))
+	// missing keys and plural messages without other fall back to english
+	assert.Equal(t, translator.LocalizeWithoutArgs("InitCode.Subject", "en"), translator.LocalizeWithoutArgs("InitCode.Subject", "ar"))
+	assert.Equal(t, translator.LocalizeWithoutArgs("VerifyEmail.Title", "en"), translator.LocalizeWithoutArgs("VerifyEmail.Title", "ar"))
+	assert.Equal(t, "InitCode.Removed", translator.LocalizeWithoutArgs("InitCode.Removed", "ar"))
+
+	_, err = LoadBundle(NOTIFICATION, language.Und, content)
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+}
+
+func TestBundleCache_Get(t *testing.T) {
+	cache := NewBundleCache(NOTIFICATION)
+	var loaded int
+	content := func() ([]byte, error) {
+		loaded++
+		return []byte("InitCode:\n  Title: title\n"), nil
+	}
+
+	first, err := cache.Get("instance", language.Arabic, 1, content)
+	require.NoError(t, err)
+	cached, err := cache.Get("instance", language.Arabic, 1, content)
+	require.NoError(t, err)
+	assert.Same(t, first, cached)
+	assert.Equal(t, 1, loaded)
+
+	// a changed bundle and other instances are loaded
+	_, err = cache.Get("instance", language.Arabic, 2, content)
+	require.NoError(t, err)
+	_, err = cache.Get("other", language.Arabic, 1, content)
+	require.NoError(t, err)
+	assert.Equal(t, 3, loaded)
+
+	_, err = cache.Get("instance", language.Hebrew, 1, func() ([]byte, error) {
+		return nil, zerrors.ThrowInternal(nil, "id", "failed")
+	})
+	assert.Error(t, err)
+}
+
+func TestTranslator_LocalizePlural(t *testing.T) {
+	SupportLanguages(language.English, language.German)
+	translator, err := NewNotificationTranslator(language.English, nil)
+	require.NoError(t, err)
+	bundle, err := LoadBundle(NOTIFICATION, language.Arabic, bundleFromMessages(t, NOTIFICATION, map[string]string{
+		"InitCode.Title": "\n    zero: zero\n    one: one\n    two: two\n    few: few {{.Count}}\n    many: many {{.Count}}\n    other: other {{.Count}}",
+	}))
+	require.NoError(t, err)
+	err = translator.AddBundle(bundle)
+	require.NoError(t, err)
+
+	tests := []struct {
+		count interface{}
+		want  string
+	}{
+		{0, "zero"},
+		{1, "one"},
+		{2, "two"},
+		{3, "few 3"},
+		{11, "many 11"},
+		{100, "other 100"},
+		{"1.5", "other 1.5"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, translator.LocalizePlural("InitCode.Title", tt.count, nil, "ar"))
+	}
+	// messages without plural forms fall back to their text
+	assert.Equal(t, translator.LocalizeWithoutArgs("InitCode.Subject", "en"), translator.LocalizePlural("InitCode.Subject", 1, nil, "en"))
+}
+
+// bundleFromMessages renders the english texts of the namespace as a yaml bundle,
+// the texts of the passed (leaf) ids are replaced by the passed raw yaml values
+func bundleFromMessages(t *testing.T, ns Namespace, overwrites map[string]string) []byte {
+	t.Helper()
+	var b strings.Builder
+	written := make(map[string]bool)
+	ids := make([]string, 0, len(translationMessages[ns][language.English].Messages))
+	for _, message := range translationMessages[ns][language.English].Messages {
+		ids = append(ids, message.ID)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		parts := strings.Split(id, ".")
+		for i := range parts[:len(parts)-1] {
+			prefix := strings.Join(parts[:i+1], ".")
+			if written[prefix] {
+				continue
+			}
+			written[prefix] = true
+			b.WriteString(strings.Repeat("  ", i) + parts[i] + ":\n")
+		}
+		value, ok := overwrites[id]
+		if !ok {
+			value = "text"
+		}
+		b.WriteString(strings.Repeat("  ", len(parts)-1) + parts[len(parts)-1] + ": " + value + "\n")
+	}
+	return []byte(b.String())
+}
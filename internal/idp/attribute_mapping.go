@@ -0,0 +1,167 @@
+package idp
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// RawAttributesUser is implemented by users, which are not able to provide their
+// raw attributes (SAML) or claims (OIDC / OAuth) by marshalling them to JSON
+type RawAttributesUser interface {
+	GetRawAttributes() map[string]any
+}
+
+// RawAttributes returns the raw attributes or claims of the federated user
+func RawAttributes(user User) map[string]any {
+	if u, ok := user.(RawAttributesUser); ok {
+		return u.GetRawAttributes()
+	}
+	data, err := json.Marshal(user)
+	if err != nil {
+		return nil
+	}
+	attributes := make(map[string]any)
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil
+	}
+	return attributes
+}
+
+// MapExternalUser maps the federated user to an external user of the identity provider
+// and applies the attribute mappings on it.
+func MapExternalUser(idpID string, user User, mappings []domain.IDPAttributeMapping) *domain.ExternalUser {
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:       idpID,
+		ExternalUserID:    user.GetID(),
+		PreferredUsername: user.GetPreferredUsername(),
+		DisplayName:       user.GetDisplayName(),
+		FirstName:         user.GetFirstName(),
+		LastName:          user.GetLastName(),
+		NickName:          user.GetNickname(),
+		Email:             user.GetEmail(),
+		IsEmailVerified:   user.IsEmailVerified(),
+		PreferredLanguage: user.GetPreferredLanguage(),
+		Phone:             user.GetPhone(),
+		IsPhoneVerified:   user.IsPhoneVerified(),
+	}
+	ApplyAttributeMappings(externalUser, user, mappings)
+	return externalUser
+}
+
+// ApplyAttributeMappings overwrites the information of the external user
+// with the attributes of the federated user as defined by the mappings.
+// Mappings of attributes, which are not provided by the identity provider, are ignored.
+func ApplyAttributeMappings(externalUser *domain.ExternalUser, user User, mappings []domain.IDPAttributeMapping) {
+	if len(mappings) == 0 {
+		return
+	}
+	attributes := RawAttributes(user)
+	for _, mapping := range mappings {
+		values := attributeValues(attributes, mapping.Source)
+		if len(values) == 0 {
+			continue
+		}
+		for i, value := range values {
+			values[i] = mapping.Transform.Apply(value)
+		}
+		applyAttributeMapping(externalUser, mapping, values)
+	}
+}
+
+func applyAttributeMapping(externalUser *domain.ExternalUser, mapping domain.IDPAttributeMapping, values []string) {
+	// profile fields can only hold a single value, so the first one is used
+	value := values[0]
+	switch mapping.Target {
+	case domain.IDPAttributeMappingTargetFirstName:
+		externalUser.FirstName = value
+	case domain.IDPAttributeMappingTargetLastName:
+		externalUser.LastName = value
+	case domain.IDPAttributeMappingTargetDisplayName:
+		externalUser.DisplayName = value
+	case domain.IDPAttributeMappingTargetNickName:
+		externalUser.NickName = value
+	case domain.IDPAttributeMappingTargetPreferredUsername:
+		externalUser.PreferredUsername = value
+	case domain.IDPAttributeMappingTargetEmail:
+		externalUser.Email = domain.EmailAddress(value)
+	case domain.IDPAttributeMappingTargetEmailVerified:
+		externalUser.IsEmailVerified, _ = strconv.ParseBool(value)
+	case domain.IDPAttributeMappingTargetPhone:
+		externalUser.Phone = domain.PhoneNumber(value)
+	case domain.IDPAttributeMappingTargetPhoneVerified:
+		externalUser.IsPhoneVerified, _ = strconv.ParseBool(value)
+	case domain.IDPAttributeMappingTargetPreferredLanguage:
+		if tag, err := language.Parse(value); err == nil {
+			externalUser.PreferredLanguage = tag
+		}
+	case domain.IDPAttributeMappingTargetMetadata:
+		setExternalUserMetadata(externalUser, mapping.Key, []byte(strings.Join(values, ",")))
+	case domain.IDPAttributeMappingTargetSchemaProperty,
+		domain.IDPAttributeMappingTargetUnspecified:
+	}
+}
+
+func setExternalUserMetadata(externalUser *domain.ExternalUser, key string, value []byte) {
+	for _, metadata := range externalUser.Metadatas {
+		if metadata.Key == key {
+			metadata.Value = value
+			return
+		}
+	}
+	externalUser.Metadatas = append(externalUser.Metadatas, &domain.Metadata{Key: key, Value: value})
+}
+
+// attributeValues returns the values of the attribute.
+// The source is looked up as is first, since SAML attribute names are often URIs containing dots.
+// Otherwise, it's used as dot separated path to address nested claims.
+func attributeValues(attributes map[string]any, source string) []string {
+	if value, ok := attributes[source]; ok {
+		return stringValues(value)
+	}
+	path := strings.Split(source, ".")
+	var value any = attributes
+	for _, key := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if value, ok = object[key]; !ok {
+			return nil
+		}
+	}
+	return stringValues(value)
+}
+
+func stringValues(value any) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{v}
+	case []string:
+		return append([]string(nil), v...)
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case int:
+		return []string{strconv.Itoa(v)}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, stringValues(item)...)
+		}
+		return values
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return []string{string(data)}
+	}
+}
@@ -0,0 +1,139 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+type rawAttributesUser struct {
+	User
+	attributes map[string]any
+}
+
+func (u *rawAttributesUser) GetRawAttributes() map[string]any {
+	return u.attributes
+}
+
+func TestApplyAttributeMappings(t *testing.T) {
+	type args struct {
+		externalUser *domain.ExternalUser
+		attributes   map[string]any
+		mappings     []domain.IDPAttributeMapping
+	}
+	tests := []struct {
+		name string
+		args args
+		want *domain.ExternalUser
+	}{
+		{
+			"no mappings, unchanged",
+			args{
+				externalUser: &domain.ExternalUser{FirstName: "firstname"},
+				attributes:   map[string]any{"given_name": "changed"},
+			},
+			&domain.ExternalUser{FirstName: "firstname"},
+		},
+		{
+			"missing attribute, unchanged",
+			args{
+				externalUser: &domain.ExternalUser{FirstName: "firstname"},
+				attributes:   map[string]any{},
+				mappings: []domain.IDPAttributeMapping{
+					{Source: "given_name", Target: domain.IDPAttributeMappingTargetFirstName},
+				},
+			},
+			&domain.ExternalUser{FirstName: "firstname"},
+		},
+		{
+			"saml attributes, mapped",
+			args{
+				externalUser: &domain.ExternalUser{},
+				attributes: map[string]any{
+					"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname": []string{"First"},
+					"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn":       []string{"User@Example.com"},
+					"memberOf": []string{"admins", "users"},
+				},
+				mappings: []domain.IDPAttributeMapping{
+					{
+						Source: "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+						Target: domain.IDPAttributeMappingTargetFirstName,
+					},
+					{
+						Source:    "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn",
+						Target:    domain.IDPAttributeMappingTargetPreferredUsername,
+						Transform: domain.IDPAttributeTransformRemoveDomain,
+					},
+					{
+						Source: "memberOf",
+						Target: domain.IDPAttributeMappingTargetMetadata,
+						Key:    "groups",
+					},
+				},
+			},
+			&domain.ExternalUser{
+				FirstName:         "First",
+				PreferredUsername: "User",
+				Metadatas: []*domain.Metadata{
+					{Key: "groups", Value: []byte("admins,users")},
+				},
+			},
+		},
+		{
+			"nested claims, mapped",
+			args{
+				externalUser: &domain.ExternalUser{
+					Metadatas: []*domain.Metadata{
+						{Key: "department", Value: []byte("old")},
+					},
+				},
+				attributes: map[string]any{
+					"contact": map[string]any{
+						"mail":          "USER@example.com",
+						"mail_verified": true,
+					},
+					"locale":     "de-CH",
+					"department": "IT",
+				},
+				mappings: []domain.IDPAttributeMapping{
+					{
+						Source:    "contact.mail",
+						Target:    domain.IDPAttributeMappingTargetEmail,
+						Transform: domain.IDPAttributeTransformLowercase,
+					},
+					{
+						Source: "contact.mail_verified",
+						Target: domain.IDPAttributeMappingTargetEmailVerified,
+					},
+					{
+						Source: "locale",
+						Target: domain.IDPAttributeMappingTargetPreferredLanguage,
+					},
+					{
+						Source:    "department",
+						Target:    domain.IDPAttributeMappingTargetMetadata,
+						Key:       "department",
+						Transform: domain.IDPAttributeTransformLowercase,
+					},
+				},
+			},
+			&domain.ExternalUser{
+				Email:             "user@example.com",
+				IsEmailVerified:   true,
+				PreferredLanguage: language.MustParse("de-CH"),
+				Metadatas: []*domain.Metadata{
+					{Key: "department", Value: []byte("it")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyAttributeMappings(tt.args.externalUser, &rawAttributesUser{attributes: tt.args.attributes}, tt.args.mappings)
+			assert.Equal(t, tt.want, tt.args.externalUser)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package ldapsync periodically synchronizes the users of LDAP directories into ZITADEL
+// for all LDAP identity providers with enabled directory synchronization.
+package ldapsync
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// SyncUserID is set as editor of all events created by the synchronization
+	SyncUserID = "LDAP-SYNC"
+
+	lockNamePrefix = "ldap_sync_"
+)
+
+type Config struct {
+	// Enabled starts the synchronization in the background
+	Enabled bool
+	// RequeueEvery defines how often the identity providers are checked for a due synchronization.
+	// It's also the minimal interval between two synchronizations of the same identity provider.
+	RequeueEvery time.Duration
+	// DefaultInterval is used for identity providers without a configured sync interval
+	DefaultInterval time.Duration
+	// PageSize is the amount of entries requested per page from the directory
+	PageSize uint32
+}
+
+type Queries interface {
+	LDAPSyncIDPs(ctx context.Context) ([]*query.LDAPSyncIDP, error)
+	InstanceByID(ctx context.Context, id string) (authz.Instance, error)
+}
+
+type Commands interface {
+	SyncLDAPProvider(ctx context.Context, resourceOwner, idpID string, pageSize uint32, dryRun bool) (*domain.LDAPSyncReport, error)
+}
+
+type Synchronizer struct {
+	config    Config
+	queries   Queries
+	commands  Commands
+	newLocker func(idpID string) crdb.Locker
+}
+
+func New(config Config, queries Queries, commands Commands, client *sql.DB) *Synchronizer {
+	return &Synchronizer{
+		config:   config,
+		queries:  queries,
+		commands: commands,
+		// a new locker (and therefore locker id) is used for every synchronization,
+		// so the lock is held for the whole interval and prevents other runs, including the ones of the same instance
+		newLocker: func(idpID string) crdb.Locker {
+			return crdb.NewLocker(client, projection.LocksTable, lockNamePrefix+idpID)
+		},
+	}
+}
+
+// Start runs the synchronization in the background until the context is done
+func (s *Synchronizer) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+	go s.run(ctx)
+}
+
+func (s *Synchronizer) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			s.syncAll(ctx)
+			timer.Reset(s.config.RequeueEvery)
+		}
+	}
+}
+
+func (s *Synchronizer) syncAll(ctx context.Context) {
+	idps, err := s.queries.LDAPSyncIDPs(ctx)
+	if err != nil {
+		logging.WithError(err).Warn("unable to query ldap identity providers for directory synchronization")
+		return
+	}
+	for _, idp := range idps {
+		if ctx.Err() != nil {
+			return
+		}
+		s.sync(ctx, idp)
+	}
+}
+
+// sync synchronizes the directory of the identity provider if no other synchronization
+// was executed within the interval, which is ensured by a lock held for the whole interval
+func (s *Synchronizer) sync(ctx context.Context, idp *query.LDAPSyncIDP) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := s.newLocker(idp.IDPID).Lock(ctx, s.interval(idp), idp.InstanceID)
+	err, ok := <-errs
+	if err != nil || !ok {
+		if !zerrors.IsErrorAlreadyExists(err) {
+			logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID).OnError(err).Warn("unable to lock ldap directory synchronization")
+		}
+		return
+	}
+	// the lock is renewed in the background as long as the synchronization is running
+	go func() {
+		for err := range errs {
+			logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID).OnError(err).Debug("unable to renew lock of ldap directory synchronization")
+		}
+	}()
+
+	instance, err := s.queries.InstanceByID(ctx, idp.InstanceID)
+	if err != nil {
+		logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID).WithError(err).Warn("unable to get instance for ldap directory synchronization")
+		return
+	}
+	ctx = authz.SetCtxData(authz.WithInstance(ctx, instance), authz.CtxData{UserID: SyncUserID})
+	report, err := s.commands.SyncLDAPProvider(ctx, "", idp.IDPID, s.config.PageSize, false)
+	if err != nil {
+		logging.WithFields("instance", idp.InstanceID, "idp", idp.IDPID).WithError(err).Warn("ldap directory synchronization failed")
+		return
+	}
+	logReport(idp.InstanceID, report)
+}
+
+func (s *Synchronizer) interval(idp *query.LDAPSyncIDP) time.Duration {
+	interval := idp.SyncInterval
+	if interval <= 0 {
+		interval = s.config.DefaultInterval
+	}
+	if interval < s.config.RequeueEvery {
+		interval = s.config.RequeueEvery
+	}
+	return interval
+}
+
+func logReport(instanceID string, report *domain.LDAPSyncReport) {
+	for _, user := range report.Users {
+		if user.Action != domain.LDAPSyncActionFailed {
+			continue
+		}
+		logging.WithFields("instance", instanceID, "idp", report.IDPID, "user", user.UserID, "externalUser", user.ExternalUserID).WithError(user.Error).Warn("ldap directory synchronization of user failed")
+	}
+	logging.WithFields(
+		"instance", instanceID,
+		"idp", report.IDPID,
+		"created", report.Count(domain.LDAPSyncActionCreated),
+		"updated", report.Count(domain.LDAPSyncActionUpdated),
+		"deactivated", report.Count(domain.LDAPSyncActionDeactivated),
+		"reactivated", report.Count(domain.LDAPSyncActionReactivated),
+		"failed", report.Count(domain.LDAPSyncActionFailed),
+	).Info("ldap directory synchronized")
+}
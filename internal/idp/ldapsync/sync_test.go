@@ -0,0 +1,126 @@
+package ldapsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/crdb"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testLocker struct {
+	err      error
+	duration time.Duration
+}
+
+func (l *testLocker) Lock(ctx context.Context, lockDuration time.Duration, _ ...string) <-chan error {
+	l.duration = lockDuration
+	errs := make(chan error)
+	go func() {
+		errs <- l.err
+		<-ctx.Done()
+		close(errs)
+	}()
+	return errs
+}
+
+func (l *testLocker) Unlock(...string) error {
+	return nil
+}
+
+type testQueries struct {
+	idps []*query.LDAPSyncIDP
+}
+
+func (q *testQueries) LDAPSyncIDPs(context.Context) ([]*query.LDAPSyncIDP, error) {
+	return q.idps, nil
+}
+
+func (q *testQueries) InstanceByID(_ context.Context, id string) (authz.Instance, error) {
+	return &testInstance{id: id}, nil
+}
+
+type testInstance struct {
+	authz.Instance
+	id string
+}
+
+func (i *testInstance) InstanceID() string {
+	return i.id
+}
+
+type testCommands struct {
+	synced []string
+}
+
+func (c *testCommands) SyncLDAPProvider(ctx context.Context, _, idpID string, _ uint32, dryRun bool) (*domain.LDAPSyncReport, error) {
+	c.synced = append(c.synced, authz.GetInstance(ctx).InstanceID()+"/"+idpID)
+	return &domain.LDAPSyncReport{IDPID: idpID, DryRun: dryRun}, nil
+}
+
+func TestSynchronizer_syncAll(t *testing.T) {
+	tests := []struct {
+		name         string
+		idps         []*query.LDAPSyncIDP
+		lockErr      error
+		wantSynced   []string
+		wantDuration time.Duration
+	}{
+		{
+			name:         "lock acquired, synced",
+			idps:         []*query.LDAPSyncIDP{{InstanceID: "instance1", IDPID: "idp1", SyncInterval: time.Hour}},
+			wantSynced:   []string{"instance1/idp1"},
+			wantDuration: time.Hour,
+		},
+		{
+			name:         "no interval, default interval",
+			idps:         []*query.LDAPSyncIDP{{InstanceID: "instance1", IDPID: "idp1"}},
+			wantSynced:   []string{"instance1/idp1"},
+			wantDuration: 24 * time.Hour,
+		},
+		{
+			name:         "interval below requeue, requeue interval",
+			idps:         []*query.LDAPSyncIDP{{InstanceID: "instance1", IDPID: "idp1", SyncInterval: time.Second}},
+			wantSynced:   []string{"instance1/idp1"},
+			wantDuration: time.Minute,
+		},
+		{
+			name:         "already locked, not synced",
+			idps:         []*query.LDAPSyncIDP{{InstanceID: "instance1", IDPID: "idp1", SyncInterval: time.Hour}},
+			lockErr:      zerrors.ThrowAlreadyExists(nil, "TEST-lOck1", "already locked"),
+			wantDuration: time.Hour,
+		},
+		{
+			name:         "lock failed, not synced",
+			idps:         []*query.LDAPSyncIDP{{InstanceID: "instance1", IDPID: "idp1", SyncInterval: time.Hour}},
+			lockErr:      zerrors.ThrowInternal(nil, "TEST-lOck2", "lock failed"),
+			wantDuration: time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locker := &testLocker{err: tt.lockErr}
+			commands := new(testCommands)
+			s := &Synchronizer{
+				config: Config{
+					RequeueEvery:    time.Minute,
+					DefaultInterval: 24 * time.Hour,
+				},
+				queries:  &testQueries{idps: tt.idps},
+				commands: commands,
+				newLocker: func(string) crdb.Locker {
+					return locker
+				},
+			}
+			s.syncAll(context.Background())
+			assert.Equal(t, tt.wantSynced, commands.synced)
+			assert.Equal(t, tt.wantDuration, locker.duration)
+		})
+	}
+}
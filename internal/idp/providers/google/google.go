@@ -45,3 +45,9 @@ type User struct {
 func (u *User) GetPreferredUsername() string {
 	return string(u.GetEmail())
 }
+
+// GetRawAttributes implements the [idp.RawAttributesUser] interface.
+// It returns the claims of the wrapped [idp.User].
+func (u *User) GetRawAttributes() map[string]any {
+	return idp.RawAttributes(u.User)
+}
@@ -0,0 +1,147 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	// DefaultSyncPageSize is used if no page size is provided for [Provider.SearchUsers]
+	DefaultSyncPageSize uint32 = 500
+
+	// userAccountControlAttribute is the Active Directory attribute containing the account flags
+	userAccountControlAttribute = "userAccountControl"
+	// accountDisabledFlag is set in the userAccountControl if the account is disabled in Active Directory
+	accountDisabledFlag = 0x2
+)
+
+var ErrNoServerReachable = errors.New("no ldap server reachable")
+
+// SyncUser is a user returned by a directory search of the [Provider]
+type SyncUser struct {
+	*User
+	// Disabled is set if the account is disabled in the directory (e.g. Active Directory userAccountControl)
+	Disabled bool
+}
+
+// ValidateFilter checks if the filter is a valid LDAP search filter
+func ValidateFilter(filter string) error {
+	_, err := ldap.CompileFilter(filter)
+	return err
+}
+
+// SearchUsers pages through the directory using the bind user and returns all users
+// matching the object classes of the provider and the (optional) additional filter.
+func (p *Provider) SearchUsers(ctx context.Context, filter string, pageSize uint32) (users []*SyncUser, err error) {
+	if pageSize == 0 {
+		pageSize = DefaultSyncPageSize
+	}
+	for _, server := range p.servers {
+		users, err = p.searchUsers(ctx, server, filter, pageSize)
+		if err == nil {
+			return users, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	if err == nil {
+		err = ErrNoServerReachable
+	}
+	return nil, err
+}
+
+func (p *Provider) searchUsers(ctx context.Context, server, filter string, pageSize uint32) ([]*SyncUser, error) {
+	conn, err := getConnection(server, p.startTLS, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(p.bindDN, p.bindPassword); err != nil {
+		return nil, err
+	}
+
+	paging := ldap.NewControlPaging(pageSize)
+	searchRequest := ldap.NewSearchRequest(
+		p.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		syncSearchQuery(p.userObjectClasses, filter),
+		append(p.getNecessaryAttributes(), userAccountControlAttribute),
+		[]ldap.Control{paging},
+	)
+
+	users := make([]*SyncUser, 0, pageSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := conn.Search(searchRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range result.Entries {
+			user, err := p.mapEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			users = append(users, &SyncUser{
+				User:     user,
+				Disabled: isDisabled(entry),
+			})
+		}
+		pagingResult, ok := ldap.FindControl(result.Controls, ldap.ControlTypePaging).(*ldap.ControlPaging)
+		if !ok || len(pagingResult.Cookie) == 0 {
+			return users, nil
+		}
+		paging.SetCookie(pagingResult.Cookie)
+	}
+}
+
+func (p *Provider) mapEntry(entry *ldap.Entry) (*User, error) {
+	return mapLDAPEntryToUser(
+		entry,
+		p.idAttribute,
+		p.firstNameAttribute,
+		p.lastNameAttribute,
+		p.displayNameAttribute,
+		p.nickNameAttribute,
+		p.preferredUsernameAttribute,
+		p.emailAttribute,
+		p.emailVerifiedAttribute,
+		p.phoneAttribute,
+		p.phoneVerifiedAttribute,
+		p.preferredLanguageAttribute,
+		p.avatarURLAttribute,
+		p.profileAttribute,
+	)
+}
+
+func syncSearchQuery(objectClasses []string, filter string) string {
+	queries := make([]string, 0, len(objectClasses)+1)
+	for _, class := range objectClasses {
+		queries = append(queries, objectClassesToSearchQuery([]string{class}))
+	}
+	if filter != "" {
+		queries = append(queries, filter)
+	}
+	if len(queries) == 0 {
+		return "(objectClass=*)"
+	}
+	return queriesAndToSearchQuery(queries...)
+}
+
+func isDisabled(entry *ldap.Entry) bool {
+	value := entry.GetAttributeValue(userAccountControlAttribute)
+	if value == "" {
+		return false
+	}
+	flags, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	return flags&accountDisabledFlag != 0
+}
@@ -0,0 +1,97 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_syncSearchQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		objectClasses []string
+		filter        string
+		want          string
+	}{
+		{
+			name: "no object classes and filter",
+			want: "(objectClass=*)",
+		},
+		{
+			name:          "one object class",
+			objectClasses: []string{"user"},
+			want:          "(objectClass=user)",
+		},
+		{
+			name:          "multiple object classes",
+			objectClasses: []string{"user", "person"},
+			want:          "(&(objectClass=user)(objectClass=person))",
+		},
+		{
+			name:          "object classes and filter",
+			objectClasses: []string{"user", "person"},
+			filter:        "(memberOf=cn=zitadel,dc=example,dc=com)",
+			want:          "(&(objectClass=user)(objectClass=person)(memberOf=cn=zitadel,dc=example,dc=com))",
+		},
+		{
+			name:   "only filter",
+			filter: "(uid=*)",
+			want:   "(uid=*)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := syncSearchQuery(tt.objectClasses, tt.filter)
+			assert.Equal(t, tt.want, query)
+			assert.NoError(t, ValidateFilter(query))
+		})
+	}
+}
+
+func TestProvider_isDisabled(t *testing.T) {
+	tests := []struct {
+		name  string
+		value []string
+		want  bool
+	}{
+		{
+			name: "no attribute",
+			want: false,
+		},
+		{
+			name:  "normal account",
+			value: []string{"512"},
+			want:  false,
+		},
+		{
+			name:  "disabled account",
+			value: []string{"514"},
+			want:  true,
+		},
+		{
+			name:  "disabled, password never expires",
+			value: []string{"66050"},
+			want:  true,
+		},
+		{
+			name:  "invalid value",
+			value: []string{"invalid"},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := map[string][]string{}
+			if tt.value != nil {
+				attributes[userAccountControlAttribute] = tt.value
+			}
+			assert.Equal(t, tt.want, isDisabled(ldap.NewEntry("cn=user,dc=example,dc=com", attributes)))
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	assert.NoError(t, ValidateFilter("(&(objectClass=user)(!(cn=admin)))"))
+	assert.Error(t, ValidateFilter("objectClass=user)"))
+}
@@ -108,3 +108,8 @@ func (u *UserMapper) GetAvatarURL() string {
 func (u *UserMapper) GetProfile() string {
 	return ""
 }
+
+// GetRawAttributes is an implementation of the [idp.RawAttributesUser] interface.
+func (u *UserMapper) GetRawAttributes() map[string]any {
+	return u.RawInfo
+}
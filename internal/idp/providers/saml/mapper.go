@@ -87,3 +87,12 @@ func (u *UserMapper) GetAvatarURL() string {
 func (u *UserMapper) GetProfile() string {
 	return ""
 }
+
+// GetRawAttributes is an implementation of the [idp.RawAttributesUser] interface.
+func (u *UserMapper) GetRawAttributes() map[string]any {
+	attributes := make(map[string]any, len(u.Attributes))
+	for name, values := range u.Attributes {
+		attributes[name] = values
+	}
+	return attributes
+}
@@ -5,13 +5,16 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
 )
 
@@ -26,26 +29,33 @@ type deliveryMetrics struct {
 	email string
 	sms   string
 	json  string
+	push  string
 }
 
 type channels struct {
-	q        *handlers.NotificationQueries
-	counters counters
+	q          *handlers.NotificationQueries
+	commands   *command.Commands
+	pushConfig push.Config
+	counters   counters
 }
 
-func newChannels(q *handlers.NotificationQueries) *channels {
+func newChannels(q *handlers.NotificationQueries, commands *command.Commands, pushConfig push.Config) *channels {
 	c := &channels{
-		q: q,
+		q:          q,
+		commands:   commands,
+		pushConfig: pushConfig,
 		counters: counters{
 			success: deliveryMetrics{
 				email: "successful_deliveries_email",
 				sms:   "successful_deliveries_sms",
 				json:  "successful_deliveries_json",
+				push:  "successful_deliveries_push",
 			},
 			failed: deliveryMetrics{
 				email: "failed_deliveries_email",
 				sms:   "failed_deliveries_sms",
 				json:  "failed_deliveries_json",
+				push:  "failed_deliveries_push",
 			},
 		},
 	}
@@ -55,6 +65,8 @@ func newChannels(q *handlers.NotificationQueries) *channels {
 	registerCounter(c.counters.failed.sms, "Failed SMS deliveries")
 	registerCounter(c.counters.success.json, "Successfully delivered JSON messages")
 	registerCounter(c.counters.failed.json, "Failed JSON message deliveries")
+	registerCounter(c.counters.success.push, "Successfully delivered push challenges")
+	registerCounter(c.counters.failed.push, "Failed push challenge deliveries")
 	return c
 }
 
@@ -63,8 +75,8 @@ func registerCounter(counter, desc string) {
 	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
 }
 
-func (c *channels) Email(ctx context.Context) (*senders.Chain, *email.Config, error) {
-	emailCfg, err := c.q.GetActiveEmailConfig(ctx)
+func (c *channels) Email(ctx context.Context, resourceOwner string) (*senders.Chain, *email.Config, error) {
+	emailCfg, err := c.q.GetActiveEmailConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,8 +91,8 @@ func (c *channels) Email(ctx context.Context) (*senders.Chain, *email.Config, er
 	return chain, emailCfg, err
 }
 
-func (c *channels) SMS(ctx context.Context) (*senders.Chain, *sms.Config, error) {
-	smsCfg, err := c.q.GetActiveSMSConfig(ctx)
+func (c *channels) SMS(ctx context.Context, resourceOwner string) (*senders.Chain, *sms.Config, error) {
+	smsCfg, err := c.q.GetActiveSMSConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,3 +128,20 @@ func (c *channels) SecurityTokenEvent(ctx context.Context, cfg set.Config) (*sen
 		c.counters.failed.json,
 	)
 }
+
+func (c *channels) Push(ctx context.Context) (*senders.Chain, *push.Config, error) {
+	chain, err := senders.PushChannels(
+		ctx,
+		c.pushConfig,
+		c.q.GetFileSystemProvider,
+		c.q.GetLogProvider,
+		c.counters.success.push,
+		c.counters.failed.push,
+	)
+	return chain, &c.pushConfig, err
+}
+
+func (c *channels) NotificationDelivered(ctx context.Context, resourceOwner string, delivery *notification.Delivery, err error) {
+	recordErr := c.commands.NotificationDelivered(ctx, resourceOwner, delivery, err)
+	logging.WithFields("user", delivery.UserID, "messageType", delivery.MessageType).OnError(recordErr).Warn("unable to record notification delivery")
+}
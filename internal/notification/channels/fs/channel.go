@@ -43,6 +43,8 @@ func InitFSChannel(config Config) (channels.NotificationChannel, error) {
 			fileName = fileName + "sms_to_" + msg.RecipientPhoneNumber + ".txt"
 		case *messages.JSON:
 			fileName = "message.json"
+		case *messages.Push:
+			fileName = fileName + "push_to_" + msg.UserID + ".json"
 		default:
 			return zerrors.ThrowUnimplementedf(nil, "NOTIF-6f9a1", "filesystem provider doesn't support message type %T", message)
 		}
@@ -0,0 +1,74 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestInitChannel(t *testing.T) {
+	var (
+		gotHeader http.Header
+		gotBody   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	channel, err := InitChannel(context.Background(), Config{
+		CallURL: server.URL,
+		Headers: http.Header{"Authorization": []string{"Bearer token"}},
+	})
+	require.NoError(t, err)
+
+	err = channel.HandleMessage(&messages.Push{
+		Devices:   []messages.PushDevice{{ID: "device1", Token: "token1"}},
+		SessionID: "session1",
+		UserID:    "user1",
+		Challenge: "challenge",
+		Title:     "title",
+		Body:      "body",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", gotHeader.Get("Authorization"))
+	assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	assert.Equal(t, map[string]any{
+		"devices": []any{
+			map[string]any{"id": "device1", "token": "token1"},
+		},
+		"sessionId": "session1",
+		"userId":    "user1",
+		"challenge": "challenge",
+		"title":     "title",
+		"body":      "body",
+	}, payload)
+}
+
+func TestInitChannel_errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := InitChannel(context.Background(), Config{})
+	require.Error(t, err, "missing call url")
+
+	channel, err := InitChannel(context.Background(), Config{CallURL: server.URL})
+	require.NoError(t, err)
+	assert.Error(t, channel.HandleMessage(&messages.Push{}), "error status")
+	assert.Error(t, channel.HandleMessage(&messages.JSON{}), "wrong message type")
+}
@@ -0,0 +1,23 @@
+package push
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Config of the HTTP push provider.
+// The challenge is posted as JSON to the CallURL, which relays it to the devices (e.g. through FCM or APNs).
+type Config struct {
+	CallURL string
+	Headers http.Header
+}
+
+func (c *Config) Validate() error {
+	if c.CallURL == "" {
+		return zerrors.ThrowPreconditionFailed(nil, "PUSH-ooc4X", "push provider not configured")
+	}
+	_, err := url.Parse(c.CallURL)
+	return err
+}
@@ -14,11 +14,17 @@ type Commands interface {
 	PasswordCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	HumanOTPSMSCodeSent(ctx context.Context, userID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
 	HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error
+	HumanMagicLinkCodeSent(ctx context.Context, userID, resourceOwner string) error
 	OTPSMSSent(ctx context.Context, sessionID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
 	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error
+	MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error
+	LoginRiskNotified(ctx context.Context, sessionID, resourceOwner string) error
+	PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
+	SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) error
+	CustomNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	InviteCodeSent(ctx context.Context, orgID, userID string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
@@ -9,12 +9,14 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetSMTPConfig reads the iam SMTP provider config
-func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.Config, error) {
-	config, err := n.SMTPConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+// GetActiveEmailConfig reads the active SMTP provider config of the organization (resource owner)
+// and falls back to the active provider of the instance if the organization has none.
+func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context, resourceOwner string) (*email.Config, error) {
+	config, err := n.activeSMTPConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +56,17 @@ func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.
 	}
 	return nil, zerrors.ThrowNotFound(err, "QUERY-KPQleOckOV", "Errors.SMTPConfig.NotFound")
 }
+
+func (n *NotificationQueries) activeSMTPConfig(ctx context.Context, resourceOwner string) (*query.SMTPConfig, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if resourceOwner != "" && resourceOwner != instanceID {
+		config, err := n.SMTPConfigActive(ctx, resourceOwner)
+		if err == nil {
+			return config, nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return n.SMTPConfigActive(ctx, instanceID)
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetActiveSMSConfig reads the active iam sms provider config
-func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Config, error) {
-	config, err := n.SMSProviderConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+// GetActiveSMSConfig reads the active sms provider config of the organization (resource owner)
+// and falls back to the active provider of the instance if the organization has none.
+func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context, resourceOwner string) (*sms.Config, error) {
+	config, err := n.activeSMSConfig(ctx, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -51,3 +53,26 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 
 	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 }
+
+func (n *NotificationQueries) activeSMSConfig(ctx context.Context, resourceOwner string) (*query.SMSConfig, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	instanceConfig, instanceErr := n.SMSProviderConfigActive(ctx, instanceID)
+	if instanceErr != nil && !zerrors.IsNotFound(instanceErr) {
+		return nil, instanceErr
+	}
+	// codes of an instance using Twilio Verify are created and checked by the verify service of the instance,
+	// so they must be sent through it as well
+	if instanceErr == nil && instanceConfig.TwilioConfig != nil && instanceConfig.TwilioConfig.VerifyServiceSID != "" {
+		return instanceConfig, nil
+	}
+	if resourceOwner != "" && resourceOwner != instanceID {
+		config, err := n.SMSProviderConfigActive(ctx, resourceOwner)
+		if err == nil {
+			return config, nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return instanceConfig, instanceErr
+}
@@ -0,0 +1,11 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/notification/types"
+)
+
+func (n *NotificationQueries) MailTemplate(ctx context.Context, orgID string) (types.MailTemplate, error) {
+	return types.GetMailTemplate(ctx, n.Queries, orgID)
+}
@@ -43,6 +43,20 @@ func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
 	return m.recorder
 }
 
+// CustomNotificationSent mocks base method.
+func (m *MockCommands) CustomNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomNotificationSent", ctx, orgID, userID, messageType, triggerSequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomNotificationSent indicates an expected call of CustomNotificationSent.
+func (mr *MockCommandsMockRecorder) CustomNotificationSent(ctx, orgID, userID, messageType, triggerSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomNotificationSent", reflect.TypeOf((*MockCommands)(nil).CustomNotificationSent), ctx, orgID, userID, messageType, triggerSequence)
+}
+
 // HumanEmailVerificationCodeSent mocks base method.
 func (m *MockCommands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string) error {
 	m.ctrl.T.Helper()
@@ -71,6 +85,20 @@ func (mr *MockCommandsMockRecorder) HumanInitCodeSent(ctx, orgID, userID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanInitCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanInitCodeSent), ctx, orgID, userID)
 }
 
+// HumanMagicLinkCodeSent mocks base method.
+func (m *MockCommands) HumanMagicLinkCodeSent(ctx context.Context, userID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HumanMagicLinkCodeSent", ctx, userID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HumanMagicLinkCodeSent indicates an expected call of HumanMagicLinkCodeSent.
+func (mr *MockCommandsMockRecorder) HumanMagicLinkCodeSent(ctx, userID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanMagicLinkCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanMagicLinkCodeSent), ctx, userID, resourceOwner)
+}
+
 // HumanOTPEmailCodeSent mocks base method.
 func (m *MockCommands) HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error {
 	m.ctrl.T.Helper()
@@ -141,6 +169,34 @@ func (mr *MockCommandsMockRecorder) InviteCodeSent(ctx, orgID, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCodeSent", reflect.TypeOf((*MockCommands)(nil).InviteCodeSent), ctx, orgID, userID)
 }
 
+// LoginRiskNotified mocks base method.
+func (m *MockCommands) LoginRiskNotified(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginRiskNotified", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoginRiskNotified indicates an expected call of LoginRiskNotified.
+func (mr *MockCommandsMockRecorder) LoginRiskNotified(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginRiskNotified", reflect.TypeOf((*MockCommands)(nil).LoginRiskNotified), ctx, sessionID, resourceOwner)
+}
+
+// MagicLinkSent mocks base method.
+func (m *MockCommands) MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MagicLinkSent", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MagicLinkSent indicates an expected call of MagicLinkSent.
+func (mr *MockCommandsMockRecorder) MagicLinkSent(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MagicLinkSent", reflect.TypeOf((*MockCommands)(nil).MagicLinkSent), ctx, sessionID, resourceOwner)
+}
+
 // MilestonePushed mocks base method.
 func (m *MockCommands) MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error {
 	m.ctrl.T.Helper()
@@ -211,6 +267,34 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(ctx, orgID, userID, generat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), ctx, orgID, userID, generatorInfo)
 }
 
+// PushChallengeSent mocks base method.
+func (m *MockCommands) PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushChallengeSent", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushChallengeSent indicates an expected call of PushChallengeSent.
+func (mr *MockCommandsMockRecorder) PushChallengeSent(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushChallengeSent", reflect.TypeOf((*MockCommands)(nil).PushChallengeSent), ctx, sessionID, resourceOwner)
+}
+
+// SecurityNotificationSent mocks base method.
+func (m *MockCommands) SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string, triggerSequence uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityNotificationSent", ctx, orgID, userID, messageType, triggerSequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityNotificationSent indicates an expected call of SecurityNotificationSent.
+func (mr *MockCommandsMockRecorder) SecurityNotificationSent(ctx, orgID, userID, messageType, triggerSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityNotificationSent", reflect.TypeOf((*MockCommands)(nil).SecurityNotificationSent), ctx, orgID, userID, messageType, triggerSequence)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
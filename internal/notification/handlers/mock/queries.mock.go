@@ -17,6 +17,7 @@ import (
 	jose "github.com/go-jose/go-jose/v4"
 	authz "github.com/zitadel/zitadel/internal/api/authz"
 	domain "github.com/zitadel/zitadel/internal/domain"
+	i18n "github.com/zitadel/zitadel/internal/i18n"
 	query "github.com/zitadel/zitadel/internal/query"
 	gomock "go.uber.org/mock/gomock"
 	language "golang.org/x/text/language"
@@ -165,6 +166,21 @@ func (mr *MockQueriesMockRecorder) InstanceByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceByID", reflect.TypeOf((*MockQueries)(nil).InstanceByID), ctx, id)
 }
 
+// LanguageBundles mocks base method.
+func (m *MockQueries) LanguageBundles(ctx context.Context, namespace i18n.Namespace) ([]*query.LanguageBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LanguageBundles", ctx, namespace)
+	ret0, _ := ret[0].([]*query.LanguageBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LanguageBundles indicates an expected call of LanguageBundles.
+func (mr *MockQueriesMockRecorder) LanguageBundles(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LanguageBundles", reflect.TypeOf((*MockQueries)(nil).LanguageBundles), ctx, namespace)
+}
+
 // MailTemplateByOrg mocks base method.
 func (m *MockQueries) MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +196,21 @@ func (mr *MockQueriesMockRecorder) MailTemplateByOrg(ctx, orgID, withOwnerRemove
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MailTemplateByOrg), ctx, orgID, withOwnerRemoved)
 }
 
+// MessageTemplateByOrg mocks base method.
+func (m *MockQueries) MessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MessageTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageTemplateByOrg", ctx, orgID, messageType, lang)
+	ret0, _ := ret[0].(*query.MessageTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MessageTemplateByOrg indicates an expected call of MessageTemplateByOrg.
+func (mr *MockQueriesMockRecorder) MessageTemplateByOrg(ctx, orgID, messageType, lang any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MessageTemplateByOrg), ctx, orgID, messageType, lang)
+}
+
 // NotificationPolicyByOrg mocks base method.
 func (m *MockQueries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (*query.NotificationPolicy, error) {
 	m.ctrl.T.Helper()
@@ -195,6 +226,21 @@ func (mr *MockQueriesMockRecorder) NotificationPolicyByOrg(ctx, shouldTriggerBul
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationPolicyByOrg", reflect.TypeOf((*MockQueries)(nil).NotificationPolicyByOrg), ctx, shouldTriggerBulk, orgID, withOwnerRemoved)
 }
 
+// NotificationPreferencesByUserID mocks base method.
+func (m *MockQueries) NotificationPreferencesByUserID(ctx context.Context, userID, resourceOwner string) (*domain.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationPreferencesByUserID", ctx, userID, resourceOwner)
+	ret0, _ := ret[0].(*domain.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationPreferencesByUserID indicates an expected call of NotificationPreferencesByUserID.
+func (mr *MockQueriesMockRecorder) NotificationPreferencesByUserID(ctx, userID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationPreferencesByUserID", reflect.TypeOf((*MockQueries)(nil).NotificationPreferencesByUserID), ctx, userID, resourceOwner)
+}
+
 // NotificationProviderByIDAndType mocks base method.
 func (m *MockQueries) NotificationProviderByIDAndType(ctx context.Context, aggID string, providerType domain.NotificationProviderType) (*query.DebugNotificationProvider, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TriggeringEvent returns the event which originally caused the notification to be sent
+func (n *NotificationQueries) TriggeringEvent(ctx context.Context, e *notification.ResendRequestedEvent) (eventstore.Event, error) {
+	events, err := n.es.Filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(e.Aggregate().InstanceID).
+		SequenceGreater(e.TriggeringSequence-1).
+		OrderAsc().
+		Limit(1).
+		AddQuery().
+		AggregateTypes(e.TriggeringAggregateType).
+		AggregateIDs(e.TriggeringAggregateID).
+		EventTypes(e.TriggeringEventType).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 || events[0].Sequence() != e.TriggeringSequence {
+		return nil, zerrors.ThrowNotFound(nil, "HANDL-Iet3a", "Errors.Notification.NotFound")
+	}
+	return events[0], nil
+}
+
+// reduceNotificationResendRequested executes the reducer of the event which originally caused the notification again,
+// codes which were already sent or are expired in the meantime will not be resent
+func (u *userNotifier) reduceNotificationResendRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*notification.ResendRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ooC4e", "reduce.wrong.event.type %s", notification.ResendRequestedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		triggeringEvent, err := u.queries.TriggeringEvent(ctx, e)
+		if err != nil {
+			return err
+		}
+		reduce := u.eventReducer(triggeringEvent.Aggregate().Type, triggeringEvent.Type())
+		if reduce == nil {
+			return nil
+		}
+		stmt, err := reduce(triggeringEvent)
+		if err != nil {
+			return err
+		}
+		if stmt.Execute == nil {
+			return nil
+		}
+		return stmt.Execute(ex, projectionName)
+	}), nil
+}
+
+func (u *userNotifier) eventReducer(aggregateType eventstore.AggregateType, eventType eventstore.EventType) handler.Reduce {
+	for _, aggregateReducer := range u.Reducers() {
+		if aggregateReducer.Aggregate != aggregateType || aggregateType == notification.AggregateType {
+			continue
+		}
+		for _, eventReducer := range aggregateReducer.EventReducers {
+			if eventReducer.Event == eventType {
+				return eventReducer.Reduce
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_userNotifier_reduceNotificationResendRequested(t *testing.T) {
+	resendRequested := func() *notification.ResendRequestedEvent {
+		return &notification.ResendRequestedEvent{
+			BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+				AggregateID:   notification.ID(userID, 5),
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+			}),
+			TriggeringAggregateType: user.AggregateType,
+			TriggeringAggregateID:   userID,
+			TriggeringEventType:     user.HumanEmailCodeAddedType,
+			TriggeringSequence:      5,
+		}
+	}
+	tests := []struct {
+		name    string
+		es      func(t *testing.T) *eventstore.Eventstore
+		event   eventstore.Event
+		wantErr func(error) bool
+	}{
+		{
+			name: "wrong event type",
+			es: func(t *testing.T) *eventstore.Eventstore {
+				return eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).MockQuerier,
+				})
+			},
+			event: &user.DomainClaimedEvent{
+				BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+					AggregateID:   userID,
+					ResourceOwner: sql.NullString{String: orgID},
+				}),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "triggering event not found",
+			es: func(t *testing.T) *eventstore.Eventstore {
+				return eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+				})
+			},
+			event:   resendRequested(),
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "triggering event without reducer, ok",
+			es: func(t *testing.T) *eventstore.Eventstore {
+				return eventstore.NewEventstore(&eventstore.Config{
+					Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+						eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateType: user.AggregateType,
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							Typ:           user.HumanAddedType,
+							Seq:           5,
+						}),
+					).MockQuerier,
+				})
+			},
+			event: &notification.ResendRequestedEvent{
+				BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+					AggregateID:   notification.ID(userID, 5),
+					ResourceOwner: sql.NullString{String: orgID},
+				}),
+				TriggeringAggregateType: user.AggregateType,
+				TriggeringAggregateID:   userID,
+				TriggeringEventType:     user.HumanAddedType,
+				TriggeringSequence:      5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			u := newUserNotifier(t, ctrl, queries, fields{
+				queries:  queries,
+				commands: commands,
+				es:       tt.es(t),
+			}, args{event: tt.event}, want{})
+			stmt, err := u.reduceNotificationResendRequested(tt.event)
+			if err != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			err = stmt.Execute(nil, "")
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// previousEmailReadModel is the email address of a user before the triggering event was created
+type previousEmailReadModel struct {
+	eventstore.WriteModel
+
+	sequence uint64
+
+	Address  domain.EmailAddress
+	Verified bool
+}
+
+func (p *previousEmailReadModel) Reduce() error {
+	for _, event := range p.Events {
+		if event.Sequence() >= p.sequence {
+			break
+		}
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			p.Address = e.EmailAddress
+			p.Verified = false
+		case *user.HumanRegisteredEvent:
+			p.Address = e.EmailAddress
+			p.Verified = false
+		case *user.HumanEmailChangedEvent:
+			p.Address = e.EmailAddress
+			p.Verified = false
+		case *user.HumanEmailVerifiedEvent:
+			p.Verified = true
+		}
+	}
+	return p.WriteModel.Reduce()
+}
+
+func (p *previousEmailReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(p.InstanceID).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(p.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1EmailChangedType,
+			user.HumanEmailChangedType,
+			user.UserV1EmailVerifiedType,
+			user.HumanEmailVerifiedType,
+		).
+		Builder()
+}
+
+// previousEmail returns the email address of the user which was set before the event.
+func (n *NotificationQueries) previousEmail(ctx context.Context, event eventstore.Event) (*previousEmailReadModel, error) {
+	previous := &previousEmailReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: event.Aggregate().ID,
+			InstanceID:  event.Aggregate().InstanceID,
+		},
+		sequence: event.Sequence(),
+	}
+	if err := n.es.FilterToQueryReducer(ctx, previous); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
@@ -11,12 +11,15 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
 )
 
 type Queries interface {
 	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
 	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+	MessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MessageTemplate, error)
 	GetNotifyUserByID(ctx context.Context, shouldTriggered bool, userID string) (*query.NotifyUser, error)
 	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
 	SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error)
@@ -24,11 +27,13 @@ type Queries interface {
 	NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (*query.NotificationPolicy, error)
 	SearchMilestones(ctx context.Context, instanceIDs []string, queries *query.MilestonesSearchQueries) (*query.Milestones, error)
 	NotificationProviderByIDAndType(ctx context.Context, aggID string, providerType domain.NotificationProviderType) (*query.DebugNotificationProvider, error)
+	NotificationPreferencesByUserID(ctx context.Context, userID, resourceOwner string) (*domain.NotificationPreferences, error)
 	SMSProviderConfigActive(ctx context.Context, resourceOwner string) (config *query.SMSConfig, err error)
 	SMTPConfigActive(ctx context.Context, resourceOwner string) (*query.SMTPConfig, error)
 	GetDefaultLanguage(ctx context.Context) language.Tag
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
 	InstanceByID(ctx context.Context, id string) (instance authz.Instance, err error)
+	LanguageBundles(ctx context.Context, namespace i18n.Namespace) ([]*query.LanguageBundle, error)
 	GetActiveSigningWebKey(ctx context.Context) (*jose.JSONWebKey, error)
 	ActivePrivateSigningKey(ctx context.Context, t time.Time) (keys *query.PrivateKeys, err error)
 }
@@ -36,6 +41,7 @@ type Queries interface {
 type NotificationQueries struct {
 	Queries
 	es                 *eventstore.Eventstore
+	staticStorage      static.Storage
 	externalDomain     string
 	externalPort       uint16
 	externalSecure     bool
@@ -48,6 +54,7 @@ type NotificationQueries struct {
 func NewNotificationQueries(
 	baseQueries Queries,
 	es *eventstore.Eventstore,
+	staticStorage static.Storage,
 	externalDomain string,
 	externalPort uint16,
 	externalSecure bool,
@@ -59,6 +66,7 @@ func NewNotificationQueries(
 	return &NotificationQueries{
 		Queries:            baseQueries,
 		es:                 es,
+		staticStorage:      staticStorage,
 		externalDomain:     externalDomain,
 		externalPort:       externalPort,
 		externalSecure:     externalSecure,
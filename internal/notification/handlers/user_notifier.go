@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,9 +12,11 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -107,10 +110,66 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailCodeAddedType,
 					Reduce: u.reduceOTPEmailCodeAdded,
 				},
+				{
+					Event:  user.HumanMagicLinkCodeAddedType,
+					Reduce: u.reduceMagicLinkCodeAdded,
+				},
 				{
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
+				{
+					Event:  user.HumanMFAOTPVerifiedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanOTPSMSAddedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanOTPEmailAddedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanU2FTokenVerifiedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanMFAOTPRemovedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanOTPSMSRemovedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanOTPEmailRemovedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanU2FTokenRemovedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenVerifiedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanPhoneChangedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: u.reduceSecurityNotification,
+				},
+				{
+					Event:  user.HumanCustomNotificationRequestedType,
+					Reduce: u.reduceCustomNotificationRequested,
+				},
 			},
 		},
 		{
@@ -124,6 +183,27 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.PushChallengedType,
+					Reduce: u.reduceSessionPushChallenged,
+				},
+				{
+					Event:  session.MagicLinkChallengedType,
+					Reduce: u.reduceSessionMagicLinkChallenged,
+				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: u.reduceSessionRiskEvaluated,
+				},
+			},
+		},
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.ResendRequestedType,
+					Reduce: u.reduceNotificationResendRequested,
+				},
 			},
 		},
 	}
@@ -155,7 +235,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -173,7 +253,7 @@ func (u *userNotifier) reduceInitCodeAdded(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -212,7 +292,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -230,7 +310,7 @@ func (u *userNotifier) reduceEmailCodeAdded(event eventstore.Event) (*handler.St
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -271,7 +351,7 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -289,9 +369,18 @@ func (u *userNotifier) reducePasswordCodeAdded(event eventstore.Event) (*handler
 		if err != nil {
 			return err
 		}
+		notificationType := e.NotificationType
+		// without a code the SMS provider verifies the code itself, so the channel can't be changed
+		if e.Code != nil {
+			preferences, err := u.queries.NotificationPreferencesByUserID(ctx, notifyUser.ID, notifyUser.ResourceOwner)
+			if err != nil {
+				return err
+			}
+			notificationType = preferences.CodeChannel(notificationType, u.codeChannelAvailable(ctx, notifyUser), e.CreatedAt())
+		}
 		generatorInfo := new(senders.CodeGeneratorInfo)
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
-		if e.NotificationType == domain.NotificationTypeSms {
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
+		if notificationType == domain.NotificationTypeSms {
 			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, generatorInfo)
 		}
 		err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
@@ -487,7 +576,7 @@ func (u *userNotifier) reduceOTPEmail(
 		return nil, err
 	}
 
-	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+	template, err := u.queries.MailTemplate(ctx, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -508,7 +597,7 @@ func (u *userNotifier) reduceOTPEmail(
 	if err != nil {
 		return nil, err
 	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
+	notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event)
 	err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
 	if err != nil {
 		return nil, err
@@ -520,6 +609,165 @@ func (u *userNotifier) reduceOTPEmail(
 	return handler.NewNoOpStatement(event), nil
 }
 
+func (u *userNotifier) reduceMagicLinkCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMagicLinkCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohj1u", "reduce.wrong.event.type %s", user.HumanMagicLinkCodeAddedType)
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+		user.HumanMagicLinkCodeAddedType, user.HumanMagicLinkCodeSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return handler.NewNoOpStatement(e), nil
+	}
+	code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, e.Aggregate().ResourceOwner, domain.MagicLinkMessageType)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = u.queries.Origin(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	var authRequestID string
+	if e.AuthRequestInfo != nil {
+		authRequestID = e.AuthRequestInfo.ID
+	}
+	err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+		SendMagicLink(ctx, notifyUser, code, authRequestID, e.Expiry)
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.HumanMagicLinkCodeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewNoOpStatement(e), nil
+}
+
+func (u *userNotifier) reduceSessionMagicLinkChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MagicLinkChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ied5a", "reduce.wrong.event.type %s", session.MagicLinkChallengedType)
+	}
+	if e.ReturnCode {
+		return handler.NewNoOpStatement(e), nil
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+		session.MagicLinkChallengedType, session.MagicLinkSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return handler.NewNoOpStatement(e), nil
+	}
+	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
+	if err != nil {
+		return nil, err
+	}
+	code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, s.UserFactor.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplate(ctx, s.UserFactor.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, s.UserFactor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, s.UserFactor.ResourceOwner, domain.MagicLinkMessageType)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = u.queries.Origin(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	var url strings.Builder
+	if err = domain.RenderMagicLinkURLTemplate(&url, e.URLTmpl, code, notifyUser.ID, notifyUser.PreferredLoginName, notifyUser.DisplayName, e.Aggregate().ID, notifyUser.PreferredLanguage); err != nil {
+		return nil, err
+	}
+	err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+		SendMagicLinkURL(ctx, url.String(), e.Expiry)
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.MagicLinkSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewNoOpStatement(e), nil
+}
+
+func (u *userNotifier) reduceSessionPushChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.PushChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Quah9", "reduce.wrong.event.type %s", session.PushChallengedType)
+	}
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+		session.PushChallengedType, session.PushSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return handler.NewNoOpStatement(e), nil
+	}
+	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
+	if err != nil {
+		return nil, err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, s.UserFactor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PushChallengeMessageType)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]messages.PushDevice, len(e.Devices))
+	for i, device := range e.Devices {
+		devices[i] = messages.PushDevice{
+			ID:    device.ID,
+			Token: device.Token,
+		}
+	}
+	err = types.SendPush(ctx, u.channels, translator, notifyUser, e.Aggregate().ID, e.Challenge, devices, e)
+	if err != nil {
+		return nil, err
+	}
+	err = u.commands.PushChallengeSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewNoOpStatement(e), nil
+}
+
 func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.DomainClaimedEvent)
 	if !ok {
@@ -540,7 +788,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -558,7 +806,7 @@ func (u *userNotifier) reduceDomainClaimed(event eventstore.Event) (*handler.Sta
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendDomainClaimed(ctx, notifyUser, e.UserName)
 		if err != nil {
 			return err
@@ -594,7 +842,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -612,7 +860,7 @@ func (u *userNotifier) reducePasswordlessCodeRequested(event eventstore.Event) (
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
 		if err != nil {
 			return err
@@ -654,7 +902,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -671,7 +919,7 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
 			SendPasswordChange(ctx, notifyUser)
 		if err != nil {
 			return err
@@ -680,6 +928,290 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}), nil
 }
 
+func (u *userNotifier) reduceSessionRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.RiskEvaluatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Xoh4e", "reduce.wrong.event.type %s", session.RiskEvaluatedType)
+	}
+	if e.Action != domain.LoginRiskActionNotify {
+		return u.reduceNewDeviceLogin(e)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, session.RiskNotifiedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.UserResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplate(ctx, e.UserResourceOwner)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if err != nil {
+			return err
+		}
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.LoginRiskMessageType)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e).
+			SendLoginRisk(ctx, notifyUser)
+		if err != nil {
+			return err
+		}
+		return u.commands.LoginRiskNotified(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	}), nil
+}
+
+// reduceNewDeviceLogin informs the user about a login from a new device,
+// if the login risk policy did not already notify the user or block the login.
+func (u *userNotifier) reduceNewDeviceLogin(e *session.RiskEvaluatedEvent) (*handler.Statement, error) {
+	if e.Action == domain.LoginRiskActionBlock || !slices.Contains(e.Signals, domain.LoginRiskSignalNewDevice) {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(e, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(e.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, e, nil, session.RiskNotifiedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		sent, err := u.sendSecurityNotification(ctx, e, e.UserID, e.UserResourceOwner, domain.NewDeviceLoginMessageType, "")
+		if err != nil || !sent {
+			return err
+		}
+		return u.commands.LoginRiskNotified(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	}), nil
+}
+
+func (u *userNotifier) reduceSecurityNotification(event eventstore.Event) (*handler.Statement, error) {
+	messageType := securityNotificationMessageType(event)
+	if messageType == "" {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ohB4u", "reduce.wrong.event.type %s", event.Type())
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event,
+			map[string]interface{}{
+				"messageType":     messageType,
+				"triggerSequence": event.Sequence(),
+			},
+			user.HumanSecurityNotificationSentType,
+		)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		var recipient domain.EmailAddress
+		if changed, ok := event.(*user.HumanEmailChangedEvent); ok {
+			// the notification about a changed email is sent to the previous address, which must have been verified
+			previous, err := u.queries.previousEmail(ctx, event)
+			if err != nil {
+				return err
+			}
+			if !previous.Verified || previous.Address == "" || previous.Address.Normalize() == changed.EmailAddress.Normalize() {
+				return nil
+			}
+			recipient = previous.Address
+		}
+		sent, err := u.sendSecurityNotification(ctx, event, event.Aggregate().ID, event.Aggregate().ResourceOwner, messageType, recipient)
+		if err != nil || !sent {
+			return err
+		}
+		return u.commands.SecurityNotificationSent(ctx, event.Aggregate().ResourceOwner, event.Aggregate().ID, messageType, event.Sequence())
+	}), nil
+}
+
+// sendSecurityNotification sends the message to the user, if security notifications are enabled by the notification policy.
+// If a recipient is provided, the message is sent to it instead of the current email address of the user.
+func (u *userNotifier) sendSecurityNotification(ctx context.Context, event eventstore.Event, userID, resourceOwner, messageType string, recipient domain.EmailAddress) (bool, error) {
+	notificationPolicy, err := u.queries.NotificationPolicyByOrg(ctx, true, resourceOwner, false)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !notificationPolicy.SecurityNotification {
+		return false, nil
+	}
+
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+	if err != nil {
+		return false, err
+	}
+
+	template, err := u.queries.MailTemplate(ctx, resourceOwner)
+	if err != nil {
+		return false, err
+	}
+
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+	if err != nil {
+		return false, err
+	}
+	if recipient != "" {
+		notifyUser.LastEmail = string(recipient)
+		notifyUser.VerifiedEmail = string(recipient)
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, messageType)
+	if err != nil {
+		return false, err
+	}
+	ctx, err = u.queries.Origin(ctx, event)
+	if err != nil {
+		return false, err
+	}
+	err = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, event).
+		SendSecurityNotification(ctx, notifyUser, messageType)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (u *userNotifier) reduceCustomNotificationRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanCustomNotificationRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Thai4", "reduce.wrong.event.type %s", user.HumanCustomNotificationRequestedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event,
+			map[string]interface{}{
+				"messageType":     e.MessageType,
+				"triggerSequence": event.Sequence(),
+			},
+			user.HumanCustomNotificationSentType,
+		)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		preferences, err := u.queries.NotificationPreferencesByUserID(ctx, notifyUser.ID, notifyUser.ResourceOwner)
+		if err != nil {
+			return err
+		}
+		if preferences.OptedOut(e.MessageType) {
+			return nil
+		}
+		channel := e.Channel
+		if channel == domain.NotificationTypeSms && preferences.SMSQuiet(e.CreatedAt()) {
+			if notifyUser.VerifiedEmail == "" {
+				return nil
+			}
+			channel = domain.NotificationTypeEmail
+		}
+
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, e.MessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		var notify types.Notify
+		switch channel {
+		case domain.NotificationTypeSms:
+			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, new(senders.CodeGeneratorInfo))
+		case domain.NotificationTypeEmail:
+			template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
+			if err != nil {
+				return err
+			}
+			notify = types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
+		default:
+			return zerrors.ThrowInvalidArgument(nil, "HANDL-Ieph0", "Errors.CustomNotificationType.InvalidChannel")
+		}
+		if err = notify.SendCustomNotification(e.URL, e.Args, e.MessageType); err != nil {
+			return err
+		}
+		return u.commands.CustomNotificationSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, e.MessageType, e.Sequence())
+	}), nil
+}
+
+// codeChannelAvailable checks if a code can be sent to the user on the channel.
+// The user needs a verified address and the SMS provider must not generate its own codes (e.g. Twilio Verify),
+// since the code was already generated by ZITADEL.
+func (u *userNotifier) codeChannelAvailable(ctx context.Context, notifyUser *query.NotifyUser) func(domain.NotificationType) bool {
+	return func(notificationType domain.NotificationType) bool {
+		switch notificationType {
+		case domain.NotificationTypeEmail:
+			return notifyUser.VerifiedEmail != ""
+		case domain.NotificationTypeSms:
+			if notifyUser.VerifiedPhone == "" {
+				return false
+			}
+			_, config, err := u.channels.SMS(ctx, notifyUser.ResourceOwner)
+			if err != nil || config == nil {
+				return false
+			}
+			return config.TwilioConfig == nil || config.TwilioConfig.VerifyServiceSID == ""
+		default:
+			return false
+		}
+	}
+}
+
+func securityNotificationMessageType(event eventstore.Event) string {
+	switch event.(type) {
+	case *user.HumanOTPVerifiedEvent,
+		*user.HumanOTPSMSAddedEvent,
+		*user.HumanOTPEmailAddedEvent,
+		*user.HumanU2FVerifiedEvent:
+		return domain.MFAAddedMessageType
+	case *user.HumanOTPRemovedEvent,
+		*user.HumanOTPSMSRemovedEvent,
+		*user.HumanOTPEmailRemovedEvent,
+		*user.HumanU2FRemovedEvent:
+		return domain.MFARemovedMessageType
+	case *user.HumanPasswordlessVerifiedEvent:
+		return domain.PasskeyAddedMessageType
+	case *user.HumanEmailChangedEvent:
+		return domain.EmailChangedMessageType
+	case *user.HumanPhoneChangedEvent:
+		return domain.PhoneChangedMessageType
+	case *user.UserLockedEvent:
+		return domain.AccountLockedMessageType
+	}
+	return ""
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -762,7 +1294,7 @@ func (u *userNotifier) reduceInviteCodeAdded(event eventstore.Event) (*handler.S
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		template, err := u.queries.MailTemplate(ctx, e.Aggregate().ResourceOwner)
 		if err != nil {
 			return err
 		}
@@ -780,7 +1312,7 @@ func (u *userNotifier) reduceInviteCodeAdded(event eventstore.Event) (*handler.S
 		if err != nil {
 			return err
 		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+		notify := types.SendEmail(ctx, u.channels, template, translator, notifyUser, colors, e)
 		err = notify.SendInviteCode(ctx, notifyUser, code, e.ApplicationName, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -19,6 +19,7 @@ import (
 	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	channel_mock "github.com/zitadel/zitadel/internal/notification/channels/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
@@ -29,8 +30,10 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -807,6 +810,82 @@ func Test_userNotifier_reducePasswordCodeAdded(t *testing.T) {
 					},
 				}, w
 		},
+	}, {
+		name: "preferred code channel",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			codeAlg, code := cryptoValue(t, ctrl, "testcode")
+			expectTemplateQueries(queries, givenTemplate)
+			queries.EXPECT().NotificationPreferencesByUserID(gomock.Any(), userID, orgID).Return(&domain.NotificationPreferences{
+				CodeChannels: []domain.NotificationType{domain.NotificationTypeEmail},
+			}, nil)
+			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+					userDataCrypto: codeAlg,
+				}, args{
+					event: &user.HumanPasswordCodeAddedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						Code:              code,
+						Expiry:            time.Hour,
+						CodeReturned:      false,
+						NotificationType:  domain.NotificationTypeSms,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
+	}, {
+		name: "preferred code channel, sms provider generates codes",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s%s/%s/%s", eventOrigin, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			codeAlg, code := cryptoValue(t, ctrl, "testcode")
+			expectTemplateQueries(queries, givenTemplate)
+			queries.EXPECT().NotificationPreferencesByUserID(gomock.Any(), userID, orgID).Return(&domain.NotificationPreferences{
+				CodeChannels: []domain.NotificationType{domain.NotificationTypeSms},
+			}, nil)
+			commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+					userDataCrypto: codeAlg,
+				}, args{
+					event: &user.HumanPasswordCodeAddedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						Code:              code,
+						Expiry:            time.Hour,
+						CodeReturned:      false,
+						NotificationType:  domain.NotificationTypeEmail,
+						TriggeredAtOrigin: eventOrigin,
+					},
+				}, w
+		},
 	},
 	}
 	for _, tt := range tests {
@@ -1243,6 +1322,419 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceSessionRiskEvaluated(t *testing.T) {
+	expectMailSubject := "Unusual sign-in to your account"
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "action notify, asset url without event trigger url",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    expectMailSubject,
+				Content:    expectContent,
+			}
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().LoginRiskNotified(gomock.Any(), "sessionID", "instanceID").Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &session.RiskEvaluatedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   "sessionID",
+							ResourceOwner: sql.NullString{String: "instanceID"},
+							CreationDate:  time.Now().UTC(),
+						}),
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						Signals:           []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+						Action:            domain.LoginRiskActionNotify,
+					},
+				}, w
+		},
+	}, {
+		name: "action none, new device, security notification",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    "Sign-in to your account from a new device",
+				Content:    expectContent,
+			}
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityNotification: true,
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().LoginRiskNotified(gomock.Any(), "sessionID", "instanceID").Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &session.RiskEvaluatedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   "sessionID",
+							ResourceOwner: sql.NullString{String: "instanceID"},
+							CreationDate:  time.Now().UTC(),
+						}),
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						Signals:           []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+						Action:            domain.LoginRiskActionNone,
+					},
+				}, w
+		},
+	}, {
+		name: "action none, new device, security notification disabled",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityNotification: false,
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &session.RiskEvaluatedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   "sessionID",
+							ResourceOwner: sql.NullString{String: "instanceID"},
+							CreationDate:  time.Now().UTC(),
+						}),
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						Signals:           []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+						Action:            domain.LoginRiskActionNone,
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSessionRiskEvaluated(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceSecurityNotification(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "mfa added",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    "A second factor was added to your account",
+				Content:    expectContent,
+			}
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityNotification: true,
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SecurityNotificationSent(gomock.Any(), orgID, userID, domain.MFAAddedMessageType, gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanOTPSMSAddedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}, {
+		name: "account locked",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			givenTemplate := "{{.LogoURL}}"
+			expectContent := fmt.Sprintf("%s://%s:%d%s/%s/%s", externalProtocol, instancePrimaryDomain, externalPort, assetsPath, policyID, logoURL)
+			w.message = &messages.Email{
+				Recipients: []string{lastEmail},
+				Subject:    "Your account was locked",
+				Content:    expectContent,
+			}
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityNotification: true,
+			}, nil)
+			queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+				Domains: []*query.InstanceDomain{{
+					Domain:    instancePrimaryDomain,
+					IsPrimary: true,
+				}},
+			}, nil)
+			expectTemplateQueries(queries, givenTemplate)
+			commands.EXPECT().SecurityNotificationSent(gomock.Any(), orgID, userID, domain.AccountLockedMessageType, gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.UserLockedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}, {
+		name: "security notification disabled",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+				SecurityNotification: false,
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.UserLockedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceSecurityNotification(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceCustomNotificationRequested(t *testing.T) {
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{{
+		name: "email",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.message = &messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    "Your subscription expires",
+				Content:    "Your subscription expires on 2026-12-31: https://example.com/subscription",
+			}
+			expectCustomNotificationQueries(queries)
+			queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte("{{.Text}}: {{.URL}}")}, nil)
+			queries.EXPECT().MessageTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, zerrors.ThrowNotFound(nil, "", ""))
+			commands.EXPECT().CustomNotificationSent(gomock.Any(), orgID, userID, "CustomSubscriptionExpires", gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanCustomNotificationRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						MessageType: "CustomSubscriptionExpires",
+						Channel:     domain.NotificationTypeEmail,
+						Args:        map[string]string{"ExpiryDate": "2026-12-31"},
+						URL:         "https://example.com/subscription",
+					},
+				}, w
+		},
+	}, {
+		name: "sms",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			w.messageSMS = &messages.SMS{
+				SenderPhoneNumber:    "senderNumber",
+				RecipientPhoneNumber: verifiedPhone,
+				Content:              "Your subscription expires on 2026-12-31",
+			}
+			expectCustomNotificationQueries(queries)
+			commands.EXPECT().CustomNotificationSent(gomock.Any(), orgID, userID, "CustomSubscriptionExpires", gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanCustomNotificationRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						MessageType: "CustomSubscriptionExpires",
+						Channel:     domain.NotificationTypeSms,
+						Args:        map[string]string{"ExpiryDate": "2026-12-31"},
+					},
+				}, w
+		},
+	}, {
+		name: "opted out",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.LabelPolicy{}, nil)
+			queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
+				ID:            userID,
+				ResourceOwner: orgID,
+				VerifiedEmail: verifiedEmail,
+			}, nil)
+			queries.EXPECT().NotificationPreferencesByUserID(gomock.Any(), userID, orgID).Return(&domain.NotificationPreferences{
+				OptOutMessageTypes: []string{"CustomSubscriptionExpires"},
+			}, nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanCustomNotificationRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  time.Now().UTC(),
+						}),
+						MessageType: "CustomSubscriptionExpires",
+						Channel:     domain.NotificationTypeEmail,
+					},
+				}, w
+		},
+	}, {
+		name: "sms during quiet hours, email",
+		test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+			createdAt := time.Now().UTC()
+			w.message = &messages.Email{
+				Recipients: []string{verifiedEmail},
+				Subject:    "Your subscription expires",
+				Content:    "Your subscription expires on 2026-12-31",
+			}
+			expectCustomNotificationQueries(queries)
+			queries.EXPECT().NotificationPreferencesByUserID(gomock.Any(), userID, orgID).Return(&domain.NotificationPreferences{
+				SMSQuietHours: &domain.QuietHours{
+					Start:    createdAt.Add(-time.Hour).Format("15:04"),
+					End:      createdAt.Add(time.Hour).Format("15:04"),
+					TimeZone: "UTC",
+				},
+			}, nil)
+			queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte("{{.Text}}")}, nil)
+			queries.EXPECT().MessageTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, zerrors.ThrowNotFound(nil, "", ""))
+			commands.EXPECT().CustomNotificationSent(gomock.Any(), orgID, userID, "CustomSubscriptionExpires", gomock.Any()).Return(nil)
+			return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: &user.HumanCustomNotificationRequestedEvent{
+						BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+							AggregateID:   userID,
+							ResourceOwner: sql.NullString{String: orgID},
+							CreationDate:  createdAt,
+						}),
+						MessageType: "CustomSubscriptionExpires",
+						Channel:     domain.NotificationTypeSms,
+						Args:        map[string]string{"ExpiryDate": "2026-12-31"},
+					},
+				}, w
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceCustomNotificationRequested(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	expectMailSubject := "Verify One-Time Password"
 	tests := []struct {
@@ -1579,6 +2071,8 @@ type want struct {
 
 func newUserNotifier(t *testing.T, ctrl *gomock.Controller, queries *mock.MockQueries, f fields, a args, w want) *userNotifier {
 	queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
+	queries.EXPECT().NotificationPreferencesByUserID(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&domain.NotificationPreferences{}, nil)
+	queries.EXPECT().LanguageBundles(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
 	smtpAlg, _ := cryptoValue(t, ctrl, "smtppw")
 	channel := channel_mock.NewMockNotificationChannel(ctrl)
 	if w.err == nil {
@@ -1599,6 +2093,7 @@ func newUserNotifier(t *testing.T, ctrl *gomock.Controller, queries *mock.MockQu
 		queries: NewNotificationQueries(
 			f.queries,
 			f.es,
+			nil,
 			externalDomain,
 			externalPort,
 			externalSecure,
@@ -1652,11 +2147,11 @@ type channels struct {
 	SMSConfig   *sms.Config
 }
 
-func (c *channels) Email(context.Context) (*senders.Chain, *email.Config, error) {
+func (c *channels) Email(context.Context, string) (*senders.Chain, *email.Config, error) {
 	return &c.Chain, c.EmailConfig, nil
 }
 
-func (c *channels) SMS(context.Context) (*senders.Chain, *sms.Config, error) {
+func (c *channels) SMS(context.Context, string) (*senders.Chain, *sms.Config, error) {
 	return &c.Chain, c.SMSConfig, nil
 }
 
@@ -1668,6 +2163,12 @@ func (c *channels) SecurityTokenEvent(context.Context, set.Config) (*senders.Cha
 	return &c.Chain, nil
 }
 
+func (c *channels) Push(context.Context) (*senders.Chain, *push.Config, error) {
+	return &c.Chain, nil, nil
+}
+
+func (c *channels) NotificationDelivered(context.Context, string, *notification.Delivery, error) {}
+
 func expectTemplateQueries(queries *mock.MockQueries, template string) {
 	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
 		AllowedLanguages: []language.Tag{language.English},
@@ -1679,6 +2180,7 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 		},
 	}, nil)
 	queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(template)}, nil)
+	queries.EXPECT().MessageTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, zerrors.ThrowNotFound(nil, "", ""))
 	queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
 		ID:                 userID,
 		ResourceOwner:      orgID,
@@ -1715,6 +2217,41 @@ func expectTemplateQueriesSMS(queries *mock.MockQueries, template string) {
 	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
 }
 
+// expectCustomNotificationQueries returns the texts of the custom notification type CustomSubscriptionExpires set on the instance.
+func expectCustomNotificationQueries(queries *mock.MockQueries) {
+	queries.EXPECT().GetInstanceRestrictions(gomock.Any()).Return(query.Restrictions{
+		AllowedLanguages: []language.Tag{language.English},
+	}, nil)
+	queries.EXPECT().ActiveLabelPolicyByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.LabelPolicy{
+		ID: policyID,
+		Light: query.Theme{
+			LogoURL: logoURL,
+		},
+	}, nil)
+	queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
+		ID:                 userID,
+		ResourceOwner:      orgID,
+		LastEmail:          lastEmail,
+		VerifiedEmail:      verifiedEmail,
+		PreferredLoginName: preferredLoginName,
+		LastPhone:          lastPhone,
+		VerifiedPhone:      verifiedPhone,
+	}, nil)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
+	queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+		Domains: []*query.InstanceDomain{{
+			Domain:    instancePrimaryDomain,
+			IsPrimary: true,
+		}},
+	}, nil)
+	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), "CustomSubscriptionExpires", gomock.Any()).Times(2).Return(&query.CustomTexts{
+		CustomTexts: []*query.CustomText{
+			{Template: "CustomSubscriptionExpires", Language: language.English, Key: domain.MessageSubject, Text: "Your subscription expires"},
+			{Template: "CustomSubscriptionExpires", Language: language.English, Key: domain.MessageText, Text: "Your subscription expires on {{.ExpiryDate}}"},
+		},
+	}, nil)
+}
+
 func cryptoValue(t *testing.T, ctrl *gomock.Controller, value string) (*crypto.MockEncryptionAlgorithm, *crypto.CryptoValue) {
 	encAlg := crypto.NewMockEncryptionAlgorithm(ctrl)
 	encAlg.EXPECT().Algorithm().AnyTimes().Return("enc")
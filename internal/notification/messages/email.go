@@ -1,8 +1,11 @@
 package messages
 
 import (
+	"bytes"
 	"fmt"
 	"mime"
+	"mime/multipart"
+	"net/textproto"
 	"regexp"
 	"strings"
 	"time"
@@ -27,6 +30,7 @@ type Email struct {
 	ReplyToAddress  string
 	Subject         string
 	Content         string
+	PlainText       string
 	TriggeringEvent eventstore.Event
 }
 
@@ -50,17 +54,54 @@ func (msg *Email) GetContent() (string, error) {
 		message += fmt.Sprintf("%s: %s"+lineBreak, k, v)
 	}
 
+	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
+	if msg.PlainText != "" && isHTML(msg.Content) {
+		alternative, err := msg.alternativeContent()
+		if err != nil {
+			return "", err
+		}
+		return message + subject + alternative, nil
+	}
+
 	//default mime-type is html
 	mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: text/html; charset=\"UTF-8\"" + lineBreak + lineBreak
 	if !isHTML(msg.Content) {
 		mime = "MIME-Version: 1.0" + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak
 	}
-	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
 	message += subject + mime + lineBreak + msg.Content
 
 	return message, nil
 }
 
+// alternativeContent returns the plain text and html content as multipart/alternative body including its headers
+func (msg *Email) alternativeContent() (string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain", content: msg.PlainText},
+		{contentType: "text/html", content: msg.Content},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {part.contentType + "; charset=\"UTF-8\""},
+		})
+		if err != nil {
+			return "", err
+		}
+		if _, err = w.Write([]byte(part.content)); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return "MIME-Version: 1.0" + lineBreak +
+		"Content-Type: multipart/alternative; boundary=\"" + writer.Boundary() + "\"" + lineBreak + lineBreak +
+		body.String(), nil
+}
+
 func (msg *Email) GetTriggeringEvent() eventstore.Event {
 	return msg.TriggeringEvent
 }
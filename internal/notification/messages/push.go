@@ -0,0 +1,36 @@
+package messages
+
+import (
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+)
+
+var _ channels.Message = (*Push)(nil)
+
+// Push is a challenge sent to the enrolled devices of a user,
+// which is approved by the device signing the challenge.
+type Push struct {
+	Devices         []PushDevice     `json:"devices"`
+	SessionID       string           `json:"sessionId"`
+	UserID          string           `json:"userId"`
+	Challenge       string           `json:"challenge"`
+	Title           string           `json:"title,omitempty"`
+	Body            string           `json:"body,omitempty"`
+	TriggeringEvent eventstore.Event `json:"-"`
+}
+
+type PushDevice struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+func (msg *Push) GetContent() (string, error) {
+	bytes, err := json.Marshal(msg)
+	return string(bytes), err
+}
+
+func (msg *Push) GetTriggeringEvent() eventstore.Event {
+	return msg.TriggeringEvent
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	_ "github.com/zitadel/zitadel/internal/notification/statik"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/static"
 )
 
 var projections []*handler.Handler
@@ -26,12 +28,14 @@ func Register(
 	commands *command.Commands,
 	queries *query.Queries,
 	es *eventstore.Eventstore,
+	staticStorage static.Storage,
 	otpEmailTmpl, fileSystemPath string,
 	userEncryption, smtpEncryption, smsEncryption, keysEncryptionAlg crypto.EncryptionAlgorithm,
 	tokenLifetime time.Duration,
+	pushConfig push.Config,
 ) {
-	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption)
-	c := newChannels(q)
+	q := handlers.NewNotificationQueries(queries, es, staticStorage, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption)
+	c := newChannels(q, commands, pushConfig)
 	projections = append(projections, handlers.NewUserNotifier(ctx, projection.ApplyCustomConfig(userHandlerCustomConfig), commands, q, c, otpEmailTmpl))
 	projections = append(projections, handlers.NewQuotaNotifier(ctx, projection.ApplyCustomConfig(quotaHandlerCustomConfig), commands, q, c))
 	projections = append(projections, handlers.NewBackChannelLogoutNotifier(
@@ -65,6 +69,17 @@ func ProjectInstance(ctx context.Context) error {
 	return nil
 }
 
+// SkipInstance marks the current events of the instance as processed,
+// so no notifications are sent for them, e.g. after they were restored
+func SkipInstance(ctx context.Context, instanceID string) error {
+	for _, projection := range projections {
+		if err := projection.SkipInstance(ctx, instanceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func Projections() []*handler.Handler {
 	return projections
 }
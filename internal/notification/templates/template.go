@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io/ioutil"
 	"net/http"
+	text_template "text/template"
 )
 
 const (
@@ -20,6 +21,19 @@ func GetParsedTemplate(mailhtml string, contentData interface{}) (string, error)
 	return ParseTemplateText(template, contentData)
 }
 
+// GetParsedPlainText renders the plain text alternative of an email, which must not be html escaped.
+func GetParsedPlainText(plainText string, contentData interface{}) (string, error) {
+	tmpl, err := text_template.New("plainText").Parse(plainText)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, contentData); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func ParseTemplateFile(mailhtml string, data interface{}) (string, error) {
 	tmpl, err := template.New("tmpl").Parse(mailhtml)
 	if err != nil {
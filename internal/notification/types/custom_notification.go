@@ -0,0 +1,11 @@
+package types
+
+// SendCustomNotification sends a message of a custom notification type.
+// The args are passed to the texts of the type additionally to the ones of the user.
+func (notify Notify) SendCustomNotification(url string, args map[string]string, messageType string) error {
+	notifyArgs := make(map[string]interface{}, len(args))
+	for key, value := range args {
+		notifyArgs[key] = value
+	}
+	return notify(url, notifyArgs, messageType, false)
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (notify Notify) SendMagicLink(ctx context.Context, user *query.NotifyUser, code, authRequestID string, expiry time.Duration) error {
+	url := login.MagicLink(http_utils.DomainContext(ctx).Origin(), user.ID, code, authRequestID)
+	args := make(map[string]interface{})
+	args["Expiry"] = expiry
+	return notify(url, args, domain.MagicLinkMessageType, false)
+}
+
+func (notify Notify) SendMagicLinkURL(ctx context.Context, url string, expiry time.Duration) error {
+	args := make(map[string]interface{})
+	args["Expiry"] = expiry
+	return notify(url, args, domain.MagicLinkMessageType, false)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MailTemplateQueries interface {
+	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+	MessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MessageTemplate, error)
+}
+
+// GetMailTemplate returns the templates to render the emails of the organization.
+// The message template of the message type is preferred over the mail template of the organization.
+func GetMailTemplate(ctx context.Context, queries MailTemplateQueries, orgID string) (MailTemplate, error) {
+	template, err := queries.MailTemplateByOrg(ctx, orgID, false)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, messageType string, lang language.Tag) (string, string, error) {
+		messageTemplate, err := queries.MessageTemplateByOrg(ctx, orgID, messageType, lang)
+		if zerrors.IsNotFound(err) {
+			return string(template.Template), "", nil
+		}
+		if err != nil {
+			return "", "", err
+		}
+		if messageTemplate.HTML == "" {
+			return string(template.Template), messageTemplate.PlainText, nil
+		}
+		return messageTemplate.HTML, messageTemplate.PlainText, nil
+	}, nil
+}
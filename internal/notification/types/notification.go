@@ -4,16 +4,21 @@ import (
 	"context"
 	"html"
 
+	"golang.org/x/text/language"
+
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/push"
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 )
 
 type Notify func(
@@ -23,17 +28,33 @@ type Notify func(
 	allowUnverifiedNotificationChannel bool,
 ) error
 
+// MailTemplate returns the html and the optional plain text template
+// used to render the email of the message type in the requested language.
+type MailTemplate func(ctx context.Context, messageType string, lang language.Tag) (html, plainText string, err error)
+
+// StaticMailTemplate uses the same html template for all message types and languages.
+func StaticMailTemplate(mailhtml string) MailTemplate {
+	return func(context.Context, string, language.Tag) (string, string, error) {
+		return mailhtml, "", nil
+	}
+}
+
 type ChannelChains interface {
-	Email(context.Context) (*senders.Chain, *email.Config, error)
-	SMS(context.Context) (*senders.Chain, *sms.Config, error)
+	// Email returns the chain of the active email provider of the resource owner (organization) or the instance
+	Email(ctx context.Context, resourceOwner string) (*senders.Chain, *email.Config, error)
+	// SMS returns the chain of the active sms provider of the resource owner (organization) or the instance
+	SMS(ctx context.Context, resourceOwner string) (*senders.Chain, *sms.Config, error)
 	Webhook(context.Context, webhook.Config) (*senders.Chain, error)
 	SecurityTokenEvent(context.Context, set.Config) (*senders.Chain, error)
+	Push(context.Context) (*senders.Chain, *push.Config, error)
+	// NotificationDelivered records the result of an email or SMS delivery to a user
+	NotificationDelivered(ctx context.Context, resourceOwner string, delivery *notification.Delivery, err error)
 }
 
 func SendEmail(
 	ctx context.Context,
 	channels ChannelChains,
-	mailhtml string,
+	mailTemplate MailTemplate,
 	translator *i18n.Translator,
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
@@ -48,20 +69,47 @@ func SendEmail(
 		args = mapNotifyUserToArgs(user, args)
 		sanitizeArgsForHTML(args)
 		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
+		mailhtml, plainText, err := mailTemplate(ctx, messageType, user.PreferredLanguage)
+		if err != nil {
+			return err
+		}
 		template, err := templates.GetParsedTemplate(mailhtml, data)
 		if err != nil {
 			return err
 		}
-		return generateEmail(
+		if plainText != "" {
+			plainText, err = templates.GetParsedPlainText(plainText, data)
+			if err != nil {
+				return err
+			}
+		}
+		delivery := newDelivery(user, messageType, domain.NotificationTypeEmail, triggeringEvent)
+		err = generateEmail(
 			ctx,
 			channels,
 			user,
 			template,
+			plainText,
 			data,
 			args,
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
+			delivery,
 		)
+		channels.NotificationDelivered(ctx, user.ResourceOwner, delivery, err)
+		return err
+	}
+}
+
+func newDelivery(user *query.NotifyUser, messageType string, channel domain.NotificationType, triggeringEvent eventstore.Event) *notification.Delivery {
+	return &notification.Delivery{
+		UserID:                  user.ID,
+		MessageType:             messageType,
+		Channel:                 channel,
+		TriggeringAggregateType: triggeringEvent.Aggregate().Type,
+		TriggeringAggregateID:   triggeringEvent.Aggregate().ID,
+		TriggeringEventType:     triggeringEvent.Type(),
+		TriggeringSequence:      triggeringEvent.Sequence(),
 	}
 }
 
@@ -99,7 +147,8 @@ func SendSMS(
 	) error {
 		args = mapNotifyUserToArgs(user, args)
 		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
-		return generateSms(
+		delivery := newDelivery(user, messageType, domain.NotificationTypeSms, triggeringEvent)
+		err := generateSms(
 			ctx,
 			channels,
 			user,
@@ -108,7 +157,10 @@ func SendSMS(
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
 			generatorInfo,
+			delivery,
 		)
+		channels.NotificationDelivered(ctx, user.ResourceOwner, delivery, err)
+		return err
 	}
 }
 
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"context"
+	"html"
+	"time"
+
+	"golang.org/x/text/language"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const previewURL = "https://example.com/ui/login"
+
+type PreviewQueries interface {
+	TranslatorQueries
+	MailTemplateQueries
+	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
+}
+
+// PreviewEmail renders the email of the message type with the branding and texts of the organization for a sample user,
+// so a template can be checked before it is sent to real users.
+// If neither html nor plain text are passed, the templates currently used for the message type are rendered.
+// If only the plain text is passed, it's rendered together with the html currently used.
+func PreviewEmail(
+	ctx context.Context,
+	queries PreviewQueries,
+	staticStorage static.Storage,
+	orgID string,
+	messageType string,
+	lang language.Tag,
+	mailhtml string,
+	plainText string,
+) (subject, content, text string, err error) {
+	if err := validatePreviewTemplate(messageType, lang, mailhtml, plainText); err != nil {
+		return "", "", "", err
+	}
+	translator, err := GetTranslatorWithOrgTexts(ctx, queries, staticStorage, orgID, messageType)
+	if err != nil {
+		return "", "", "", err
+	}
+	colors, err := queries.ActiveLabelPolicyByOrg(ctx, orgID, false)
+	if err != nil {
+		return "", "", "", err
+	}
+	if mailhtml == "" {
+		mailTemplate, err := GetMailTemplate(ctx, queries, orgID)
+		if err != nil {
+			return "", "", "", err
+		}
+		currentHTML, currentPlainText, err := mailTemplate(ctx, messageType, lang)
+		if err != nil {
+			return "", "", "", err
+		}
+		mailhtml = currentHTML
+		if plainText == "" {
+			plainText = currentPlainText
+		}
+	}
+
+	user := previewUser(lang)
+	args := mapNotifyUserToArgs(user, previewArgs(ctx))
+	sanitizeArgsForHTML(args)
+	data := GetTemplateData(ctx, translator, args, previewURL, messageType, lang.String(), colors)
+	content, err = templates.GetParsedTemplate(mailhtml, data)
+	if err != nil {
+		return "", "", "", zerrors.ThrowInvalidArgument(err, "MAIL-Ohc4u", "Errors.MessageTemplate.InvalidHTML")
+	}
+	if plainText != "" {
+		text, err = templates.GetParsedPlainText(plainText, data)
+		if err != nil {
+			return "", "", "", zerrors.ThrowInvalidArgument(err, "MAIL-xo6Ai", "Errors.MessageTemplate.InvalidPlainText")
+		}
+	}
+	return data.Subject, html.UnescapeString(content), html.UnescapeString(text), nil
+}
+
+func validatePreviewTemplate(messageType string, lang language.Tag, mailhtml, plainText string) error {
+	if mailhtml == "" && plainText == "" {
+		if !domain.IsMessageTextType(messageType) && !domain.IsCustomMessageType(messageType) {
+			return zerrors.ThrowInvalidArgument(nil, "MAIL-Iek4a", "Errors.MessageTemplate.Invalid")
+		}
+		return nil
+	}
+	template := &domain.MessageTemplate{
+		MessageType: messageType,
+		Language:    lang,
+		HTML:        mailhtml,
+		PlainText:   plainText,
+	}
+	return template.IsValid(i18n.SupportedLanguages())
+}
+
+func previewUser(lang language.Tag) *query.NotifyUser {
+	now := time.Now()
+	return &query.NotifyUser{
+		ID:                 "preview",
+		CreationDate:       now,
+		ChangeDate:         now,
+		Username:           "john.doe",
+		FirstName:          "John",
+		LastName:           "Doe",
+		NickName:           "Johnny",
+		DisplayName:        "John Doe",
+		PreferredLanguage:  lang,
+		LastEmail:          "john.doe@example.com",
+		VerifiedEmail:      "john.doe@example.com",
+		LastPhone:          "+41 79 123 45 67",
+		VerifiedPhone:      "+41 79 123 45 67",
+		PreferredLoginName: "john.doe@example.com",
+		LoginNames:         []string{"john.doe@example.com"},
+	}
+}
+
+func previewArgs(ctx context.Context) map[string]interface{} {
+	domainCtx := http_util.DomainContext(ctx)
+	return map[string]interface{}{
+		"Code":            "ABC123",
+		"OTP":             "123456",
+		"Expiry":          "5m0s",
+		"Origin":          domainCtx.Origin(),
+		"Domain":          domainCtx.RequestedDomain(),
+		"TempUsername":    "john.doe@example.com",
+		"ApplicationName": "ZITADEL",
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
+)
+
+// languageBundles caches the parsed notification language bundles of the instances
+var languageBundles = i18n.NewBundleCache(i18n.NOTIFICATION)
+
+type TranslatorQueries interface {
+	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
+	GetDefaultLanguage(ctx context.Context) language.Tag
+	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
+	LanguageBundles(ctx context.Context, namespace i18n.Namespace) ([]*query.LanguageBundle, error)
+}
+
+// GetTranslatorWithOrgTexts returns the notification translator including the language bundles uploaded to the instance
+// and the custom texts of the instance and the organization.
+func GetTranslatorWithOrgTexts(ctx context.Context, queries TranslatorQueries, staticStorage static.Storage, orgID, textType string) (*i18n.Translator, error) {
+	restrictions, err := queries.GetInstanceRestrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := i18n.NewNotificationTranslator(queries.GetDefaultLanguage(ctx), restrictions.AllowedLanguages)
+	if err != nil {
+		return nil, err
+	}
+	addLanguageBundles(ctx, queries, staticStorage, translator)
+
+	allCustomTexts, err := queries.CustomTextListByTemplate(ctx, authz.GetInstance(ctx).InstanceID(), textType, false)
+	if err != nil {
+		return translator, nil
+	}
+	customTexts, err := queries.CustomTextListByTemplate(ctx, orgID, textType, false)
+	if err != nil {
+		return translator, nil
+	}
+	allCustomTexts.CustomTexts = append(allCustomTexts.CustomTexts, customTexts.CustomTexts...)
+
+	for _, text := range allCustomTexts.CustomTexts {
+		msg := i18n.Message{
+			ID:   text.Template + "." + text.Key,
+			Text: text.Text,
+		}
+		err = translator.AddMessages(text.Language, msg)
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "orgID", orgID, "messageType", textType, "messageID", msg.ID).
+			OnError(err).
+			Warn("could not add translation message")
+	}
+	return translator, nil
+}
+
+// addLanguageBundles adds the language bundles uploaded to the instance.
+// Bundles which cannot be loaded are skipped, so the notification is still sent with the built-in texts.
+func addLanguageBundles(ctx context.Context, queries TranslatorQueries, staticStorage static.Storage, translator *i18n.Translator) {
+	bundles, err := queries.LanguageBundles(ctx, i18n.NOTIFICATION)
+	if err != nil {
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID()).WithError(err).Warn("could not load language bundles")
+		return
+	}
+	for _, bundle := range bundles {
+		parsed, err := languageBundles.Get(authz.GetInstance(ctx).InstanceID(), bundle.Language, bundle.Sequence, func() ([]byte, error) {
+			return bundle.Content(ctx, staticStorage)
+		})
+		if err == nil {
+			err = translator.AddBundle(parsed)
+		}
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "language", bundle.Language).
+			OnError(err).
+			Warn("could not add language bundle")
+	}
+}
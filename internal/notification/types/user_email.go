@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -19,25 +20,31 @@ func generateEmail(
 	channels ChannelChains,
 	user *query.NotifyUser,
 	template string,
+	plainText string,
 	data templates.TemplateData,
 	args map[string]interface{},
 	lastEmail bool,
 	triggeringEvent eventstore.Event,
+	delivery *notification.Delivery,
 ) error {
-	emailChannels, config, err := channels.Email(ctx)
+	recipient := user.VerifiedEmail
+	if lastEmail {
+		recipient = user.LastEmail
+	}
+	emailChannels, config, err := channels.Email(ctx, user.ResourceOwner)
 	logging.OnError(err).Error("could not create email channel")
 	if emailChannels == nil || emailChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
-	recipient := user.VerifiedEmail
-	if lastEmail {
-		recipient = user.LastEmail
+	if config.ProviderConfig != nil {
+		delivery.ProviderID = config.ProviderConfig.ID
 	}
 	if config.SMTPConfig != nil {
 		message := &messages.Email{
 			Recipients:      []string{recipient},
 			Subject:         data.Subject,
 			Content:         html.UnescapeString(template),
+			PlainText:       html.UnescapeString(plainText),
 			TriggeringEvent: triggeringEvent,
 		}
 		return emailChannels.HandleMessage(message)
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -29,15 +30,19 @@ func generateSms(
 	lastPhone bool,
 	triggeringEvent eventstore.Event,
 	generatorInfo *senders.CodeGeneratorInfo,
+	delivery *notification.Delivery,
 ) error {
-	smsChannels, config, err := channels.SMS(ctx)
+	recipient := user.VerifiedPhone
+	if lastPhone {
+		recipient = user.LastPhone
+	}
+	smsChannels, config, err := channels.SMS(ctx, user.ResourceOwner)
 	logging.OnError(err).Error("could not create sms channel")
 	if smsChannels == nil || smsChannels.Len() == 0 {
 		return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 	}
-	recipient := user.VerifiedPhone
-	if lastPhone {
-		recipient = user.LastPhone
+	if config.ProviderConfig != nil {
+		delivery.ProviderID = config.ProviderConfig.ID
 	}
 	if config.TwilioConfig != nil {
 		number := ""
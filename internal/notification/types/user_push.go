@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SendPush sends the challenge of the session to the enrolled push devices of the user,
+// which approve the login by signing the challenge.
+func SendPush(
+	ctx context.Context,
+	channels ChannelChains,
+	translator *i18n.Translator,
+	user *query.NotifyUser,
+	sessionID, challenge string,
+	devices []messages.PushDevice,
+	triggeringEvent eventstore.Event,
+) error {
+	pushChannels, _, err := channels.Push(ctx)
+	logging.OnError(err).Error("could not create push channel")
+	if pushChannels == nil || pushChannels.Len() == 0 {
+		return zerrors.ThrowPreconditionFailed(nil, "PUSH-Eeg4o", "Errors.Notification.Channels.NotPresent")
+	}
+	args := mapNotifyUserToArgs(user, nil)
+	lang := user.PreferredLanguage.String()
+	message := &messages.Push{
+		Devices:         devices,
+		SessionID:       sessionID,
+		UserID:          user.ID,
+		Challenge:       challenge,
+		Title:           translator.Localize(fmt.Sprintf("%s.%s", domain.PushChallengeMessageType, domain.MessageTitle), args, lang),
+		Body:            translator.Localize(fmt.Sprintf("%s.%s", domain.PushChallengeMessageType, domain.MessageText), args, lang),
+		TriggeringEvent: triggeringEvent,
+	}
+	return pushChannels.HandleMessage(message)
+}
@@ -32,6 +32,7 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+	PossibleLOAs []domain.LevelOfAssurance
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context) error {
@@ -63,6 +64,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		scope   database.TextArray[string]
 		prompt  database.NumberArray[domain.Prompt]
 		locales database.TextArray[string]
+		loas    database.NumberArray[domain.LevelOfAssurance]
 	)
 
 	dst := new(AuthRequest)
@@ -71,7 +73,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &loas,
 			)
 		},
 		q.authRequestByIDQuery(ctx),
@@ -87,6 +89,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 	dst.Scope = scope
 	dst.Prompt = prompt
 	dst.UiLocales = locales
+	dst.PossibleLOAs = loas
 
 	if checkLoginClient {
 		if err = dst.checkLoginClient(ctx); err != nil {
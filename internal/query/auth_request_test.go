@@ -38,6 +38,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 		projection.AuthRequestColumnLoginHint,
 		projection.AuthRequestColumnMaxAge,
 		projection.AuthRequestColumnHintUserID,
+		projection.AuthRequestColumnPossibleLOAs,
 	}
 	type args struct {
 		shouldTriggerBulk bool
@@ -70,6 +71,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				"me@example.com",
 				int64(time.Minute),
 				"userID",
+				database.NumberArray[domain.LevelOfAssurance]{domain.LevelOfAssuranceMultiFactor},
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -83,6 +85,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    gu.Ptr("me@example.com"),
 				MaxAge:       gu.Ptr(time.Minute),
 				HintUserID:   gu.Ptr("userID"),
+				PossibleLOAs: []domain.LevelOfAssurance{domain.LevelOfAssuranceMultiFactor},
 			},
 		},
 		{
@@ -104,6 +107,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -117,6 +121,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    nil,
 				MaxAge:       nil,
 				HintUserID:   nil,
+				PossibleLOAs: []domain.LevelOfAssurance{},
 			},
 		},
 		{
@@ -156,6 +161,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			wantErr: zerrors.ThrowPermissionDeniedf(nil, "OIDCv2-aL0ag", "Errors.AuthRequest.WrongLoginClient"),
 		},
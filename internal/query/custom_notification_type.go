@@ -0,0 +1,145 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type CustomNotificationType struct {
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+	Type         string
+	Description  string
+	InstanceID   string
+}
+
+type CustomNotificationTypes struct {
+	SearchResponse
+	Types []*CustomNotificationType
+}
+
+type CustomNotificationTypeSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *CustomNotificationTypeSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewCustomNotificationTypeTypeSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(CustomNotificationTypeTypeCol, value, method)
+}
+
+func (q *Queries) SearchCustomNotificationTypes(ctx context.Context, queries *CustomNotificationTypeSearchQueries) (types *CustomNotificationTypes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareCustomNotificationTypesQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			CustomNotificationTypeInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-ooN1e", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		types, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	types.State, err = q.latestState(ctx, customNotificationTypesTable)
+	return types, err
+}
+
+func prepareCustomNotificationTypesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*CustomNotificationTypes, error)) {
+	return sq.Select(
+			CustomNotificationTypeCreationDateCol.identifier(),
+			CustomNotificationTypeChangeDateCol.identifier(),
+			CustomNotificationTypeSequenceCol.identifier(),
+			CustomNotificationTypeTypeCol.identifier(),
+			CustomNotificationTypeDescriptionCol.identifier(),
+			CustomNotificationTypeInstanceIDCol.identifier(),
+			countColumn.identifier(),
+		).From(customNotificationTypesTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*CustomNotificationTypes, error) {
+			types := make([]*CustomNotificationType, 0)
+			var count uint64
+			for rows.Next() {
+				notificationType := new(CustomNotificationType)
+				err := rows.Scan(
+					&notificationType.CreationDate,
+					&notificationType.ChangeDate,
+					&notificationType.Sequence,
+					&notificationType.Type,
+					&notificationType.Description,
+					&notificationType.InstanceID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				types = append(types, notificationType)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ahx5i", "Errors.Query.CloseRows")
+			}
+
+			return &CustomNotificationTypes{
+				Types: types,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+var (
+	customNotificationTypesTable = table{
+		name:          projection.CustomNotificationTypeTable,
+		instanceIDCol: projection.CustomNotificationTypeInstanceIDCol,
+	}
+	CustomNotificationTypeCreationDateCol = Column{
+		name:  projection.CustomNotificationTypeCreationDateCol,
+		table: customNotificationTypesTable,
+	}
+	CustomNotificationTypeChangeDateCol = Column{
+		name:  projection.CustomNotificationTypeChangeDateCol,
+		table: customNotificationTypesTable,
+	}
+	CustomNotificationTypeSequenceCol = Column{
+		name:  projection.CustomNotificationTypeSequenceCol,
+		table: customNotificationTypesTable,
+	}
+	CustomNotificationTypeTypeCol = Column{
+		name:  projection.CustomNotificationTypeTypeCol,
+		table: customNotificationTypesTable,
+	}
+	CustomNotificationTypeDescriptionCol = Column{
+		name:  projection.CustomNotificationTypeDescriptionCol,
+		table: customNotificationTypesTable,
+	}
+	CustomNotificationTypeInstanceIDCol = Column{
+		name:  projection.CustomNotificationTypeInstanceIDCol,
+		table: customNotificationTypesTable,
+	}
+)
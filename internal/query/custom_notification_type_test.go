@@ -0,0 +1,114 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareCustomNotificationTypesStmt = `SELECT projections.custom_notification_types.creation_date,` +
+		` projections.custom_notification_types.change_date,` +
+		` projections.custom_notification_types.sequence,` +
+		` projections.custom_notification_types.notification_type,` +
+		` projections.custom_notification_types.description,` +
+		` projections.custom_notification_types.instance_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.custom_notification_types` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareCustomNotificationTypesCols = []string{
+		"creation_date",
+		"change_date",
+		"sequence",
+		"notification_type",
+		"description",
+		"instance_id",
+		"count",
+	}
+)
+
+func Test_CustomNotificationTypePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareCustomNotificationTypesQuery no result",
+			prepare: prepareCustomNotificationTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomNotificationTypesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &CustomNotificationTypes{Types: []*CustomNotificationType{}},
+		},
+		{
+			name:    "prepareCustomNotificationTypesQuery one result",
+			prepare: prepareCustomNotificationTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareCustomNotificationTypesStmt),
+					prepareCustomNotificationTypesCols,
+					[][]driver.Value{
+						{
+							testNow,
+							testNow,
+							uint64(20211109),
+							"CustomSubscriptionExpires",
+							"subscription expires soon",
+							"inst-id",
+						},
+					},
+				),
+			},
+			object: &CustomNotificationTypes{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Types: []*CustomNotificationType{
+					{
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211109,
+						Type:         "CustomSubscriptionExpires",
+						Description:  "subscription expires soon",
+						InstanceID:   "inst-id",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareCustomNotificationTypesQuery sql err",
+			prepare: prepareCustomNotificationTypesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareCustomNotificationTypesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomNotificationTypes)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
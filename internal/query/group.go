@@ -0,0 +1,813 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	groupsTable = table{
+		name:          projection.GroupProjectionTable,
+		instanceIDCol: projection.GroupColumnInstanceID,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupColumnID,
+		table: groupsTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupColumnCreationDate,
+		table: groupsTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupColumnChangeDate,
+		table: groupsTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupColumnSequence,
+		table: groupsTable,
+	}
+	GroupColumnState = Column{
+		name:  projection.GroupColumnState,
+		table: groupsTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupColumnResourceOwner,
+		table: groupsTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupColumnInstanceID,
+		table: groupsTable,
+	}
+	GroupColumnName = Column{
+		name:  projection.GroupColumnName,
+		table: groupsTable,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupColumnDescription,
+		table: groupsTable,
+	}
+
+	groupMembersTable = table{
+		name:          projection.GroupMemberTable,
+		instanceIDCol: projection.GroupMemberColumnInstanceID,
+	}
+	GroupMemberColumnInstanceID = Column{
+		name:  projection.GroupMemberColumnInstanceID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnGroupID = Column{
+		name:  projection.GroupMemberColumnGroupID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnMemberID = Column{
+		name:  projection.GroupMemberColumnMemberID,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnMemberType = Column{
+		name:  projection.GroupMemberColumnMemberType,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnCreationDate = Column{
+		name:  projection.GroupMemberColumnCreationDate,
+		table: groupMembersTable,
+	}
+	GroupMemberColumnSequence = Column{
+		name:  projection.GroupMemberColumnSequence,
+		table: groupMembersTable,
+	}
+
+	groupGrantsTable = table{
+		name:          projection.GroupGrantTable,
+		instanceIDCol: projection.GroupGrantColumnInstanceID,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantColumnInstanceID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnID = Column{
+		name:  projection.GroupGrantColumnID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantColumnGroupID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantColumnCreationDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantColumnChangeDate,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantColumnSequence,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantColumnResourceOwner,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantColumnProjectID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnGrantID = Column{
+		name:  projection.GroupGrantColumnGrantID,
+		table: groupGrantsTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantColumnRoles,
+		table: groupGrantsTable,
+	}
+
+	groupMembershipsTable = table{
+		name:          projection.GroupMembershipProjectionTable,
+		instanceIDCol: projection.GroupMembershipColumnInstanceID,
+	}
+	GroupMembershipColumnInstanceID = Column{
+		name:  projection.GroupMembershipColumnInstanceID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnAggregateType = Column{
+		name:  projection.GroupMembershipColumnAggregateType,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnAggregateID = Column{
+		name:  projection.GroupMembershipColumnAggregateID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnGroupID = Column{
+		name:  projection.GroupMembershipColumnGroupID,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnRoles = Column{
+		name:  projection.GroupMembershipColumnRoles,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnResourceOwner = Column{
+		name:  projection.GroupMembershipColumnResourceOwner,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnCreationDate = Column{
+		name:  projection.GroupMembershipColumnCreationDate,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnChangeDate = Column{
+		name:  projection.GroupMembershipColumnChangeDate,
+		table: groupMembershipsTable,
+	}
+	GroupMembershipColumnSequence = Column{
+		name:  projection.GroupMembershipColumnSequence,
+		table: groupMembershipsTable,
+	}
+)
+
+var (
+	//go:embed user_groups.sql
+	userGroupsQuery string
+	//go:embed user_group_memberships.sql
+	userGroupMembershipsQuery string
+	//go:embed user_group_grants.sql
+	userGroupGrantsQuery string
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+type Group struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.GroupState
+	ResourceOwner string
+
+	Name        string
+	Description string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewGroupIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnID, id, TextEquals)
+}
+
+func NewGroupNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func (q *Queries) GroupByID(ctx context.Context, shouldTriggerBulk bool, id, resourceOwner string) (group *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerGroupProjection")
+		ctx, err = projection.GroupProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	eq := sq.Eq{
+		GroupColumnID.identifier():         id,
+		GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[GroupColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareGroupQuery(ctx, q.client)
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gro1q", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		group, err = scan(row)
+		return err
+	}, stmt, args...)
+	return group, err
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries) (groups *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupsQuery(ctx, q.client)
+	eq := sq.Eq{GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gro2q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		groups, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gro3q", "Errors.Internal")
+	}
+	groups.State, err = q.latestState(ctx, groupsTable)
+	return groups, err
+}
+
+func prepareGroupQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+		).From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			g := new(Group)
+			err := row.Scan(
+				&g.ID,
+				&g.CreationDate,
+				&g.ChangeDate,
+				&g.Sequence,
+				&g.State,
+				&g.ResourceOwner,
+				&g.Name,
+				&g.Description,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Gro4q", "Errors.Group.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gro5q", "Errors.Internal")
+			}
+			return g, nil
+		}
+}
+
+func prepareGroupsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			countColumn.identifier(),
+		).From(groupsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(Group)
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.State,
+					&g.ResourceOwner,
+					&g.Name,
+					&g.Description,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, g)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Gro6q", "Errors.Query.CloseRows")
+			}
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+type GroupMembers struct {
+	SearchResponse
+	Members []*GroupMember
+}
+
+// GroupMember is a direct member of a group, either a user or a subgroup
+type GroupMember struct {
+	GroupID      string
+	MemberID     string
+	MemberType   domain.GroupMemberType
+	CreationDate time.Time
+	Sequence     uint64
+}
+
+type GroupMemberSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupMemberSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewGroupMemberGroupIDSearchQuery(groupID string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnGroupID, groupID, TextEquals)
+}
+
+func NewGroupMemberMemberIDSearchQuery(memberID string) (SearchQuery, error) {
+	return NewTextQuery(GroupMemberColumnMemberID, memberID, TextEquals)
+}
+
+func NewGroupMemberTypeSearchQuery(memberType domain.GroupMemberType) (SearchQuery, error) {
+	return NewNumberQuery(GroupMemberColumnMemberType, memberType, NumberEquals)
+}
+
+func (q *Queries) SearchGroupMembers(ctx context.Context, queries *GroupMemberSearchQueries) (members *GroupMembers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupMembersQuery(ctx, q.client)
+	eq := sq.Eq{GroupMemberColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-GMe1q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		members, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GMe2q", "Errors.Internal")
+	}
+	members.State, err = q.latestState(ctx, groupsTable)
+	return members, err
+}
+
+func prepareGroupMembersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupMembers, error)) {
+	return sq.Select(
+			GroupMemberColumnGroupID.identifier(),
+			GroupMemberColumnMemberID.identifier(),
+			GroupMemberColumnMemberType.identifier(),
+			GroupMemberColumnCreationDate.identifier(),
+			GroupMemberColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(groupMembersTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMembers, error) {
+			members := make([]*GroupMember, 0)
+			var count uint64
+			for rows.Next() {
+				m := new(GroupMember)
+				err := rows.Scan(
+					&m.GroupID,
+					&m.MemberID,
+					&m.MemberType,
+					&m.CreationDate,
+					&m.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				members = append(members, m)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-GMe3q", "Errors.Query.CloseRows")
+			}
+			return &GroupMembers{
+				Members: members,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+type GroupGrants struct {
+	SearchResponse
+	Grants []*GroupGrant
+}
+
+type GroupGrant struct {
+	ID            string
+	GroupID       string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	ProjectID     string
+	// GrantID represents the project grant id
+	GrantID string
+	Roles   database.TextArray[string]
+}
+
+type GroupGrantSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupGrantSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewGroupGrantGroupIDSearchQuery(groupID string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGroupID, groupID, TextEquals)
+}
+
+func NewGroupGrantProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, projectID, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantSearchQueries) (grants *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupGrantsQuery(ctx, q.client)
+	eq := sq.Eq{GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-GGr1q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GGr2q", "Errors.Internal")
+	}
+	grants.State, err = q.latestState(ctx, groupsTable)
+	return grants, err
+}
+
+func prepareGroupGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnID.identifier(),
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			countColumn.identifier(),
+		).From(groupGrantsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(GroupGrant)
+				err := rows.Scan(
+					&g.ID,
+					&g.GroupID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.ResourceOwner,
+					&g.ProjectID,
+					&g.GrantID,
+					&g.Roles,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grants = append(grants, g)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-GGr3q", "Errors.Query.CloseRows")
+			}
+			return &GroupGrants{
+				Grants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+type GroupMemberships struct {
+	SearchResponse
+	Memberships []*GroupMembership
+}
+
+// GroupMembership is the membership of a group on an organization or project
+type GroupMembership struct {
+	GroupID       string
+	AggregateType string
+	AggregateID   string
+	Roles         database.TextArray[string]
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+}
+
+type GroupMembershipSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupMembershipSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewGroupMembershipGroupIDSearchQuery(groupID string) (SearchQuery, error) {
+	return NewTextQuery(GroupMembershipColumnGroupID, groupID, TextEquals)
+}
+
+func NewGroupMembershipOrgIDSearchQuery(orgID string) (SearchQuery, error) {
+	aggregateType, err := NewTextQuery(GroupMembershipColumnAggregateType, org.AggregateType, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	aggregateID, err := NewTextQuery(GroupMembershipColumnAggregateID, orgID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(aggregateType, aggregateID)
+}
+
+func NewGroupMembershipProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	aggregateType, err := NewTextQuery(GroupMembershipColumnAggregateType, project.AggregateType, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	aggregateID, err := NewTextQuery(GroupMembershipColumnAggregateID, projectID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(aggregateType, aggregateID)
+}
+
+func NewGroupMembershipResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(GroupMembershipColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func (q *Queries) SearchGroupMemberships(ctx context.Context, queries *GroupMembershipSearchQueries) (memberships *GroupMemberships, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupMembershipsQuery(ctx, q.client)
+	eq := sq.Eq{GroupMembershipColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-GMs1q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		memberships, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GMs2q", "Errors.Internal")
+	}
+	memberships.State, err = q.latestState(ctx, groupMembershipsTable)
+	return memberships, err
+}
+
+func prepareGroupMembershipsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*GroupMemberships, error)) {
+	return sq.Select(
+			GroupMembershipColumnGroupID.identifier(),
+			GroupMembershipColumnAggregateType.identifier(),
+			GroupMembershipColumnAggregateID.identifier(),
+			GroupMembershipColumnRoles.identifier(),
+			GroupMembershipColumnResourceOwner.identifier(),
+			GroupMembershipColumnCreationDate.identifier(),
+			GroupMembershipColumnChangeDate.identifier(),
+			GroupMembershipColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(groupMembershipsTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMemberships, error) {
+			memberships := make([]*GroupMembership, 0)
+			var count uint64
+			for rows.Next() {
+				m := new(GroupMembership)
+				err := rows.Scan(
+					&m.GroupID,
+					&m.AggregateType,
+					&m.AggregateID,
+					&m.Roles,
+					&m.ResourceOwner,
+					&m.CreationDate,
+					&m.ChangeDate,
+					&m.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				memberships = append(memberships, m)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-GMs3q", "Errors.Query.CloseRows")
+			}
+			return &GroupMemberships{
+				Memberships: memberships,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+// UserGroupMemberships returns the organization and project memberships of the organization
+// the user inherits through the groups (and their parent groups) the user is a member of.
+func (q *Queries) UserGroupMemberships(ctx context.Context, userID, orgID string) (memberships []*Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		memberships, err = scanUserGroupMemberships(rows)
+		return err
+	}, userGroupsQuery+userGroupMembershipsQuery, userID, authz.GetInstance(ctx).InstanceID(), orgID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GMs4q", "Errors.Internal")
+	}
+	return memberships, nil
+}
+
+func scanUserGroupMemberships(rows *sql.Rows) ([]*Membership, error) {
+	memberships := make([]*Membership, 0)
+	for rows.Next() {
+		var (
+			membership    = new(Membership)
+			aggregateType string
+			aggregateID   string
+		)
+		err := rows.Scan(
+			&aggregateType,
+			&aggregateID,
+			&membership.Roles,
+			&membership.CreationDate,
+			&membership.ChangeDate,
+			&membership.Sequence,
+			&membership.ResourceOwner,
+		)
+		if err != nil {
+			return nil, err
+		}
+		switch aggregateType {
+		case org.AggregateType:
+			membership.Org = &OrgMembership{OrgID: aggregateID}
+		case project.AggregateType:
+			membership.Project = &ProjectMembership{ProjectID: aggregateID}
+		default:
+			continue
+		}
+		memberships = append(memberships, membership)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GMs5q", "Errors.Query.CloseRows")
+	}
+	return memberships, nil
+}
+
+// UserGroupGrants returns the grants on the projects
+// the user inherits through the groups (and their parent groups) the user is a member of.
+func (q *Queries) UserGroupGrants(ctx context.Context, userID string, projectIDs []string) (grants []*UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scanUserGroupGrants(userID, rows)
+		return err
+	}, userGroupsQuery+userGroupGrantsQuery, userID, authz.GetInstance(ctx).InstanceID(), database.TextArray[string](projectIDs))
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GGr4q", "Errors.Internal")
+	}
+	return grants, nil
+}
+
+func scanUserGroupGrants(userID string, rows *sql.Rows) ([]*UserGrant, error) {
+	grants := make([]*UserGrant, 0)
+	for rows.Next() {
+		var (
+			grant            = &UserGrant{UserID: userID, State: domain.UserGrantStateActive}
+			orgName          sql.NullString
+			orgPrimaryDomain sql.NullString
+			projectName      sql.NullString
+		)
+		err := rows.Scan(
+			&grant.ID,
+			&grant.GrantID,
+			&grant.CreationDate,
+			&grant.ChangeDate,
+			&grant.Sequence,
+			&grant.Roles,
+			&grant.ResourceOwner,
+			&orgName,
+			&orgPrimaryDomain,
+			&grant.ProjectID,
+			&projectName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		grant.OrgName = orgName.String
+		grant.OrgPrimaryDomain = orgPrimaryDomain.String
+		grant.ProjectName = projectName.String
+		grants = append(grants, grant)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-GGr5q", "Errors.Query.CloseRows")
+	}
+	return grants, nil
+}
@@ -0,0 +1,234 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// IDPDiscoveryDomain routes users with a login name of the domain to the identity provider
+type IDPDiscoveryDomain struct {
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+	Domain       string
+	IDPID        string
+	IDPName      string
+	// IDPResourceOwner is the instance or organization the identity provider belongs to
+	IDPResourceOwner string
+	IDPOwnerType     domain.IdentityProviderType
+	IDPType          domain.IDPType
+}
+
+type IDPDiscoveryDomains struct {
+	SearchResponse
+	Domains []*IDPDiscoveryDomain
+}
+
+type IDPDiscoveryDomainSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *IDPDiscoveryDomainSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewIDPDiscoveryDomainDomainSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(IDPDiscoveryDomainDomainCol, value, method)
+}
+
+func NewIDPDiscoveryDomainIDPIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(IDPDiscoveryDomainIDPIDCol, value, TextEquals)
+}
+
+// IDPDiscoveryDomainByLoginName returns the identity provider the user has to be routed to,
+// based on the domain of the login name (e.g. customer.com of alice@customer.com).
+// A not found error is returned if no (active) identity provider is assigned to the domain.
+func (q *Queries) IDPDiscoveryDomainByLoginName(ctx context.Context, loginName string) (_ *IDPDiscoveryDomain, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	index := strings.LastIndex(loginName, "@")
+	if index < 0 || index == len(loginName)-1 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Hrd5n", "Errors.IDPConfig.DiscoveryDomainNotFound")
+	}
+	discoveryDomain := strings.ToLower(strings.TrimSpace(loginName[index+1:]))
+
+	query, scan := prepareIDPDiscoveryDomainQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		IDPDiscoveryDomainInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		IDPDiscoveryDomainDomainCol.identifier():     discoveryDomain,
+		IDPTemplateOwnerRemovedCol.identifier():      false,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hrd5s", "Errors.Query.SQLStatement")
+	}
+
+	var discovery *IDPDiscoveryDomain
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		discovery, err = scan(row)
+		return err
+	}, stmt, args...)
+	return discovery, err
+}
+
+func (q *Queries) SearchIDPDiscoveryDomains(ctx context.Context, queries *IDPDiscoveryDomainSearchQueries) (domains *IDPDiscoveryDomains, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareIDPDiscoveryDomainsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).
+		Where(sq.Eq{
+			IDPDiscoveryDomainInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+			IDPTemplateOwnerRemovedCol.identifier():      false,
+		}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Hrd6s", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		domains, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	domains.State, err = q.latestState(ctx, idpDiscoveryDomainsTable)
+	return domains, err
+}
+
+func prepareIDPDiscoveryDomainQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*IDPDiscoveryDomain, error)) {
+	return sq.Select(
+			IDPDiscoveryDomainCreationDateCol.identifier(),
+			IDPDiscoveryDomainChangeDateCol.identifier(),
+			IDPDiscoveryDomainSequenceCol.identifier(),
+			IDPDiscoveryDomainDomainCol.identifier(),
+			IDPDiscoveryDomainIDPIDCol.identifier(),
+			IDPTemplateNameCol.identifier(),
+			IDPTemplateResourceOwnerCol.identifier(),
+			IDPTemplateOwnerTypeCol.identifier(),
+			IDPTemplateTypeCol.identifier(),
+		).From(idpDiscoveryDomainsTable.identifier()).
+			Join(join(IDPTemplateIDCol, IDPDiscoveryDomainIDPIDCol) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*IDPDiscoveryDomain, error) {
+			discovery := new(IDPDiscoveryDomain)
+			err := row.Scan(
+				&discovery.CreationDate,
+				&discovery.ChangeDate,
+				&discovery.Sequence,
+				&discovery.Domain,
+				&discovery.IDPID,
+				&discovery.IDPName,
+				&discovery.IDPResourceOwner,
+				&discovery.IDPOwnerType,
+				&discovery.IDPType,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Hrd7n", "Errors.IDPConfig.DiscoveryDomainNotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Hrd7s", "Errors.Internal")
+			}
+			return discovery, nil
+		}
+}
+
+func prepareIDPDiscoveryDomainsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*IDPDiscoveryDomains, error)) {
+	return sq.Select(
+			IDPDiscoveryDomainCreationDateCol.identifier(),
+			IDPDiscoveryDomainChangeDateCol.identifier(),
+			IDPDiscoveryDomainSequenceCol.identifier(),
+			IDPDiscoveryDomainDomainCol.identifier(),
+			IDPDiscoveryDomainIDPIDCol.identifier(),
+			IDPTemplateNameCol.identifier(),
+			IDPTemplateResourceOwnerCol.identifier(),
+			IDPTemplateOwnerTypeCol.identifier(),
+			IDPTemplateTypeCol.identifier(),
+			countColumn.identifier(),
+		).From(idpDiscoveryDomainsTable.identifier()).
+			Join(join(IDPTemplateIDCol, IDPDiscoveryDomainIDPIDCol) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*IDPDiscoveryDomains, error) {
+			domains := make([]*IDPDiscoveryDomain, 0)
+			var count uint64
+			for rows.Next() {
+				discovery := new(IDPDiscoveryDomain)
+				err := rows.Scan(
+					&discovery.CreationDate,
+					&discovery.ChangeDate,
+					&discovery.Sequence,
+					&discovery.Domain,
+					&discovery.IDPID,
+					&discovery.IDPName,
+					&discovery.IDPResourceOwner,
+					&discovery.IDPOwnerType,
+					&discovery.IDPType,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				domains = append(domains, discovery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Hrd8c", "Errors.Query.CloseRows")
+			}
+
+			return &IDPDiscoveryDomains{
+				Domains: domains,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+var (
+	idpDiscoveryDomainsTable = table{
+		name:          projection.IDPDiscoveryDomainTable,
+		instanceIDCol: projection.IDPDiscoveryDomainInstanceIDCol,
+	}
+
+	IDPDiscoveryDomainInstanceIDCol = Column{
+		name:  projection.IDPDiscoveryDomainInstanceIDCol,
+		table: idpDiscoveryDomainsTable,
+	}
+	IDPDiscoveryDomainDomainCol = Column{
+		name:  projection.IDPDiscoveryDomainDomainCol,
+		table: idpDiscoveryDomainsTable,
+	}
+	IDPDiscoveryDomainIDPIDCol = Column{
+		name:  projection.IDPDiscoveryDomainIDPIDCol,
+		table: idpDiscoveryDomainsTable,
+	}
+	IDPDiscoveryDomainCreationDateCol = Column{
+		name:  projection.IDPDiscoveryDomainCreationDateCol,
+		table: idpDiscoveryDomainsTable,
+	}
+	IDPDiscoveryDomainChangeDateCol = Column{
+		name:  projection.IDPDiscoveryDomainChangeDateCol,
+		table: idpDiscoveryDomainsTable,
+	}
+	IDPDiscoveryDomainSequenceCol = Column{
+		name:  projection.IDPDiscoveryDomainSequenceCol,
+		table: idpDiscoveryDomainsTable,
+	}
+)
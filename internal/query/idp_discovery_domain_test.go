@@ -0,0 +1,231 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareIDPDiscoveryDomainStmt = `SELECT projections.idp_discovery_domains.creation_date,` +
+		` projections.idp_discovery_domains.change_date,` +
+		` projections.idp_discovery_domains.sequence,` +
+		` projections.idp_discovery_domains.domain,` +
+		` projections.idp_discovery_domains.idp_id,` +
+		` projections.idp_templates6.name,` +
+		` projections.idp_templates6.resource_owner,` +
+		` projections.idp_templates6.owner_type,` +
+		` projections.idp_templates6.type` +
+		` FROM projections.idp_discovery_domains` +
+		` JOIN projections.idp_templates6 ON projections.idp_discovery_domains.idp_id = projections.idp_templates6.id AND projections.idp_discovery_domains.instance_id = projections.idp_templates6.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareIDPDiscoveryDomainCols = []string{
+		"creation_date",
+		"change_date",
+		"sequence",
+		"domain",
+		"idp_id",
+		"name",
+		"resource_owner",
+		"owner_type",
+		"type",
+	}
+	prepareIDPDiscoveryDomainsStmt = `SELECT projections.idp_discovery_domains.creation_date,` +
+		` projections.idp_discovery_domains.change_date,` +
+		` projections.idp_discovery_domains.sequence,` +
+		` projections.idp_discovery_domains.domain,` +
+		` projections.idp_discovery_domains.idp_id,` +
+		` projections.idp_templates6.name,` +
+		` projections.idp_templates6.resource_owner,` +
+		` projections.idp_templates6.owner_type,` +
+		` projections.idp_templates6.type,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.idp_discovery_domains` +
+		` JOIN projections.idp_templates6 ON projections.idp_discovery_domains.idp_id = projections.idp_templates6.id AND projections.idp_discovery_domains.instance_id = projections.idp_templates6.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareIDPDiscoveryDomainsCols = append(prepareIDPDiscoveryDomainCols, "count")
+)
+
+func Test_IDPDiscoveryDomainPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareIDPDiscoveryDomainQuery no result",
+			prepare: prepareIDPDiscoveryDomainQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*IDPDiscoveryDomain)(nil),
+		},
+		{
+			name:    "prepareIDPDiscoveryDomainQuery found",
+			prepare: prepareIDPDiscoveryDomainQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainStmt),
+					prepareIDPDiscoveryDomainCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						uint64(20211109),
+						"customer.com",
+						"idp-id",
+						"customer",
+						"org-id",
+						domain.IdentityProviderTypeOrg,
+						domain.IDPTypeAzureAD,
+					},
+				),
+			},
+			object: &IDPDiscoveryDomain{
+				CreationDate:     testNow,
+				ChangeDate:       testNow,
+				Sequence:         20211109,
+				Domain:           "customer.com",
+				IDPID:            "idp-id",
+				IDPName:          "customer",
+				IDPResourceOwner: "org-id",
+				IDPOwnerType:     domain.IdentityProviderTypeOrg,
+				IDPType:          domain.IDPTypeAzureAD,
+			},
+		},
+		{
+			name:    "prepareIDPDiscoveryDomainQuery sql err",
+			prepare: prepareIDPDiscoveryDomainQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*IDPDiscoveryDomain)(nil),
+		},
+		{
+			name:    "prepareIDPDiscoveryDomainsQuery no result",
+			prepare: prepareIDPDiscoveryDomainsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &IDPDiscoveryDomains{Domains: []*IDPDiscoveryDomain{}},
+		},
+		{
+			name:    "prepareIDPDiscoveryDomainsQuery multiple result",
+			prepare: prepareIDPDiscoveryDomainsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainsStmt),
+					prepareIDPDiscoveryDomainsCols,
+					[][]driver.Value{
+						{
+							testNow,
+							testNow,
+							uint64(20211109),
+							"customer.com",
+							"idp-id",
+							"customer",
+							"org-id",
+							domain.IdentityProviderTypeOrg,
+							domain.IDPTypeAzureAD,
+						},
+						{
+							testNow,
+							testNow,
+							uint64(20211109),
+							"partner.com",
+							"idp-id2",
+							"partner",
+							"inst-id",
+							domain.IdentityProviderTypeSystem,
+							domain.IDPTypeSAML,
+						},
+					},
+				),
+			},
+			object: &IDPDiscoveryDomains{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Domains: []*IDPDiscoveryDomain{
+					{
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						Sequence:         20211109,
+						Domain:           "customer.com",
+						IDPID:            "idp-id",
+						IDPName:          "customer",
+						IDPResourceOwner: "org-id",
+						IDPOwnerType:     domain.IdentityProviderTypeOrg,
+						IDPType:          domain.IDPTypeAzureAD,
+					},
+					{
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						Sequence:         20211109,
+						Domain:           "partner.com",
+						IDPID:            "idp-id2",
+						IDPName:          "partner",
+						IDPResourceOwner: "inst-id",
+						IDPOwnerType:     domain.IdentityProviderTypeSystem,
+						IDPType:          domain.IDPTypeSAML,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareIDPDiscoveryDomainsQuery sql err",
+			prepare: prepareIDPDiscoveryDomainsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareIDPDiscoveryDomainsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*IDPDiscoveryDomains)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// LDAPSyncIDP is an active LDAP identity provider with enabled directory synchronization
+type LDAPSyncIDP struct {
+	InstanceID   string
+	IDPID        string
+	SyncInterval time.Duration
+}
+
+// LDAPSyncIDPs returns the LDAP identity providers of all instances with enabled directory synchronization.
+// It's used by the background synchronization and is therefore not restricted to the instance of the context.
+func (q *Queries) LDAPSyncIDPs(ctx context.Context) (idps []*LDAPSyncIDP, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareLDAPSyncIDPsQuery(ctx, q.client)
+	stmt, args, err := query.Where(
+		sq.Eq{
+			LDAPSyncEnabledCol.identifier():         true,
+			IDPTemplateStateCol.identifier():        domain.IDPStateActive,
+			IDPTemplateOwnerRemovedCol.identifier(): false,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lds9e1", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		idps, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lds9e2", "Errors.Internal")
+	}
+	return idps, nil
+}
+
+func prepareLDAPSyncIDPsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*LDAPSyncIDP, error)) {
+	return sq.Select(
+			LDAPInstanceIDCol.identifier(),
+			LDAPIDCol.identifier(),
+			LDAPSyncIntervalCol.identifier(),
+		).From(ldapIdpTemplateTable.identifier()).
+			Join(join(IDPTemplateIDCol, LDAPIDCol) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*LDAPSyncIDP, error) {
+			idps := make([]*LDAPSyncIDP, 0)
+			for rows.Next() {
+				idp := new(LDAPSyncIDP)
+				interval := sql.NullInt64{}
+				if err := rows.Scan(
+					&idp.InstanceID,
+					&idp.IDPID,
+					&interval,
+				); err != nil {
+					return nil, err
+				}
+				idp.SyncInterval = time.Duration(interval.Int64)
+				idps = append(idps, idp)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Lds9e3", "Errors.Query.CloseRows")
+			}
+			return idps, nil
+		}
+}
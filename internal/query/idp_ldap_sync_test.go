@@ -0,0 +1,106 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	ldapSyncIDPsQuery = regexp.QuoteMeta(`SELECT projections.idp_templates6_ldap2.instance_id,` +
+		` projections.idp_templates6_ldap2.idp_id,` +
+		` projections.idp_templates6_ldap2.sync_interval` +
+		` FROM projections.idp_templates6_ldap2` +
+		` JOIN projections.idp_templates6 ON projections.idp_templates6_ldap2.idp_id = projections.idp_templates6.id AND projections.idp_templates6_ldap2.instance_id = projections.idp_templates6.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`)
+	ldapSyncIDPsCols = []string{
+		"instance_id",
+		"idp_id",
+		"sync_interval",
+	}
+)
+
+func Test_LDAPSyncIDPsPrepare(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLDAPSyncIDPsQuery no result",
+			prepare: prepareLDAPSyncIDPsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					ldapSyncIDPsQuery,
+					nil,
+					nil,
+				),
+			},
+			object: []*LDAPSyncIDP{},
+		},
+		{
+			name:    "prepareLDAPSyncIDPsQuery",
+			prepare: prepareLDAPSyncIDPsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					ldapSyncIDPsQuery,
+					ldapSyncIDPsCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"idp-id",
+							time.Hour,
+						},
+						{
+							"instance-id",
+							"idp-id-2",
+							nil,
+						},
+					},
+				),
+			},
+			object: []*LDAPSyncIDP{
+				{
+					InstanceID:   "instance-id",
+					IDPID:        "idp-id",
+					SyncInterval: time.Hour,
+				},
+				{
+					InstanceID: "instance-id",
+					IDPID:      "idp-id-2",
+				},
+			},
+		},
+		{
+			name:    "prepareLDAPSyncIDPsQuery sql err",
+			prepare: prepareLDAPSyncIDPsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					ldapSyncIDPsQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*LDAPSyncIDP)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -36,6 +37,7 @@ type IDPTemplate struct {
 	IsAutoCreation    bool
 	IsAutoUpdate      bool
 	AutoLinking       domain.AutoLinkingOption
+	AttributeMappings []domain.IDPAttributeMapping
 	*OAuthIDPTemplate
 	*OIDCIDPTemplate
 	*JWTIDPTemplate
@@ -143,6 +145,7 @@ type LDAPIDPTemplate struct {
 	UserFilters       []string
 	Timeout           time.Duration
 	idp.LDAPAttributes
+	idp.LDAPSyncOptions
 }
 
 type AppleIDPTemplate struct {
@@ -234,6 +237,10 @@ var (
 		name:  projection.IDPTemplateAutoLinkingCol,
 		table: idpTemplateTable,
 	}
+	IDPTemplateAttributeMappingsCol = Column{
+		name:  projection.IDPTemplateAttributeMappingsCol,
+		table: idpTemplateTable,
+	}
 )
 
 var (
@@ -632,6 +639,18 @@ var (
 		name:  projection.LDAPProfileAttributeCol,
 		table: ldapIdpTemplateTable,
 	}
+	LDAPSyncEnabledCol = Column{
+		name:  projection.LDAPSyncEnabledCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPSyncFilterCol = Column{
+		name:  projection.LDAPSyncFilterCol,
+		table: ldapIdpTemplateTable,
+	}
+	LDAPSyncIntervalCol = Column{
+		name:  projection.LDAPSyncIntervalCol,
+		table: ldapIdpTemplateTable,
+	}
 )
 
 var (
@@ -796,6 +815,40 @@ func (q *Queries) IDPTemplates(ctx context.Context, queries *IDPTemplateSearchQu
 	return idps, err
 }
 
+// IDPTemplateSecret returns the decrypted client secret, bind password or private key of the template.
+// An empty string is returned for types without a secret.
+func (q *Queries) IDPTemplateSecret(template *IDPTemplate) (string, error) {
+	var secret *crypto.CryptoValue
+	switch template.Type {
+	case domain.IDPTypeOAuth:
+		secret = template.OAuthIDPTemplate.ClientSecret
+	case domain.IDPTypeOIDC:
+		secret = template.OIDCIDPTemplate.ClientSecret
+	case domain.IDPTypeAzureAD:
+		secret = template.AzureADIDPTemplate.ClientSecret
+	case domain.IDPTypeGitHub:
+		secret = template.GitHubIDPTemplate.ClientSecret
+	case domain.IDPTypeGitHubEnterprise:
+		secret = template.GitHubEnterpriseIDPTemplate.ClientSecret
+	case domain.IDPTypeGitLab:
+		secret = template.GitLabIDPTemplate.ClientSecret
+	case domain.IDPTypeGitLabSelfHosted:
+		secret = template.GitLabSelfHostedIDPTemplate.ClientSecret
+	case domain.IDPTypeGoogle:
+		secret = template.GoogleIDPTemplate.ClientSecret
+	case domain.IDPTypeLDAP:
+		secret = template.LDAPIDPTemplate.BindPassword
+	case domain.IDPTypeApple:
+		secret = template.AppleIDPTemplate.PrivateKey
+	case domain.IDPTypeUnspecified, domain.IDPTypeJWT, domain.IDPTypeSAML:
+		return "", nil
+	}
+	if secret == nil || secret.Crypted == nil {
+		return "", nil
+	}
+	return crypto.DecryptString(secret, q.idpConfigEncryption)
+}
+
 type IDPTemplateSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -849,6 +902,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateAttributeMappingsCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -940,6 +994,9 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPSyncEnabledCol.identifier(),
+			LDAPSyncFilterCol.identifier(),
+			LDAPSyncIntervalCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -965,6 +1022,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			idpTemplate := new(IDPTemplate)
 
 			name := sql.NullString{}
+			var attributeMappings []byte
 
 			oauthID := sql.NullString{}
 			oauthClientID := sql.NullString{}
@@ -1056,6 +1114,9 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			ldapPreferredLanguageAttribute := sql.NullString{}
 			ldapAvatarURLAttribute := sql.NullString{}
 			ldapProfileAttribute := sql.NullString{}
+			ldapSyncEnabled := sql.NullBool{}
+			ldapSyncFilter := sql.NullString{}
+			ldapSyncInterval := sql.NullInt64{}
 
 			appleID := sql.NullString{}
 			appleClientID := sql.NullString{}
@@ -1079,6 +1140,7 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&idpTemplate.IsAutoCreation,
 				&idpTemplate.IsAutoUpdate,
 				&idpTemplate.AutoLinking,
+				&attributeMappings,
 				// oauth
 				&oauthID,
 				&oauthClientID,
@@ -1170,6 +1232,9 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 				&ldapPreferredLanguageAttribute,
 				&ldapAvatarURLAttribute,
 				&ldapProfileAttribute,
+				&ldapSyncEnabled,
+				&ldapSyncFilter,
+				&ldapSyncInterval,
 				// apple
 				&appleID,
 				&appleClientID,
@@ -1186,6 +1251,9 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 			}
 
 			idpTemplate.Name = name.String
+			if err := unmarshalIDPAttributeMappings(attributeMappings, &idpTemplate.AttributeMappings); err != nil {
+				return nil, err
+			}
 
 			if oauthID.Valid {
 				idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
@@ -1311,6 +1379,11 @@ func prepareIDPTemplateByIDQuery(ctx context.Context, db prepareDatabase) (sq.Se
 						AvatarURLAttribute:         ldapAvatarURLAttribute.String,
 						ProfileAttribute:           ldapProfileAttribute.String,
 					},
+					LDAPSyncOptions: idp.LDAPSyncOptions{
+						SyncEnabled:  ldapSyncEnabled.Bool,
+						SyncFilter:   ldapSyncFilter.String,
+						SyncInterval: time.Duration(ldapSyncInterval.Int64),
+					},
 				}
 			}
 			if appleID.Valid {
@@ -1344,6 +1417,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateAttributeMappingsCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -1435,6 +1509,9 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			LDAPPreferredLanguageAttributeCol.identifier(),
 			LDAPAvatarURLAttributeCol.identifier(),
 			LDAPProfileAttributeCol.identifier(),
+			LDAPSyncEnabledCol.identifier(),
+			LDAPSyncFilterCol.identifier(),
+			LDAPSyncIntervalCol.identifier(),
 			// apple
 			AppleIDCol.identifier(),
 			AppleClientIDCol.identifier(),
@@ -1465,6 +1542,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				idpTemplate := new(IDPTemplate)
 
 				name := sql.NullString{}
+				var attributeMappings []byte
 
 				oauthID := sql.NullString{}
 				oauthClientID := sql.NullString{}
@@ -1556,6 +1634,9 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				ldapPreferredLanguageAttribute := sql.NullString{}
 				ldapAvatarURLAttribute := sql.NullString{}
 				ldapProfileAttribute := sql.NullString{}
+				ldapSyncEnabled := sql.NullBool{}
+				ldapSyncFilter := sql.NullString{}
+				ldapSyncInterval := sql.NullInt64{}
 
 				appleID := sql.NullString{}
 				appleClientID := sql.NullString{}
@@ -1579,6 +1660,7 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&idpTemplate.IsAutoCreation,
 					&idpTemplate.IsAutoUpdate,
 					&idpTemplate.AutoLinking,
+					&attributeMappings,
 					// oauth
 					&oauthID,
 					&oauthClientID,
@@ -1670,6 +1752,9 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 					&ldapPreferredLanguageAttribute,
 					&ldapAvatarURLAttribute,
 					&ldapProfileAttribute,
+					&ldapSyncEnabled,
+					&ldapSyncFilter,
+					&ldapSyncInterval,
 					// apple
 					&appleID,
 					&appleClientID,
@@ -1685,6 +1770,9 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 				}
 
 				idpTemplate.Name = name.String
+				if err := unmarshalIDPAttributeMappings(attributeMappings, &idpTemplate.AttributeMappings); err != nil {
+					return nil, err
+				}
 
 				if oauthID.Valid {
 					idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
@@ -1810,6 +1898,11 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 							AvatarURLAttribute:         ldapAvatarURLAttribute.String,
 							ProfileAttribute:           ldapProfileAttribute.String,
 						},
+						LDAPSyncOptions: idp.LDAPSyncOptions{
+							SyncEnabled:  ldapSyncEnabled.Bool,
+							SyncFilter:   ldapSyncFilter.String,
+							SyncInterval: time.Duration(ldapSyncInterval.Int64),
+						},
 					}
 				}
 				if appleID.Valid {
@@ -1837,3 +1930,13 @@ func prepareIDPTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.Selec
 			}, nil
 		}
 }
+
+func unmarshalIDPAttributeMappings(data []byte, mappings *[]domain.IDPAttributeMapping) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, mappings); err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-Atm5q", "Errors.Internal")
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.attribute_mappings,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -121,6 +122,9 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.sync_enabled,` +
+		` projections.idp_templates6_ldap2.sync_filter,` +
+		` projections.idp_templates6_ldap2.sync_interval,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -157,6 +161,7 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"attribute_mappings",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -248,6 +253,9 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"sync_enabled",
+		"sync_filter",
+		"sync_interval",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -270,6 +278,7 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.attribute_mappings,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -361,6 +370,9 @@ var (
 		` projections.idp_templates6_ldap2.preferred_language_attribute,` +
 		` projections.idp_templates6_ldap2.avatar_url_attribute,` +
 		` projections.idp_templates6_ldap2.profile_attribute,` +
+		` projections.idp_templates6_ldap2.sync_enabled,` +
+		` projections.idp_templates6_ldap2.sync_filter,` +
+		` projections.idp_templates6_ldap2.sync_interval,` +
 		// apple
 		` projections.idp_templates6_apple.idp_id,` +
 		` projections.idp_templates6_apple.client_id,` +
@@ -398,6 +410,7 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"attribute_mappings",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -489,6 +502,9 @@ var (
 		"preferred_language_attribute",
 		"avatar_url_attribute",
 		"profile_attribute",
+		"sync_enabled",
+		"sync_filter",
+		"sync_interval",
 		// apple config
 		"idp_id",
 		"client_id",
@@ -551,6 +567,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						[]byte(`[{"source":"department","target":11,"key":"department","transform":1}]`),
 						// oauth
 						"idp-id",
 						"client_id",
@@ -642,6 +659,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -667,6 +687,14 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				IsAutoCreation:    true,
 				IsAutoUpdate:      true,
 				AutoLinking:       domain.AutoLinkingOptionUsername,
+				AttributeMappings: []domain.IDPAttributeMapping{
+					{
+						Source:    "department",
+						Target:    domain.IDPAttributeMappingTargetMetadata,
+						Key:       "department",
+						Transform: domain.IDPAttributeTransformLowercase,
+					},
+				},
 				OAuthIDPTemplate: &OAuthIDPTemplate{
 					IDPID:                 "idp-id",
 					ClientID:              "client_id",
@@ -701,6 +729,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -792,6 +821,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -849,6 +881,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -940,6 +973,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -996,6 +1032,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1087,6 +1124,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1142,6 +1182,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1233,6 +1274,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1288,6 +1332,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1379,6 +1424,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1435,6 +1483,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1526,6 +1575,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1581,6 +1633,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1672,6 +1725,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -1731,6 +1787,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1822,6 +1879,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"lang",
 						"avatar",
 						"profile",
+						true,
+						"(department=it)",
+						3600000000000,
 						// apple
 						nil,
 						nil,
@@ -1872,6 +1932,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						AvatarURLAttribute:         "avatar",
 						ProfileAttribute:           "profile",
 					},
+					LDAPSyncOptions: idp.LDAPSyncOptions{
+						SyncEnabled:  true,
+						SyncFilter:   "(department=it)",
+						SyncInterval: time.Hour,
+					},
 				},
 			},
 		},
@@ -1897,6 +1962,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -1988,6 +2054,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						"idp-id",
 						"client_id",
@@ -2045,6 +2114,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
 						// oauth
 						nil,
 						nil,
@@ -2136,6 +2206,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// apple
 						nil,
 						nil,
@@ -2221,6 +2294,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2312,6 +2386,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							true,
+							"(department=it)",
+							3600000000000,
 							// apple
 							nil,
 							nil,
@@ -2368,6 +2445,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 								AvatarURLAttribute:         "avatar",
 								ProfileAttribute:           "profile",
 							},
+							LDAPSyncOptions: idp.LDAPSyncOptions{
+								SyncEnabled:  true,
+								SyncFilter:   "(department=it)",
+								SyncInterval: time.Hour,
+							},
 						},
 					},
 				},
@@ -2396,6 +2478,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2487,6 +2570,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2545,6 +2631,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2636,6 +2723,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							"lang",
 							"avatar",
 							"profile",
+							true,
+							"(department=it)",
+							3600000000000,
 							// apple
 							nil,
 							nil,
@@ -2659,6 +2749,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2750,6 +2841,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2773,6 +2867,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -2864,6 +2959,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -2887,6 +2985,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							"idp-id-oauth",
 							"client_id",
@@ -2978,6 +3077,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3001,6 +3103,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -3092,6 +3195,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3115,6 +3221,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
 							// oauth
 							nil,
 							nil,
@@ -3206,6 +3313,9 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// apple
 							nil,
 							nil,
@@ -3262,6 +3372,11 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 								AvatarURLAttribute:         "avatar",
 								ProfileAttribute:           "profile",
 							},
+							LDAPSyncOptions: idp.LDAPSyncOptions{
+								SyncEnabled:  true,
+								SyncFilter:   "(department=it)",
+								SyncInterval: time.Hour,
+							},
 						},
 					},
 					{
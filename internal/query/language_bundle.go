@@ -0,0 +1,106 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// LanguageBundle is a language bundle uploaded to the instance, the content is stored as asset.
+type LanguageBundle struct {
+	Namespace  i18n.Namespace
+	Language   language.Tag
+	AssetKey   string
+	ChangeDate time.Time
+	Sequence   uint64
+}
+
+// Content loads the bundle from the static storage.
+func (b *LanguageBundle) Content(ctx context.Context, storage static.Storage) ([]byte, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	content, _, err := storage.GetObject(ctx, instanceID, instanceID, b.AssetKey)
+	return content, err
+}
+
+// LanguageBundles returns the language bundles of the namespace uploaded to the instance ordered by language.
+func (q *Queries) LanguageBundles(ctx context.Context, namespace i18n.Namespace) (_ []*LanguageBundle, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewInstanceLanguageBundlesReadModel(authz.GetInstance(ctx).InstanceID(), namespace)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	bundles := make([]*LanguageBundle, 0, len(readModel.Bundles))
+	for _, bundle := range readModel.Bundles {
+		bundles = append(bundles, bundle)
+	}
+	slices.SortFunc(bundles, func(a, b *LanguageBundle) int {
+		return strings.Compare(a.Language.String(), b.Language.String())
+	})
+	return bundles, nil
+}
+
+type InstanceLanguageBundlesReadModel struct {
+	*eventstore.ReadModel
+
+	Namespace i18n.Namespace
+	Bundles   map[language.Tag]*LanguageBundle
+}
+
+func NewInstanceLanguageBundlesReadModel(instanceID string, namespace i18n.Namespace) *InstanceLanguageBundlesReadModel {
+	return &InstanceLanguageBundlesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Namespace: namespace,
+		Bundles:   make(map[language.Tag]*LanguageBundle),
+	}
+}
+
+func (rm *InstanceLanguageBundlesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *instance.LanguageBundleSetEvent:
+			rm.Bundles[e.Language] = &LanguageBundle{
+				Namespace:  rm.Namespace,
+				Language:   e.Language,
+				AssetKey:   e.AssetKey,
+				ChangeDate: e.CreationDate(),
+				Sequence:   e.Sequence(),
+			}
+		case *instance.LanguageBundleRemovedEvent:
+			delete(rm.Bundles, e.Language)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *InstanceLanguageBundlesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			instance.LanguageBundleSetEventType,
+			instance.LanguageBundleRemovedEventType,
+		).
+		EventData(map[string]interface{}{
+			"namespace": rm.Namespace,
+		}).
+		Builder()
+}
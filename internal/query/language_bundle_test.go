@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+func TestQueries_LanguageBundles(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	aggregate := &instance.NewAggregate("instance1").Aggregate
+
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       []*LanguageBundle
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "no bundles",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			want: []*LanguageBundle{},
+		},
+		{
+			name: "set and removed bundles",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(instance.NewLanguageBundleSetEvent(ctx, aggregate, "login", language.Hebrew, "i18n/login/he.yaml")),
+					eventFromEventPusher(instance.NewLanguageBundleSetEvent(ctx, aggregate, "login", language.Arabic, "i18n/login/ar.yaml")),
+					eventFromEventPusher(instance.NewLanguageBundleSetEvent(ctx, aggregate, "login", language.Korean, "i18n/login/ko.yaml")),
+					eventFromEventPusher(instance.NewLanguageBundleRemovedEvent(ctx, aggregate, "login", language.Korean)),
+				),
+			),
+			want: []*LanguageBundle{
+				{
+					Namespace: i18n.LOGIN,
+					Language:  language.Arabic,
+					AssetKey:  "i18n/login/ar.yaml",
+				},
+				{
+					Namespace: i18n.LOGIN,
+					Language:  language.Hebrew,
+					AssetKey:  "i18n/login/he.yaml",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &Queries{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := q.LanguageBundles(ctx, i18n.LOGIN)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
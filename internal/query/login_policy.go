@@ -38,6 +38,7 @@ type LoginPolicy struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      database.Duration
 	ExternalLoginCheckLifetime database.Duration
@@ -138,6 +139,10 @@ var (
 		name:  projection.DisableLoginWithPhone,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnAllowMagicLink = Column{
+		name:  projection.AllowMagicLink,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnDefaultRedirectURI = Column{
 		name:  projection.DefaultRedirectURI,
 		table: loginPolicyTable,
@@ -378,6 +383,7 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LoginPolicyColumnAllowDomainDiscovery.identifier(),
 			LoginPolicyColumnDisableLoginWithEmail.identifier(),
 			LoginPolicyColumnDisableLoginWithPhone.identifier(),
+			LoginPolicyColumnAllowMagicLink.identifier(),
 			LoginPolicyColumnDefaultRedirectURI.identifier(),
 			LoginPolicyColumnPasswordCheckLifetime.identifier(),
 			LoginPolicyColumnExternalLoginCheckLifetime.identifier(),
@@ -409,6 +415,7 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&p.AllowDomainDiscovery,
 					&p.DisableLoginWithEmail,
 					&p.DisableLoginWithPhone,
+					&p.AllowMagicLink,
 					&defaultRedirectURI,
 					&p.PasswordCheckLifetime,
 					&p.ExternalLoginCheckLifetime,
@@ -33,6 +33,7 @@ var (
 		` projections.login_policies5.allow_domain_discovery,` +
 		` projections.login_policies5.disable_login_with_email,` +
 		` projections.login_policies5.disable_login_with_phone,` +
+		` projections.login_policies5.allow_magic_link,` +
 		` projections.login_policies5.default_redirect_uri,` +
 		` projections.login_policies5.password_check_lifetime,` +
 		` projections.login_policies5.external_login_check_lifetime,` +
@@ -60,6 +61,7 @@ var (
 		"allow_domain_discovery",
 		"disable_login_with_email",
 		"disable_login_with_phone",
+		"allow_magic_link",
 		"default_redirect_uri",
 		"password_check_lifetime",
 		"external_login_check_lifetime",
@@ -139,6 +141,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						true,
 						true,
 						true,
+						true,
 						"https://example.com/redirect",
 						&duration,
 						&duration,
@@ -167,6 +170,7 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				AllowDomainDiscovery:       true,
 				DisableLoginWithEmail:      true,
 				DisableLoginWithPhone:      true,
+				AllowMagicLink:             true,
 				DefaultRedirectURI:         "https://example.com/redirect",
 				PasswordCheckLifetime:      database.Duration(duration),
 				ExternalLoginCheckLifetime: database.Duration(duration),
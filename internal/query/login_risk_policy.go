@@ -0,0 +1,196 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type LoginRiskPolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.PolicyState
+
+	NewDevice        domain.LoginRiskAction
+	NewNetwork       domain.LoginRiskAction
+	ImpossibleTravel domain.LoginRiskAction
+
+	IsDefault bool
+}
+
+var (
+	loginRiskPolicyTable = table{
+		name:          projection.LoginRiskPolicyProjectionTable,
+		instanceIDCol: projection.LoginRiskPolicyColumnInstanceID,
+	}
+	LoginRiskPolicyColID = Column{
+		name:  projection.LoginRiskPolicyColumnID,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColSequence = Column{
+		name:  projection.LoginRiskPolicyColumnSequence,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColCreationDate = Column{
+		name:  projection.LoginRiskPolicyColumnCreationDate,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColChangeDate = Column{
+		name:  projection.LoginRiskPolicyColumnChangeDate,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColResourceOwner = Column{
+		name:  projection.LoginRiskPolicyColumnResourceOwner,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColInstanceID = Column{
+		name:  projection.LoginRiskPolicyColumnInstanceID,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColNewDevice = Column{
+		name:  projection.LoginRiskPolicyColumnNewDevice,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColNewNetwork = Column{
+		name:  projection.LoginRiskPolicyColumnNewNetwork,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColImpossibleTravel = Column{
+		name:  projection.LoginRiskPolicyColumnImpossibleTravel,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColIsDefault = Column{
+		name:  projection.LoginRiskPolicyColumnIsDefault,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColState = Column{
+		name:  projection.LoginRiskPolicyColumnStateCol,
+		table: loginRiskPolicyTable,
+	}
+	LoginRiskPolicyColOwnerRemoved = Column{
+		name:  projection.LoginRiskPolicyColumnOwnerRemoved,
+		table: loginRiskPolicyTable,
+	}
+)
+
+// LoginRiskPolicyByOrg returns the policy of the organization or the default policy of the instance.
+func (q *Queries) LoginRiskPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (policy *LoginRiskPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLoginRiskPolicyProjection")
+		ctx, err = projection.LoginRiskPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		traceSpan.EndWithError(err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	eq := sq.Eq{LoginRiskPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if !withOwnerRemoved {
+		eq[LoginRiskPolicyColOwnerRemoved.identifier()] = false
+	}
+	stmt, scan := prepareLoginRiskPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			eq,
+			sq.Or{
+				sq.Eq{LoginRiskPolicyColID.identifier(): orgID},
+				sq.Eq{LoginRiskPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			},
+		}).
+		OrderBy(LoginRiskPolicyColIsDefault.identifier()).Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ahX4e", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+func (q *Queries) DefaultLoginRiskPolicy(ctx context.Context, shouldTriggerBulk bool) (policy *LoginRiskPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLoginRiskPolicyProjection")
+		ctx, err = projection.LoginRiskPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		traceSpan.EndWithError(err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stmt, scan := prepareLoginRiskPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		LoginRiskPolicyColID.identifier():         authz.GetInstance(ctx).InstanceID(),
+		LoginRiskPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).
+		OrderBy(LoginRiskPolicyColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ii6ae", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+func prepareLoginRiskPolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*LoginRiskPolicy, error)) {
+	return sq.Select(
+			LoginRiskPolicyColID.identifier(),
+			LoginRiskPolicyColSequence.identifier(),
+			LoginRiskPolicyColCreationDate.identifier(),
+			LoginRiskPolicyColChangeDate.identifier(),
+			LoginRiskPolicyColResourceOwner.identifier(),
+			LoginRiskPolicyColNewDevice.identifier(),
+			LoginRiskPolicyColNewNetwork.identifier(),
+			LoginRiskPolicyColImpossibleTravel.identifier(),
+			LoginRiskPolicyColIsDefault.identifier(),
+			LoginRiskPolicyColState.identifier(),
+		).
+			From(loginRiskPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*LoginRiskPolicy, error) {
+			policy := new(LoginRiskPolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.NewDevice,
+				&policy.NewNetwork,
+				&policy.ImpossibleTravel,
+				&policy.IsDefault,
+				&policy.State,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-uY5ae", "Errors.Org.LoginRiskPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-ohJ9i", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	loginRiskPolicyStmt = regexp.QuoteMeta(`SELECT projections.login_risk_policies.id,` +
+		` projections.login_risk_policies.sequence,` +
+		` projections.login_risk_policies.creation_date,` +
+		` projections.login_risk_policies.change_date,` +
+		` projections.login_risk_policies.resource_owner,` +
+		` projections.login_risk_policies.new_device,` +
+		` projections.login_risk_policies.new_network,` +
+		` projections.login_risk_policies.impossible_travel,` +
+		` projections.login_risk_policies.is_default,` +
+		` projections.login_risk_policies.state` +
+		` FROM projections.login_risk_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`)
+	loginRiskPolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"new_device",
+		"new_network",
+		"impossible_travel",
+		"is_default",
+		"state",
+	}
+)
+
+func Test_LoginRiskPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLoginRiskPolicyQuery no result",
+			prepare: prepareLoginRiskPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					loginRiskPolicyStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LoginRiskPolicy)(nil),
+		},
+		{
+			name:    "prepareLoginRiskPolicyQuery found",
+			prepare: prepareLoginRiskPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					loginRiskPolicyStmt,
+					loginRiskPolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						domain.LoginRiskActionNotify,
+						domain.LoginRiskActionRequireMFA,
+						domain.LoginRiskActionBlock,
+						true,
+						domain.PolicyStateActive,
+					},
+				),
+			},
+			object: &LoginRiskPolicy{
+				ID:               "pol-id",
+				CreationDate:     testNow,
+				ChangeDate:       testNow,
+				Sequence:         20211109,
+				ResourceOwner:    "ro",
+				State:            domain.PolicyStateActive,
+				NewDevice:        domain.LoginRiskActionNotify,
+				NewNetwork:       domain.LoginRiskActionRequireMFA,
+				ImpossibleTravel: domain.LoginRiskActionBlock,
+				IsDefault:        true,
+			},
+		},
+		{
+			name:    "prepareLoginRiskPolicyQuery sql err",
+			prepare: prepareLoginRiskPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					loginRiskPolicyStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LoginRiskPolicy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MessageTemplate struct {
+	AggregateID  string
+	Sequence     uint64
+	CreationDate time.Time
+	ChangeDate   time.Time
+	State        domain.PolicyState
+	IsDefault    bool
+
+	Type      string
+	Language  language.Tag
+	HTML      string
+	PlainText string
+}
+
+var (
+	messageTemplateTable = table{
+		name:          projection.MessageTemplateTable,
+		instanceIDCol: projection.MessageTemplateInstanceIDCol,
+	}
+	MessageTemplateColAggregateID = Column{
+		name:  projection.MessageTemplateAggregateIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColInstanceID = Column{
+		name:  projection.MessageTemplateInstanceIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColSequence = Column{
+		name:  projection.MessageTemplateSequenceCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColCreationDate = Column{
+		name:  projection.MessageTemplateCreationDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColChangeDate = Column{
+		name:  projection.MessageTemplateChangeDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColState = Column{
+		name:  projection.MessageTemplateStateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColIsDefault = Column{
+		name:  projection.MessageTemplateIsDefaultCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColType = Column{
+		name:  projection.MessageTemplateTypeCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColLanguage = Column{
+		name:  projection.MessageTemplateLanguageCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColHTML = Column{
+		name:  projection.MessageTemplateHTMLCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColPlainText = Column{
+		name:  projection.MessageTemplatePlainTextCol,
+		table: messageTemplateTable,
+	}
+)
+
+// MessageTemplateByOrg returns the template used for the message type in the requested language.
+// Templates of the organization are preferred over the ones of the instance
+// and templates of the requested language over the ones for all languages.
+func (q *Queries) MessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (_ *MessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	templates, err := q.messageTemplates(ctx, sq.Eq{
+		MessageTemplateColInstanceID.identifier():  instanceID,
+		MessageTemplateColAggregateID.identifier(): []string{orgID, instanceID},
+		MessageTemplateColType.identifier():        messageType,
+		MessageTemplateColLanguage.identifier():    []string{lang.String(), language.Und.String()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var found *MessageTemplate
+	for _, template := range templates {
+		if found == nil || template.preferredOver(found) {
+			found = template
+		}
+	}
+	if found == nil {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ahgh5", "Errors.MessageTemplate.NotFound")
+	}
+	return found, nil
+}
+
+// CustomMessageTemplate returns the template of the organization or instance set exactly for the message type and language.
+func (q *Queries) CustomMessageTemplate(ctx context.Context, aggregateID, messageType string, lang language.Tag) (_ *MessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	templates, err := q.messageTemplates(ctx, sq.Eq{
+		MessageTemplateColInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+		MessageTemplateColAggregateID.identifier(): aggregateID,
+		MessageTemplateColType.identifier():        messageType,
+		MessageTemplateColLanguage.identifier():    lang.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-eiSh4", "Errors.MessageTemplate.NotFound")
+	}
+	return templates[0], nil
+}
+
+func (q *Queries) messageTemplates(ctx context.Context, eq sq.Eq) (templates []*MessageTemplate, err error) {
+	stmt, scan := prepareMessageTemplatesQuery(ctx, q.client)
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Oeg1a", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		templates, err = scan(rows)
+		return err
+	}, query, args...)
+	return templates, err
+}
+
+func (t *MessageTemplate) preferredOver(other *MessageTemplate) bool {
+	if t.IsDefault != other.IsDefault {
+		return !t.IsDefault
+	}
+	return other.Language.IsRoot() && !t.Language.IsRoot()
+}
+
+func prepareMessageTemplatesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*MessageTemplate, error)) {
+	return sq.Select(
+			MessageTemplateColAggregateID.identifier(),
+			MessageTemplateColSequence.identifier(),
+			MessageTemplateColCreationDate.identifier(),
+			MessageTemplateColChangeDate.identifier(),
+			MessageTemplateColState.identifier(),
+			MessageTemplateColIsDefault.identifier(),
+			MessageTemplateColType.identifier(),
+			MessageTemplateColLanguage.identifier(),
+			MessageTemplateColHTML.identifier(),
+			MessageTemplateColPlainText.identifier(),
+		).
+			From(messageTemplateTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*MessageTemplate, error) {
+			templates := make([]*MessageTemplate, 0)
+			for rows.Next() {
+				template := new(MessageTemplate)
+				lang := ""
+				html := sql.NullString{}
+				plainText := sql.NullString{}
+				err := rows.Scan(
+					&template.AggregateID,
+					&template.Sequence,
+					&template.CreationDate,
+					&template.ChangeDate,
+					&template.State,
+					&template.IsDefault,
+					&template.Type,
+					&lang,
+					&html,
+					&plainText,
+				)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-ooG5a", "Errors.Internal")
+				}
+				template.Language = language.Make(lang)
+				template.HTML = html.String
+				template.PlainText = plainText.String
+				templates = append(templates, template)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Thoo8", "Errors.Query.CloseRows")
+			}
+
+			return templates, nil
+		}
+}
@@ -0,0 +1,188 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareMessageTemplatesStmt = `SELECT projections.message_templates.aggregate_id,` +
+		` projections.message_templates.sequence,` +
+		` projections.message_templates.creation_date,` +
+		` projections.message_templates.change_date,` +
+		` projections.message_templates.state,` +
+		` projections.message_templates.is_default,` +
+		` projections.message_templates.type,` +
+		` projections.message_templates.language,` +
+		` projections.message_templates.html,` +
+		` projections.message_templates.plain_text` +
+		` FROM projections.message_templates`
+	prepareMessageTemplatesCols = []string{
+		"aggregate_id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"state",
+		"is_default",
+		"type",
+		"language",
+		"html",
+		"plain_text",
+	}
+)
+
+func Test_MessageTemplatePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareMessageTemplatesQuery no result",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: []*MessageTemplate{},
+		},
+		{
+			name:    "prepareMessageTemplatesQuery found",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					prepareMessageTemplatesCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							domain.PolicyStateActive,
+							false,
+							"InviteUser",
+							"en",
+							"<html>{{.Text}}</html>",
+							nil,
+						},
+						{
+							"instance-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							domain.PolicyStateActive,
+							true,
+							"InviteUser",
+							"und",
+							nil,
+							"{{.Text}}",
+						},
+					},
+				),
+			},
+			object: []*MessageTemplate{
+				{
+					AggregateID:  "org-id",
+					Sequence:     20211109,
+					CreationDate: testNow,
+					ChangeDate:   testNow,
+					State:        domain.PolicyStateActive,
+					IsDefault:    false,
+					Type:         "InviteUser",
+					Language:     language.English,
+					HTML:         "<html>{{.Text}}</html>",
+				},
+				{
+					AggregateID:  "instance-id",
+					Sequence:     20211109,
+					CreationDate: testNow,
+					ChangeDate:   testNow,
+					State:        domain.PolicyStateActive,
+					IsDefault:    true,
+					Type:         "InviteUser",
+					Language:     language.Und,
+					PlainText:    "{{.Text}}",
+				},
+			},
+		},
+		{
+			name:    "prepareMessageTemplatesQuery sql err",
+			prepare: prepareMessageTemplatesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareMessageTemplatesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestMessageTemplate_preferredOver(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *MessageTemplate
+		other    *MessageTemplate
+		want     bool
+	}{
+		{
+			name:     "org over instance",
+			template: &MessageTemplate{IsDefault: false, Language: language.Und},
+			other:    &MessageTemplate{IsDefault: true, Language: language.English},
+			want:     true,
+		},
+		{
+			name:     "instance not over org",
+			template: &MessageTemplate{IsDefault: true, Language: language.English},
+			other:    &MessageTemplate{IsDefault: false, Language: language.Und},
+			want:     false,
+		},
+		{
+			name:     "language over all languages",
+			template: &MessageTemplate{Language: language.English},
+			other:    &MessageTemplate{Language: language.Und},
+			want:     true,
+		},
+		{
+			name:     "all languages not over language",
+			template: &MessageTemplate{Language: language.Und},
+			other:    &MessageTemplate{Language: language.English},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.template.preferredOver(tt.other))
+		})
+	}
+}
@@ -34,6 +34,14 @@ type MessageTexts struct {
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
 	InviteUser               MessageText
+	LoginRisk                MessageText
+	MFAAdded                 MessageText
+	MFARemoved               MessageText
+	PasskeyAdded             MessageText
+	EmailChanged             MessageText
+	PhoneChanged             MessageText
+	AccountLocked            MessageText
+	NewDeviceLogin           MessageText
 }
 
 type MessageText struct {
@@ -349,6 +357,22 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordChange
 	case domain.InviteUserMessageType:
 		return &m.InviteUser
+	case domain.LoginRiskMessageType:
+		return &m.LoginRisk
+	case domain.MFAAddedMessageType:
+		return &m.MFAAdded
+	case domain.MFARemovedMessageType:
+		return &m.MFARemoved
+	case domain.PasskeyAddedMessageType:
+		return &m.PasskeyAdded
+	case domain.EmailChangedMessageType:
+		return &m.EmailChanged
+	case domain.PhoneChangedMessageType:
+		return &m.PhoneChanged
+	case domain.AccountLockedMessageType:
+		return &m.AccountLocked
+	case domain.NewDeviceLoginMessageType:
+		return &m.NewDeviceLogin
 	}
 	return nil
 }
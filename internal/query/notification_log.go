@@ -0,0 +1,222 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationLogsTable = table{
+		name:          projection.NotificationLogProjectionTable,
+		instanceIDCol: projection.NotificationLogColumnInstanceID,
+	}
+	NotificationLogColumnID = Column{
+		name:  projection.NotificationLogColumnID,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnCreationDate = Column{
+		name:  projection.NotificationLogColumnCreationDate,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnChangeDate = Column{
+		name:  projection.NotificationLogColumnChangeDate,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnSequence = Column{
+		name:  projection.NotificationLogColumnSequence,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnState = Column{
+		name:  projection.NotificationLogColumnState,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnResourceOwner = Column{
+		name:  projection.NotificationLogColumnResourceOwner,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnInstanceID = Column{
+		name:  projection.NotificationLogColumnInstanceID,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnUserID = Column{
+		name:  projection.NotificationLogColumnUserID,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnMessageType = Column{
+		name:  projection.NotificationLogColumnMessageType,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnChannel = Column{
+		name:  projection.NotificationLogColumnChannel,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnProviderID = Column{
+		name:  projection.NotificationLogColumnProviderID,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnError = Column{
+		name:  projection.NotificationLogColumnError,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnAttempts = Column{
+		name:  projection.NotificationLogColumnAttempts,
+		table: notificationLogsTable,
+	}
+	NotificationLogColumnTriggeringEventType = Column{
+		name:  projection.NotificationLogColumnTriggeringEventType,
+		table: notificationLogsTable,
+	}
+)
+
+type NotificationLogs struct {
+	SearchResponse
+	Notifications []*NotificationLog
+}
+
+// NotificationLog is the delivery history of a notification sent to a user
+type NotificationLog struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.NotificationState
+	ResourceOwner string
+
+	UserID      string
+	MessageType string
+	Channel     domain.NotificationType
+	ProviderID  string
+	// Recipient is the current email address or phone number of the user,
+	// it's not stored with the notification, so it's erased together with the user
+	Recipient           string
+	Error               string
+	Attempts            uint64
+	TriggeringEventType string
+}
+
+type NotificationLogSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationLogSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewNotificationLogUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(NotificationLogColumnUserID, userID, TextEquals)
+}
+
+func NewNotificationLogResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(NotificationLogColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewNotificationLogStateSearchQuery(state domain.NotificationState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationLogColumnState, state, NumberEquals)
+}
+
+func NewNotificationLogMessageTypeSearchQuery(messageType string) (SearchQuery, error) {
+	return NewTextQuery(NotificationLogColumnMessageType, messageType, TextEquals)
+}
+
+func (q *Queries) SearchNotificationLogs(ctx context.Context, queries *NotificationLogSearchQueries) (notifications *NotificationLogs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareNotificationLogsQuery(ctx, q.client)
+	eq := sq.Eq{NotificationLogColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Ahx5i", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		notifications, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ufo4e", "Errors.Internal")
+	}
+	notifications.State, err = q.latestState(ctx, notificationLogsTable)
+	return notifications, err
+}
+
+func prepareNotificationLogsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*NotificationLogs, error)) {
+	return sq.Select(
+			NotificationLogColumnID.identifier(),
+			NotificationLogColumnCreationDate.identifier(),
+			NotificationLogColumnChangeDate.identifier(),
+			NotificationLogColumnSequence.identifier(),
+			NotificationLogColumnState.identifier(),
+			NotificationLogColumnResourceOwner.identifier(),
+			NotificationLogColumnUserID.identifier(),
+			NotificationLogColumnMessageType.identifier(),
+			NotificationLogColumnChannel.identifier(),
+			NotificationLogColumnProviderID.identifier(),
+			HumanEmailCol.identifier(),
+			HumanPhoneCol.identifier(),
+			NotificationLogColumnError.identifier(),
+			NotificationLogColumnAttempts.identifier(),
+			NotificationLogColumnTriggeringEventType.identifier(),
+			countColumn.identifier(),
+		).From(notificationLogsTable.identifier()).
+			LeftJoin(join(HumanUserIDCol, NotificationLogColumnUserID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationLogs, error) {
+			notifications := make([]*NotificationLog, 0)
+			var count uint64
+			for rows.Next() {
+				n := new(NotificationLog)
+				var email, phone sql.NullString
+				err := rows.Scan(
+					&n.ID,
+					&n.CreationDate,
+					&n.ChangeDate,
+					&n.Sequence,
+					&n.State,
+					&n.ResourceOwner,
+					&n.UserID,
+					&n.MessageType,
+					&n.Channel,
+					&n.ProviderID,
+					&email,
+					&phone,
+					&n.Error,
+					&n.Attempts,
+					&n.TriggeringEventType,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				n.Recipient = email.String
+				if n.Channel == domain.NotificationTypeSms {
+					n.Recipient = phone.String
+				}
+				notifications = append(notifications, n)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ohng0", "Errors.Query.CloseRows")
+			}
+			return &NotificationLogs{
+				Notifications: notifications,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
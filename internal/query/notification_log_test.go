@@ -0,0 +1,151 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareNotificationLogsStmt = `SELECT projections.notification_logs.id,` +
+		` projections.notification_logs.creation_date,` +
+		` projections.notification_logs.change_date,` +
+		` projections.notification_logs.sequence,` +
+		` projections.notification_logs.state,` +
+		` projections.notification_logs.resource_owner,` +
+		` projections.notification_logs.user_id,` +
+		` projections.notification_logs.message_type,` +
+		` projections.notification_logs.channel,` +
+		` projections.notification_logs.provider_id,` +
+		` projections.users13_humans.email,` +
+		` projections.users13_humans.phone,` +
+		` projections.notification_logs.error,` +
+		` projections.notification_logs.attempts,` +
+		` projections.notification_logs.triggering_event_type,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_logs` +
+		` LEFT JOIN projections.users13_humans ON projections.notification_logs.user_id = projections.users13_humans.user_id AND projections.notification_logs.instance_id = projections.users13_humans.instance_id`
+	prepareNotificationLogsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"resource_owner",
+		"user_id",
+		"message_type",
+		"channel",
+		"provider_id",
+		"email",
+		"phone",
+		"error",
+		"attempts",
+		"triggering_event_type",
+		"count",
+	}
+)
+
+func Test_NotificationLogPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationLogsQuery no result",
+			prepare: prepareNotificationLogsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationLogsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationLogs{Notifications: []*NotificationLog{}},
+		},
+		{
+			name:    "prepareNotificationLogsQuery found",
+			prepare: prepareNotificationLogsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationLogsStmt),
+					prepareNotificationLogsCols,
+					[][]driver.Value{
+						{
+							"user1-5",
+							testNow,
+							testNow,
+							uint64(20211109),
+							domain.NotificationStateFailed,
+							"org-id",
+							"user1",
+							domain.InitCodeMessageType,
+							domain.NotificationTypeEmail,
+							"smtp1",
+							"user@example.com",
+							"+41791234567",
+							"connection refused",
+							uint64(3),
+							"user.human.initialization.code.added",
+						},
+					},
+				),
+			},
+			object: &NotificationLogs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Notifications: []*NotificationLog{
+					{
+						ID:                  "user1-5",
+						CreationDate:        testNow,
+						ChangeDate:          testNow,
+						Sequence:            20211109,
+						State:               domain.NotificationStateFailed,
+						ResourceOwner:       "org-id",
+						UserID:              "user1",
+						MessageType:         domain.InitCodeMessageType,
+						Channel:             domain.NotificationTypeEmail,
+						ProviderID:          "smtp1",
+						Recipient:           "user@example.com",
+						Error:               "connection refused",
+						Attempts:            3,
+						TriggeringEventType: "user.human.initialization.code.added",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationLogsQuery sql err",
+			prepare: prepareNotificationLogsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationLogsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationLogs)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
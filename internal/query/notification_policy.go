@@ -25,7 +25,8 @@ type NotificationPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	PasswordChange bool
+	PasswordChange       bool
+	SecurityNotification bool
 
 	IsDefault bool
 }
@@ -63,6 +64,10 @@ var (
 		name:  projection.NotificationPolicyColumnPasswordChange,
 		table: notificationPolicyTable,
 	}
+	NotificationPolicyColSecurityNotification = Column{
+		name:  projection.NotificationPolicyColumnSecurityNotification,
+		table: notificationPolicyTable,
+	}
 	NotificationPolicyColIsDefault = Column{
 		name:  projection.NotificationPolicyColumnIsDefault,
 		table: notificationPolicyTable,
@@ -153,6 +158,7 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 			NotificationPolicyColChangeDate.identifier(),
 			NotificationPolicyColResourceOwner.identifier(),
 			NotificationPolicyColPasswordChange.identifier(),
+			NotificationPolicyColSecurityNotification.identifier(),
 			NotificationPolicyColIsDefault.identifier(),
 			NotificationPolicyColState.identifier(),
 		).
@@ -167,6 +173,7 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 				&policy.ChangeDate,
 				&policy.ResourceOwner,
 				&policy.PasswordChange,
+				&policy.SecurityNotification,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -12,7 +12,7 @@ import (
 )
 
 const (
-	AuthRequestsProjectionTable = "projections.auth_requests"
+	AuthRequestsProjectionTable = "projections.auth_requests2"
 
 	AuthRequestColumnID            = "id"
 	AuthRequestColumnCreationDate  = "creation_date"
@@ -29,6 +29,7 @@ const (
 	AuthRequestColumnMaxAge        = "max_age"
 	AuthRequestColumnLoginHint     = "login_hint"
 	AuthRequestColumnHintUserID    = "hint_user_id"
+	AuthRequestColumnPossibleLOAs  = "possible_loas"
 )
 
 type authRequestProjection struct{}
@@ -60,6 +61,7 @@ func (*authRequestProjection) Init() *old_handler.Check {
 			handler.NewColumn(AuthRequestColumnMaxAge, handler.ColumnTypeInt64, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnLoginHint, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnHintUserID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AuthRequestColumnPossibleLOAs, handler.ColumnTypeEnumArray, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AuthRequestColumnInstanceID, AuthRequestColumnID),
 		),
@@ -121,6 +123,7 @@ func (p *authRequestProjection) reduceAuthRequestAdded(event eventstore.Event) (
 			handler.NewCol(AuthRequestColumnMaxAge, e.MaxAge),
 			handler.NewCol(AuthRequestColumnLoginHint, e.LoginHint),
 			handler.NewCol(AuthRequestColumnHintUserID, e.HintUserID),
+			handler.NewCol(AuthRequestColumnPossibleLOAs, e.PossibleLOAs),
 		},
 	), nil
 }
@@ -13,6 +13,8 @@ type Config struct {
 	Customizations        map[string]CustomConfig
 	HandleActiveInstances time.Duration
 	TransactionDuration   time.Duration
+	// NotificationLogRetention is the duration the delivery history of notifications is kept, zero keeps it forever
+	NotificationLogRetention time.Duration
 }
 
 type CustomConfig struct {
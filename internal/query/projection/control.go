@@ -0,0 +1,91 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// States returns the states of the projections for the instances.
+// If projectionName is empty the states of all projections are returned,
+// if no instance is passed the states of all instances are returned.
+func States(ctx context.Context, projectionName string, instanceIDs ...string) ([]*handler.ProjectionState, error) {
+	if projectionName != "" {
+		p, err := projectionByName(projectionName)
+		if err != nil {
+			return nil, err
+		}
+		return p.States(ctx, instanceIDs...)
+	}
+	var states []*handler.ProjectionState
+	for _, p := range projections {
+		projectionStates, err := p.States(ctx, instanceIDs...)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, projectionStates...)
+	}
+	return states, nil
+}
+
+// Rebuild rebuilds the projection synchronously, see [handler.Handler.Rebuild]
+func Rebuild(ctx context.Context, projectionName string) error {
+	p, err := projectionByName(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.Rebuild(ctx)
+}
+
+// StartRebuild rebuilds the projection in the background, see [handler.Handler.Rebuild]
+func StartRebuild(ctx context.Context, projectionName string) error {
+	p, err := projectionByName(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.StartRebuild(ctx)
+}
+
+// Pause stops the processing of events of the projection on all nodes
+func Pause(ctx context.Context, projectionName string) error {
+	p, err := projectionByName(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.Pause(ctx)
+}
+
+// Resume continues the processing of events of a paused projection
+func Resume(ctx context.Context, projectionName string) error {
+	p, err := projectionByName(projectionName)
+	if err != nil {
+		return err
+	}
+	return p.Resume(ctx)
+}
+
+// ResetInstance removes the data of the instance from all projections, see [handler.Handler.ResetInstance].
+// The states of the field handlers are reset as well, their data are part of the eventstore.
+func ResetInstance(ctx context.Context, instanceID string) error {
+	for _, p := range projections {
+		if err := p.ResetInstance(ctx, instanceID); err != nil {
+			return err
+		}
+	}
+	for _, fields := range []*handler.FieldHandler{ProjectGrantFields, OrgDomainVerifiedFields} {
+		if err := fields.ResetInstance(ctx, instanceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func projectionByName(name string) (projection, error) {
+	for _, p := range projections {
+		if p.ProjectionName() == name {
+			return p, nil
+		}
+	}
+	return nil, zerrors.ThrowNotFound(nil, "HANDL-Quah4", "Errors.Projection.NotFound")
+}
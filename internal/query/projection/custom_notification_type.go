@@ -0,0 +1,123 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	CustomNotificationTypeTable = "projections.custom_notification_types"
+
+	CustomNotificationTypeInstanceIDCol   = "instance_id"
+	CustomNotificationTypeCreationDateCol = "creation_date"
+	CustomNotificationTypeChangeDateCol   = "change_date"
+	CustomNotificationTypeSequenceCol     = "sequence"
+	CustomNotificationTypeTypeCol         = "notification_type"
+	CustomNotificationTypeDescriptionCol  = "description"
+)
+
+type customNotificationTypeProjection struct{}
+
+func newCustomNotificationTypeProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(customNotificationTypeProjection))
+}
+
+func (*customNotificationTypeProjection) Name() string {
+	return CustomNotificationTypeTable
+}
+
+func (*customNotificationTypeProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(CustomNotificationTypeInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(CustomNotificationTypeCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CustomNotificationTypeChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CustomNotificationTypeSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(CustomNotificationTypeTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(CustomNotificationTypeDescriptionCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(CustomNotificationTypeInstanceIDCol, CustomNotificationTypeTypeCol),
+		),
+	)
+}
+
+func (p *customNotificationTypeProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.CustomNotificationTypeAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.CustomNotificationTypeChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  instance.CustomNotificationTypeRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(CustomNotificationTypeInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *customNotificationTypeProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.CustomNotificationTypeAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(CustomNotificationTypeCreationDateCol, e.CreatedAt()),
+			handler.NewCol(CustomNotificationTypeChangeDateCol, e.CreatedAt()),
+			handler.NewCol(CustomNotificationTypeSequenceCol, e.Sequence()),
+			handler.NewCol(CustomNotificationTypeTypeCol, e.NotificationType),
+			handler.NewCol(CustomNotificationTypeDescriptionCol, e.Description),
+			handler.NewCol(CustomNotificationTypeInstanceIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *customNotificationTypeProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.CustomNotificationTypeChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(CustomNotificationTypeChangeDateCol, e.CreatedAt()),
+			handler.NewCol(CustomNotificationTypeSequenceCol, e.Sequence()),
+			handler.NewCol(CustomNotificationTypeDescriptionCol, e.Description),
+		},
+		[]handler.Condition{
+			handler.NewCond(CustomNotificationTypeTypeCol, e.NotificationType),
+			handler.NewCond(CustomNotificationTypeInstanceIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *customNotificationTypeProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.CustomNotificationTypeRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CustomNotificationTypeTypeCol, e.NotificationType),
+			handler.NewCond(CustomNotificationTypeInstanceIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,150 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCustomNotificationTypeProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.CustomNotificationTypeAddedEventType,
+						instance.AggregateType,
+						[]byte(`{"notificationType": "CustomSubscriptionExpires", "description": "subscription expires soon"}`),
+					), eventstore.GenericEventMapper[instance.CustomNotificationTypeAddedEvent]),
+			},
+			reduce: (&customNotificationTypeProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.custom_notification_types (creation_date, change_date, sequence, notification_type, description, instance_id) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"CustomSubscriptionExpires",
+								"subscription expires soon",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.CustomNotificationTypeChangedEventType,
+						instance.AggregateType,
+						[]byte(`{"notificationType": "CustomSubscriptionExpires", "description": "subscription expires"}`),
+					), eventstore.GenericEventMapper[instance.CustomNotificationTypeChangedEvent]),
+			},
+			reduce: (&customNotificationTypeProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.custom_notification_types SET (change_date, sequence, description) = ($1, $2, $3) WHERE (notification_type = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"subscription expires",
+								"CustomSubscriptionExpires",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.CustomNotificationTypeRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"notificationType": "CustomSubscriptionExpires"}`),
+					), eventstore.GenericEventMapper[instance.CustomNotificationTypeRemovedEvent]),
+			},
+			reduce: (&customNotificationTypeProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_notification_types WHERE (notification_type = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"CustomSubscriptionExpires",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(CustomNotificationTypeInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_notification_types WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, CustomNotificationTypeTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,575 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	GroupProjectionTable   = "projections.groups1"
+	GroupMemberTableSuffix = "members"
+	GroupMemberTable       = GroupProjectionTable + "_" + GroupMemberTableSuffix
+	GroupGrantTableSuffix  = "grants"
+	GroupGrantTable        = GroupProjectionTable + "_" + GroupGrantTableSuffix
+
+	GroupColumnID            = "id"
+	GroupColumnCreationDate  = "creation_date"
+	GroupColumnChangeDate    = "change_date"
+	GroupColumnSequence      = "sequence"
+	GroupColumnState         = "state"
+	GroupColumnResourceOwner = "resource_owner"
+	GroupColumnInstanceID    = "instance_id"
+	GroupColumnName          = "name"
+	GroupColumnDescription   = "description"
+
+	GroupMemberColumnInstanceID          = "instance_id"
+	GroupMemberColumnGroupID             = "group_id"
+	GroupMemberColumnMemberID            = "member_id"
+	GroupMemberColumnMemberType          = "member_type"
+	GroupMemberColumnMemberResourceOwner = "member_resource_owner"
+	GroupMemberColumnCreationDate        = "creation_date"
+	GroupMemberColumnSequence            = "sequence"
+
+	GroupGrantColumnInstanceID           = "instance_id"
+	GroupGrantColumnID                   = "id"
+	GroupGrantColumnGroupID              = "group_id"
+	GroupGrantColumnCreationDate         = "creation_date"
+	GroupGrantColumnChangeDate           = "change_date"
+	GroupGrantColumnSequence             = "sequence"
+	GroupGrantColumnResourceOwner        = "resource_owner"
+	GroupGrantColumnProjectID            = "project_id"
+	GroupGrantColumnResourceOwnerProject = "resource_owner_project"
+	GroupGrantColumnGrantID              = "grant_id"
+	GroupGrantColumnRoles                = "roles"
+)
+
+type groupProjection struct {
+	es handler.EventStore
+}
+
+func newGroupProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &groupProjection{es: config.Eventstore})
+}
+
+func (*groupProjection) Name() string {
+	return GroupProjectionTable
+}
+
+func (*groupProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(GroupColumnID, handler.ColumnTypeText),
+			handler.NewColumn(GroupColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(GroupColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(GroupColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(GroupColumnName, handler.ColumnTypeText),
+			handler.NewColumn(GroupColumnDescription, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(GroupColumnInstanceID, GroupColumnID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{GroupColumnResourceOwner})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupMemberColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberColumnGroupID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberColumnMemberID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberColumnMemberType, handler.ColumnTypeEnum),
+			handler.NewColumn(GroupMemberColumnMemberResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMemberColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupMemberColumnInstanceID, GroupMemberColumnGroupID, GroupMemberColumnMemberID),
+			GroupMemberTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupMemberColumnInstanceID, GroupMemberColumnGroupID}, []string{GroupColumnInstanceID, GroupColumnID})),
+			handler.WithIndex(handler.NewIndex("member_id", []string{GroupMemberColumnMemberID})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupGrantColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnID, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnGroupID, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupGrantColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnResourceOwnerProject, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantColumnGrantID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupGrantColumnRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(GroupGrantColumnInstanceID, GroupGrantColumnID),
+			GroupGrantTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupGrantColumnInstanceID, GroupGrantColumnGroupID}, []string{GroupColumnInstanceID, GroupColumnID})),
+			handler.WithIndex(handler.NewIndex("group_id", []string{GroupGrantColumnGroupID})),
+			handler.WithIndex(handler.NewIndex("project_id", []string{GroupGrantColumnProjectID})),
+		),
+	)
+}
+
+func (p *groupProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.GroupAddedType,
+					Reduce: p.reduceGroupAdded,
+				},
+				{
+					Event:  group.GroupChangedType,
+					Reduce: p.reduceGroupChanged,
+				},
+				{
+					Event:  group.GroupRemovedType,
+					Reduce: p.reduceGroupRemoved,
+				},
+				{
+					Event:  group.MemberAddedType,
+					Reduce: p.reduceMemberAdded,
+				},
+				{
+					Event:  group.MemberRemovedType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  group.SubgroupAddedType,
+					Reduce: p.reduceSubgroupAdded,
+				},
+				{
+					Event:  group.SubgroupRemovedType,
+					Reduce: p.reduceSubgroupRemoved,
+				},
+				{
+					Event:  group.GrantAddedType,
+					Reduce: p.reduceGrantAdded,
+				},
+				{
+					Event:  group.GrantChangedType,
+					Reduce: p.reduceGrantChanged,
+				},
+				{
+					Event:  group.GrantRemovedType,
+					Reduce: p.reduceGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+				{
+					Event:  project.GrantChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantCascadeChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GroupAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupColumnID, e.Aggregate().ID),
+			handler.NewCol(GroupColumnCreationDate, e.CreationDate()),
+			handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+			handler.NewCol(GroupColumnSequence, e.Sequence()),
+			handler.NewCol(GroupColumnState, domain.GroupStateActive),
+			handler.NewCol(GroupColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(GroupColumnName, e.Name),
+			handler.NewCol(GroupColumnDescription, e.Description),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GroupChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if e.Name == nil && e.Description == nil {
+		return handler.NewNoOpStatement(e), nil
+	}
+	columns := []handler.Column{
+		handler.NewCol(GroupColumnChangeDate, e.CreationDate()),
+		handler.NewCol(GroupColumnSequence, e.Sequence()),
+	}
+	if e.Name != nil {
+		columns = append(columns, handler.NewCol(GroupColumnName, *e.Name))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(GroupColumnDescription, *e.Description))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, e.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GroupRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// members and grants of the group are removed by the foreign key
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberColumnMemberID, e.Aggregate().ID),
+				handler.NewCond(GroupMemberColumnMemberType, domain.GroupMemberTypeGroup),
+				handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(GroupMemberTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupColumnID, e.Aggregate().ID),
+				handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceMemberAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	userOwner, err := getUserResourceOwner(setUserGrantContext(e.Aggregate()), p.es, e.Aggregate().InstanceID, e.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return p.memberAdded(e, e.UserID, domain.GroupMemberTypeUser, userOwner), nil
+}
+
+func (p *groupProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.memberRemoved(e, e.UserID), nil
+}
+
+func (p *groupProjection) reduceSubgroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.SubgroupAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// subgroups always belong to the same organization
+	return p.memberAdded(e, e.GroupID, domain.GroupMemberTypeGroup, e.Aggregate().ResourceOwner), nil
+}
+
+func (p *groupProjection) reduceSubgroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.SubgroupRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.memberRemoved(e, e.GroupID), nil
+}
+
+func (p *groupProjection) memberAdded(event eventstore.Event, memberID string, memberType domain.GroupMemberType, memberResourceOwner string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupMemberColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCol(GroupMemberColumnGroupID, event.Aggregate().ID),
+				handler.NewCol(GroupMemberColumnMemberID, memberID),
+				handler.NewCol(GroupMemberColumnMemberType, memberType),
+				handler.NewCol(GroupMemberColumnMemberResourceOwner, memberResourceOwner),
+				handler.NewCol(GroupMemberColumnCreationDate, event.CreatedAt()),
+				handler.NewCol(GroupMemberColumnSequence, event.Sequence()),
+			},
+			handler.WithTableSuffix(GroupMemberTableSuffix),
+		),
+		p.addGroupChanged(event),
+	)
+}
+
+func (p *groupProjection) memberRemoved(event eventstore.Event, memberID string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(GroupMemberColumnGroupID, event.Aggregate().ID),
+				handler.NewCond(GroupMemberColumnMemberID, memberID),
+			},
+			handler.WithTableSuffix(GroupMemberTableSuffix),
+		),
+		p.addGroupChanged(event),
+	)
+}
+
+func (p *groupProjection) reduceGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	_, projectOwner, _, err := getResourceOwners(setUserGrantContext(e.Aggregate()), p.es, e.Aggregate().InstanceID, "", e.ProjectID, "")
+	if err != nil {
+		return nil, err
+	}
+	if projectOwner == "" {
+		return nil, zerrors.ThrowNotFound(nil, "PROJE-Gr0ap", "Errors.NotFound")
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(GroupGrantColumnID, e.GrantID),
+				handler.NewCol(GroupGrantColumnGroupID, e.Aggregate().ID),
+				handler.NewCol(GroupGrantColumnCreationDate, e.CreatedAt()),
+				handler.NewCol(GroupGrantColumnChangeDate, e.CreatedAt()),
+				handler.NewCol(GroupGrantColumnSequence, e.Sequence()),
+				handler.NewCol(GroupGrantColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(GroupGrantColumnProjectID, e.ProjectID),
+				handler.NewCol(GroupGrantColumnResourceOwnerProject, projectOwner),
+				handler.NewCol(GroupGrantColumnGrantID, e.ProjectGrantID),
+				handler.NewCol(GroupGrantColumnRoles, database.TextArray[string](e.RoleKeys)),
+			},
+			handler.WithTableSuffix(GroupGrantTableSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupGrantColumnChangeDate, e.CreatedAt()),
+				handler.NewCol(GroupGrantColumnSequence, e.Sequence()),
+				handler.NewCol(GroupGrantColumnRoles, database.TextArray[string](e.RoleKeys)),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(GroupGrantColumnID, e.GrantID),
+			},
+			handler.WithTableSuffix(GroupGrantTableSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(GroupGrantColumnID, e.GrantID),
+			},
+			handler.WithTableSuffix(GroupGrantTableSuffix),
+		),
+		p.addGroupChanged(e),
+	), nil
+}
+
+func (p *groupProjection) addGroupChanged(event eventstore.Event) func(eventstore.Event) handler.Exec {
+	return handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(GroupColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(GroupColumnSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupColumnID, event.Aggregate().ID),
+			handler.NewCond(GroupColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *groupProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberColumnMemberID, e.Aggregate().ID),
+			handler.NewCond(GroupMemberColumnMemberType, domain.GroupMemberTypeUser),
+			handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupMemberTableSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantTableSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewArrayRemoveCol(GroupGrantColumnRoles, e.Key),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantTableSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGrantID, e.GrantID),
+			handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantTableSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	var grantID string
+	var keys database.TextArray[string]
+	switch e := event.(type) {
+	case *project.GrantChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	case *project.GrantCascadeChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Gr0c1", "reduce.wrong.event.type %v", []eventstore.EventType{project.GrantChangedType, project.GrantCascadeChangedType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewArrayIntersectCol(GroupGrantColumnRoles, keys),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantColumnGrantID, grantID),
+			handler.NewCond(GroupGrantColumnInstanceID, event.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(GroupGrantTableSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		// members and grants of the groups are removed by the foreign key
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(GroupColumnResourceOwner, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(GroupMemberColumnMemberResourceOwner, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(GroupMemberTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupGrantColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(GroupGrantColumnResourceOwnerProject, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(GroupGrantTableSuffix),
+		),
+	), nil
+}
@@ -0,0 +1,259 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/member"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+const (
+	GroupMembershipProjectionTable = "projections.group_memberships1"
+
+	GroupMembershipColumnInstanceID    = "instance_id"
+	GroupMembershipColumnAggregateType = "aggregate_type"
+	GroupMembershipColumnAggregateID   = "aggregate_id"
+	GroupMembershipColumnGroupID       = "group_id"
+	GroupMembershipColumnRoles         = "roles"
+	GroupMembershipColumnResourceOwner = "resource_owner"
+	GroupMembershipColumnCreationDate  = "creation_date"
+	GroupMembershipColumnChangeDate    = "change_date"
+	GroupMembershipColumnSequence      = "sequence"
+)
+
+// groupMembershipProjection projects the memberships of groups on organizations and projects
+type groupMembershipProjection struct{}
+
+func newGroupMembershipProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(groupMembershipProjection))
+}
+
+func (*groupMembershipProjection) Name() string {
+	return GroupMembershipProjectionTable
+}
+
+func (*groupMembershipProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(GroupMembershipColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMembershipColumnAggregateType, handler.ColumnTypeText),
+			handler.NewColumn(GroupMembershipColumnAggregateID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMembershipColumnGroupID, handler.ColumnTypeText),
+			handler.NewColumn(GroupMembershipColumnRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(GroupMembershipColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(GroupMembershipColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMembershipColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMembershipColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupMembershipColumnInstanceID, GroupMembershipColumnAggregateID, GroupMembershipColumnGroupID),
+			handler.WithIndex(handler.NewIndex("group_id", []string{GroupMembershipColumnGroupID})),
+		),
+	)
+}
+
+func (p *groupMembershipProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.GroupMemberAddedEventType,
+					Reduce: p.reduceOrgAdded,
+				},
+				{
+					Event:  org.GroupMemberChangedEventType,
+					Reduce: p.reduceOrgChanged,
+				},
+				{
+					Event:  org.GroupMemberRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.GroupMemberAddedEventType,
+					Reduce: p.reduceProjectAdded,
+				},
+				{
+					Event:  project.GroupMemberChangedEventType,
+					Reduce: p.reduceProjectChanged,
+				},
+				{
+					Event:  project.GroupMemberRemovedEventType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceAggregateRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.GroupRemovedType,
+					Reduce: p.reduceGroupRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupMembershipColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupMembershipProjection) reduceOrgAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.GroupMemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceAdded(&e.GroupMemberAddedEvent)
+}
+
+func (p *groupMembershipProjection) reduceProjectAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GroupMemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceAdded(&e.GroupMemberAddedEvent)
+}
+
+func (p *groupMembershipProjection) reduceAdded(e *member.GroupMemberAddedEvent) (*handler.Statement, error) {
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(GroupMembershipColumnAggregateType, e.Aggregate().Type),
+			handler.NewCol(GroupMembershipColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(GroupMembershipColumnGroupID, e.GroupID),
+			handler.NewCol(GroupMembershipColumnRoles, database.TextArray[string](e.Roles)),
+			handler.NewCol(GroupMembershipColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupMembershipColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(GroupMembershipColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(GroupMembershipColumnSequence, e.Sequence()),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceOrgChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.GroupMemberChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceChanged(&e.GroupMemberChangedEvent)
+}
+
+func (p *groupMembershipProjection) reduceProjectChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GroupMemberChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceChanged(&e.GroupMemberChangedEvent)
+}
+
+func (p *groupMembershipProjection) reduceChanged(e *member.GroupMemberChangedEvent) (*handler.Statement, error) {
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupMembershipColumnRoles, database.TextArray[string](e.Roles)),
+			handler.NewCol(GroupMembershipColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(GroupMembershipColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnGroupID, e.GroupID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.GroupMemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceRemoved(&e.GroupMemberRemovedEvent)
+}
+
+func (p *groupMembershipProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GroupMemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceRemoved(&e.GroupMemberRemovedEvent)
+}
+
+func (p *groupMembershipProjection) reduceRemoved(e *member.GroupMemberRemovedEvent) (*handler.Statement, error) {
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnAggregateID, e.Aggregate().ID),
+			handler.NewCond(GroupMembershipColumnGroupID, e.GroupID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceAggregateRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnAggregateID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GroupRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnGroupID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupMembershipProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// groups are always members of organizations and projects of their own organization
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMembershipColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMembershipColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
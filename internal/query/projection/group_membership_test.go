@@ -0,0 +1,240 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestGroupMembershipProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceOrgAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.GroupMemberAddedEventType,
+						org.AggregateType,
+						[]byte(`{"groupId": "group-id", "roles": ["ORG_OWNER"]}`),
+					), eventstore.GenericEventMapper[org.GroupMemberAddedEvent]),
+			},
+			reduce: (&groupMembershipProjection{}).reduceOrgAdded,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.group_memberships1 (instance_id, aggregate_type, aggregate_id, group_id, roles, resource_owner, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								eventstore.AggregateType("org"),
+								"agg-id",
+								"group-id",
+								database.TextArray[string]{"ORG_OWNER"},
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GroupMemberChangedEventType,
+						project.AggregateType,
+						[]byte(`{"groupId": "group-id", "roles": ["PROJECT_OWNER"]}`),
+					), eventstore.GenericEventMapper[project.GroupMemberChangedEvent]),
+			},
+			reduce: (&groupMembershipProjection{}).reduceProjectChanged,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_memberships1 SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (aggregate_id = $5) AND (group_id = $6)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"PROJECT_OWNER"},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+								"group-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GroupMemberRemovedEventType,
+						project.AggregateType,
+						[]byte(`{"groupId": "group-id"}`),
+					), eventstore.GenericEventMapper[project.GroupMemberRemovedEvent]),
+			},
+			reduce: (&groupMembershipProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships1 WHERE (instance_id = $1) AND (aggregate_id = $2) AND (group_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"group-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceAggregateRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceAggregateRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships1 WHERE (instance_id = $1) AND (aggregate_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "group reduceGroupRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GroupRemovedType,
+						group.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[group.GroupRemovedEvent]),
+			},
+			reduce: (&groupMembershipProjection{}).reduceGroupRemoved,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships1 WHERE (instance_id = $1) AND (group_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&groupMembershipProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships1 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(GroupMembershipColumnInstanceID),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_memberships1 WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupMembershipProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/idpconfig"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	IDPDiscoveryDomainTable = "projections.idp_discovery_domains"
+
+	IDPDiscoveryDomainInstanceIDCol   = "instance_id"
+	IDPDiscoveryDomainDomainCol       = "domain"
+	IDPDiscoveryDomainIDPIDCol        = "idp_id"
+	IDPDiscoveryDomainCreationDateCol = "creation_date"
+	IDPDiscoveryDomainChangeDateCol   = "change_date"
+	IDPDiscoveryDomainSequenceCol     = "sequence"
+)
+
+type idpDiscoveryDomainProjection struct{}
+
+func newIDPDiscoveryDomainProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(idpDiscoveryDomainProjection))
+}
+
+func (*idpDiscoveryDomainProjection) Name() string {
+	return IDPDiscoveryDomainTable
+}
+
+func (*idpDiscoveryDomainProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(IDPDiscoveryDomainInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(IDPDiscoveryDomainDomainCol, handler.ColumnTypeText),
+			handler.NewColumn(IDPDiscoveryDomainIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(IDPDiscoveryDomainCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(IDPDiscoveryDomainChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(IDPDiscoveryDomainSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(IDPDiscoveryDomainInstanceIDCol, IDPDiscoveryDomainDomainCol),
+			handler.WithIndex(handler.NewIndex("idp_id", []string{IDPDiscoveryDomainIDPIDCol})),
+		),
+	)
+}
+
+func (p *idpDiscoveryDomainProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.IDPDiscoveryDomainSetEventType,
+					Reduce: p.reduceDomainSet,
+				},
+				{
+					Event:  instance.IDPDiscoveryDomainRemovedEventType,
+					Reduce: p.reduceDomainRemoved,
+				},
+				{
+					Event:  instance.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  instance.IDPConfigRemovedEventType,
+					Reduce: p.reduceIDPConfigRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(IDPDiscoveryDomainInstanceIDCol),
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  org.IDPConfigRemovedEventType,
+					Reduce: p.reduceIDPConfigRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *idpDiscoveryDomainProjection) reduceDomainSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.IDPDiscoveryDomainSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	conflictCols := []handler.Column{
+		handler.NewCol(IDPDiscoveryDomainInstanceIDCol, nil),
+		handler.NewCol(IDPDiscoveryDomainDomainCol, nil),
+	}
+	return handler.NewUpsertStatement(
+		e,
+		conflictCols,
+		[]handler.Column{
+			handler.NewCol(IDPDiscoveryDomainInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(IDPDiscoveryDomainDomainCol, e.Domain),
+			handler.NewCol(IDPDiscoveryDomainIDPIDCol, e.IDPID),
+			handler.NewCol(IDPDiscoveryDomainCreationDateCol, handler.OnlySetValueOnInsert(IDPDiscoveryDomainTable, e.CreationDate())),
+			handler.NewCol(IDPDiscoveryDomainChangeDateCol, e.CreationDate()),
+			handler.NewCol(IDPDiscoveryDomainSequenceCol, e.Sequence()),
+		},
+	), nil
+}
+
+func (p *idpDiscoveryDomainProjection) reduceDomainRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.IDPDiscoveryDomainRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(IDPDiscoveryDomainInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(IDPDiscoveryDomainDomainCol, e.Domain),
+		},
+	), nil
+}
+
+func (p *idpDiscoveryDomainProjection) reduceIDPRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.RemovedEvent
+	switch e := event.(type) {
+	case *org.IDPRemovedEvent:
+		idpEvent = e.RemovedEvent
+	case *instance.IDPRemovedEvent:
+		idpEvent = e.RemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Hrd4r", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPRemovedEventType, instance.IDPRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		&idpEvent,
+		[]handler.Condition{
+			handler.NewCond(IDPDiscoveryDomainInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			handler.NewCond(IDPDiscoveryDomainIDPIDCol, idpEvent.ID),
+		},
+	), nil
+}
+
+func (p *idpDiscoveryDomainProjection) reduceIDPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.IDPConfigRemovedEvent
+	switch e := event.(type) {
+	case *org.IDPConfigRemovedEvent:
+		idpEvent = e.IDPConfigRemovedEvent
+	case *instance.IDPConfigRemovedEvent:
+		idpEvent = e.IDPConfigRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Hrd4c", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPConfigRemovedEventType, instance.IDPConfigRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		&idpEvent,
+		[]handler.Condition{
+			handler.NewCond(IDPDiscoveryDomainInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			handler.NewCond(IDPDiscoveryDomainIDPIDCol, idpEvent.ConfigID),
+		},
+	), nil
+}
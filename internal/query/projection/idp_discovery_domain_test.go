@@ -0,0 +1,175 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestIDPDiscoveryDomainProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceDomainSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPDiscoveryDomainSetEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "customer.com", "idpId": "idp-id"}`),
+					), instance.IDPDiscoveryDomainSetEventMapper),
+			},
+			reduce: (&idpDiscoveryDomainProjection{}).reduceDomainSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.idp_discovery_domains (instance_id, domain, idp_id, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, domain) DO UPDATE SET (idp_id, creation_date, change_date, sequence) = (EXCLUDED.idp_id, projections.idp_discovery_domains.creation_date, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"customer.com",
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDomainRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPDiscoveryDomainRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"domain": "customer.com"}`),
+					), instance.IDPDiscoveryDomainRemovedEventMapper),
+			},
+			reduce: (&idpDiscoveryDomainProjection{}).reduceDomainRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.idp_discovery_domains WHERE (instance_id = $1) AND (domain = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"customer.com",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceIDPRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.IDPRemovedEventType,
+						org.AggregateType,
+						[]byte(`{"id": "idp-id"}`),
+					), org.IDPRemovedEventMapper),
+			},
+			reduce: (&idpDiscoveryDomainProjection{}).reduceIDPRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.idp_discovery_domains WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceIDPConfigRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPConfigRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"idpConfigId": "idp-id"}`),
+					), instance.IDPConfigRemovedEventMapper),
+			},
+			reduce: (&idpDiscoveryDomainProjection{}).reduceIDPConfigRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.idp_discovery_domains WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(IDPDiscoveryDomainInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.idp_discovery_domains WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPDiscoveryDomainTable, tt.want)
+		})
+	}
+}
@@ -60,6 +60,7 @@ const (
 	IDPTemplateIsAutoCreationCol    = "is_auto_creation"
 	IDPTemplateIsAutoUpdateCol      = "is_auto_update"
 	IDPTemplateAutoLinkingCol       = "auto_linking"
+	IDPTemplateAttributeMappingsCol = "attribute_mappings"
 
 	OAuthIDCol                    = "idp_id"
 	OAuthInstanceIDCol            = "instance_id"
@@ -152,6 +153,9 @@ const (
 	LDAPPreferredLanguageAttributeCol = "preferred_language_attribute"
 	LDAPAvatarURLAttributeCol         = "avatar_url_attribute"
 	LDAPProfileAttributeCol           = "profile_attribute"
+	LDAPSyncEnabledCol                = "sync_enabled"
+	LDAPSyncFilterCol                 = "sync_filter"
+	LDAPSyncIntervalCol               = "sync_interval"
 
 	AppleIDCol         = "idp_id"
 	AppleInstanceIDCol = "instance_id"
@@ -201,6 +205,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(IDPTemplateIsAutoCreationCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateIsAutoUpdateCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateAutoLinkingCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(IDPTemplateAttributeMappingsCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(IDPTemplateInstanceIDCol, IDPTemplateIDCol),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{IDPTemplateResourceOwnerCol})),
@@ -343,6 +348,9 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(LDAPPreferredLanguageAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPAvatarURLAttributeCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(LDAPProfileAttributeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncEnabledCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(LDAPSyncFilterCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncIntervalCol, handler.ColumnTypeInt64, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(LDAPInstanceIDCol, LDAPIDCol),
 			IDPTemplateLDAPSuffix,
@@ -520,6 +528,10 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
 				},
+				{
+					Event:  instance.IDPAttributeMappingsSetEventType,
+					Reduce: p.reduceIDPAttributeMappingsSet,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(IDPTemplateInstanceIDCol),
@@ -665,6 +677,10 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
 				},
+				{
+					Event:  org.IDPAttributeMappingsSetEventType,
+					Reduce: p.reduceIDPAttributeMappingsSet,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOwnerRemoved,
@@ -1909,6 +1925,9 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(LDAPPreferredLanguageAttributeCol, idpEvent.PreferredLanguageAttribute),
 				handler.NewCol(LDAPAvatarURLAttributeCol, idpEvent.AvatarURLAttribute),
 				handler.NewCol(LDAPProfileAttributeCol, idpEvent.ProfileAttribute),
+				handler.NewCol(LDAPSyncEnabledCol, idpEvent.SyncEnabled),
+				handler.NewCol(LDAPSyncFilterCol, idpEvent.SyncFilter),
+				handler.NewCol(LDAPSyncIntervalCol, idpEvent.SyncInterval),
 			},
 			handler.WithTableSuffix(IDPTemplateLDAPSuffix),
 		),
@@ -2186,6 +2205,31 @@ func (p *idpTemplateProjection) reduceIDPRemoved(event eventstore.Event) (*handl
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceIDPAttributeMappingsSet(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.AttributeMappingsSetEvent
+	switch e := event.(type) {
+	case *org.IDPAttributeMappingsSetEvent:
+		idpEvent = e.AttributeMappingsSetEvent
+	case *instance.IDPAttributeMappingsSetEvent:
+		idpEvent = e.AttributeMappingsSetEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Atm4p", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPAttributeMappingsSetEventType, instance.IDPAttributeMappingsSetEventType})
+	}
+
+	return handler.NewUpdateStatement(
+		&idpEvent,
+		[]handler.Column{
+			handler.NewJSONCol(IDPTemplateAttributeMappingsCol, idpEvent.AttributeMappings),
+			handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+			handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+			handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
@@ -2393,7 +2437,7 @@ func reduceGoogleIDPChangedColumns(idpEvent idp.GoogleIDPChangedEvent) []handler
 }
 
 func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Column {
-	ldapCols := make([]handler.Column, 0, 22)
+	ldapCols := make([]handler.Column, 0, 25)
 	if idpEvent.Servers != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPServersCol, database.TextArray[string](idpEvent.Servers)))
 	}
@@ -2460,6 +2504,15 @@ func reduceLDAPIDPChangedColumns(idpEvent idp.LDAPIDPChangedEvent) []handler.Col
 	if idpEvent.ProfileAttribute != nil {
 		ldapCols = append(ldapCols, handler.NewCol(LDAPProfileAttributeCol, *idpEvent.ProfileAttribute))
 	}
+	if idpEvent.SyncEnabled != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPSyncEnabledCol, *idpEvent.SyncEnabled))
+	}
+	if idpEvent.SyncFilter != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPSyncFilterCol, *idpEvent.SyncFilter))
+	}
+	if idpEvent.SyncInterval != nil {
+		ldapCols = append(ldapCols, handler.NewCol(LDAPSyncIntervalCol, *idpEvent.SyncInterval))
+	}
 	return ldapCols
 }
 
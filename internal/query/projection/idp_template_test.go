@@ -161,6 +161,97 @@ func TestIDPTemplateProjection_reducesRemove(t *testing.T) {
 	}
 }
 
+func TestIDPTemplateProjection_reducesAttributeMappings(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name:   "instance reduceIDPAttributeMappingsSet",
+			reduce: (&idpTemplateProjection{}).reduceIDPAttributeMappingsSet,
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPAttributeMappingsSetEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"attributeMappings": [{"source": "department", "target": 11, "key": "department", "transform": 1}]
+}`),
+					), instance.IDPAttributeMappingsSetEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6 SET (attribute_mappings, change_date, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								[]byte(`[{"source":"department","target":11,"key":"department","transform":1}]`),
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceIDPAttributeMappingsSet removed",
+			reduce: (&idpTemplateProjection{}).reduceIDPAttributeMappingsSet,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.IDPAttributeMappingsSetEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id"
+}`),
+					), org.IDPAttributeMappingsSetEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.idp_templates6 SET (attribute_mappings, change_date, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								[]byte(`null`),
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
 func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 	type args struct {
 		event func(t *testing.T) eventstore.Event
@@ -2130,6 +2221,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 	"preferredLanguageAttribute": "lang",
 	"avatarURLAttribute": "avatar",
 	"profileAttribute": "profile",
+	"syncEnabled": true,
+	"syncFilter": "(department=it)",
+	"syncInterval": 3600000000000,
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -2165,7 +2259,7 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_ldap2 (idp_id, instance_id, servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_ldap2 (idp_id, instance_id, servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute, sync_enabled, sync_filter, sync_interval) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -2191,6 +2285,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 								"lang",
 								"avatar",
 								"profile",
+								true,
+								"(department=it)",
+								time.Duration(3600000000000),
 							},
 						},
 					},
@@ -2233,6 +2330,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 	"preferredLanguageAttribute": "lang",
 	"avatarURLAttribute": "avatar",
 	"profileAttribute": "profile",
+	"syncEnabled": true,
+	"syncFilter": "(department=it)",
+	"syncInterval": 3600000000000,
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -2268,7 +2368,7 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_ldap2 (idp_id, instance_id, servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_ldap2 (idp_id, instance_id, servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute, sync_enabled, sync_filter, sync_interval) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -2294,6 +2394,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 								"lang",
 								"avatar",
 								"profile",
+								true,
+								"(department=it)",
+								time.Duration(3600000000000),
 							},
 						},
 					},
@@ -2378,6 +2481,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 	"preferredLanguageAttribute": "lang",
 	"avatarURLAttribute": "avatar",
 	"profileAttribute": "profile",
+	"syncEnabled": true,
+	"syncFilter": "(department=it)",
+	"syncInterval": 3600000000000,
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -2408,7 +2514,7 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_ldap2 SET (servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22) WHERE (idp_id = $23) AND (instance_id = $24)",
+							expectedStmt: "UPDATE projections.idp_templates6_ldap2 SET (servers, start_tls, base_dn, bind_dn, bind_password, user_base, user_object_classes, user_filters, timeout, id_attribute, first_name_attribute, last_name_attribute, display_name_attribute, nick_name_attribute, preferred_username_attribute, email_attribute, email_verified, phone_attribute, phone_verified_attribute, preferred_language_attribute, avatar_url_attribute, profile_attribute, sync_enabled, sync_filter, sync_interval) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25) WHERE (idp_id = $26) AND (instance_id = $27)",
 							expectedArgs: []interface{}{
 								database.TextArray[string]{"server"},
 								false,
@@ -2432,6 +2538,9 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 								"lang",
 								"avatar",
 								"profile",
+								true,
+								"(department=it)",
+								time.Duration(3600000000000),
 								"idp-id",
 								"instance-id",
 							},
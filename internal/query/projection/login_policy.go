@@ -34,6 +34,7 @@ const (
 	AllowDomainDiscovery                = "allow_domain_discovery"
 	DisableLoginWithEmail               = "disable_login_with_email"
 	DisableLoginWithPhone               = "disable_login_with_phone"
+	AllowMagicLink                      = "allow_magic_link"
 	DefaultRedirectURI                  = "default_redirect_uri"
 	PasswordCheckLifetimeCol            = "password_check_lifetime"
 	ExternalLoginCheckLifetimeCol       = "external_login_check_lifetime"
@@ -75,6 +76,7 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(AllowDomainDiscovery, handler.ColumnTypeBool),
 			handler.NewColumn(DisableLoginWithEmail, handler.ColumnTypeBool),
 			handler.NewColumn(DisableLoginWithPhone, handler.ColumnTypeBool),
+			handler.NewColumn(AllowMagicLink, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(DefaultRedirectURI, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(PasswordCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(ExternalLoginCheckLifetimeCol, handler.ColumnTypeInt64),
@@ -196,6 +198,7 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(AllowDomainDiscovery, policyEvent.AllowDomainDiscovery),
 		handler.NewCol(DisableLoginWithEmail, policyEvent.DisableLoginWithEmail),
 		handler.NewCol(DisableLoginWithPhone, policyEvent.DisableLoginWithPhone),
+		handler.NewCol(AllowMagicLink, policyEvent.AllowMagicLink),
 		handler.NewCol(DefaultRedirectURI, policyEvent.DefaultRedirectURI),
 		handler.NewCol(PasswordCheckLifetimeCol, policyEvent.PasswordCheckLifetime),
 		handler.NewCol(ExternalLoginCheckLifetimeCol, policyEvent.ExternalLoginCheckLifetime),
@@ -253,6 +256,9 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.DisableLoginWithPhone != nil {
 		cols = append(cols, handler.NewCol(DisableLoginWithPhone, *policyEvent.DisableLoginWithPhone))
 	}
+	if policyEvent.AllowMagicLink != nil {
+		cols = append(cols, handler.NewCol(AllowMagicLink, *policyEvent.AllowMagicLink))
+	}
 	if policyEvent.DefaultRedirectURI != nil {
 		cols = append(cols, handler.NewCol(DefaultRedirectURI, *policyEvent.DefaultRedirectURI))
 	}
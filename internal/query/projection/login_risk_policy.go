@@ -0,0 +1,195 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	LoginRiskPolicyProjectionTable = "projections.login_risk_policies"
+
+	LoginRiskPolicyColumnID               = "id"
+	LoginRiskPolicyColumnCreationDate     = "creation_date"
+	LoginRiskPolicyColumnChangeDate       = "change_date"
+	LoginRiskPolicyColumnResourceOwner    = "resource_owner"
+	LoginRiskPolicyColumnInstanceID       = "instance_id"
+	LoginRiskPolicyColumnSequence         = "sequence"
+	LoginRiskPolicyColumnStateCol         = "state"
+	LoginRiskPolicyColumnIsDefault        = "is_default"
+	LoginRiskPolicyColumnNewDevice        = "new_device"
+	LoginRiskPolicyColumnNewNetwork       = "new_network"
+	LoginRiskPolicyColumnImpossibleTravel = "impossible_travel"
+	LoginRiskPolicyColumnOwnerRemoved     = "owner_removed"
+)
+
+type loginRiskPolicyProjection struct{}
+
+func newLoginRiskPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(loginRiskPolicyProjection))
+}
+
+func (*loginRiskPolicyProjection) Name() string {
+	return LoginRiskPolicyProjectionTable
+}
+
+func (*loginRiskPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(LoginRiskPolicyColumnID, handler.ColumnTypeText),
+			handler.NewColumn(LoginRiskPolicyColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LoginRiskPolicyColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LoginRiskPolicyColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(LoginRiskPolicyColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(LoginRiskPolicyColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(LoginRiskPolicyColumnStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(LoginRiskPolicyColumnIsDefault, handler.ColumnTypeBool),
+			handler.NewColumn(LoginRiskPolicyColumnNewDevice, handler.ColumnTypeEnum),
+			handler.NewColumn(LoginRiskPolicyColumnNewNetwork, handler.ColumnTypeEnum),
+			handler.NewColumn(LoginRiskPolicyColumnImpossibleTravel, handler.ColumnTypeEnum),
+			handler.NewColumn(LoginRiskPolicyColumnOwnerRemoved, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(LoginRiskPolicyColumnInstanceID, LoginRiskPolicyColumnID),
+		),
+	)
+}
+
+func (p *loginRiskPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.LoginRiskPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.LoginRiskPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.LoginRiskPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(LoginRiskPolicyColumnInstanceID),
+				},
+				{
+					Event:  instance.LoginRiskPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.LoginRiskPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+			},
+		},
+	}
+}
+
+func (p *loginRiskPolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.LoginRiskPolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.LoginRiskPolicyAddedEvent:
+		policyEvent = e.LoginRiskPolicyAddedEvent
+		isDefault = false
+	case *instance.LoginRiskPolicyAddedEvent:
+		policyEvent = e.LoginRiskPolicyAddedEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Iej4a", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginRiskPolicyAddedEventType, instance.LoginRiskPolicyAddedEventType})
+	}
+	return handler.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(LoginRiskPolicyColumnCreationDate, policyEvent.CreationDate()),
+			handler.NewCol(LoginRiskPolicyColumnChangeDate, policyEvent.CreationDate()),
+			handler.NewCol(LoginRiskPolicyColumnSequence, policyEvent.Sequence()),
+			handler.NewCol(LoginRiskPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCol(LoginRiskPolicyColumnStateCol, domain.PolicyStateActive),
+			handler.NewCol(LoginRiskPolicyColumnNewDevice, policyEvent.NewDevice),
+			handler.NewCol(LoginRiskPolicyColumnNewNetwork, policyEvent.NewNetwork),
+			handler.NewCol(LoginRiskPolicyColumnImpossibleTravel, policyEvent.ImpossibleTravel),
+			handler.NewCol(LoginRiskPolicyColumnIsDefault, isDefault),
+			handler.NewCol(LoginRiskPolicyColumnResourceOwner, policyEvent.Aggregate().ResourceOwner),
+			handler.NewCol(LoginRiskPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *loginRiskPolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.LoginRiskPolicyChangedEvent
+	switch e := event.(type) {
+	case *org.LoginRiskPolicyChangedEvent:
+		policyEvent = e.LoginRiskPolicyChangedEvent
+	case *instance.LoginRiskPolicyChangedEvent:
+		policyEvent = e.LoginRiskPolicyChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-aeS3u", "reduce.wrong.event.type %v", []eventstore.EventType{org.LoginRiskPolicyChangedEventType, instance.LoginRiskPolicyChangedEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(LoginRiskPolicyColumnChangeDate, policyEvent.CreationDate()),
+		handler.NewCol(LoginRiskPolicyColumnSequence, policyEvent.Sequence()),
+	}
+	if policyEvent.NewDevice != nil {
+		cols = append(cols, handler.NewCol(LoginRiskPolicyColumnNewDevice, *policyEvent.NewDevice))
+	}
+	if policyEvent.NewNetwork != nil {
+		cols = append(cols, handler.NewCol(LoginRiskPolicyColumnNewNetwork, *policyEvent.NewNetwork))
+	}
+	if policyEvent.ImpossibleTravel != nil {
+		cols = append(cols, handler.NewCol(LoginRiskPolicyColumnImpossibleTravel, *policyEvent.ImpossibleTravel))
+	}
+	return handler.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(LoginRiskPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCond(LoginRiskPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *loginRiskPolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	policyEvent, ok := event.(*org.LoginRiskPolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eew8p", "reduce.wrong.event.type %s", org.LoginRiskPolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		policyEvent,
+		[]handler.Condition{
+			handler.NewCond(LoginRiskPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCond(LoginRiskPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *loginRiskPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ahd5i", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LoginRiskPolicyColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(LoginRiskPolicyColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
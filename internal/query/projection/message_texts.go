@@ -273,7 +273,9 @@ func isMessageTemplate(template string) bool {
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
 		template == domain.PasswordChangeMessageType ||
-		template == domain.InviteUserMessageType
+		template == domain.InviteUserMessageType ||
+		template == domain.LoginRiskMessageType ||
+		domain.IsSecurityNotificationMessageType(template)
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -0,0 +1,229 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationLogProjectionTable = "projections.notification_logs"
+
+	NotificationLogColumnID                  = "id"
+	NotificationLogColumnCreationDate        = "creation_date"
+	NotificationLogColumnChangeDate          = "change_date"
+	NotificationLogColumnSequence            = "sequence"
+	NotificationLogColumnState               = "state"
+	NotificationLogColumnResourceOwner       = "resource_owner"
+	NotificationLogColumnInstanceID          = "instance_id"
+	NotificationLogColumnUserID              = "user_id"
+	NotificationLogColumnMessageType         = "message_type"
+	NotificationLogColumnChannel             = "channel"
+	NotificationLogColumnProviderID          = "provider_id"
+	NotificationLogColumnError               = "error"
+	NotificationLogColumnAttempts            = "attempts"
+	NotificationLogColumnTriggeringEventType = "triggering_event_type"
+)
+
+type notificationLogProjection struct {
+	// retention is the duration notifications are kept after their last change,
+	// zero keeps them forever
+	retention time.Duration
+}
+
+func newNotificationLogProjection(ctx context.Context, config handler.Config, retention time.Duration) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &notificationLogProjection{retention: retention})
+}
+
+func (*notificationLogProjection) Name() string {
+	return NotificationLogProjectionTable
+}
+
+func (*notificationLogProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationLogColumnID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLogColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationLogColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationLogColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationLogColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationLogColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLogColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLogColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLogColumnMessageType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationLogColumnChannel, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationLogColumnProviderID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationLogColumnError, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationLogColumnAttempts, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationLogColumnTriggeringEventType, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(NotificationLogColumnInstanceID, NotificationLogColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{NotificationLogColumnUserID})),
+		),
+	)
+}
+
+func (p *notificationLogProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.SentType,
+					Reduce: p.reduceSent,
+				},
+				{
+					Event:  notification.FailedType,
+					Reduce: p.reduceFailed,
+				},
+				{
+					Event:  notification.ResendRequestedType,
+					Reduce: p.reduceResendRequested,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationLogColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationLogProjection) reduceSent(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*notification.SentEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Chie4", "reduce.wrong.event.type %s", notification.SentType)
+	}
+	return p.reduceDelivery(e, &e.Delivery, domain.NotificationStateSent, ""), nil
+}
+
+func (p *notificationLogProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*notification.FailedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Iet3a", "reduce.wrong.event.type %s", notification.FailedType)
+	}
+	return p.reduceDelivery(e, &e.Delivery, domain.NotificationStateFailed, e.Error), nil
+}
+
+func (p *notificationLogProjection) reduceDelivery(event eventstore.Event, delivery *notification.Delivery, state domain.NotificationState, deliveryErr string) *handler.Statement {
+	statements := []func(eventstore.Event) handler.Exec{
+		handler.AddUpsertStatement(
+			[]handler.Column{
+				handler.NewCol(NotificationLogColumnInstanceID, nil),
+				handler.NewCol(NotificationLogColumnID, nil),
+			},
+			[]handler.Column{
+				handler.NewCol(NotificationLogColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCol(NotificationLogColumnID, event.Aggregate().ID),
+				handler.NewCol(NotificationLogColumnCreationDate, handler.OnlySetValueOnInsert(NotificationLogProjectionTable, event.CreatedAt())),
+				handler.NewCol(NotificationLogColumnChangeDate, event.CreatedAt()),
+				handler.NewCol(NotificationLogColumnSequence, event.Sequence()),
+				handler.NewCol(NotificationLogColumnState, state),
+				handler.NewCol(NotificationLogColumnResourceOwner, event.Aggregate().ResourceOwner),
+				handler.NewCol(NotificationLogColumnUserID, delivery.UserID),
+				handler.NewCol(NotificationLogColumnMessageType, delivery.MessageType),
+				handler.NewCol(NotificationLogColumnChannel, delivery.Channel),
+				handler.NewCol(NotificationLogColumnProviderID, delivery.ProviderID),
+				handler.NewCol(NotificationLogColumnError, deliveryErr),
+				handler.NewCol(NotificationLogColumnTriggeringEventType, delivery.TriggeringEventType),
+			},
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewIncrementCol(NotificationLogColumnAttempts, 1),
+			},
+			[]handler.Condition{
+				handler.NewCond(NotificationLogColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(NotificationLogColumnID, event.Aggregate().ID),
+			},
+		),
+	}
+	if p.retention > 0 {
+		statements = append(statements, handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(NotificationLogColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewLessThanCond(NotificationLogColumnChangeDate, event.CreatedAt().Add(-p.retention)),
+			},
+		))
+	}
+	return handler.NewMultiStatement(event, statements...)
+}
+
+func (p *notificationLogProjection) reduceResendRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*notification.ResendRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-oo9Ae", "reduce.wrong.event.type %s", notification.ResendRequestedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationLogColumnChangeDate, e.CreationDate()),
+			handler.NewCol(NotificationLogColumnSequence, e.Sequence()),
+			handler.NewCol(NotificationLogColumnState, domain.NotificationStateResendRequested),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotificationLogColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationLogColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationLogProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-ieN6u", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationLogColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationLogColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationLogProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Wai4k", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationLogColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationLogColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
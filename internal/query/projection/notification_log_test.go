@@ -0,0 +1,250 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationLogProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSent",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.SentType,
+						notification.AggregateType,
+						[]byte(`{"userId": "user1", "messageType": "InitCode", "providerId": "smtp1", "triggeringEventType": "user.human.initialization.code.added"}`),
+					), eventstore.GenericEventMapper[notification.SentEvent]),
+			},
+			reduce: (&notificationLogProjection{}).reduceSent,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_logs (instance_id, id, creation_date, change_date, sequence, state, resource_owner, user_id, message_type, channel, provider_id, error, triggering_event_type) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, state, resource_owner, user_id, message_type, channel, provider_id, error, triggering_event_type) = (projections.notification_logs.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.resource_owner, EXCLUDED.user_id, EXCLUDED.message_type, EXCLUDED.channel, EXCLUDED.provider_id, EXCLUDED.error, EXCLUDED.triggering_event_type)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateSent,
+								"ro-id",
+								"user1",
+								domain.InitCodeMessageType,
+								domain.NotificationTypeEmail,
+								"smtp1",
+								"",
+								eventstore.EventType("user.human.initialization.code.added"),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.notification_logs SET attempts = attempts + $1 WHERE (instance_id = $2) AND (id = $3)",
+							expectedArgs: []interface{}{
+								1,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed with retention",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.FailedType,
+						notification.AggregateType,
+						[]byte(`{"userId": "user1", "messageType": "InitCode", "channel": 1, "triggeringEventType": "user.human.initialization.code.added", "error": "connection refused"}`),
+					), eventstore.GenericEventMapper[notification.FailedEvent]),
+			},
+			reduce: (&notificationLogProjection{retention: time.Hour}).reduceFailed,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_logs (instance_id, id, creation_date, change_date, sequence, state, resource_owner, user_id, message_type, channel, provider_id, error, triggering_event_type) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, state, resource_owner, user_id, message_type, channel, provider_id, error, triggering_event_type) = (projections.notification_logs.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.resource_owner, EXCLUDED.user_id, EXCLUDED.message_type, EXCLUDED.channel, EXCLUDED.provider_id, EXCLUDED.error, EXCLUDED.triggering_event_type)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateFailed,
+								"ro-id",
+								"user1",
+								domain.InitCodeMessageType,
+								domain.NotificationTypeSms,
+								"",
+								"connection refused",
+								eventstore.EventType("user.human.initialization.code.added"),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.notification_logs SET attempts = attempts + $1 WHERE (instance_id = $2) AND (id = $3)",
+							expectedArgs: []interface{}{
+								1,
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.notification_logs WHERE (instance_id = $1) AND (change_date < $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResendRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.ResendRequestedType,
+						notification.AggregateType,
+						[]byte(`{"triggeringAggregateType": "user", "triggeringAggregateId": "user1", "triggeringEventType": "user.human.initialization.code.added", "triggeringSequence": 5}`),
+					), eventstore.GenericEventMapper[notification.ResendRequestedEvent]),
+			},
+			reduce: (&notificationLogProjection{}).reduceResendRequested,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_logs SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationStateResendRequested,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "user reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&notificationLogProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_logs WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&notificationLogProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_logs WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationLogColumnInstanceID),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_logs WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationLogProjectionTable, tt.want)
+		})
+	}
+}
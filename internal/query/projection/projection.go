@@ -40,12 +40,14 @@ var (
 	IDPTemplateProjection               *handler.Handler
 	MailTemplateProjection              *handler.Handler
 	MessageTextProjection               *handler.Handler
+	MessageTemplateProjection           *handler.Handler
 	CustomTextProjection                *handler.Handler
 	UserProjection                      *handler.Handler
 	LoginNameProjection                 *handler.Handler
 	OrgMemberProjection                 *handler.Handler
 	InstanceDomainProjection            *handler.Handler
 	InstanceTrustedDomainProjection     *handler.Handler
+	CustomNotificationTypeProjection    *handler.Handler
 	InstanceMemberProjection            *handler.Handler
 	ProjectMemberProjection             *handler.Handler
 	ProjectGrantMemberProjection        *handler.Handler
@@ -79,7 +81,12 @@ var (
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
+	IDPDiscoveryDomainProjection        *handler.Handler
+	LoginRiskPolicyProjection           *handler.Handler
 	DebugEventsProjection               *handler.Handler
+	GroupProjection                     *handler.Handler
+	GroupMembershipProjection           *handler.Handler
+	NotificationLogProjection           *handler.Handler
 
 	ProjectGrantFields      *handler.FieldHandler
 	OrgDomainVerifiedFields *handler.FieldHandler
@@ -89,6 +96,13 @@ type projection interface {
 	Start(ctx context.Context)
 	Init(ctx context.Context) error
 	Trigger(ctx context.Context, opts ...handler.TriggerOpt) (_ context.Context, err error)
+	ProjectionName() string
+	States(ctx context.Context, instanceIDs ...string) ([]*handler.ProjectionState, error)
+	Rebuild(ctx context.Context) error
+	StartRebuild(ctx context.Context) error
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	ResetInstance(ctx context.Context, instanceID string) error
 	migration.Migration
 }
 
@@ -130,12 +144,14 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	IDPTemplateProjection = newIDPTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_templates"]))
 	MailTemplateProjection = newMailTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["mail_templates"]))
 	MessageTextProjection = newMessageTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_texts"]))
+	MessageTemplateProjection = newMessageTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_templates"]))
 	CustomTextProjection = newCustomTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_texts"]))
 	UserProjection = newUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["users"]))
 	LoginNameProjection = newLoginNameProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_names"]))
 	OrgMemberProjection = newOrgMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_members"]))
 	InstanceDomainProjection = newInstanceDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_domains"]))
 	InstanceTrustedDomainProjection = newInstanceTrustedDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_trusted_domains"]))
+	CustomNotificationTypeProjection = newCustomNotificationTypeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_notification_types"]))
 	InstanceMemberProjection = newInstanceMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["iam_members"]))
 	ProjectMemberProjection = newProjectMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_members"]))
 	ProjectGrantMemberProjection = newProjectGrantMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grant_members"]))
@@ -166,7 +182,12 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
+	IDPDiscoveryDomainProjection = newIDPDiscoveryDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_discovery_domains"]))
+	LoginRiskPolicyProjection = newLoginRiskPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_risk_policies"]))
 	DebugEventsProjection = newDebugEventsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_events"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
+	GroupMembershipProjection = newGroupMembershipProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_memberships"]))
+	NotificationLogProjection = newNotificationLogProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_logs"]), config.NotificationLogRetention)
 
 	ProjectGrantFields = newFillProjectGrantFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsProjectGrant]))
 	OrgDomainVerifiedFields = newFillOrgDomainVerifiedFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsOrgDomainVerified]))
@@ -261,12 +282,14 @@ func newProjectionsList() {
 		IDPLoginPolicyLinkProjection,
 		MailTemplateProjection,
 		MessageTextProjection,
+		MessageTemplateProjection,
 		CustomTextProjection,
 		UserProjection,
 		LoginNameProjection,
 		OrgMemberProjection,
 		InstanceDomainProjection,
 		InstanceTrustedDomainProjection,
+		CustomNotificationTypeProjection,
 		InstanceMemberProjection,
 		ProjectMemberProjection,
 		ProjectGrantMemberProjection,
@@ -297,6 +320,11 @@ func newProjectionsList() {
 		ExecutionProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
+		IDPDiscoveryDomainProjection,
+		LoginRiskPolicyProjection,
 		DebugEventsProjection,
+		GroupProjection,
+		GroupMembershipProjection,
+		NotificationLogProjection,
 	}
 }
@@ -8,6 +8,8 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -143,13 +145,51 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMSConfigTwilioAddedEventType,
+					Reduce: p.reduceSMSConfigTwilioAdded,
+				},
+				{
+					Event:  org.SMSConfigTwilioChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioChanged,
+				},
+				{
+					Event:  org.SMSConfigTwilioTokenChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioTokenChanged,
+				},
+				{
+					Event:  org.SMSConfigActivatedEventType,
+					Reduce: p.reduceSMSConfigActivated,
+				},
+				{
+					Event:  org.SMSConfigDeactivatedEventType,
+					Reduce: p.reduceSMSConfigDeactivated,
+				},
+				{
+					Event:  org.SMSConfigRemovedEventType,
+					Reduce: p.reduceSMSConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigTwilioAddedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMSConfigTwilioAddedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigTwilioAddedEvent:
+		e = event
+	case *org.SMSConfigTwilioAddedEvent:
+		e = &event.SMSConfigTwilioAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Noh3u", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioAddedEventType, org.SMSConfigTwilioAddedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -182,9 +222,14 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event)
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigTwilioChangedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMSConfigTwilioChangedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigTwilioChangedEvent:
+		e = event
+	case *org.SMSConfigTwilioChangedEvent:
+		e = &event.SMSConfigTwilioChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ahj9e", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioChangedEventType, org.SMSConfigTwilioChangedEventType})
 	}
 
 	stmts := make([]func(eventstore.Event) handler.Exec, 0, 3)
@@ -230,9 +275,14 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Even
 }
 
 func (p *smsConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigTwilioTokenChangedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMSConfigTwilioTokenChangedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigTwilioTokenChangedEvent:
+		e = event
+	case *org.SMSConfigTwilioTokenChangedEvent:
+		e = &event.SMSConfigTwilioTokenChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Uj5ai", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigTwilioTokenChangedEventType, org.SMSConfigTwilioTokenChangedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -350,6 +400,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Ev
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -402,9 +453,14 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioRemoved(event eventstore.Even
 }
 
 func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigActivatedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMSConfigActivatedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigActivatedEvent:
+		e = event
+	case *org.SMSConfigActivatedEvent:
+		e = &event.SMSConfigActivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Shei0", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigActivatedEventType, org.SMSConfigActivatedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -419,6 +475,7 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -436,9 +493,14 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 }
 
 func (p *smsConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigDeactivatedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMSConfigDeactivatedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigDeactivatedEvent:
+		e = event
+	case *org.SMSConfigDeactivatedEvent:
+		e = &event.SMSConfigDeactivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Mai4e", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigDeactivatedEventType, org.SMSConfigDeactivatedEventType})
 	}
 
 	return handler.NewUpdateStatement(
@@ -456,7 +518,27 @@ func (p *smsConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event)
 }
 
 func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMSConfigRemovedEvent](event)
+	var e *instance.SMSConfigRemovedEvent
+	switch event := event.(type) {
+	case *instance.SMSConfigRemovedEvent:
+		e = event
+	case *org.SMSConfigRemovedEvent:
+		e = &event.SMSConfigRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Kee7f", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMSConfigRemovedEventType, org.SMSConfigRemovedEventType})
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *smsConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
 	if err != nil {
 		return nil, err
 	}
@@ -464,8 +546,8 @@ func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*h
 	return handler.NewDeleteStatement(
 		e,
 		[]handler.Condition{
-			handler.NewCond(SMSColumnID, e.ID),
 			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ID),
 		},
 	), nil
 }
@@ -8,6 +8,8 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -137,13 +139,51 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMTPConfigAddedEventType,
+					Reduce: p.reduceSMTPConfigAdded,
+				},
+				{
+					Event:  org.SMTPConfigChangedEventType,
+					Reduce: p.reduceSMTPConfigChanged,
+				},
+				{
+					Event:  org.SMTPConfigPasswordChangedEventType,
+					Reduce: p.reduceSMTPConfigPasswordChanged,
+				},
+				{
+					Event:  org.SMTPConfigActivatedEventType,
+					Reduce: p.reduceSMTPConfigActivated,
+				},
+				{
+					Event:  org.SMTPConfigDeactivatedEventType,
+					Reduce: p.reduceSMTPConfigDeactivated,
+				},
+				{
+					Event:  org.SMTPConfigRemovedEventType,
+					Reduce: p.reduceSMTPConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigAddedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigAddedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigAddedEvent:
+		e = event
+	case *org.SMTPConfigAddedEvent:
+		e = &event.SMTPConfigAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ohv6i", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigAddedEventType, org.SMTPConfigAddedEventType})
 	}
 
 	description := e.Description
@@ -260,9 +300,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigHTTPChanged(event eventstore.Even
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigChangedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigChangedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigChangedEvent:
+		e = event
+	case *org.SMTPConfigChangedEvent:
+		e = &event.SMTPConfigChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eel8a", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigChangedEventType, org.SMTPConfigChangedEventType})
 	}
 
 	stmts := make([]func(eventstore.Event) handler.Exec, 0, 3)
@@ -320,9 +365,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigPasswordChangedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigPasswordChangedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigPasswordChangedEvent:
+		e = event
+	case *org.SMTPConfigPasswordChangedEvent:
+		e = &event.SMTPConfigPasswordChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Iu3ai", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigPasswordChangedEventType, org.SMTPConfigPasswordChangedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -351,9 +401,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigActivatedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigActivatedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigActivatedEvent:
+		e = event
+	case *org.SMTPConfigActivatedEvent:
+		e = &event.SMTPConfigActivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Hah8u", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigActivatedEventType, org.SMTPConfigActivatedEventType})
 	}
 
 	return handler.NewMultiStatement(
@@ -368,6 +423,7 @@ func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event)
 				handler.Not(handler.NewCond(SMTPConfigColumnID, getSMTPConfigID(e.ID, e.Aggregate()))),
 				handler.NewCond(SMTPConfigColumnState, domain.SMTPConfigStateActive),
 				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -385,9 +441,14 @@ func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event)
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigDeactivatedEvent](event)
-	if err != nil {
-		return nil, err
+	var e *instance.SMTPConfigDeactivatedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigDeactivatedEvent:
+		e = event
+	case *org.SMTPConfigDeactivatedEvent:
+		e = &event.SMTPConfigDeactivatedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ooph5", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigDeactivatedEventType, org.SMTPConfigDeactivatedEventType})
 	}
 
 	return handler.NewUpdateStatement(
@@ -405,7 +466,27 @@ func (p *smtpConfigProjection) reduceSMTPConfigDeactivated(event eventstore.Even
 }
 
 func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
-	e, err := assertEvent[*instance.SMTPConfigRemovedEvent](event)
+	var e *instance.SMTPConfigRemovedEvent
+	switch event := event.(type) {
+	case *instance.SMTPConfigRemovedEvent:
+		e = event
+	case *org.SMTPConfigRemovedEvent:
+		e = &event.SMTPConfigRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bae2o", "reduce.wrong.event.type %v", []eventstore.EventType{instance.SMTPConfigRemovedEventType, org.SMTPConfigRemovedEventType})
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, getSMTPConfigID(e.ID, e.Aggregate())),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
 	if err != nil {
 		return nil, err
 	}
@@ -413,8 +494,8 @@ func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (
 	return handler.NewDeleteStatement(
 		e,
 		[]handler.Condition{
-			handler.NewCond(SMTPConfigColumnID, getSMTPConfigID(e.ID, e.Aggregate())),
 			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ID),
 		},
 	), nil
 }
@@ -97,6 +97,10 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailAddedType,
 					Reduce: p.reduceAddAuthMethod,
 				},
+				{
+					Event:  user.HumanPushDeviceAddedType,
+					Reduce: p.reduceAddAuthMethod,
+				},
 				{
 					Event:  user.HumanPasswordlessTokenRemovedType,
 					Reduce: p.reduceRemoveAuthMethod,
@@ -125,6 +129,10 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailRemovedType,
 					Reduce: p.reduceRemoveAuthMethod,
 				},
+				{
+					Event:  user.HumanPushDeviceRemovedType,
+					Reduce: p.reduceRemoveAuthMethod,
+				},
 			},
 		},
 		{
@@ -232,20 +240,26 @@ func (p *userAuthMethodProjection) reduceActivateEvent(event eventstore.Event) (
 }
 
 func (p *userAuthMethodProjection) reduceAddAuthMethod(event eventstore.Event) (*handler.Statement, error) {
+	tokenID := ""
+	name := ""
 	var methodType domain.UserAuthMethodType
-	switch event.(type) {
+	switch e := event.(type) {
 	case *user.HumanOTPSMSAddedEvent:
 		methodType = domain.UserAuthMethodTypeOTPSMS
 	case *user.HumanOTPEmailAddedEvent:
 		methodType = domain.UserAuthMethodTypeOTPEmail
+	case *user.HumanPushDeviceAddedEvent:
+		methodType = domain.UserAuthMethodTypePush
+		tokenID = e.DeviceID
+		name = e.Name
 	default:
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-DS4g3", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanOTPSMSAddedType, user.HumanOTPEmailAddedType})
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-DS4g3", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanOTPSMSAddedType, user.HumanOTPEmailAddedType, user.HumanPushDeviceAddedType})
 	}
 
 	return handler.NewCreateStatement(
 		event,
 		[]handler.Column{
-			handler.NewCol(UserAuthMethodTokenIDCol, ""),
+			handler.NewCol(UserAuthMethodTokenIDCol, tokenID),
 			handler.NewCol(UserAuthMethodCreationDateCol, event.CreatedAt()),
 			handler.NewCol(UserAuthMethodChangeDateCol, event.CreatedAt()),
 			handler.NewCol(UserAuthMethodResourceOwnerCol, event.Aggregate().ResourceOwner),
@@ -254,7 +268,7 @@ func (p *userAuthMethodProjection) reduceAddAuthMethod(event eventstore.Event) (
 			handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
 			handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady),
 			handler.NewCol(UserAuthMethodTypeCol, methodType),
-			handler.NewCol(UserAuthMethodNameCol, ""),
+			handler.NewCol(UserAuthMethodNameCol, name),
 		},
 	), nil
 }
@@ -276,11 +290,14 @@ func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event
 		methodType = domain.UserAuthMethodTypeOTPSMS
 	case *user.HumanOTPEmailRemovedEvent:
 		methodType = domain.UserAuthMethodTypeOTPEmail
+	case *user.HumanPushDeviceRemovedEvent:
+		methodType = domain.UserAuthMethodTypePush
+		tokenID = e.DeviceID
 
 	default:
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v",
 			[]eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType, user.HumanMFAOTPRemovedType,
-				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType})
+				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType, user.HumanPushDeviceRemovedType})
 	}
 	conditions := []handler.Condition{
 		handler.NewCond(UserAuthMethodUserIDCol, event.Aggregate().ID),
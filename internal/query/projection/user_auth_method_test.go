@@ -354,6 +354,75 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceAddedPushDevice",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanPushDeviceAddedType,
+					user.AggregateType,
+					[]byte(`{
+						"deviceID": "device-id",
+						"name": "name",
+						"deviceToken": "token"
+					}`),
+				), eventstore.GenericEventMapper[user.HumanPushDeviceAddedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceAddAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_auth_methods5 (token_id, creation_date, change_date, resource_owner, instance_id, user_id, sequence, state, method_type, name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"device-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								domain.MFAStateReady,
+								domain.UserAuthMethodTypePush,
+								"name",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemovePushDevice",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanPushDeviceRemovedType,
+					user.AggregateType,
+					[]byte(`{
+						"deviceID": "device-id"
+					}`),
+				), eventstore.GenericEventMapper[user.HumanPushDeviceRemovedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceRemoveAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_auth_methods5 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4) AND (token_id = $5)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.UserAuthMethodTypePush,
+								"ro-id",
+								"instance-id",
+								"device-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceRemoveOTPPasswordless",
 			args: args{
@@ -27,23 +27,27 @@ type Sessions struct {
 }
 
 type Session struct {
-	ID             string
-	CreationDate   time.Time
-	ChangeDate     time.Time
-	Sequence       uint64
-	State          domain.SessionState
-	ResourceOwner  string
-	Creator        string
-	UserFactor     SessionUserFactor
-	PasswordFactor SessionPasswordFactor
-	IntentFactor   SessionIntentFactor
-	WebAuthNFactor SessionWebAuthNFactor
-	TOTPFactor     SessionTOTPFactor
-	OTPSMSFactor   SessionOTPFactor
-	OTPEmailFactor SessionOTPFactor
-	Metadata       map[string][]byte
-	UserAgent      domain.UserAgent
-	Expiration     time.Time
+	ID              string
+	CreationDate    time.Time
+	ChangeDate      time.Time
+	Sequence        uint64
+	State           domain.SessionState
+	ResourceOwner   string
+	Creator         string
+	UserFactor      SessionUserFactor
+	PasswordFactor  SessionPasswordFactor
+	IntentFactor    SessionIntentFactor
+	WebAuthNFactor  SessionWebAuthNFactor
+	TOTPFactor      SessionTOTPFactor
+	OTPSMSFactor    SessionOTPFactor
+	OTPEmailFactor  SessionOTPFactor
+	PushFactor      SessionPushFactor
+	MagicLinkFactor SessionMagicLinkFactor
+	Metadata        map[string][]byte
+	UserAgent       domain.UserAgent
+	Expiration      time.Time
+	// Risk is set if the login risk of the session was evaluated
+	Risk *SessionRisk
 }
 
 type SessionUserFactor struct {
@@ -75,6 +79,20 @@ type SessionOTPFactor struct {
 	OTPCheckedAt time.Time
 }
 
+type SessionPushFactor struct {
+	PushCheckedAt time.Time
+}
+
+type SessionMagicLinkFactor struct {
+	MagicLinkCheckedAt time.Time
+}
+
+type SessionRisk struct {
+	Signals []domain.LoginRiskSignal
+	Action  domain.LoginRiskAction
+	Country string
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -165,6 +183,14 @@ var (
 		name:  projection.SessionColumnOTPEmailCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnPushCheckedAt = Column{
+		name:  projection.SessionColumnPushCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnMagicLinkCheckedAt = Column{
+		name:  projection.SessionColumnMagicLinkCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -193,6 +219,18 @@ var (
 		name:  projection.SessionColumnExpiration,
 		table: sessionsTable,
 	}
+	SessionColumnRiskSignals = Column{
+		name:  projection.SessionColumnRiskSignals,
+		table: sessionsTable,
+	}
+	SessionColumnRiskAction = Column{
+		name:  projection.SessionColumnRiskAction,
+		table: sessionsTable,
+	}
+	SessionColumnRiskCountry = Column{
+		name:  projection.SessionColumnRiskCountry,
+		table: sessionsTable,
+	}
 )
 
 func (q *Queries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string) (session *Session, err error) {
@@ -301,6 +339,8 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -308,6 +348,9 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskCountry.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
 			LeftJoin(join(HumanUserIDCol, SessionColumnUserID)).
@@ -328,11 +371,16 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				totpCheckedAt       sql.NullTime
 				otpSMSCheckedAt     sql.NullTime
 				otpEmailCheckedAt   sql.NullTime
+				pushCheckedAt       sql.NullTime
+				magicLinkCheckedAt  sql.NullTime
 				metadata            database.Map[[]byte]
 				token               sql.NullString
 				userAgentIP         sql.NullString
 				userAgentHeader     database.Map[[]string]
 				expiration          sql.NullTime
+				riskSignals         database.NumberArray[domain.LoginRiskSignal]
+				riskAction          sql.NullInt32
+				riskCountry         sql.NullString
 			)
 
 			err := row.Scan(
@@ -355,6 +403,8 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&totpCheckedAt,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
+				&pushCheckedAt,
+				&magicLinkCheckedAt,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -362,6 +412,9 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&session.UserAgent.Description,
 				&userAgentHeader,
 				&expiration,
+				&riskSignals,
+				&riskAction,
+				&riskCountry,
 			)
 
 			if err != nil {
@@ -383,12 +436,15 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+			session.PushFactor.PushCheckedAt = pushCheckedAt.Time
+			session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
 				session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 			}
 			session.Expiration = expiration.Time
+			session.Risk = sessionRisk(riskSignals, riskAction, riskCountry)
 			return session, token.String, nil
 		}
 }
@@ -414,8 +470,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskCountry.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
@@ -440,8 +501,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					totpCheckedAt       sql.NullTime
 					otpSMSCheckedAt     sql.NullTime
 					otpEmailCheckedAt   sql.NullTime
+					pushCheckedAt       sql.NullTime
+					magicLinkCheckedAt  sql.NullTime
 					metadata            database.Map[[]byte]
 					expiration          sql.NullTime
+					riskSignals         database.NumberArray[domain.LoginRiskSignal]
+					riskAction          sql.NullInt32
+					riskCountry         sql.NullString
 				)
 
 				err := rows.Scan(
@@ -464,8 +530,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&totpCheckedAt,
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
+					&pushCheckedAt,
+					&magicLinkCheckedAt,
 					&metadata,
 					&expiration,
+					&riskSignals,
+					&riskAction,
+					&riskCountry,
 					&sessions.Count,
 				)
 
@@ -484,8 +555,11 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+				session.PushFactor.PushCheckedAt = pushCheckedAt.Time
+				session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 				session.Metadata = metadata
 				session.Expiration = expiration.Time
+				session.Risk = sessionRisk(riskSignals, riskAction, riskCountry)
 
 				sessions.Sessions = append(sessions.Sessions, session)
 			}
@@ -493,3 +567,14 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			return sessions, nil
 		}
 }
+
+func sessionRisk(signals []domain.LoginRiskSignal, action sql.NullInt32, country sql.NullString) *SessionRisk {
+	if !action.Valid {
+		return nil
+	}
+	return &SessionRisk{
+		Signals: signals,
+		Action:  domain.LoginRiskAction(action.Int32),
+		Country: country.String,
+	}
+}
@@ -20,63 +20,73 @@ import (
 )
 
 var (
-	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions11.id,` +
+		` projections.sessions11.creation_date,` +
+		` projections.sessions11.change_date,` +
+		` projections.sessions11.sequence,` +
+		` projections.sessions11.state,` +
+		` projections.sessions11.resource_owner,` +
+		` projections.sessions11.creator,` +
+		` projections.sessions11.user_id,` +
+		` projections.sessions11.user_resource_owner,` +
+		` projections.sessions11.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users13_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.token_id,` +
-		` projections.sessions8.user_agent_fingerprint_id,` +
-		` projections.sessions8.user_agent_ip,` +
-		` projections.sessions8.user_agent_description,` +
-		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users13_humans ON projections.sessions8.user_id = projections.users13_humans.user_id AND projections.sessions8.instance_id = projections.users13_humans.instance_id` +
-		` LEFT JOIN projections.users13 ON projections.sessions8.user_id = projections.users13.id AND projections.sessions8.instance_id = projections.users13.instance_id` +
+		` projections.sessions11.password_checked_at,` +
+		` projections.sessions11.intent_checked_at,` +
+		` projections.sessions11.webauthn_checked_at,` +
+		` projections.sessions11.webauthn_user_verified,` +
+		` projections.sessions11.totp_checked_at,` +
+		` projections.sessions11.otp_sms_checked_at,` +
+		` projections.sessions11.otp_email_checked_at,` +
+		` projections.sessions11.push_checked_at,` +
+		` projections.sessions11.magic_link_checked_at,` +
+		` projections.sessions11.metadata,` +
+		` projections.sessions11.token_id,` +
+		` projections.sessions11.user_agent_fingerprint_id,` +
+		` projections.sessions11.user_agent_ip,` +
+		` projections.sessions11.user_agent_description,` +
+		` projections.sessions11.user_agent_header,` +
+		` projections.sessions11.expiration,` +
+		` projections.sessions11.risk_signals,` +
+		` projections.sessions11.risk_action,` +
+		` projections.sessions11.risk_country` +
+		` FROM projections.sessions11` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions11.user_id = projections.login_names3.user_id AND projections.sessions11.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users13_humans ON projections.sessions11.user_id = projections.users13_humans.user_id AND projections.sessions11.instance_id = projections.users13_humans.instance_id` +
+		` LEFT JOIN projections.users13 ON projections.sessions11.user_id = projections.users13.id AND projections.sessions11.instance_id = projections.users13.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
-	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions11.id,` +
+		` projections.sessions11.creation_date,` +
+		` projections.sessions11.change_date,` +
+		` projections.sessions11.sequence,` +
+		` projections.sessions11.state,` +
+		` projections.sessions11.resource_owner,` +
+		` projections.sessions11.creator,` +
+		` projections.sessions11.user_id,` +
+		` projections.sessions11.user_resource_owner,` +
+		` projections.sessions11.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users13_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.expiration,` +
+		` projections.sessions11.password_checked_at,` +
+		` projections.sessions11.intent_checked_at,` +
+		` projections.sessions11.webauthn_checked_at,` +
+		` projections.sessions11.webauthn_user_verified,` +
+		` projections.sessions11.totp_checked_at,` +
+		` projections.sessions11.otp_sms_checked_at,` +
+		` projections.sessions11.otp_email_checked_at,` +
+		` projections.sessions11.push_checked_at,` +
+		` projections.sessions11.magic_link_checked_at,` +
+		` projections.sessions11.metadata,` +
+		` projections.sessions11.expiration,` +
+		` projections.sessions11.risk_signals,` +
+		` projections.sessions11.risk_action,` +
+		` projections.sessions11.risk_country,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users13_humans ON projections.sessions8.user_id = projections.users13_humans.user_id AND projections.sessions8.instance_id = projections.users13_humans.instance_id` +
-		` LEFT JOIN projections.users13 ON projections.sessions8.user_id = projections.users13.id AND projections.sessions8.instance_id = projections.users13.instance_id` +
+		` FROM projections.sessions11` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions11.user_id = projections.login_names3.user_id AND projections.sessions11.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users13_humans ON projections.sessions11.user_id = projections.users13_humans.user_id AND projections.sessions11.instance_id = projections.users13_humans.instance_id` +
+		` LEFT JOIN projections.users13 ON projections.sessions11.user_id = projections.users13.id AND projections.sessions11.instance_id = projections.users13.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	sessionCols = []string{
@@ -99,6 +109,8 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"push_checked_at",
+		"magic_link_checked_at",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -106,6 +118,9 @@ var (
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_signals",
+		"risk_action",
+		"risk_country",
 	}
 
 	sessionsCols = []string{
@@ -128,8 +143,13 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"push_checked_at",
+		"magic_link_checked_at",
 		"metadata",
 		"expiration",
+		"risk_signals",
+		"risk_action",
+		"risk_country",
 		"count",
 	}
 )
@@ -185,8 +205,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -230,6 +255,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -266,8 +297,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"session-id2",
@@ -289,8 +325,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -334,6 +375,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -373,6 +420,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -462,6 +515,8 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
+						testNow,
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -469,6 +524,9 @@ func Test_SessionPrepare(t *testing.T) {
 						"agentDescription",
 						[]byte(`{"foo":["foo","bar"]}`),
 						testNow,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -506,6 +564,12 @@ func Test_SessionPrepare(t *testing.T) {
 				OTPEmailFactor: SessionOTPFactor{
 					OTPCheckedAt: testNow,
 				},
+				PushFactor: SessionPushFactor{
+					PushCheckedAt: testNow,
+				},
+				MagicLinkFactor: SessionMagicLinkFactor{
+					MagicLinkCheckedAt: testNow,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
@@ -145,15 +145,16 @@ var (
 	}
 )
 
-func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
+func (q *Queries) SMSProviderConfigByID(ctx context.Context, resourceOwner, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	query, scan := prepareSMSConfigQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.Eq{
-			SMSColumnID.identifier():         id,
-			SMSColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+			SMSColumnID.identifier():            id,
+			SMSColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+			SMSColumnResourceOwner.identifier(): resourceOwner,
 		},
 	).ToSql()
 	if err != nil {
@@ -167,15 +168,18 @@ func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config
 	return config, err
 }
 
-func (q *Queries) SMSProviderConfigActive(ctx context.Context, instanceID string) (config *SMSConfig, err error) {
+// SMSProviderConfigActive returns the active SMS configuration of the resource owner,
+// which is either the instance or an organization overriding the configuration of the instance.
+func (q *Queries) SMSProviderConfigActive(ctx context.Context, resourceOwner string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	query, scan := prepareSMSConfigQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.Eq{
-			SMSColumnInstanceID.identifier(): instanceID,
-			SMSColumnState.identifier():      domain.SMSConfigStateActive,
+			SMSColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+			SMSColumnResourceOwner.identifier(): resourceOwner,
+			SMSColumnState.identifier():         domain.SMSConfigStateActive,
 		},
 	).ToSql()
 	if err != nil {
@@ -217,6 +221,10 @@ func NewSMSProviderStateQuery(state domain.SMSConfigState) (SearchQuery, error)
 	return NewNumberQuery(SMSColumnState, state, NumberEquals)
 }
 
+func NewSMSProviderResourceOwnerQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMSColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, error)) {
 	return sq.Select(
 			SMSColumnID.identifier(),
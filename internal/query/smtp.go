@@ -22,6 +22,14 @@ type SMTPConfigsSearchQueries struct {
 	Queries []SearchQuery
 }
 
+func (q *SMTPConfigsSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
 type SMTPConfigs struct {
 	SearchResponse
 	Configs []*SMTPConfig
@@ -149,6 +157,8 @@ type SMTP struct {
 	Password       *crypto.CryptoValue
 }
 
+// SMTPConfigActive returns the active SMTP configuration of the resource owner,
+// which is either the instance or an organization overriding the configuration of the instance.
 func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -156,7 +166,7 @@ func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (c
 	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
 		SMTPConfigColumnResourceOwner.identifier(): resourceOwner,
-		SMTPConfigColumnInstanceID.identifier():    resourceOwner,
+		SMTPConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
 		SMTPConfigColumnState.identifier():         domain.SMTPConfigStateActive,
 	}).ToSql()
 	if err != nil {
@@ -170,14 +180,15 @@ func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (c
 	return config, err
 }
 
-func (q *Queries) SMTPConfigByID(ctx context.Context, instanceID, id string) (config *SMTPConfig, err error) {
+func (q *Queries) SMTPConfigByID(ctx context.Context, resourceOwner, id string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
-		SMTPConfigColumnInstanceID.identifier(): instanceID,
-		SMTPConfigColumnID.identifier():         id,
+		SMTPConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+		SMTPConfigColumnResourceOwner.identifier(): resourceOwner,
+		SMTPConfigColumnID.identifier():            id,
 	}).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-8f8gw", "Errors.Query.SQLStatement")
@@ -349,6 +360,10 @@ func (q *Queries) SearchSMTPConfigs(ctx context.Context, queries *SMTPConfigsSea
 	return configs, err
 }
 
+func NewSMTPConfigResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMTPConfigColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 type sqlSmtpConfig struct {
 	id             sql.NullString
 	tls            sql.NullBool
@@ -0,0 +1,7 @@
+select g.id, g.grant_id, g.creation_date, g.change_date, g.sequence, g.roles, g.resource_owner, o.name, o.primary_domain, g.project_id, p.name
+from projections.groups1_grants g
+left join projections.orgs1 o on o.id = g.resource_owner and o.instance_id = g.instance_id
+left join projections.projects4 p on p.id = g.project_id and p.instance_id = g.instance_id
+where g.group_id in (select group_id from user_groups)
+and g.instance_id = $2
+and g.project_id = any($3);
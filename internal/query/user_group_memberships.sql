@@ -0,0 +1,5 @@
+select m.aggregate_type, m.aggregate_id, m.roles, m.creation_date, m.change_date, m.sequence, m.resource_owner
+from projections.group_memberships1 m
+where m.group_id in (select group_id from user_groups)
+and m.instance_id = $2
+and m.resource_owner = $3;
@@ -0,0 +1,14 @@
+-- find the groups the user is a member of, directly or through subgroups
+with recursive user_groups as (
+	select group_id
+	from projections.groups1_members
+	where member_id = $1
+	and member_type = 1
+	and instance_id = $2
+	union
+	select m.group_id
+	from projections.groups1_members m
+	join user_groups ug on m.member_id = ug.group_id
+	where m.member_type = 2
+	and m.instance_id = $2
+)
@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationPreferencesByUserID returns the notification preferences of the user.
+// If the user never set any, empty preferences are returned.
+func (q *Queries) NotificationPreferencesByUserID(ctx context.Context, userID, resourceOwner string) (_ *domain.NotificationPreferences, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "QUERY-ooT9a", "Errors.User.UserIDMissing")
+	}
+	readModel := NewHumanNotificationPreferencesReadModel(userID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.Preferences, nil
+}
+
+type HumanNotificationPreferencesReadModel struct {
+	*eventstore.ReadModel
+
+	Preferences *domain.NotificationPreferences
+}
+
+func NewHumanNotificationPreferencesReadModel(userID, resourceOwner string) *HumanNotificationPreferencesReadModel {
+	return &HumanNotificationPreferencesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		Preferences: new(domain.NotificationPreferences),
+	}
+}
+
+func (rm *HumanNotificationPreferencesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanNotificationPreferencesSetEvent:
+			rm.Preferences = &domain.NotificationPreferences{
+				CodeChannels:       e.CodeChannels,
+				OptOutMessageTypes: e.OptOutMessageTypes,
+				SMSQuietHours:      e.SMSQuietHours,
+			}
+		case *user.UserRemovedEvent:
+			rm.Preferences = new(domain.NotificationPreferences)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *HumanNotificationPreferencesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanNotificationPreferencesSetType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
@@ -1,4 +1,4 @@
-with usr as (
+with recursive usr as (
 	select u.id, u.creation_date, u.change_date, u.sequence, u.state, u.resource_owner, u.username, n.login_name as preferred_login_name
 	from projections.users13 u
 	left join projections.login_names3 n on u.id = n.user_id and u.instance_id = n.instance_id
@@ -31,7 +31,21 @@ metadata as (
 		and instance_id = $2
 	) r
 ),
--- get all user grants, needed for the orgs query
+-- find the groups the user is a member of, directly or through subgroups
+user_groups as (
+	select group_id
+	from projections.groups1_members
+	where member_id = $1
+	and member_type = 1
+	and instance_id = $2
+	union
+	select m.group_id
+	from projections.groups1_members m
+	join user_groups ug on m.member_id = ug.group_id
+	where m.member_type = 2
+	and m.instance_id = $2
+),
+-- get all user grants, including the grants of the user's groups, needed for the orgs query
 user_grants as (
 	select id, grant_id, state, creation_date, change_date, sequence, user_id, roles, resource_owner, project_id
 	from projections.user_grants5
@@ -42,6 +56,15 @@ user_grants as (
 	{{ if . -}}
 	and resource_owner = any($4)
 	{{- end }}
+	union all
+	select id, grant_id, 1 as state, creation_date, change_date, sequence, $1 as user_id, roles, resource_owner, project_id
+	from projections.groups1_grants
+	where group_id in (select group_id from user_groups)
+	and instance_id = $2
+	and project_id = any($3)
+	{{ if . -}}
+	and resource_owner = any($4)
+	{{- end }}
 ),
 -- filter all orgs we are interested in.
 orgs as (
@@ -17,6 +17,8 @@ import (
 
 const (
 	TranslateFn = "t"
+	// TranslatePluralFn selects the plural form of the message by the count, e.g. {{tp "Key" .Count "Arg" .Arg}}
+	TranslatePluralFn = "tp"
 
 	templatesPath = "/templates"
 )
@@ -67,6 +69,13 @@ func (r *Renderer) LocalizeFromRequest(translator *i18n.Translator, req *http.Re
 	}
 	return translator.LocalizeFromRequest(req, id, args)
 }
+func (r *Renderer) LocalizePluralFromRequest(translator *i18n.Translator, req *http.Request, id string, count interface{}, args map[string]interface{}) string {
+	if translator == nil {
+		return ""
+	}
+	return translator.LocalizePluralFromRequest(req, id, count, args)
+}
+
 func (r *Renderer) ReqLang(translator *i18n.Translator, req *http.Request) language.Tag {
 	if translator == nil {
 		return language.Und
@@ -79,6 +88,9 @@ func (r *Renderer) loadTemplates(dir http.FileSystem, translator *i18n.Translato
 	funcs[TranslateFn] = func(id string, args ...interface{}) string {
 		return id
 	}
+	funcs[TranslatePluralFn] = func(id string, count interface{}, args ...interface{}) string {
+		return id
+	}
 	templatesDir, err := dir.Open(templatesPath)
 	if err != nil {
 		return zerrors.ThrowNotFound(err, "RENDE-G3aea", "path not found")
@@ -124,19 +136,28 @@ func (r *Renderer) registerTranslateFn(req *http.Request, translator *i18n.Trans
 		return funcs
 	}
 	funcs[TranslateFn] = func(id string, args ...interface{}) template.HTML {
-		m := map[string]interface{}{}
-		var key string
-		for i, arg := range args {
-			if i%2 == 0 {
-				key = arg.(string)
-				continue
-			}
-			m[key] = arg
-		}
+		m := templateArgs(args)
 		if r == nil {
 			return template.HTML(r.Localize(translator, id, m))
 		}
 		return template.HTML(r.LocalizeFromRequest(translator, req, id, m))
 	}
+	funcs[TranslatePluralFn] = func(id string, count interface{}, args ...interface{}) template.HTML {
+		return template.HTML(r.LocalizePluralFromRequest(translator, req, id, count, templateArgs(args)))
+	}
 	return funcs
 }
+
+// templateArgs converts the key value pairs passed to a translate function of a template into a map
+func templateArgs(args []interface{}) map[string]interface{} {
+	m := map[string]interface{}{}
+	var key string
+	for i, arg := range args {
+		if i%2 == 0 {
+			key = arg.(string)
+			continue
+		}
+		m[key] = arg
+	}
+	return m
+}
@@ -0,0 +1,18 @@
+package group
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupAddedType, eventstore.GenericEventMapper[GroupAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupChangedType, eventstore.GenericEventMapper[GroupChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupRemovedType, eventstore.GenericEventMapper[GroupRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MemberAddedType, eventstore.GenericEventMapper[MemberAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedType, eventstore.GenericEventMapper[MemberRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SubgroupAddedType, eventstore.GenericEventMapper[SubgroupAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SubgroupRemovedType, eventstore.GenericEventMapper[SubgroupRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantAddedType, eventstore.GenericEventMapper[GrantAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantChangedType, eventstore.GenericEventMapper[GrantChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantRemovedType, eventstore.GenericEventMapper[GrantRemovedEvent])
+}
@@ -0,0 +1,98 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	grantEventTypePrefix = groupEventTypePrefix + "grant."
+	GrantAddedType       = grantEventTypePrefix + "added"
+	GrantChangedType     = grantEventTypePrefix + "changed"
+	GrantRemovedType     = grantEventTypePrefix + "removed"
+)
+
+// GrantAddedEvent grants roles of a project (or project grant) to all (indirect) members of the group
+type GrantAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID        string   `json:"grantId"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+func (e *GrantAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantAddedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, grantID, projectID, projectGrantID string, roleKeys []string) *GrantAddedEvent {
+	return &GrantAddedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantAddedType),
+		GrantID:        grantID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+type GrantChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID  string   `json:"grantId"`
+	RoleKeys []string `json:"roleKeys"`
+}
+
+func (e *GrantChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantChangedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, grantID string, roleKeys []string) *GrantChangedEvent {
+	return &GrantChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, GrantChangedType),
+		GrantID:   grantID,
+		RoleKeys:  roleKeys,
+	}
+}
+
+type GrantRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID string `json:"grantId"`
+}
+
+func (e *GrantRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, grantID string) *GrantRemovedEvent {
+	return &GrantRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, GrantRemovedType),
+		GrantID:   grantID,
+	}
+}
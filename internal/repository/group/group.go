@@ -0,0 +1,133 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueGroupName      = "group_name"
+	groupEventTypePrefix = eventstore.EventType("group.")
+	GroupAddedType       = groupEventTypePrefix + "added"
+	GroupChangedType     = groupEventTypePrefix + "changed"
+	GroupRemovedType     = groupEventTypePrefix + "removed"
+)
+
+func NewAddGroupNameUniqueConstraint(resourceOwner, name string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupName,
+		fmt.Sprintf("%s:%s", resourceOwner, name),
+		"Errors.Group.AlreadyExists")
+}
+
+func NewRemoveGroupNameUniqueConstraint(resourceOwner, name string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupName,
+		fmt.Sprintf("%s:%s", resourceOwner, name))
+}
+
+type GroupAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *GroupAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupAddedEvent) Payload() any {
+	return e
+}
+
+func (e *GroupAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddGroupNameUniqueConstraint(e.Aggregate().ResourceOwner, e.Name)}
+}
+
+func NewGroupAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, name, description string) *GroupAddedEvent {
+	return &GroupAddedEvent{
+		BaseEvent:   *eventstore.NewBaseEventForPush(ctx, aggregate, GroupAddedType),
+		Name:        name,
+		Description: description,
+	}
+}
+
+type GroupChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	oldName string
+}
+
+func (e *GroupChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupChangedEvent) Payload() any {
+	return e
+}
+
+func (e *GroupChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	if e.Name == nil || e.oldName == "" {
+		return nil
+	}
+	return []*eventstore.UniqueConstraint{
+		NewRemoveGroupNameUniqueConstraint(e.Aggregate().ResourceOwner, e.oldName),
+		NewAddGroupNameUniqueConstraint(e.Aggregate().ResourceOwner, *e.Name),
+	}
+}
+
+func NewGroupChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, changes []GroupChanges) *GroupChangedEvent {
+	changedEvent := &GroupChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, GroupChangedType),
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent
+}
+
+type GroupChanges func(event *GroupChangedEvent)
+
+func ChangeName(oldName, name string) GroupChanges {
+	return func(e *GroupChangedEvent) {
+		e.Name = &name
+		e.oldName = oldName
+	}
+}
+
+func ChangeDescription(description string) GroupChanges {
+	return func(e *GroupChangedEvent) {
+		e.Description = &description
+	}
+}
+
+type GroupRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	name string
+}
+
+func (e *GroupRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupRemovedEvent) Payload() any {
+	return nil
+}
+
+func (e *GroupRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveGroupNameUniqueConstraint(e.Aggregate().ResourceOwner, e.name)}
+}
+
+func NewGroupRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, name string) *GroupRemovedEvent {
+	return &GroupRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, GroupRemovedType),
+		name:      name,
+	}
+}
@@ -0,0 +1,47 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AttributeMappingsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID                string                       `json:"id"`
+	AttributeMappings []domain.IDPAttributeMapping `json:"attributeMappings,omitempty"`
+}
+
+func NewAttributeMappingsSetEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	attributeMappings []domain.IDPAttributeMapping,
+) *AttributeMappingsSetEvent {
+	return &AttributeMappingsSetEvent{
+		BaseEvent:         *base,
+		ID:                id,
+		AttributeMappings: attributeMappings,
+	}
+}
+
+func (e *AttributeMappingsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AttributeMappingsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func AttributeMappingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &AttributeMappingsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Atm3s", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -24,6 +24,7 @@ type LDAPIDPAddedEvent struct {
 	Timeout           time.Duration       `json:"timeout"`
 
 	LDAPAttributes
+	LDAPSyncOptions
 	Options
 }
 
@@ -129,6 +130,39 @@ func (o *LDAPAttributes) ReduceChanges(changes LDAPAttributeChanges) {
 	}
 }
 
+// LDAPSyncOptions configure the scheduled synchronization of the directory users into ZITADEL.
+type LDAPSyncOptions struct {
+	SyncEnabled  bool          `json:"syncEnabled,omitempty"`
+	SyncFilter   string        `json:"syncFilter,omitempty"`
+	SyncInterval time.Duration `json:"syncInterval,omitempty"`
+}
+
+func (o *LDAPSyncOptions) Changes(options LDAPSyncOptions) LDAPSyncOptionChanges {
+	opts := LDAPSyncOptionChanges{}
+	if o.SyncEnabled != options.SyncEnabled {
+		opts.SyncEnabled = &options.SyncEnabled
+	}
+	if o.SyncFilter != options.SyncFilter {
+		opts.SyncFilter = &options.SyncFilter
+	}
+	if o.SyncInterval != options.SyncInterval {
+		opts.SyncInterval = &options.SyncInterval
+	}
+	return opts
+}
+
+func (o *LDAPSyncOptions) ReduceChanges(changes LDAPSyncOptionChanges) {
+	if changes.SyncEnabled != nil {
+		o.SyncEnabled = *changes.SyncEnabled
+	}
+	if changes.SyncFilter != nil {
+		o.SyncFilter = *changes.SyncFilter
+	}
+	if changes.SyncInterval != nil {
+		o.SyncInterval = *changes.SyncInterval
+	}
+}
+
 func NewLDAPIDPAddedEvent(
 	base *eventstore.BaseEvent,
 	id string,
@@ -143,6 +177,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	attributes LDAPAttributes,
+	syncOptions LDAPSyncOptions,
 	options Options,
 ) *LDAPIDPAddedEvent {
 	return &LDAPIDPAddedEvent{
@@ -159,6 +194,7 @@ func NewLDAPIDPAddedEvent(
 		UserFilters:       userFilters,
 		Timeout:           timeout,
 		LDAPAttributes:    attributes,
+		LDAPSyncOptions:   syncOptions,
 		Options:           options,
 	}
 }
@@ -200,6 +236,7 @@ type LDAPIDPChangedEvent struct {
 	Timeout           *time.Duration      `json:"timeout,omitempty"`
 
 	LDAPAttributeChanges
+	LDAPSyncOptionChanges
 	OptionChanges
 }
 
@@ -235,6 +272,18 @@ func (o LDAPAttributeChanges) IsZero() bool {
 		o.ProfileAttribute == nil
 }
 
+type LDAPSyncOptionChanges struct {
+	SyncEnabled  *bool          `json:"syncEnabled,omitempty"`
+	SyncFilter   *string        `json:"syncFilter,omitempty"`
+	SyncInterval *time.Duration `json:"syncInterval,omitempty"`
+}
+
+func (o LDAPSyncOptionChanges) IsZero() bool {
+	return o.SyncEnabled == nil &&
+		o.SyncFilter == nil &&
+		o.SyncInterval == nil
+}
+
 func NewLDAPIDPChangedEvent(
 	base *eventstore.BaseEvent,
 	id string,
@@ -321,6 +370,12 @@ func ChangeLDAPAttributes(attributes LDAPAttributeChanges) func(*LDAPIDPChangedE
 	}
 }
 
+func ChangeLDAPSyncOptions(options LDAPSyncOptionChanges) func(*LDAPIDPChangedEvent) {
+	return func(e *LDAPIDPChangedEvent) {
+		e.LDAPSyncOptionChanges = options
+	}
+}
+
 func ChangeLDAPOptions(options OptionChanges) func(*LDAPIDPChangedEvent) {
 	return func(e *LDAPIDPChangedEvent) {
 		e.OptionChanges = options
@@ -0,0 +1,133 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	customNotificationTypePrefix           = "notification.custom_type."
+	UniqueCustomNotificationType           = "custom_notification_type"
+	CustomNotificationTypeAddedEventType   = instanceEventTypePrefix + customNotificationTypePrefix + "added"
+	CustomNotificationTypeChangedEventType = instanceEventTypePrefix + customNotificationTypePrefix + "changed"
+	CustomNotificationTypeRemovedEventType = instanceEventTypePrefix + customNotificationTypePrefix + "removed"
+)
+
+func NewAddCustomNotificationTypeUniqueConstraint(notificationType string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueCustomNotificationType,
+		notificationType,
+		"Errors.CustomNotificationType.AlreadyExists")
+}
+
+func NewRemoveCustomNotificationTypeUniqueConstraint(notificationType string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueCustomNotificationType,
+		notificationType)
+}
+
+type CustomNotificationTypeAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NotificationType string `json:"notificationType"`
+	Description      string `json:"description,omitempty"`
+}
+
+func (e *CustomNotificationTypeAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewCustomNotificationTypeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	notificationType,
+	description string,
+) *CustomNotificationTypeAddedEvent {
+	return &CustomNotificationTypeAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomNotificationTypeAddedEventType,
+		),
+		NotificationType: notificationType,
+		Description:      description,
+	}
+}
+
+func (e *CustomNotificationTypeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomNotificationTypeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddCustomNotificationTypeUniqueConstraint(e.NotificationType)}
+}
+
+type CustomNotificationTypeChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NotificationType string `json:"notificationType"`
+	Description      string `json:"description,omitempty"`
+}
+
+func (e *CustomNotificationTypeChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewCustomNotificationTypeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	notificationType,
+	description string,
+) *CustomNotificationTypeChangedEvent {
+	return &CustomNotificationTypeChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomNotificationTypeChangedEventType,
+		),
+		NotificationType: notificationType,
+		Description:      description,
+	}
+}
+
+func (e *CustomNotificationTypeChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomNotificationTypeChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type CustomNotificationTypeRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NotificationType string `json:"notificationType"`
+}
+
+func (e *CustomNotificationTypeRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewCustomNotificationTypeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	notificationType string,
+) *CustomNotificationTypeRemovedEvent {
+	return &CustomNotificationTypeRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CustomNotificationTypeRemovedEventType,
+		),
+		NotificationType: notificationType,
+	}
+}
+
+func (e *CustomNotificationTypeRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomNotificationTypeRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveCustomNotificationTypeUniqueConstraint(e.NotificationType)}
+}
@@ -106,6 +106,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPAttributeMappingsSetEventType, IDPAttributeMappingsSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPDiscoveryDomainSetEventType, IDPDiscoveryDomainSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPDiscoveryDomainRemovedEventType, IDPDiscoveryDomainRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderCascadeRemovedEventType, IdentityProviderCascadeRemovedEventMapper)
@@ -115,6 +118,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyMultiFactorRemovedEventType, MultiFactorRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateAddedEventType, MailTemplateAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateChangedEventType, MailTemplateChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MessageTemplateSetEventType, MessageTemplateSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MessageTemplateRemovedEventType, MessageTemplateRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTextAddedEventType, MailTextAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTextChangedEventType, MailTextChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, CustomTextSetEventType, CustomTextSetEventMapper)
@@ -128,6 +133,15 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceRemovedEventType, InstanceRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyAddedEventType, NotificationPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginRiskPolicyAddedEventType, LoginRiskPolicyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, LoginRiskPolicyChangedEventType, LoginRiskPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDomainAddedEventType, eventstore.GenericEventMapper[TrustedDomainAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDomainRemovedEventType, eventstore.GenericEventMapper[TrustedDomainRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomNotificationTypeAddedEventType, eventstore.GenericEventMapper[CustomNotificationTypeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomNotificationTypeChangedEventType, eventstore.GenericEventMapper[CustomNotificationTypeChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomNotificationTypeRemovedEventType, eventstore.GenericEventMapper[CustomNotificationTypeRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LanguageBundleSetEventType, eventstore.GenericEventMapper[LanguageBundleSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LanguageBundleRemovedEventType, eventstore.GenericEventMapper[LanguageBundleRemovedEvent])
+
+	eventstore.RegisterPersonalDataInstanceErasure(InstanceRemovedEventType)
 }
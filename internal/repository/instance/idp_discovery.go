@@ -0,0 +1,93 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	idpDiscoveryDomainPrefix           = instanceEventTypePrefix + "idp.discovery.domain."
+	IDPDiscoveryDomainSetEventType     = idpDiscoveryDomainPrefix + "set"
+	IDPDiscoveryDomainRemovedEventType = idpDiscoveryDomainPrefix + "removed"
+)
+
+// IDPDiscoveryDomainSetEvent routes users with a login name of the domain
+// to the identity provider (home realm discovery).
+// The identity provider can either be one of the instance or of an organization.
+type IDPDiscoveryDomainSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain"`
+	IDPID  string `json:"idpId"`
+}
+
+func (e *IDPDiscoveryDomainSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *IDPDiscoveryDomainSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewIDPDiscoveryDomainSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain, idpID string) *IDPDiscoveryDomainSetEvent {
+	return &IDPDiscoveryDomainSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPDiscoveryDomainSetEventType,
+		),
+		Domain: domain,
+		IDPID:  idpID,
+	}
+}
+
+func IDPDiscoveryDomainSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &IDPDiscoveryDomainSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INSTANCE-Hrd1s", "unable to unmarshal idp discovery domain set")
+	}
+
+	return e, nil
+}
+
+type IDPDiscoveryDomainRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Domain string `json:"domain"`
+}
+
+func (e *IDPDiscoveryDomainRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *IDPDiscoveryDomainRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewIDPDiscoveryDomainRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, domain string) *IDPDiscoveryDomainRemovedEvent {
+	return &IDPDiscoveryDomainRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPDiscoveryDomainRemovedEventType,
+		),
+		Domain: domain,
+	}
+}
+
+func IDPDiscoveryDomainRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &IDPDiscoveryDomainRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "INSTANCE-Hrd1r", "unable to unmarshal idp discovery domain removed")
+	}
+
+	return e, nil
+}
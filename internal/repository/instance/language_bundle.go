@@ -0,0 +1,92 @@
+package instance
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	languageBundlePrefix           = "language.bundle."
+	LanguageBundleSetEventType     = instanceEventTypePrefix + languageBundlePrefix + "set"
+	LanguageBundleRemovedEventType = instanceEventTypePrefix + languageBundlePrefix + "removed"
+)
+
+// LanguageBundleSetEvent is pushed when a language bundle of a namespace (login or notification) is uploaded.
+// The bundle itself is stored as asset of the instance.
+type LanguageBundleSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Namespace string       `json:"namespace"`
+	Language  language.Tag `json:"language"`
+	AssetKey  string       `json:"assetKey"`
+}
+
+func (e *LanguageBundleSetEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLanguageBundleSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	namespace string,
+	lang language.Tag,
+	assetKey string,
+) *LanguageBundleSetEvent {
+	return &LanguageBundleSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LanguageBundleSetEventType,
+		),
+		Namespace: namespace,
+		Language:  lang,
+		AssetKey:  assetKey,
+	}
+}
+
+func (e *LanguageBundleSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LanguageBundleSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type LanguageBundleRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Namespace string       `json:"namespace"`
+	Language  language.Tag `json:"language"`
+}
+
+func (e *LanguageBundleRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLanguageBundleRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	namespace string,
+	lang language.Tag,
+) *LanguageBundleRemovedEvent {
+	return &LanguageBundleRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LanguageBundleRemovedEventType,
+		),
+		Namespace: namespace,
+		Language:  lang,
+	}
+}
+
+func (e *LanguageBundleRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LanguageBundleRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
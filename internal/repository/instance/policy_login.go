@@ -30,7 +30,8 @@ func NewLoginPolicyAddedEvent(
 	ignoreUnknownUsernames,
 	allowDomainDiscovery,
 	disableLoginWithEmail,
-	disableLoginWithPhone bool,
+	disableLoginWithPhone,
+	allowMagicLink bool,
 	passwordlessType domain.PasswordlessType,
 	defaultRedirectURI string,
 	passwordCheckLifetime,
@@ -55,6 +56,7 @@ func NewLoginPolicyAddedEvent(
 			allowDomainDiscovery,
 			disableLoginWithEmail,
 			disableLoginWithPhone,
+			allowMagicLink,
 			passwordlessType,
 			defaultRedirectURI,
 			passwordCheckLifetime,
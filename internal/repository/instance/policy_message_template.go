@@ -0,0 +1,75 @@
+package instance
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	MessageTemplateSetEventType     = instanceEventTypePrefix + policy.MessageTemplateSetEventType
+	MessageTemplateRemovedEventType = instanceEventTypePrefix + policy.MessageTemplateRemovedEventType
+)
+
+type MessageTemplateSetEvent struct {
+	policy.MessageTemplateSetEvent
+}
+
+func NewMessageTemplateSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	lang language.Tag,
+	html,
+	plainText string,
+) *MessageTemplateSetEvent {
+	return &MessageTemplateSetEvent{
+		MessageTemplateSetEvent: *policy.NewMessageTemplateSetEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MessageTemplateSetEventType),
+			messageType,
+			lang,
+			html,
+			plainText,
+		),
+	}
+}
+
+func MessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MessageTemplateSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageTemplateSetEvent{MessageTemplateSetEvent: *e.(*policy.MessageTemplateSetEvent)}, nil
+}
+
+type MessageTemplateRemovedEvent struct {
+	policy.MessageTemplateRemovedEvent
+}
+
+func NewMessageTemplateRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	lang language.Tag,
+) *MessageTemplateRemovedEvent {
+	return &MessageTemplateRemovedEvent{
+		MessageTemplateRemovedEvent: *policy.NewMessageTemplateRemovedEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MessageTemplateRemovedEventType),
+			messageType,
+			lang,
+		),
+	}
+}
+
+func MessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MessageTemplateRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageTemplateRemovedEvent{MessageTemplateRemovedEvent: *e.(*policy.MessageTemplateRemovedEvent)}, nil
+}
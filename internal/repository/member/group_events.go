@@ -0,0 +1,120 @@
+package member
+
+import (
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueGroupMember     = "group_member"
+	GroupAddedEventType   = "group.member.added"
+	GroupChangedEventType = "group.member.changed"
+	GroupRemovedEventType = "group.member.removed"
+)
+
+func NewAddGroupMemberUniqueConstraint(aggregateID, groupID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupMember,
+		fmt.Sprintf("%s:%s", aggregateID, groupID),
+		"Errors.Group.Membership.AlreadyExists")
+}
+
+func NewRemoveGroupMemberUniqueConstraint(aggregateID, groupID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupMember,
+		fmt.Sprintf("%s:%s", aggregateID, groupID),
+	)
+}
+
+// GroupMemberAddedEvent adds a group as member with the roles,
+// all (indirect) members of the group get the roles on the aggregate.
+type GroupMemberAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Roles   []string `json:"roles"`
+	GroupID string   `json:"groupId"`
+}
+
+func (e *GroupMemberAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupMemberAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddGroupMemberUniqueConstraint(e.Aggregate().ID, e.GroupID)}
+}
+
+func NewGroupMemberAddedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+	roles ...string,
+) *GroupMemberAddedEvent {
+	return &GroupMemberAddedEvent{
+		BaseEvent: *base,
+		Roles:     roles,
+		GroupID:   groupID,
+	}
+}
+
+type GroupMemberChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Roles   []string `json:"roles,omitempty"`
+	GroupID string   `json:"groupId,omitempty"`
+}
+
+func (e *GroupMemberChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupMemberChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGroupMemberChangedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+	roles ...string,
+) *GroupMemberChangedEvent {
+	return &GroupMemberChangedEvent{
+		BaseEvent: *base,
+		Roles:     roles,
+		GroupID:   groupID,
+	}
+}
+
+type GroupMemberRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *GroupMemberRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GroupMemberRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveGroupMemberUniqueConstraint(e.Aggregate().ID, e.GroupID)}
+}
+
+func NewGroupMemberRemovedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+) *GroupMemberRemovedEvent {
+	return &GroupMemberRemovedEvent{
+		BaseEvent: *base,
+		GroupID:   groupID,
+	}
+}
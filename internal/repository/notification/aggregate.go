@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"strconv"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "notification"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+func NewAggregate(id, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+// ID returns the id of the notification triggered by the event,
+// so all delivery attempts of the event are recorded on the same aggregate.
+func ID(triggeringAggregateID string, triggeringSequence uint64) string {
+	return triggeringAggregateID + "-" + strconv.FormatUint(triggeringSequence, 10)
+}
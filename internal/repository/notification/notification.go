@@ -0,0 +1,113 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	notificationEventTypePrefix = eventstore.EventType("notification.")
+	SentType                    = notificationEventTypePrefix + "sent"
+	FailedType                  = notificationEventTypePrefix + "failed"
+	ResendRequestedType         = notificationEventTypePrefix + "resend.requested"
+)
+
+// Delivery describes the notification of a user and the event which triggered it.
+// It must not contain personal data like the email address or phone number of the user,
+// as the notification events are not part of the user aggregate and would not be erased on removal.
+type Delivery struct {
+	UserID      string                  `json:"userId,omitempty"`
+	MessageType string                  `json:"messageType,omitempty"`
+	Channel     domain.NotificationType `json:"channel,omitempty"`
+	ProviderID  string                  `json:"providerId,omitempty"`
+
+	TriggeringAggregateType eventstore.AggregateType `json:"triggeringAggregateType,omitempty"`
+	TriggeringAggregateID   string                   `json:"triggeringAggregateId,omitempty"`
+	TriggeringEventType     eventstore.EventType     `json:"triggeringEventType,omitempty"`
+	TriggeringSequence      uint64                   `json:"triggeringSequence,omitempty"`
+}
+
+type SentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Delivery
+}
+
+func (e *SentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SentEvent) Payload() any {
+	return e
+}
+
+func (e *SentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, delivery Delivery) *SentEvent {
+	return &SentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, SentType),
+		Delivery:  delivery,
+	}
+}
+
+type FailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Delivery
+
+	Error string `json:"error,omitempty"`
+}
+
+func (e *FailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *FailedEvent) Payload() any {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, delivery Delivery, deliveryErr string) *FailedEvent {
+	return &FailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, FailedType),
+		Delivery:  delivery,
+		Error:     deliveryErr,
+	}
+}
+
+// ResendRequestedEvent instructs the notification handler to handle the triggering event of a failed notification again.
+type ResendRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TriggeringAggregateType eventstore.AggregateType `json:"triggeringAggregateType,omitempty"`
+	TriggeringAggregateID   string                   `json:"triggeringAggregateId,omitempty"`
+	TriggeringEventType     eventstore.EventType     `json:"triggeringEventType,omitempty"`
+	TriggeringSequence      uint64                   `json:"triggeringSequence,omitempty"`
+}
+
+func (e *ResendRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ResendRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *ResendRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResendRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate, delivery Delivery) *ResendRequestedEvent {
+	return &ResendRequestedEvent{
+		BaseEvent:               *eventstore.NewBaseEventForPush(ctx, aggregate, ResendRequestedType),
+		TriggeringAggregateType: delivery.TriggeringAggregateType,
+		TriggeringAggregateID:   delivery.TriggeringAggregateID,
+		TriggeringEventType:     delivery.TriggeringEventType,
+		TriggeringSequence:      delivery.TriggeringSequence,
+	}
+}
@@ -38,6 +38,7 @@ const (
 	SAMLIDPAddedEventType               eventstore.EventType = "org.idp.saml.added"
 	SAMLIDPChangedEventType             eventstore.EventType = "org.idp.saml.changed"
 	IDPRemovedEventType                 eventstore.EventType = "org.idp.removed"
+	IDPAttributeMappingsSetEventType    eventstore.EventType = "org.idp.attribute.mappings.set"
 )
 
 type OAuthIDPAddedEvent struct {
@@ -853,6 +854,7 @@ func NewLDAPIDPAddedEvent(
 	userFilters []string,
 	timeout time.Duration,
 	attributes idp.LDAPAttributes,
+	syncOptions idp.LDAPSyncOptions,
 	options idp.Options,
 ) *LDAPIDPAddedEvent {
 
@@ -875,6 +877,7 @@ func NewLDAPIDPAddedEvent(
 			userFilters,
 			timeout,
 			attributes,
+			syncOptions,
 			options,
 		),
 	}
@@ -1120,3 +1123,39 @@ func IDPRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 
 	return &IDPRemovedEvent{RemovedEvent: *e.(*idp.RemovedEvent)}, nil
 }
+
+type IDPAttributeMappingsSetEvent struct {
+	idp.AttributeMappingsSetEvent
+}
+
+func NewIDPAttributeMappingsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	attributeMappings []domain.IDPAttributeMapping,
+) *IDPAttributeMappingsSetEvent {
+	return &IDPAttributeMappingsSetEvent{
+		AttributeMappingsSetEvent: *idp.NewAttributeMappingsSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPAttributeMappingsSetEventType,
+			),
+			id,
+			attributeMappings,
+		),
+	}
+}
+
+func (e *IDPAttributeMappingsSetEvent) Payload() interface{} {
+	return e
+}
+
+func IDPAttributeMappingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.AttributeMappingsSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPAttributeMappingsSetEvent{AttributeMappingsSetEvent: *e.(*idp.AttributeMappingsSetEvent)}, nil
+}
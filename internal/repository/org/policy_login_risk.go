@@ -0,0 +1,106 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	LoginRiskPolicyAddedEventType   = orgEventTypePrefix + policy.LoginRiskPolicyAddedEventType
+	LoginRiskPolicyChangedEventType = orgEventTypePrefix + policy.LoginRiskPolicyChangedEventType
+	LoginRiskPolicyRemovedEventType = orgEventTypePrefix + policy.LoginRiskPolicyRemovedEventType
+)
+
+type LoginRiskPolicyAddedEvent struct {
+	policy.LoginRiskPolicyAddedEvent
+}
+
+func NewLoginRiskPolicyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	newDevice,
+	newNetwork,
+	impossibleTravel domain.LoginRiskAction,
+) *LoginRiskPolicyAddedEvent {
+	return &LoginRiskPolicyAddedEvent{
+		LoginRiskPolicyAddedEvent: *policy.NewLoginRiskPolicyAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				LoginRiskPolicyAddedEventType),
+			newDevice,
+			newNetwork,
+			impossibleTravel,
+		),
+	}
+}
+
+func LoginRiskPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.LoginRiskPolicyAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginRiskPolicyAddedEvent{LoginRiskPolicyAddedEvent: *e.(*policy.LoginRiskPolicyAddedEvent)}, nil
+}
+
+type LoginRiskPolicyChangedEvent struct {
+	policy.LoginRiskPolicyChangedEvent
+}
+
+func NewLoginRiskPolicyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.LoginRiskPolicyChanges,
+) (*LoginRiskPolicyChangedEvent, error) {
+	changedEvent, err := policy.NewLoginRiskPolicyChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LoginRiskPolicyChangedEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginRiskPolicyChangedEvent{LoginRiskPolicyChangedEvent: *changedEvent}, nil
+}
+
+func LoginRiskPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.LoginRiskPolicyChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginRiskPolicyChangedEvent{LoginRiskPolicyChangedEvent: *e.(*policy.LoginRiskPolicyChangedEvent)}, nil
+}
+
+type LoginRiskPolicyRemovedEvent struct {
+	policy.LoginRiskPolicyRemovedEvent
+}
+
+func NewLoginRiskPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *LoginRiskPolicyRemovedEvent {
+	return &LoginRiskPolicyRemovedEvent{
+		LoginRiskPolicyRemovedEvent: *policy.NewLoginRiskPolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				LoginRiskPolicyRemovedEventType),
+		),
+	}
+}
+
+func LoginRiskPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.LoginRiskPolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginRiskPolicyRemovedEvent{LoginRiskPolicyRemovedEvent: *e.(*policy.LoginRiskPolicyRemovedEvent)}, nil
+}
@@ -20,7 +20,8 @@ type NotificationPolicyAddedEvent struct {
 func NewNotificationPolicyAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotification bool,
 ) *NotificationPolicyAddedEvent {
 	return &NotificationPolicyAddedEvent{
 		NotificationPolicyAddedEvent: *policy.NewNotificationPolicyAddedEvent(
@@ -29,6 +30,7 @@ func NewNotificationPolicyAddedEvent(
 				aggregate,
 				NotificationPolicyAddedEventType),
 			passwordChange,
+			securityNotification,
 		),
 	}
 }
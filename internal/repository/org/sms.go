@@ -0,0 +1,167 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The SMS configurations of an organization override the active SMS configuration of the instance
+// for the users of the organization. The payloads are the same as the ones of the instance events.
+const (
+	smsConfigPrefix                      = "sms.config."
+	smsConfigTwilioPrefix                = "twilio."
+	SMSConfigTwilioAddedEventType        = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType      = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigTwilioTokenChangedEventType = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigActivatedEventType          = orgEventTypePrefix + smsConfigPrefix + "activated"
+	SMSConfigDeactivatedEventType        = orgEventTypePrefix + smsConfigPrefix + "deactivated"
+	SMSConfigRemovedEventType            = orgEventTypePrefix + smsConfigPrefix + "removed"
+)
+
+type SMSConfigTwilioAddedEvent struct {
+	instance.SMSConfigTwilioAddedEvent
+}
+
+func NewSMSConfigTwilioAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description string,
+	sid,
+	senderNumber string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioAddedEvent {
+	return &SMSConfigTwilioAddedEvent{
+		SMSConfigTwilioAddedEvent: instance.SMSConfigTwilioAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioAddedEventType,
+			),
+			ID:           id,
+			Description:  description,
+			SID:          sid,
+			Token:        token,
+			SenderNumber: senderNumber,
+		},
+	}
+}
+
+type SMSConfigTwilioChangedEvent struct {
+	instance.SMSConfigTwilioChangedEvent
+}
+
+func NewSMSConfigTwilioChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMSConfigTwilioChanges,
+) (*SMSConfigTwilioChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Oow4e", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigTwilioChangedEvent{
+		SMSConfigTwilioChangedEvent: instance.SMSConfigTwilioChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMSConfigTwilioChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigTwilioTokenChangedEvent struct {
+	instance.SMSConfigTwilioTokenChangedEvent
+}
+
+func NewSMSConfigTokenChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioTokenChangedEvent {
+	return &SMSConfigTwilioTokenChangedEvent{
+		SMSConfigTwilioTokenChangedEvent: instance.SMSConfigTwilioTokenChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioTokenChangedEventType,
+			),
+			ID:    id,
+			Token: token,
+		},
+	}
+}
+
+type SMSConfigActivatedEvent struct {
+	instance.SMSConfigActivatedEvent
+}
+
+func NewSMSConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigActivatedEvent {
+	return &SMSConfigActivatedEvent{
+		SMSConfigActivatedEvent: instance.SMSConfigActivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMSConfigDeactivatedEvent struct {
+	instance.SMSConfigDeactivatedEvent
+}
+
+func NewSMSConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigDeactivatedEvent {
+	return &SMSConfigDeactivatedEvent{
+		SMSConfigDeactivatedEvent: instance.SMSConfigDeactivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMSConfigRemovedEvent struct {
+	instance.SMSConfigRemovedEvent
+}
+
+func NewSMSConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigRemovedEvent {
+	return &SMSConfigRemovedEvent{
+		SMSConfigRemovedEvent: instance.SMSConfigRemovedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
@@ -0,0 +1,173 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The SMTP configurations of an organization override the active SMTP configuration of the instance
+// for the users of the organization. The payloads are the same as the ones of the instance events.
+const (
+	smtpConfigPrefix                   = "smtp.config."
+	SMTPConfigAddedEventType           = orgEventTypePrefix + smtpConfigPrefix + "added"
+	SMTPConfigChangedEventType         = orgEventTypePrefix + smtpConfigPrefix + "changed"
+	SMTPConfigPasswordChangedEventType = orgEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigActivatedEventType       = orgEventTypePrefix + smtpConfigPrefix + "activated"
+	SMTPConfigDeactivatedEventType     = orgEventTypePrefix + smtpConfigPrefix + "deactivated"
+	SMTPConfigRemovedEventType         = orgEventTypePrefix + smtpConfigPrefix + "removed"
+)
+
+type SMTPConfigAddedEvent struct {
+	instance.SMTPConfigAddedEvent
+}
+
+func NewSMTPConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	tls bool,
+	senderAddress,
+	senderName,
+	replyToAddress,
+	host,
+	user string,
+	password *crypto.CryptoValue,
+) *SMTPConfigAddedEvent {
+	return &SMTPConfigAddedEvent{
+		SMTPConfigAddedEvent: instance.SMTPConfigAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigAddedEventType,
+			),
+			ID:             id,
+			Description:    description,
+			TLS:            tls,
+			SenderAddress:  senderAddress,
+			SenderName:     senderName,
+			ReplyToAddress: replyToAddress,
+			Host:           host,
+			User:           user,
+			Password:       password,
+		},
+	}
+}
+
+type SMTPConfigChangedEvent struct {
+	instance.SMTPConfigChangedEvent
+}
+
+func NewSMTPConfigChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMTPConfigChanges,
+) (*SMTPConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Aeph3", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigChangedEvent{
+		SMTPConfigChangedEvent: instance.SMTPConfigChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigChangedEventType,
+			),
+			ID: id,
+		},
+	}
+	for _, change := range changes {
+		change(&changeEvent.SMTPConfigChangedEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMTPConfigPasswordChangedEvent struct {
+	instance.SMTPConfigPasswordChangedEvent
+}
+
+func NewSMTPConfigPasswordChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password *crypto.CryptoValue,
+) *SMTPConfigPasswordChangedEvent {
+	return &SMTPConfigPasswordChangedEvent{
+		SMTPConfigPasswordChangedEvent: instance.SMTPConfigPasswordChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigPasswordChangedEventType,
+			),
+			ID:       id,
+			Password: password,
+		},
+	}
+}
+
+type SMTPConfigActivatedEvent struct {
+	instance.SMTPConfigActivatedEvent
+}
+
+func NewSMTPConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigActivatedEvent {
+	return &SMTPConfigActivatedEvent{
+		SMTPConfigActivatedEvent: instance.SMTPConfigActivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMTPConfigDeactivatedEvent struct {
+	instance.SMTPConfigDeactivatedEvent
+}
+
+func NewSMTPConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDeactivatedEvent {
+	return &SMTPConfigDeactivatedEvent{
+		SMTPConfigDeactivatedEvent: instance.SMTPConfigDeactivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMTPConfigRemovedEvent struct {
+	instance.SMTPConfigRemovedEvent
+}
+
+func NewSMTPConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigRemovedEvent {
+	return &SMTPConfigRemovedEvent{
+		SMTPConfigRemovedEvent: instance.SMTPConfigRemovedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package policy
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	messageTemplatePrefix           = mailTemplatePolicyPrefix + "message."
+	MessageTemplateSetEventType     = messageTemplatePrefix + "set"
+	MessageTemplateRemovedEventType = messageTemplatePrefix + "removed"
+)
+
+type MessageTemplateSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string       `json:"messageType,omitempty"`
+	Language    language.Tag `json:"language,omitempty"`
+	HTML        string       `json:"html,omitempty"`
+	PlainText   string       `json:"plainText,omitempty"`
+}
+
+func (e *MessageTemplateSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateSetEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	lang language.Tag,
+	html,
+	plainText string,
+) *MessageTemplateSetEvent {
+	return &MessageTemplateSetEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    lang,
+		HTML:        html,
+		PlainText:   plainText,
+	}
+}
+
+func MessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Quoh7", "unable to unmarshal message template")
+	}
+
+	return e, nil
+}
+
+type MessageTemplateRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string       `json:"messageType,omitempty"`
+	Language    language.Tag `json:"language,omitempty"`
+}
+
+func (e *MessageTemplateRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateRemovedEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	lang language.Tag,
+) *MessageTemplateRemovedEvent {
+	return &MessageTemplateRemovedEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    lang,
+	}
+}
+
+func MessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-ieD1u", "unable to unmarshal message template")
+	}
+
+	return e, nil
+}
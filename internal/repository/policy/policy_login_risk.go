@@ -0,0 +1,145 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	LoginRiskPolicyAddedEventType   = "policy.login.risk.added"
+	LoginRiskPolicyChangedEventType = "policy.login.risk.changed"
+	LoginRiskPolicyRemovedEventType = "policy.login.risk.removed"
+)
+
+type LoginRiskPolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NewDevice        domain.LoginRiskAction `json:"newDevice,omitempty"`
+	NewNetwork       domain.LoginRiskAction `json:"newNetwork,omitempty"`
+	ImpossibleTravel domain.LoginRiskAction `json:"impossibleTravel,omitempty"`
+}
+
+func (e *LoginRiskPolicyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LoginRiskPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewLoginRiskPolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	newDevice,
+	newNetwork,
+	impossibleTravel domain.LoginRiskAction,
+) *LoginRiskPolicyAddedEvent {
+	return &LoginRiskPolicyAddedEvent{
+		BaseEvent:        *base,
+		NewDevice:        newDevice,
+		NewNetwork:       newNetwork,
+		ImpossibleTravel: impossibleTravel,
+	}
+}
+
+func LoginRiskPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &LoginRiskPolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Ahr4i", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type LoginRiskPolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	NewDevice        *domain.LoginRiskAction `json:"newDevice,omitempty"`
+	NewNetwork       *domain.LoginRiskAction `json:"newNetwork,omitempty"`
+	ImpossibleTravel *domain.LoginRiskAction `json:"impossibleTravel,omitempty"`
+}
+
+func (e *LoginRiskPolicyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LoginRiskPolicyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewLoginRiskPolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []LoginRiskPolicyChanges,
+) (*LoginRiskPolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Ohb3e", "Errors.NoChangesFound")
+	}
+	changeEvent := &LoginRiskPolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type LoginRiskPolicyChanges func(*LoginRiskPolicyChangedEvent)
+
+func ChangeLoginRiskNewDevice(action domain.LoginRiskAction) func(*LoginRiskPolicyChangedEvent) {
+	return func(e *LoginRiskPolicyChangedEvent) {
+		e.NewDevice = &action
+	}
+}
+
+func ChangeLoginRiskNewNetwork(action domain.LoginRiskAction) func(*LoginRiskPolicyChangedEvent) {
+	return func(e *LoginRiskPolicyChangedEvent) {
+		e.NewNetwork = &action
+	}
+}
+
+func ChangeLoginRiskImpossibleTravel(action domain.LoginRiskAction) func(*LoginRiskPolicyChangedEvent) {
+	return func(e *LoginRiskPolicyChangedEvent) {
+		e.ImpossibleTravel = &action
+	}
+}
+
+func LoginRiskPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &LoginRiskPolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-aeQu4", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type LoginRiskPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *LoginRiskPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *LoginRiskPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewLoginRiskPolicyRemovedEvent(base *eventstore.BaseEvent) *LoginRiskPolicyRemovedEvent {
+	return &LoginRiskPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func LoginRiskPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &LoginRiskPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -14,7 +14,8 @@ const (
 type NotificationPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	PasswordChange bool `json:"passwordChange,omitempty"`
+	PasswordChange       bool `json:"passwordChange,omitempty"`
+	SecurityNotification bool `json:"securityNotification,omitempty"`
 }
 
 func (e *NotificationPolicyAddedEvent) Payload() interface{} {
@@ -27,11 +28,13 @@ func (e *NotificationPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueC
 
 func NewNotificationPolicyAddedEvent(
 	base *eventstore.BaseEvent,
-	passwordChange bool,
+	passwordChange,
+	securityNotification bool,
 ) *NotificationPolicyAddedEvent {
 	return &NotificationPolicyAddedEvent{
-		BaseEvent:      *base,
-		PasswordChange: passwordChange,
+		BaseEvent:            *base,
+		PasswordChange:       passwordChange,
+		SecurityNotification: securityNotification,
 	}
 }
 
@@ -51,7 +54,8 @@ func NotificationPolicyAddedEventMapper(event eventstore.Event) (eventstore.Even
 type NotificationPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	PasswordChange *bool `json:"passwordChange,omitempty"`
+	PasswordChange       *bool `json:"passwordChange,omitempty"`
+	SecurityNotification *bool `json:"securityNotification,omitempty"`
 }
 
 func (e *NotificationPolicyChangedEvent) Payload() interface{} {
@@ -86,6 +90,12 @@ func ChangePasswordChange(passwordChange bool) func(*NotificationPolicyChangedEv
 	}
 }
 
+func ChangeSecurityNotification(securityNotification bool) func(*NotificationPolicyChangedEvent) {
+	return func(e *NotificationPolicyChangedEvent) {
+		e.SecurityNotification = &securityNotification
+	}
+}
+
 func NotificationPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &NotificationPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -14,6 +14,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedType, MemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberCascadeRemovedType, MemberCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberAddedEventType, eventstore.GenericEventMapper[GroupMemberAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberChangedEventType, eventstore.GenericEventMapper[GroupMemberChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberRemovedEventType, eventstore.GenericEventMapper[GroupMemberRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleAddedType, RoleAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleChangedType, RoleChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleRemovedType, RoleRemovedEventMapper)
@@ -44,4 +47,6 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+
+	eventstore.RegisterArchivable(AggregateType, ProjectRemovedType)
 }
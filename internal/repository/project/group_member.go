@@ -0,0 +1,81 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/member"
+)
+
+var (
+	GroupMemberAddedEventType   = projectEventTypePrefix + member.GroupAddedEventType
+	GroupMemberChangedEventType = projectEventTypePrefix + member.GroupChangedEventType
+	GroupMemberRemovedEventType = projectEventTypePrefix + member.GroupRemovedEventType
+)
+
+type GroupMemberAddedEvent struct {
+	member.GroupMemberAddedEvent
+}
+
+func NewGroupMemberAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+	roles ...string,
+) *GroupMemberAddedEvent {
+	return &GroupMemberAddedEvent{
+		GroupMemberAddedEvent: *member.NewGroupMemberAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberAddedEventType,
+			),
+			groupID,
+			roles...,
+		),
+	}
+}
+
+type GroupMemberChangedEvent struct {
+	member.GroupMemberChangedEvent
+}
+
+func NewGroupMemberChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+	roles ...string,
+) *GroupMemberChangedEvent {
+	return &GroupMemberChangedEvent{
+		GroupMemberChangedEvent: *member.NewGroupMemberChangedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberChangedEventType,
+			),
+			groupID,
+			roles...,
+		),
+	}
+}
+
+type GroupMemberRemovedEvent struct {
+	member.GroupMemberRemovedEvent
+}
+
+func NewGroupMemberRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+) *GroupMemberRemovedEvent {
+	return &GroupMemberRemovedEvent{
+		GroupMemberRemovedEvent: *member.NewGroupMemberRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberRemovedEventType,
+			),
+			groupID,
+		),
+	}
+}
@@ -16,8 +16,18 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailChallengedType, eventstore.GenericEventMapper[OTPEmailChallengedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailSentType, eventstore.GenericEventMapper[OTPEmailSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailCheckedType, eventstore.GenericEventMapper[OTPEmailCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushChallengedType, eventstore.GenericEventMapper[PushChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushSentType, eventstore.GenericEventMapper[PushSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushCheckedType, eventstore.GenericEventMapper[PushCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkChallengedType, eventstore.GenericEventMapper[MagicLinkChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkSentType, eventstore.GenericEventMapper[MagicLinkSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkCheckedType, eventstore.GenericEventMapper[MagicLinkCheckedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TokenSetType, TokenSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MetadataSetType, MetadataSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LifetimeSetType, eventstore.GenericEventMapper[LifetimeSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskEvaluatedType, eventstore.GenericEventMapper[RiskEvaluatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskNotifiedType, eventstore.GenericEventMapper[RiskNotifiedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TerminateType, TerminateEventMapper)
+
+	eventstore.RegisterArchivable(AggregateType, TerminateType)
 }
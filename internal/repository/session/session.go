@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"golang.org/x/text/language"
@@ -15,24 +16,32 @@ import (
 )
 
 const (
-	sessionEventPrefix     = "session."
-	AddedType              = sessionEventPrefix + "added"
-	UserCheckedType        = sessionEventPrefix + "user.checked"
-	PasswordCheckedType    = sessionEventPrefix + "password.checked"
-	IntentCheckedType      = sessionEventPrefix + "intent.checked"
-	WebAuthNChallengedType = sessionEventPrefix + "webAuthN.challenged"
-	WebAuthNCheckedType    = sessionEventPrefix + "webAuthN.checked"
-	TOTPCheckedType        = sessionEventPrefix + "totp.checked"
-	OTPSMSChallengedType   = sessionEventPrefix + "otp.sms.challenged"
-	OTPSMSSentType         = sessionEventPrefix + "otp.sms.sent"
-	OTPSMSCheckedType      = sessionEventPrefix + "otp.sms.checked"
-	OTPEmailChallengedType = sessionEventPrefix + "otp.email.challenged"
-	OTPEmailSentType       = sessionEventPrefix + "otp.email.sent"
-	OTPEmailCheckedType    = sessionEventPrefix + "otp.email.checked"
-	TokenSetType           = sessionEventPrefix + "token.set"
-	MetadataSetType        = sessionEventPrefix + "metadata.set"
-	LifetimeSetType        = sessionEventPrefix + "lifetime.set"
-	TerminateType          = sessionEventPrefix + "terminated"
+	sessionEventPrefix      = "session."
+	AddedType               = sessionEventPrefix + "added"
+	UserCheckedType         = sessionEventPrefix + "user.checked"
+	PasswordCheckedType     = sessionEventPrefix + "password.checked"
+	IntentCheckedType       = sessionEventPrefix + "intent.checked"
+	WebAuthNChallengedType  = sessionEventPrefix + "webAuthN.challenged"
+	WebAuthNCheckedType     = sessionEventPrefix + "webAuthN.checked"
+	TOTPCheckedType         = sessionEventPrefix + "totp.checked"
+	OTPSMSChallengedType    = sessionEventPrefix + "otp.sms.challenged"
+	OTPSMSSentType          = sessionEventPrefix + "otp.sms.sent"
+	OTPSMSCheckedType       = sessionEventPrefix + "otp.sms.checked"
+	OTPEmailChallengedType  = sessionEventPrefix + "otp.email.challenged"
+	OTPEmailSentType        = sessionEventPrefix + "otp.email.sent"
+	OTPEmailCheckedType     = sessionEventPrefix + "otp.email.checked"
+	PushChallengedType      = sessionEventPrefix + "push.challenged"
+	PushSentType            = sessionEventPrefix + "push.sent"
+	PushCheckedType         = sessionEventPrefix + "push.checked"
+	MagicLinkChallengedType = sessionEventPrefix + "magic.link.challenged"
+	MagicLinkSentType       = sessionEventPrefix + "magic.link.sent"
+	MagicLinkCheckedType    = sessionEventPrefix + "magic.link.checked"
+	TokenSetType            = sessionEventPrefix + "token.set"
+	MetadataSetType         = sessionEventPrefix + "metadata.set"
+	LifetimeSetType         = sessionEventPrefix + "lifetime.set"
+	RiskEvaluatedType       = sessionEventPrefix + "risk.evaluated"
+	RiskNotifiedType        = sessionEventPrefix + "risk.notified"
+	TerminateType           = sessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -542,6 +551,232 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+// PushDevice is an enrolled device of the user the push challenge is sent to
+type PushDevice struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+type PushChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge         string        `json:"challenge"`
+	Expiry            time.Duration `json:"expiry"`
+	Devices           []PushDevice  `json:"devices"`
+	TriggeredAtOrigin string        `json:"triggerOrigin,omitempty"`
+}
+
+func (e *PushChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *PushChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewPushChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge string,
+	expiry time.Duration,
+	devices []PushDevice,
+) *PushChallengedEvent {
+	return &PushChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushChallengedType,
+		),
+		Challenge:         challenge,
+		Expiry:            expiry,
+		Devices:           devices,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type PushSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushSentEvent {
+	return &PushSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushSentType,
+		),
+	}
+}
+
+type PushCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID  string    `json:"deviceID"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *PushCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	checkedAt time.Time,
+) *PushCheckedEvent {
+	return &PushCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushCheckedType,
+		),
+		DeviceID:  deviceID,
+		CheckedAt: checkedAt,
+	}
+}
+
+type MagicLinkChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue `json:"code"`
+	Expiry            time.Duration       `json:"expiry"`
+	ReturnCode        bool                `json:"returnCode,omitempty"`
+	URLTmpl           string              `json:"urlTmpl,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *MagicLinkChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *MagicLinkChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewMagicLinkChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	returnCode bool,
+	urlTmpl string,
+) *MagicLinkChallengedEvent {
+	return &MagicLinkChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkChallengedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		ReturnCode:        returnCode,
+		URLTmpl:           urlTmpl,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type MagicLinkSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *MagicLinkSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *MagicLinkSentEvent {
+	return &MagicLinkSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkSentType,
+		),
+	}
+}
+
+type MagicLinkCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *MagicLinkCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *MagicLinkCheckedEvent {
+	return &MagicLinkCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -657,6 +892,89 @@ func NewLifetimeSetEvent(
 	}
 }
 
+// RiskEvaluatedEvent records the result of the login risk evaluation of the session.
+// The user agent information is stored as well, since the events are used as login history
+// for the evaluation of subsequent logins of the user.
+type RiskEvaluatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string                   `json:"userID"`
+	UserResourceOwner string                   `json:"userResourceOwner"`
+	FingerprintID     string                   `json:"fingerprintID,omitempty"`
+	IP                net.IP                   `json:"ip,omitempty"`
+	Country           string                   `json:"country,omitempty"`
+	Signals           []domain.LoginRiskSignal `json:"signals,omitempty"`
+	Action            domain.LoginRiskAction   `json:"action,omitempty"`
+}
+
+func (e *RiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskEvaluatedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	userResourceOwner,
+	fingerprintID string,
+	ip net.IP,
+	country string,
+	signals []domain.LoginRiskSignal,
+	action domain.LoginRiskAction,
+) *RiskEvaluatedEvent {
+	return &RiskEvaluatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskEvaluatedType,
+		),
+		UserID:            userID,
+		UserResourceOwner: userResourceOwner,
+		FingerprintID:     fingerprintID,
+		IP:                ip,
+		Country:           country,
+		Signals:           signals,
+		Action:            action,
+	}
+}
+
+type RiskNotifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RiskNotifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskNotifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskNotifiedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskNotifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RiskNotifiedEvent {
+	return &RiskNotifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskNotifiedType,
+		),
+	}
+}
+
 type TerminateEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -62,6 +62,15 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCodeAddedType, HumanPasswordCodeAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCodeSentType, eventstore.GenericEventMapper[HumanPasswordCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordChangeSentType, HumanPasswordChangeSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanSecurityNotificationSentType, eventstore.GenericEventMapper[HumanSecurityNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanCustomNotificationRequestedType, eventstore.GenericEventMapper[HumanCustomNotificationRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanCustomNotificationSentType, eventstore.GenericEventMapper[HumanCustomNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanNotificationPreferencesSetType, eventstore.GenericEventMapper[HumanNotificationPreferencesSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeAddedType, eventstore.GenericEventMapper[HumanMagicLinkCodeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeSentType, eventstore.GenericEventMapper[HumanMagicLinkCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkRequestedType, eventstore.GenericEventMapper[HumanMagicLinkRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckSucceededType, eventstore.GenericEventMapper[HumanMagicLinkCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckFailedType, eventstore.GenericEventMapper[HumanMagicLinkCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordHashUpdatedType, eventstore.GenericEventMapper[HumanPasswordHashUpdatedEvent])
@@ -104,6 +113,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCodeSentType, eventstore.GenericEventMapper[HumanOTPEmailCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCheckSucceededType, eventstore.GenericEventMapper[HumanOTPEmailCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCheckFailedType, eventstore.GenericEventMapper[HumanOTPEmailCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceAddedType, eventstore.GenericEventMapper[HumanPushDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceRemovedType, eventstore.GenericEventMapper[HumanPushDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushCheckSucceededType, eventstore.GenericEventMapper[HumanPushCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushCheckFailedType, eventstore.GenericEventMapper[HumanPushCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenAddedType, HumanU2FAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenVerifiedType, HumanU2FVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenSignCountChangedType, HumanU2FSignCountChangedEventMapper)
@@ -141,4 +154,32 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCodeSentType, eventstore.GenericEventMapper[HumanInviteCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckSucceededType, eventstore.GenericEventMapper[HumanInviteCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckFailedType, eventstore.GenericEventMapper[HumanInviteCheckFailedEvent])
+
+	eventstore.RegisterPersonalData(HumanAddedType, humanPersonalDataFields...)
+	eventstore.RegisterPersonalData(HumanRegisteredType, humanPersonalDataFields...)
+	eventstore.RegisterPersonalData(UserUserNameChangedType, "userName")
+	eventstore.RegisterPersonalData(UserDomainClaimedType, "userName")
+	eventstore.RegisterPersonalData(HumanProfileChangedType, "firstName", "lastName", "nickName", "displayName")
+	eventstore.RegisterPersonalData(HumanEmailChangedType, "email")
+	eventstore.RegisterPersonalData(HumanPhoneChangedType, "phone")
+	eventstore.RegisterPersonalData(HumanAddressChangedType, "country", "locality", "postalCode", "region", "streetAddress")
+	eventstore.RegisterPersonalDataErasure(UserRemovedType)
+
+	eventstore.RegisterArchivable(AggregateType, UserRemovedType)
+}
+
+// humanPersonalDataFields are the fields of the human added and registered events containing personal data.
+var humanPersonalDataFields = []string{
+	"userName",
+	"firstName",
+	"lastName",
+	"nickName",
+	"displayName",
+	"email",
+	"phone",
+	"country",
+	"locality",
+	"postalCode",
+	"region",
+	"streetAddress",
 }
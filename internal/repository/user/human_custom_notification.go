@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	customNotificationPrefix             = humanEventPrefix + "custom.notification."
+	HumanCustomNotificationRequestedType = customNotificationPrefix + "requested"
+	HumanCustomNotificationSentType      = customNotificationPrefix + "sent"
+)
+
+// HumanCustomNotificationRequestedEvent requests a notification of a custom notification type to be sent to the user.
+// The arguments can be used in the texts of the type.
+type HumanCustomNotificationRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string                  `json:"messageType"`
+	Channel     domain.NotificationType `json:"channel"`
+	Args        map[string]string       `json:"args,omitempty"`
+	URL         string                  `json:"url,omitempty"`
+}
+
+func (e *HumanCustomNotificationRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanCustomNotificationRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanCustomNotificationRequestedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanCustomNotificationRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	channel domain.NotificationType,
+	args map[string]string,
+	url string,
+) *HumanCustomNotificationRequestedEvent {
+	return &HumanCustomNotificationRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanCustomNotificationRequestedType,
+		),
+		MessageType: messageType,
+		Channel:     channel,
+		Args:        args,
+		URL:         url,
+	}
+}
+
+// HumanCustomNotificationSentEvent stores that the requested custom notification was sent to the user.
+// The sequence of the requested event allows to send multiple notifications of the same type.
+type HumanCustomNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType     string `json:"messageType"`
+	TriggerSequence uint64 `json:"triggerSequence"`
+}
+
+func (e *HumanCustomNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanCustomNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanCustomNotificationSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanCustomNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	triggerSequence uint64,
+) *HumanCustomNotificationSentEvent {
+	return &HumanCustomNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanCustomNotificationSentType,
+		),
+		MessageType:     messageType,
+		TriggerSequence: triggerSequence,
+	}
+}
@@ -0,0 +1,164 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	pushEventPrefix             = mfaEventPrefix + "push."
+	HumanPushDeviceAddedType    = pushEventPrefix + "device.added"
+	HumanPushDeviceRemovedType  = pushEventPrefix + "device.removed"
+	HumanPushCheckSucceededType = pushEventPrefix + "check.succeeded"
+	HumanPushCheckFailedType    = pushEventPrefix + "check.failed"
+)
+
+type HumanPushDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID    string `json:"deviceID"`
+	Name        string `json:"name,omitempty"`
+	DeviceToken string `json:"deviceToken"`
+	// PublicKey is the PKIX, ASN.1 DER encoded public key of the device,
+	// which is used to verify the signed challenges
+	PublicKey []byte `json:"publicKey"`
+}
+
+func (e *HumanPushDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	name,
+	deviceToken string,
+	publicKey []byte,
+) *HumanPushDeviceAddedEvent {
+	return &HumanPushDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceAddedType,
+		),
+		DeviceID:    deviceID,
+		Name:        name,
+		DeviceToken: deviceToken,
+		PublicKey:   publicKey,
+	}
+}
+
+type HumanPushDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceID"`
+}
+
+func (e *HumanPushDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceRemovedEvent {
+	return &HumanPushDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type HumanPushCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+
+	DeviceID string `json:"deviceID"`
+}
+
+func (e *HumanPushCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	info *AuthRequestInfo,
+) *HumanPushCheckSucceededEvent {
+	return &HumanPushCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushCheckSucceededType,
+		),
+		AuthRequestInfo: info,
+		DeviceID:        deviceID,
+	}
+}
+
+type HumanPushCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+
+	DeviceID string `json:"deviceID"`
+}
+
+func (e *HumanPushCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	info *AuthRequestInfo,
+) *HumanPushCheckFailedEvent {
+	return &HumanPushCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushCheckFailedType,
+		),
+		AuthRequestInfo: info,
+		DeviceID:        deviceID,
+	}
+}
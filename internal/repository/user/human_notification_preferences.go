@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	HumanNotificationPreferencesSetType = humanEventPrefix + "notification.preferences.set"
+)
+
+// HumanNotificationPreferencesSetEvent replaces all notification preferences of the user.
+type HumanNotificationPreferencesSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CodeChannels       []domain.NotificationType `json:"codeChannels,omitempty"`
+	OptOutMessageTypes []string                  `json:"optOutMessageTypes,omitempty"`
+	SMSQuietHours      *domain.QuietHours        `json:"smsQuietHours,omitempty"`
+}
+
+func (e *HumanNotificationPreferencesSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanNotificationPreferencesSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanNotificationPreferencesSetEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanNotificationPreferencesSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	preferences *domain.NotificationPreferences,
+) *HumanNotificationPreferencesSetEvent {
+	return &HumanNotificationPreferencesSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanNotificationPreferencesSetType,
+		),
+		CodeChannels:       preferences.CodeChannels,
+		OptOutMessageTypes: preferences.OptOutMessageTypes,
+		SMSQuietHours:      preferences.SMSQuietHours,
+	}
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	HumanSecurityNotificationSentType = humanEventPrefix + "security.notification.sent"
+)
+
+// HumanSecurityNotificationSentEvent stores that the user was informed about a security relevant change of the account.
+// The sequence of the event which triggered the notification allows to send multiple notifications of the same type.
+type HumanSecurityNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType     string `json:"messageType"`
+	TriggerSequence uint64 `json:"triggerSequence"`
+}
+
+func (e *HumanSecurityNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanSecurityNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanSecurityNotificationSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanSecurityNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	triggerSequence uint64,
+) *HumanSecurityNotificationSentEvent {
+	return &HumanSecurityNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSecurityNotificationSentType,
+		),
+		MessageType:     messageType,
+		TriggerSequence: triggerSequence,
+	}
+}
@@ -0,0 +1,121 @@
+package risk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Location is the geographical location of an IP address
+type Location struct {
+	Country   string
+	Latitude  float64
+	Longitude float64
+}
+
+type ipRange struct {
+	start    net.IP
+	end      net.IP
+	location *Location
+}
+
+// GeoIP resolves IP addresses to their [Location] using a locally loaded database.
+type GeoIP struct {
+	ranges []*ipRange
+}
+
+// LoadGeoIP reads an ip range database in CSV format from the provided path.
+// The following layouts are supported:
+//   - 5 columns: ip_start, ip_end, country, latitude, longitude
+//   - 8 columns: ip_start, ip_end, continent, country, region, city, latitude, longitude (e.g. DB-IP IP to City Lite)
+//
+// Lines starting with `#` are ignored.
+func LoadGeoIP(path string) (*GeoIP, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadGeoIP(f)
+}
+
+func ReadGeoIP(r io.Reader) (*GeoIP, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	ranges := make([]*ipRange, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry, err := parseRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("geoip line %d: %w", line, err)
+		}
+		ranges = append(ranges, entry)
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+	return &GeoIP{ranges: ranges}, nil
+}
+
+func parseRecord(record []string) (_ *ipRange, err error) {
+	var country, latitude, longitude string
+	switch len(record) {
+	case 5:
+		country, latitude, longitude = record[2], record[3], record[4]
+	case 8:
+		country, latitude, longitude = record[3], record[6], record[7]
+	default:
+		return nil, fmt.Errorf("unsupported number of columns: %d", len(record))
+	}
+	entry := &ipRange{
+		start:    net.ParseIP(strings.TrimSpace(record[0])).To16(),
+		end:      net.ParseIP(strings.TrimSpace(record[1])).To16(),
+		location: &Location{Country: strings.TrimSpace(country)},
+	}
+	if entry.start == nil || entry.end == nil {
+		return nil, errors.New("invalid ip range")
+	}
+	if entry.location.Latitude, err = strconv.ParseFloat(strings.TrimSpace(latitude), 64); err != nil {
+		return nil, err
+	}
+	if entry.location.Longitude, err = strconv.ParseFloat(strings.TrimSpace(longitude), 64); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Lookup returns the location of the ip or nil if it's not part of any range.
+func (g *GeoIP) Lookup(ip net.IP) *Location {
+	if g == nil || len(ip) == 0 {
+		return nil
+	}
+	ip = ip.To16()
+	// find the first range starting after the ip, the previous one is the candidate
+	i := sort.Search(len(g.ranges), func(i int) bool {
+		return bytes.Compare(g.ranges[i].start, ip) > 0
+	})
+	if i == 0 {
+		return nil
+	}
+	candidate := g.ranges[i-1]
+	if bytes.Compare(ip, candidate.end) > 0 {
+		return nil
+	}
+	return candidate.location
+}
@@ -0,0 +1,111 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGeoIP = `# ip_start,ip_end,country,latitude,longitude
+10.0.0.0,10.0.255.255,CH,47.3769,8.5417
+10.1.0.0,10.1.255.255,US,40.7128,-74.0060
+2001:db8::,2001:db8:ffff:ffff:ffff:ffff:ffff:ffff,DE,52.5200,13.4050
+192.168.0.0,192.168.255.255,EU,AT,Vienna,Vienna,48.2082,16.3738
+`
+
+func TestReadGeoIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: testGeoIP,
+		},
+		{
+			name:    "invalid ip",
+			data:    "10.0.0,10.0.255.255,CH,47.3769,8.5417",
+			wantErr: true,
+		},
+		{
+			name:    "invalid latitude",
+			data:    "10.0.0.0,10.0.255.255,CH,north,8.5417",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported columns",
+			data:    "10.0.0.0,10.0.255.255,CH",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadGeoIP(strings.NewReader(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGeoIP_Lookup(t *testing.T) {
+	geoIP, err := ReadGeoIP(strings.NewReader(testGeoIP))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ip   net.IP
+		want string
+	}{
+		{
+			name: "no ip",
+			ip:   nil,
+			want: "",
+		},
+		{
+			name: "ipv4 start of range",
+			ip:   net.ParseIP("10.0.0.0"),
+			want: "CH",
+		},
+		{
+			name: "ipv4 end of range",
+			ip:   net.ParseIP("10.1.255.255"),
+			want: "US",
+		},
+		{
+			name: "ipv4 between ranges",
+			ip:   net.ParseIP("10.2.0.1"),
+			want: "",
+		},
+		{
+			name: "ipv4 before ranges",
+			ip:   net.ParseIP("1.1.1.1"),
+			want: "",
+		},
+		{
+			name: "ipv6",
+			ip:   net.ParseIP("2001:db8::1"),
+			want: "DE",
+		},
+		{
+			name: "city layout",
+			ip:   net.ParseIP("192.168.1.1"),
+			want: "AT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var country string
+			if location := geoIP.Lookup(tt.ip); location != nil {
+				country = location.Country
+			}
+			assert.Equal(t, tt.want, country)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package risk
+
+import (
+	"math"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	earthRadiusKM = 6371
+	// minTravelDistanceKM prevents impossible travel signals caused by the inaccuracy of GeoIP databases
+	minTravelDistanceKM = 100
+)
+
+type Config struct {
+	// GeoIPDatabase is the path to a local ip range database in CSV format, see [LoadGeoIP].
+	// If empty, no impossible travel detection is done.
+	GeoIPDatabase string
+	// MaxTravelSpeed in km/h, faster travels between two logins are considered impossible
+	MaxTravelSpeed float64
+	// HistoryLimit is the number of previous logins of the user, the login is compared with
+	HistoryLimit uint64
+}
+
+// Login is the user agent information of a (successful) authentication of a user
+type Login struct {
+	FingerprintID string
+	IP            net.IP
+	Time          time.Time
+}
+
+type Result struct {
+	Signals []domain.LoginRiskSignal
+	Country string
+}
+
+// Evaluator detects login risk signals by comparing a login with the previous logins of the user.
+type Evaluator struct {
+	geoIP          *GeoIP
+	maxTravelSpeed float64
+	historyLimit   uint64
+}
+
+func NewEvaluator(config Config) (_ *Evaluator, err error) {
+	evaluator := &Evaluator{
+		maxTravelSpeed: config.MaxTravelSpeed,
+		historyLimit:   config.HistoryLimit,
+	}
+	if config.GeoIPDatabase != "" {
+		evaluator.geoIP, err = LoadGeoIP(config.GeoIPDatabase)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evaluator, nil
+}
+
+func (e *Evaluator) HistoryLimit() uint64 {
+	return e.historyLimit
+}
+
+// Evaluate the login against the history, which is expected to be ordered by time (newest first).
+// Without any history no signals are returned, as the first login of a user is the baseline.
+func (e *Evaluator) Evaluate(login *Login, history []*Login) *Result {
+	result := new(Result)
+	location := e.geoIP.Lookup(login.IP)
+	if location != nil {
+		result.Country = location.Country
+	}
+	if len(history) == 0 {
+		return result
+	}
+	if login.FingerprintID != "" && !slices.ContainsFunc(history, func(previous *Login) bool {
+		return previous.FingerprintID == login.FingerprintID
+	}) {
+		result.Signals = append(result.Signals, domain.LoginRiskSignalNewDevice)
+	}
+	if network := ipNetwork(login.IP); network != nil && !slices.ContainsFunc(history, func(previous *Login) bool {
+		return network.Contains(previous.IP)
+	}) {
+		result.Signals = append(result.Signals, domain.LoginRiskSignalNewNetwork)
+	}
+	if location != nil && e.impossibleTravel(login, location, history) {
+		result.Signals = append(result.Signals, domain.LoginRiskSignalImpossibleTravel)
+	}
+	return result
+}
+
+// impossibleTravel compares the location with the one of the latest located login
+func (e *Evaluator) impossibleTravel(login *Login, location *Location, history []*Login) bool {
+	if e.maxTravelSpeed <= 0 {
+		return false
+	}
+	for _, previous := range history {
+		previousLocation := e.geoIP.Lookup(previous.IP)
+		if previousLocation == nil {
+			continue
+		}
+		distance := distanceKM(location, previousLocation)
+		if distance < minTravelDistanceKM {
+			return false
+		}
+		elapsed := login.Time.Sub(previous.Time).Hours()
+		return elapsed <= 0 || distance/elapsed > e.maxTravelSpeed
+	}
+	return false
+}
+
+// ipNetwork returns the /24 (IPv4) or /48 (IPv6) network of the ip
+func ipNetwork(ip net.IP) *net.IPNet {
+	if len(ip) == 0 {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}
+	}
+	return &net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}
+}
+
+// distanceKM computes the great-circle distance using the haversine formula
+func distanceKM(a, b *Location) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	deltaLat := lat2 - lat1
+	deltaLon := radians(b.Longitude - a.Longitude)
+	h := math.Pow(math.Sin(deltaLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(deltaLon/2), 2)
+	return 2 * earthRadiusKM * math.Asin(math.Sqrt(h))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,119 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	geoIP, err := ReadGeoIP(strings.NewReader(testGeoIP))
+	require.NoError(t, err)
+	now := time.Now()
+
+	type args struct {
+		login   *Login
+		history []*Login
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Result
+	}{
+		{
+			name: "no history, no signals",
+			args: args{
+				login: &Login{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now},
+			},
+			want: &Result{Country: "CH"},
+		},
+		{
+			name: "known device and network, no signals",
+			args: args{
+				login: &Login{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now},
+				history: []*Login{
+					{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.200"), Time: now.Add(-time.Hour)},
+				},
+			},
+			want: &Result{Country: "CH"},
+		},
+		{
+			name: "new device",
+			args: args{
+				login: &Login{FingerprintID: "fp2", IP: net.ParseIP("10.0.0.1"), Time: now},
+				history: []*Login{
+					{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now.Add(-time.Hour)},
+				},
+			},
+			want: &Result{
+				Signals: []domain.LoginRiskSignal{domain.LoginRiskSignalNewDevice},
+				Country: "CH",
+			},
+		},
+		{
+			name: "new network, reachable",
+			args: args{
+				login: &Login{FingerprintID: "fp1", IP: net.ParseIP("10.0.1.1"), Time: now},
+				history: []*Login{
+					{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now.Add(-time.Hour)},
+				},
+			},
+			want: &Result{
+				Signals: []domain.LoginRiskSignal{domain.LoginRiskSignalNewNetwork},
+				Country: "CH",
+			},
+		},
+		{
+			name: "impossible travel",
+			args: args{
+				login: &Login{FingerprintID: "fp1", IP: net.ParseIP("10.1.0.1"), Time: now},
+				history: []*Login{
+					{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now.Add(-time.Hour)},
+				},
+			},
+			want: &Result{
+				Signals: []domain.LoginRiskSignal{domain.LoginRiskSignalNewNetwork, domain.LoginRiskSignalImpossibleTravel},
+				Country: "US",
+			},
+		},
+		{
+			name: "possible travel",
+			args: args{
+				login: &Login{FingerprintID: "fp1", IP: net.ParseIP("10.1.0.1"), Time: now},
+				history: []*Login{
+					{FingerprintID: "fp1", IP: net.ParseIP("10.0.0.1"), Time: now.Add(-24 * time.Hour)},
+				},
+			},
+			want: &Result{
+				Signals: []domain.LoginRiskSignal{domain.LoginRiskSignalNewNetwork},
+				Country: "US",
+			},
+		},
+		{
+			name: "ipv6 same network",
+			args: args{
+				login: &Login{IP: net.ParseIP("2001:db8:0:1::1"), Time: now},
+				history: []*Login{
+					{IP: net.ParseIP("2001:db8:0:2::1"), Time: now.Add(-time.Hour)},
+				},
+			},
+			want: &Result{Country: "DE"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Evaluator{
+				geoIP:          geoIP,
+				maxTravelSpeed: 1000,
+			}
+			got := e.Evaluate(tt.args.login, tt.args.history)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -197,3 +197,55 @@ func (c *crdbStorage) RemoveInstanceObjects(ctx context.Context, instanceID stri
 	}
 	return nil
 }
+
+func (c *crdbStorage) ListInstanceObjects(ctx context.Context, instanceID string) (_ []*static.Asset, err error) {
+	query, args, err := squirrel.Select(AssetColResourceOwner, AssetColName, AssetColType, AssetColContentType, AssetColLocation, "length("+AssetColData+")", AssetColHash, AssetColUpdatedAt).
+		From(assetsTable).
+		Where(squirrel.Eq{
+			AssetColInstanceID: instanceID,
+		}).
+		OrderBy(AssetColResourceOwner, AssetColName).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "DATAB-ieL6o", "Errors.Internal")
+	}
+	rows, err := c.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "DATAB-Iu3ee", "Errors.Assets.Object.ListFailed")
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if err == nil && closeErr != nil {
+			err = zerrors.ThrowInternal(closeErr, "DATAB-eiF3a", "Errors.Assets.Object.ListFailed")
+		}
+	}()
+	var assets []*static.Asset
+	for rows.Next() {
+		var (
+			objectType string
+			location   sql.NullString
+		)
+		asset := &static.Asset{InstanceID: instanceID}
+		err = rows.Scan(
+			&asset.ResourceOwner,
+			&asset.Name,
+			&objectType,
+			&asset.ContentType,
+			&location,
+			&asset.Size,
+			&asset.Hash,
+			&asset.LastModified,
+		)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "DATAB-Oong7", "Errors.Assets.Object.ListFailed")
+		}
+		asset.Type = static.ObjectTypeFromString(objectType)
+		asset.Location = location.String
+		assets = append(assets, asset)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "DATAB-aeR1c", "Errors.Assets.Object.ListFailed")
+	}
+	return assets, nil
+}
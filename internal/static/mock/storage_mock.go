@@ -87,6 +87,21 @@ func (mr *MockStorageMockRecorder) PutObject(ctx, instanceID, location, resource
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockStorage)(nil).PutObject), ctx, instanceID, location, resourceOwner, name, contentType, objectType, object, objectSize)
 }
 
+// ListInstanceObjects mocks base method.
+func (m *MockStorage) ListInstanceObjects(ctx context.Context, instanceID string) ([]*static.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceObjects", ctx, instanceID)
+	ret0, _ := ret[0].([]*static.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceObjects indicates an expected call of ListInstanceObjects.
+func (mr *MockStorageMockRecorder) ListInstanceObjects(ctx, instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceObjects", reflect.TypeOf((*MockStorage)(nil).ListInstanceObjects), ctx, instanceID)
+}
+
 // RemoveInstanceObjects mocks base method.
 func (m *MockStorage) RemoveInstanceObjects(ctx context.Context, instanceID string) error {
 	m.ctrl.T.Helper()
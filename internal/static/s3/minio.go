@@ -101,6 +101,8 @@ func (m *Minio) RemoveObjects(ctx context.Context, instanceID, resourceOwner str
 	switch objectType {
 	case static.ObjectTypeStyling:
 		path = domain.LabelPolicyPrefix + "/"
+	case static.ObjectTypeLanguageBundle:
+		path = domain.LanguageBundlePrefix + "/"
 	default:
 		return nil
 	}
@@ -141,6 +143,36 @@ func (m *Minio) RemoveInstanceObjects(ctx context.Context, instanceID string) er
 	return m.Client.RemoveBucket(ctx, bucketName)
 }
 
+func (m *Minio) ListInstanceObjects(ctx context.Context, instanceID string) ([]*static.Asset, error) {
+	bucketName := m.prefixBucketName(instanceID)
+	objects, cancel := m.listObjects(ctx, bucketName, "", true)
+	defer cancel()
+	var assets []*static.Asset
+	for object := range objects {
+		if err := object.Err; err != nil {
+			if errResp := minio.ToErrorResponse(err); errResp.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, zerrors.ThrowInternal(err, "MINIO-ahX6o", "Errors.Assets.Object.ListFailed")
+		}
+		// objects are stored as resourceOwner/name
+		resourceOwner, name, ok := strings.Cut(object.Key, "/")
+		if !ok {
+			continue
+		}
+		asset := m.objectToAssetInfo(instanceID, resourceOwner, object)
+		asset.Name = name
+		if strings.HasPrefix(name, domain.LabelPolicyPrefix+"/") {
+			asset.Type = static.ObjectTypeStyling
+		}
+		if strings.HasPrefix(name, domain.LanguageBundlePrefix+"/") {
+			asset.Type = static.ObjectTypeLanguageBundle
+		}
+		assets = append(assets, asset)
+	}
+	return assets, nil
+}
+
 func (m *Minio) createBucket(ctx context.Context, name, location string) error {
 	if location == "" {
 		location = m.Location
@@ -16,6 +16,8 @@ type Storage interface {
 	RemoveObject(ctx context.Context, instanceID, resourceOwner, name string) error
 	RemoveObjects(ctx context.Context, instanceID, resourceOwner string, objectType ObjectType) error
 	RemoveInstanceObjects(ctx context.Context, instanceID string) error
+	// ListInstanceObjects returns the information of all objects of the instance
+	ListInstanceObjects(ctx context.Context, instanceID string) ([]*Asset, error)
 	//TODO: add functionality to move asset location
 }
 
@@ -24,14 +26,28 @@ type ObjectType int32
 const (
 	ObjectTypeUserAvatar ObjectType = iota
 	ObjectTypeStyling
+	ObjectTypeLanguageBundle
 )
 
+// ObjectTypeFromString parses the representation of [ObjectType.String]
+func ObjectTypeFromString(s string) ObjectType {
+	switch s {
+	case ObjectTypeStyling.String():
+		return ObjectTypeStyling
+	case ObjectTypeLanguageBundle.String():
+		return ObjectTypeLanguageBundle
+	}
+	return ObjectTypeUserAvatar
+}
+
 func (o ObjectType) String() string {
 	switch o {
 	case ObjectTypeUserAvatar:
 		return "0"
 	case ObjectTypeStyling:
 		return "1"
+	case ObjectTypeLanguageBundle:
+		return "2"
 	default:
 		return ""
 	}
@@ -46,6 +62,7 @@ type Asset struct {
 	LastModified  time.Time
 	Location      string
 	ContentType   string
+	Type          ObjectType
 }
 
 func (a *Asset) VersionedName() string {
@@ -22,6 +22,7 @@ type UserSessionView struct {
 	PasswordVerification         time.Time
 	PasswordlessVerification     time.Time
 	ExternalLoginVerification    time.Time
+	MagicLinkVerification        time.Time
 	SecondFactorVerification     time.Time
 	SecondFactorVerificationType domain.MFAType
 	MultiFactorVerification      time.Time
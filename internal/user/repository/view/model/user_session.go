@@ -31,6 +31,7 @@ const (
 	UserSessionKeyMultiFactorVerificationType  = "multi_factor_verification_type"
 	UserSessionKeyPasswordlessVerification     = "passwordless_verification"
 	UserSessionKeyExternalLoginVerification    = "external_login_verification"
+	UserSessionKeyMagicLinkVerification        = "magic_link_verification"
 	UserSessionKeySelectedIDPConfigID          = "selected_idp_config_id"
 	UserSessionKeyID                           = "id"
 )
@@ -54,6 +55,7 @@ type UserSessionView struct {
 	PasswordVerification         sql.NullTime   `json:"-" gorm:"column:password_verification"`
 	PasswordlessVerification     sql.NullTime   `json:"-" gorm:"column:passwordless_verification"`
 	ExternalLoginVerification    sql.NullTime   `json:"-" gorm:"column:external_login_verification"`
+	MagicLinkVerification        sql.NullTime   `json:"-" gorm:"column:magic_link_verification"`
 	SecondFactorVerification     sql.NullTime   `json:"-" gorm:"column:second_factor_verification"`
 	SecondFactorVerificationType sql.NullInt32  `json:"-" gorm:"column:second_factor_verification_type"`
 	MultiFactorVerification      sql.NullTime   `json:"-" gorm:"column:multi_factor_verification"`
@@ -97,6 +99,7 @@ func UserSessionToModel(userSession *UserSessionView) *model.UserSessionView {
 		PasswordVerification:         userSession.PasswordVerification.Time,
 		PasswordlessVerification:     userSession.PasswordlessVerification.Time,
 		ExternalLoginVerification:    userSession.ExternalLoginVerification.Time,
+		MagicLinkVerification:        userSession.MagicLinkVerification.Time,
 		SecondFactorVerification:     userSession.SecondFactorVerification.Time,
 		SecondFactorVerificationType: domain.MFAType(userSession.SecondFactorVerificationType.Int32),
 		MultiFactorVerification:      userSession.MultiFactorVerification.Time,
@@ -184,6 +187,11 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		if v.UserAgentID == data.UserAgentID {
 			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeOTPEmail)
 		}
+	case user.HumanMagicLinkCheckSucceededType:
+		v.MagicLinkVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
+		v.State.V = domain.UserSessionStateActive
+	case user.HumanMagicLinkCheckFailedType:
+		v.MagicLinkVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 	case user.UserV1MFAOTPCheckFailedType,
 		user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPCheckFailedType,
@@ -216,6 +224,7 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		v.MultiFactorVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 		v.MultiFactorVerificationType = sql.NullInt32{Int32: int32(domain.MFALevelNotSetUp)}
 		v.ExternalLoginVerification = sql.NullTime{Time: time.Time{}, Valid: true}
+		v.MagicLinkVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 		v.State.V = domain.UserSessionStateTerminated
 	case user.UserIDPLinkRemovedType, user.UserIDPLinkCascadeRemovedType:
 		v.ExternalLoginVerification = sql.NullTime{Time: time.Time{}, Valid: true}
@@ -253,6 +262,8 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanOTPSMSCheckFailedType,
 		user.HumanOTPEmailCheckSucceededType,
 		user.HumanOTPEmailCheckFailedType,
+		user.HumanMagicLinkCheckSucceededType,
+		user.HumanMagicLinkCheckFailedType,
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType,
 		user.HumanU2FTokenVerifiedType,
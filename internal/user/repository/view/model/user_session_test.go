@@ -194,6 +194,22 @@ func TestAppendEvent(t *testing.T) {
 			},
 			result: &UserSessionView{ChangeDate: now(), SecondFactorVerification: sql.NullTime{Time: time.Time{}, Valid: true}},
 		},
+		{
+			name: "append human magic link check succeeded event",
+			args: args{
+				event:    &es_models.Event{CreationDate: now(), Typ: user.HumanMagicLinkCheckSucceededType},
+				userView: &UserSessionView{},
+			},
+			result: &UserSessionView{ChangeDate: now(), MagicLinkVerification: sql.NullTime{Time: now(), Valid: true}},
+		},
+		{
+			name: "append human magic link check failed event",
+			args: args{
+				event:    &es_models.Event{CreationDate: now(), Typ: user.HumanMagicLinkCheckFailedType},
+				userView: &UserSessionView{MagicLinkVerification: sql.NullTime{Time: now(), Valid: true}},
+			},
+			result: &UserSessionView{ChangeDate: now(), MagicLinkVerification: sql.NullTime{Time: time.Time{}, Valid: true}},
+		},
 		{
 			name: "append user signed out event",
 			args: args{
@@ -210,6 +226,7 @@ func TestAppendEvent(t *testing.T) {
 				ExternalLoginVerification: sql.NullTime{Time: time.Time{}, Valid: true},
 				PasswordlessVerification:  sql.NullTime{Time: time.Time{}, Valid: true},
 				MultiFactorVerification:   sql.NullTime{Time: time.Time{}, Valid: true},
+				MagicLinkVerification:     sql.NullTime{Time: time.Time{}, Valid: true},
 				State:                     sql.Null[domain.UserSessionState]{V: domain.UserSessionStateTerminated},
 			},
 		},
@@ -229,6 +246,7 @@ func TestAppendEvent(t *testing.T) {
 				ExternalLoginVerification: sql.NullTime{Time: time.Time{}, Valid: true},
 				PasswordlessVerification:  sql.NullTime{Time: time.Time{}, Valid: true},
 				MultiFactorVerification:   sql.NullTime{Time: time.Time{}, Valid: true},
+				MagicLinkVerification:     sql.NullTime{Time: time.Time{}, Valid: true},
 				State:                     sql.Null[domain.UserSessionState]{V: domain.UserSessionStateTerminated},
 			},
 		},
@@ -117,6 +117,7 @@ func scanUserSession(row *sql.Row) (*model.UserSessionView, error) {
 		&session.PasswordVerification,
 		&session.PasswordlessVerification,
 		&session.ExternalLoginVerification,
+		&session.MagicLinkVerification,
 		&session.SecondFactorVerification,
 		&session.SecondFactorVerificationType,
 		&session.MultiFactorVerification,
@@ -150,6 +151,7 @@ func scanUserSessions(rows *sql.Rows) ([]*model.UserSessionView, error) {
 			&session.PasswordVerification,
 			&session.PasswordlessVerification,
 			&session.ExternalLoginVerification,
+			&session.MagicLinkVerification,
 			&session.SecondFactorVerification,
 			&session.SecondFactorVerificationType,
 			&session.MultiFactorVerification,
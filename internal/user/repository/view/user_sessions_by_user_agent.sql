@@ -12,6 +12,7 @@ SELECT s.creation_date,
        s.password_verification,
        s.passwordless_verification,
        s.external_login_verification,
+       s.magic_link_verification,
        s.second_factor_verification,
        s.second_factor_verification_type,
        s.multi_factor_verification,
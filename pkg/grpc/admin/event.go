@@ -77,3 +77,10 @@ func (resp *ListEventsResponse) Localizers() []middleware.Localizer {
 	}
 	return localizers
 }
+
+func (resp *StreamEventsResponse) Localizers() []middleware.Localizer {
+	if resp == nil || resp.GetEvent() == nil {
+		return nil
+	}
+	return []middleware.Localizer{resp.GetEvent().Type.Localized, resp.GetEvent().Aggregate.Type.Localized}
+}